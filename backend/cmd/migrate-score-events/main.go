@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+
+	"pet-of-the-day/internal/points/application/services"
+	pointsinfra "pet-of-the-day/internal/points/infrastructure/ent"
+	"pet-of-the-day/internal/shared/database"
+
+	_ "github.com/lib/pq"
+)
+
+// Converts the score events of the legacy scoring system into behavior logs shared with their
+// group, then recomputes the daily scores of the days they land on. The database is read from
+// DATABASE_URL or the DB_* variables, like the server.
+func main() {
+	dryRun := flag.Bool("dry-run", false, "report what would be migrated without writing anything")
+	verify := flag.Bool("verify", false, "only compare migrated behavior logs with their score events")
+	flag.Parse()
+
+	repoFactory, err := database.NewRepositoryFactory()
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer repoFactory.Close()
+
+	client := repoFactory.GetEntClient()
+	if client == nil {
+		log.Fatal("Score events can only be migrated in a database")
+	}
+
+	authRepo := pointsinfra.NewAuthorizationRepository(client)
+	userSettingsRepo := repoFactory.CreateUserSettingsRepository()
+	migrationService := services.NewScoreEventMigrationService(
+		pointsinfra.NewScoreEventRepository(client),
+		pointsinfra.NewBehaviorLogRepository(client, authRepo, userSettingsRepo),
+		pointsinfra.NewDailyScoreRepository(client, authRepo, userSettingsRepo),
+		authRepo,
+		userSettingsRepo,
+	)
+
+	ctx := context.Background()
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	if !*verify {
+		report, err := migrationService.Migrate(ctx, *dryRun)
+		if err != nil {
+			log.Fatalf("Migration failed: %v", err)
+		}
+		log.Printf("Scanned %d score events: %d migrated, %d already migrated, %d skipped, %d daily scores recalculated (dry run: %t)",
+			report.Scanned, report.Migrated, report.AlreadyMigrated, len(report.Skipped), report.RecalculatedDays, report.DryRun)
+		if err := encoder.Encode(report); err != nil {
+			log.Fatalf("Failed to write migration report: %v", err)
+		}
+		if *dryRun {
+			return
+		}
+	}
+
+	report, err := migrationService.Verify(ctx)
+	if err != nil {
+		log.Fatalf("Verification failed: %v", err)
+	}
+	log.Printf("Verified %d of %d score events, %d mismatches", report.Verified, report.Checked, len(report.Mismatches))
+	if err := encoder.Encode(report); err != nil {
+		log.Fatalf("Failed to write verification report: %v", err)
+	}
+	if len(report.Mismatches) > 0 {
+		os.Exit(1)
+	}
+}
@@ -7,6 +7,11 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	GroupCreatedEventType       = "community.group.created"
+	MembershipAcceptedEventType = "community.membership.accepted"
+)
+
 type GroupCreatedEvent struct {
 	events.BaseEvent
 	GroupID   uuid.UUID `json:"group_id"`
@@ -17,7 +22,7 @@ type GroupCreatedEvent struct {
 
 func NewGroupCreatedEvent(groupID uuid.UUID, groupName string, creatorID uuid.UUID) *GroupCreatedEvent {
 	return &GroupCreatedEvent{
-		BaseEvent: events.NewBaseEvent("community.group.created", groupID),
+		BaseEvent: events.NewBaseEvent(GroupCreatedEventType, groupID),
 		GroupID:   groupID,
 		GroupName: groupName,
 		CreatorID: creatorID,
@@ -53,7 +58,7 @@ type MembershipAcceptedEvent struct {
 
 func NewMembershipAcceptedEvent(groupID, userID uuid.UUID, petIDs []uuid.UUID) *MembershipAcceptedEvent {
 	return &MembershipAcceptedEvent{
-		BaseEvent:  events.NewBaseEvent("community.membership.accepted", groupID),
+		BaseEvent:  events.NewBaseEvent(MembershipAcceptedEventType, groupID),
 		GroupID:    groupID,
 		UserID:     userID,
 		PetIDs:     petIDs,
@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/events"
+)
+
+// AddCommentCommand represents a command to comment on a behavior log shared with a group
+type AddCommentCommand struct {
+	BehaviorLogID uuid.UUID  `json:"behavior_log_id" validate:"required"`
+	GroupID       uuid.UUID  `json:"group_id" validate:"required"`
+	UserID        uuid.UUID  `json:"user_id" validate:"required"`
+	ParentID      *uuid.UUID `json:"parent_id,omitempty"` // Comment replied to
+	Body          string     `json:"body" validate:"required"`
+}
+
+// AddCommentHandler handles new comments on behavior logs
+type AddCommentHandler struct {
+	behaviorLogRepo domain.BehaviorLogRepository
+	commentRepo     domain.CommentRepository
+	authRepo        domain.AuthorizationRepository
+	eventBus        events.Bus
+}
+
+// NewAddCommentHandler creates a new add comment handler
+func NewAddCommentHandler(
+	behaviorLogRepo domain.BehaviorLogRepository,
+	commentRepo domain.CommentRepository,
+	authRepo domain.AuthorizationRepository,
+	eventBus events.Bus,
+) *AddCommentHandler {
+	return &AddCommentHandler{
+		behaviorLogRepo: behaviorLogRepo,
+		commentRepo:     commentRepo,
+		authRepo:        authRepo,
+		eventBus:        eventBus,
+	}
+}
+
+// Handle executes the add comment command
+func (h *AddCommentHandler) Handle(ctx context.Context, cmd *AddCommentCommand) (*domain.Comment, error) {
+	if _, err := getSharedBehaviorLog(ctx, h.behaviorLogRepo, h.authRepo, cmd.UserID, cmd.BehaviorLogID, cmd.GroupID); err != nil {
+		return nil, err
+	}
+
+	var parent *domain.Comment
+	if cmd.ParentID != nil {
+		var err error
+		parent, err = h.commentRepo.GetByID(ctx, *cmd.ParentID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get comment: %w", err)
+		}
+		if parent == nil || parent.GroupID != cmd.GroupID {
+			return nil, &NotFoundError{Resource: "comment", ID: cmd.ParentID.String()}
+		}
+	}
+
+	comment, err := domain.NewComment(cmd.BehaviorLogID, cmd.GroupID, cmd.UserID, parent, cmd.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.commentRepo.Create(ctx, comment); err != nil {
+		return nil, fmt.Errorf("failed to save comment: %w", err)
+	}
+
+	h.eventBus.Publish(ctx, domain.NewCommentPostedEvent(comment))
+
+	return comment, nil
+}
+
+// getSharedBehaviorLog returns a behavior log shared with a group the user is a member of.
+// Comments and reactions live in a group share, so the log is not found in other groups.
+func getSharedBehaviorLog(ctx context.Context, behaviorLogRepo domain.BehaviorLogRepository, authRepo domain.AuthorizationRepository, userID, behaviorLogID, groupID uuid.UUID) (*domain.BehaviorLog, error) {
+	canAccess, err := authRepo.CanUserAccessGroup(ctx, userID, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check group access: %w", err)
+	}
+	if !canAccess {
+		return nil, &AuthorizationError{Message: "user is not a member of this group"}
+	}
+
+	behaviorLog, err := behaviorLogRepo.GetByID(ctx, behaviorLogID)
+	if errors.Is(err, domain.ErrBehaviorLogNotFound) {
+		return nil, &NotFoundError{Resource: "behavior log", ID: behaviorLogID.String()}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get behavior log: %w", err)
+	}
+	if behaviorLog.GetGroupShare(groupID) == nil {
+		return nil, &NotFoundError{Resource: "behavior log", ID: behaviorLogID.String()}
+	}
+
+	return behaviorLog, nil
+}
@@ -0,0 +1,92 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/services"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// AdjustPointsCommand represents a command to add or remove points of a pet in a group
+// outside of behavior logs. Without a date the adjustment counts for the current group day.
+type AdjustPointsCommand struct {
+	GroupID uuid.UUID                  `json:"group_id" validate:"required"`
+	PetID   uuid.UUID                  `json:"pet_id" validate:"required"`
+	UserID  uuid.UUID                  `json:"user_id" validate:"required"`
+	Points  int                        `json:"points" validate:"required"`
+	Kind    domain.PointAdjustmentKind `json:"kind" validate:"required"`
+	Reason  string                     `json:"reason" validate:"required"`
+	Date    *time.Time                 `json:"date,omitempty"`
+}
+
+// AdjustPointsResult represents the result of a point adjustment
+type AdjustPointsResult struct {
+	Adjustment *domain.PointAdjustment `json:"adjustment"`
+}
+
+// AdjustPointsHandler handles manual point adjustments and penalties made by group admins
+type AdjustPointsHandler struct {
+	ledgerService *services.PointLedgerService
+	authRepo      domain.AuthorizationRepository
+}
+
+// NewAdjustPointsHandler creates a new adjust points handler
+func NewAdjustPointsHandler(
+	ledgerService *services.PointLedgerService,
+	authRepo domain.AuthorizationRepository,
+) *AdjustPointsHandler {
+	return &AdjustPointsHandler{
+		ledgerService: ledgerService,
+		authRepo:      authRepo,
+	}
+}
+
+// Handle executes the adjust points command
+func (h *AdjustPointsHandler) Handle(ctx context.Context, cmd *AdjustPointsCommand) (*AdjustPointsResult, error) {
+	if err := requireGroupAdmin(ctx, h.authRepo, cmd.UserID, cmd.GroupID); err != nil {
+		return nil, err
+	}
+
+	// Challenge bonuses and reversals are only recorded by the application
+	if cmd.Kind != domain.PointAdjustmentKindManual && cmd.Kind != domain.PointAdjustmentKindPenalty {
+		return nil, fmt.Errorf("only manual adjustments and penalties can be recorded")
+	}
+
+	isInGroup, err := h.authRepo.IsPetInGroup(ctx, cmd.PetID, cmd.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check pet group membership: %w", err)
+	}
+	if !isInGroup {
+		return nil, &NotFoundError{Resource: "pet", ID: cmd.PetID.String()}
+	}
+
+	today, err := h.ledgerService.GroupDay(ctx, cmd.GroupID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	date := today
+	if cmd.Date != nil {
+		date = time.Date(cmd.Date.Year(), cmd.Date.Month(), cmd.Date.Day(), 0, 0, 0, 0, today.Location())
+		if date.After(today) {
+			return nil, fmt.Errorf("points cannot be adjusted for a future day")
+		}
+	}
+
+	adjustment, err := domain.NewPointAdjustment(cmd.PetID, cmd.GroupID, cmd.UserID, date, cmd.Points, cmd.Kind, cmd.Reason)
+	if err != nil {
+		return nil, fmt.Errorf("invalid point adjustment: %w", err)
+	}
+
+	if err := h.ledgerService.Record(ctx, adjustment); err != nil {
+		return nil, err
+	}
+
+	return &AdjustPointsResult{
+		Adjustment: adjustment,
+	}, nil
+}
@@ -0,0 +1,142 @@
+package commands
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/services"
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+	"pet-of-the-day/internal/shared/events"
+)
+
+func TestAdjustPointsHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	behaviorLogRepo := mock.NewMockBehaviorLogRepository()
+	adjustmentRepo := mock.NewMockPointAdjustmentRepository()
+	dailyScoreRepo := mock.NewMockDailyScoreRepository().WithRecalculationSources(behaviorLogRepo, adjustmentRepo)
+	authRepo := mock.NewMockAuthorizationRepository()
+	bus := events.NewInMemoryEventBus()
+	adjusted := recordEvents(bus, domain.PointsAdjustedEventType)
+
+	ledgerService := services.NewPointLedgerService(adjustmentRepo, dailyScoreRepo, authRepo, mock.NewMockUserSettingsRepository(), bus)
+	adjustHandler := NewAdjustPointsHandler(ledgerService, authRepo)
+	reverseHandler := NewReversePointAdjustmentHandler(adjustmentRepo, ledgerService, authRepo)
+
+	adminID, memberID, groupID, petID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	authRepo.AddUserGroup(adminID, groupID, &domain.GroupInfo{ID: groupID, Name: "Park", OwnerID: adminID})
+	authRepo.AddUserGroup(memberID, groupID, &domain.GroupInfo{ID: groupID, Name: "Park", OwnerID: adminID})
+	authRepo.AddPetToGroup(petID, groupID)
+
+	date := time.Now().AddDate(0, 0, -2)
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	sit := &domain.BehaviorLog{ID: uuid.New(), PetID: petID, BehaviorID: uuid.New(), UserID: memberID, PointsAwarded: 5, LoggedAt: day.Add(12 * time.Hour)}
+	sit.AddGroupShare(groupID)
+	behaviorLogRepo.Create(ctx, sit)
+	score, _ := dailyScoreRepo.GetOrCreate(ctx, petID, groupID, day)
+	score.AddBehaviorLog(sit)
+
+	penalty := &AdjustPointsCommand{
+		GroupID: groupID,
+		PetID:   petID,
+		UserID:  adminID,
+		Points:  -8,
+		Kind:    domain.PointAdjustmentKindPenalty,
+		Reason:  "Logged behaviors for another pet",
+		Date:    &day,
+	}
+
+	t.Run("Not group admin", func(t *testing.T) {
+		cmd := *penalty
+		cmd.UserID = memberID
+		if _, err := adjustHandler.Handle(ctx, &cmd); err == nil {
+			t.Fatal("expected authorization error")
+		} else if _, ok := err.(*AuthorizationError); !ok {
+			t.Errorf("expected AuthorizationError, got %T", err)
+		}
+	})
+
+	t.Run("Challenge bonuses cannot be recorded by hand", func(t *testing.T) {
+		cmd := *penalty
+		cmd.Points, cmd.Kind = 8, domain.PointAdjustmentKindChallengeBonus
+		if _, err := adjustHandler.Handle(ctx, &cmd); err == nil {
+			t.Error("expected error")
+		}
+	})
+
+	t.Run("Pet outside the group", func(t *testing.T) {
+		cmd := *penalty
+		cmd.PetID = uuid.New()
+		if _, err := adjustHandler.Handle(ctx, &cmd); err == nil {
+			t.Fatal("expected not found error")
+		} else if _, ok := err.(*NotFoundError); !ok {
+			t.Errorf("expected NotFoundError, got %T", err)
+		}
+	})
+
+	t.Run("Future day", func(t *testing.T) {
+		cmd := *penalty
+		future := time.Now().AddDate(0, 0, 2)
+		cmd.Date = &future
+		if _, err := adjustHandler.Handle(ctx, &cmd); err == nil {
+			t.Error("expected error")
+		}
+	})
+
+	var recorded *domain.PointAdjustment
+	t.Run("Penalty counts toward the total only", func(t *testing.T) {
+		result, err := adjustHandler.Handle(ctx, penalty)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		recorded = result.Adjustment
+
+		if score.TotalPoints != -3 || score.BehaviorPointTotal != 5 {
+			t.Errorf("expected -3 total points and 5 behavior points, got %+v", score)
+		}
+		if event, ok := adjusted.last().(*domain.PointsAdjustedEvent); !ok || event.AdjustmentID != recorded.ID {
+			t.Errorf("expected a points adjusted event, got %v", adjusted.last())
+		}
+	})
+
+	t.Run("Recalculation replays the ledger", func(t *testing.T) {
+		score.TotalPoints = 0
+		recalculated, err := dailyScoreRepo.RecalculateFromLogs(ctx, petID, groupID, day)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if recalculated.TotalPoints != -3 || recalculated.AdjustmentPoints() != -8 {
+			t.Errorf("expected the penalty to survive recalculation, got %+v", recalculated)
+		}
+	})
+
+	t.Run("Reversal cancels the penalty once", func(t *testing.T) {
+		cmd := &ReversePointAdjustmentCommand{
+			GroupID:      groupID,
+			AdjustmentID: recorded.ID,
+			UserID:       adminID,
+			Reason:       "Appeal accepted",
+		}
+		result, err := reverseHandler.Handle(ctx, cmd)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Reversal.Points != 8 || score.TotalPoints != 5 {
+			t.Errorf("expected the penalty to be cancelled, got %+v and %+v", result.Reversal, score)
+		}
+
+		if _, err := reverseHandler.Handle(ctx, cmd); err == nil {
+			t.Error("expected error when reversing twice")
+		}
+
+		ledger, _ := adjustmentRepo.Find(ctx, domain.NewPointAdjustmentFilter().WithPet(petID))
+		if len(ledger) != 2 {
+			t.Errorf("expected both entries kept in the ledger, got %d", len(ledger))
+		}
+	})
+}
@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/services"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// BackfillBadgesCommand represents a command to award the badges earned before badges
+// existed, or after a rule was added to the catalog
+type BackfillBadgesCommand struct {
+	UserID       uuid.UUID  `json:"user_id" validate:"required"`
+	TargetUserID *uuid.UUID `json:"target_user_id,omitempty"` // Limits the backfill to one user and their pets
+}
+
+// BackfillBadgesResult represents the result of a badge backfill
+type BackfillBadgesResult struct {
+	UsersEvaluated int `json:"users_evaluated"`
+	PetsEvaluated  int `json:"pets_evaluated"`
+	BadgesAwarded  int `json:"badges_awarded"`
+}
+
+// BackfillBadgesHandler handles retroactive badge awards
+type BackfillBadgesHandler struct {
+	badgeService *services.BadgeService
+	authRepo     domain.AuthorizationRepository
+	adminChecker domain.AdminChecker
+}
+
+// NewBackfillBadgesHandler creates a new backfill badges handler
+func NewBackfillBadgesHandler(
+	badgeService *services.BadgeService,
+	authRepo domain.AuthorizationRepository,
+	adminChecker domain.AdminChecker,
+) *BackfillBadgesHandler {
+	return &BackfillBadgesHandler{
+		badgeService: badgeService,
+		authRepo:     authRepo,
+		adminChecker: adminChecker,
+	}
+}
+
+// Handle executes the backfill badges command. Badges already held are skipped, so the
+// backfill can be run again safely.
+func (h *BackfillBadgesHandler) Handle(ctx context.Context, cmd *BackfillBadgesCommand) (*BackfillBadgesResult, error) {
+	if err := requireAdmin(ctx, h.adminChecker, cmd.UserID); err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]uuid.UUID, 0, 1)
+	if cmd.TargetUserID != nil {
+		userIDs = append(userIDs, *cmd.TargetUserID)
+	} else {
+		allUserIDs, err := h.authRepo.GetAllUsers(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get users: %w", err)
+		}
+		userIDs = allUserIDs
+	}
+
+	result := &BackfillBadgesResult{}
+	for _, userID := range userIDs {
+		awarded, err := h.badgeService.EvaluateUser(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate badges of user %s: %w", userID, err)
+		}
+		result.UsersEvaluated++
+		result.BadgesAwarded += len(awarded)
+
+		petIDs, err := h.authRepo.GetUserPets(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user pets: %w", err)
+		}
+
+		for _, petID := range petIDs {
+			awarded, err := h.badgeService.EvaluatePet(ctx, petID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to evaluate badges of pet %s: %w", petID, err)
+			}
+			result.PetsEvaluated++
+			result.BadgesAwarded += len(awarded)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/services"
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+	"pet-of-the-day/internal/shared/auth"
+	"pet-of-the-day/internal/shared/events"
+)
+
+func TestBackfillBadgesHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+	adminID := uuid.New()
+
+	behaviorLogRepo := mock.NewMockBehaviorLogRepository()
+	petOfTheDayRepo := mock.NewMockPetOfTheDayRepository()
+	authRepo := mock.NewMockAuthorizationRepository()
+	badgeRepo := mock.NewMockBadgeRepository()
+	bus := events.NewInMemoryEventBus()
+	awarded := recordEvents(bus, domain.BadgeAwardedEventType)
+
+	streakService := services.NewStreakService(
+		mock.NewMockStreakRepository(),
+		behaviorLogRepo,
+		mock.NewMockBehaviorRepository(),
+		mock.NewMockGroupBehaviorRepository(),
+		petOfTheDayRepo,
+		authRepo,
+		mock.NewMockUserSettingsRepository(),
+		bus,
+	)
+	badgeService := services.NewBadgeService(badgeRepo, behaviorLogRepo, petOfTheDayRepo, authRepo, streakService, bus)
+	handler := NewBackfillBadgesHandler(badgeService, authRepo, auth.NewAdminAllowlist(adminID))
+
+	// Two owners with a past Pet of the Day win each
+	owners := []uuid.UUID{uuid.New(), uuid.New()}
+	for _, ownerID := range owners {
+		petID, groupID := uuid.New(), uuid.New()
+		authRepo.AddUserPet(ownerID, petID, &domain.PetInfo{ID: petID, Name: "Rex", Species: domain.SpeciesDog, OwnerID: ownerID})
+		petOfTheDayRepo.Create(ctx, domain.NewPetOfTheDayWinner(groupID, petID, "Rex", "Owner", time.Now().AddDate(0, 0, -30), 10, 2, 0))
+	}
+
+	t.Run("Not admin", func(t *testing.T) {
+		_, err := handler.Handle(ctx, &BackfillBadgesCommand{UserID: owners[0]})
+		if _, ok := err.(*AuthorizationError); !ok {
+			t.Fatalf("expected authorization error, got %v", err)
+		}
+	})
+
+	t.Run("Single user", func(t *testing.T) {
+		result, err := handler.Handle(ctx, &BackfillBadgesCommand{UserID: adminID, TargetUserID: &owners[0]})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.UsersEvaluated != 1 || result.PetsEvaluated != 1 || result.BadgesAwarded != 1 {
+			t.Errorf("unexpected result: %+v", result)
+		}
+	})
+
+	t.Run("All users", func(t *testing.T) {
+		result, err := handler.Handle(ctx, &BackfillBadgesCommand{UserID: adminID})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// The first owner's badge was already awarded by the previous run
+		if result.UsersEvaluated != 2 || result.PetsEvaluated != 2 || result.BadgesAwarded != 1 {
+			t.Errorf("unexpected result: %+v", result)
+		}
+		if count := len(awarded.events); count != 2 {
+			t.Errorf("expected 2 badge unlocks in total, got %d", count)
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/services"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// CastPetOfTheDayVoteCommand represents a command to vote for the Pet of the Day of the group
+// day in progress. Members cannot vote for their own pets.
+type CastPetOfTheDayVoteCommand struct {
+	GroupID uuid.UUID `json:"group_id" validate:"required"`
+	PetID   uuid.UUID `json:"pet_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// CastPetOfTheDayVoteResult represents the result of a vote, with the live tallies of the day
+type CastPetOfTheDayVoteResult struct {
+	Vote    *domain.PetOfTheDayVote `json:"vote"`
+	Tallies []*domain.VoteTally     `json:"tallies"`
+}
+
+// CastPetOfTheDayVoteHandler handles Pet of the Day votes
+type CastPetOfTheDayVoteHandler struct {
+	votingService *services.VotingService
+	authRepo      domain.AuthorizationRepository
+}
+
+// NewCastPetOfTheDayVoteHandler creates a new cast Pet of the Day vote handler
+func NewCastPetOfTheDayVoteHandler(
+	votingService *services.VotingService,
+	authRepo domain.AuthorizationRepository,
+) *CastPetOfTheDayVoteHandler {
+	return &CastPetOfTheDayVoteHandler{
+		votingService: votingService,
+		authRepo:      authRepo,
+	}
+}
+
+// Handle executes the cast Pet of the Day vote command
+func (h *CastPetOfTheDayVoteHandler) Handle(ctx context.Context, cmd *CastPetOfTheDayVoteCommand) (*CastPetOfTheDayVoteResult, error) {
+	canAccess, err := h.authRepo.CanUserAccessGroup(ctx, cmd.UserID, cmd.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check group access: %w", err)
+	}
+	if !canAccess {
+		return nil, &AuthorizationError{Message: "user is not a member of this group"}
+	}
+
+	isInGroup, err := h.authRepo.IsPetInGroup(ctx, cmd.PetID, cmd.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check pet group membership: %w", err)
+	}
+	if !isInGroup {
+		return nil, &NotFoundError{Resource: "pet", ID: cmd.PetID.String()}
+	}
+
+	isOwnPet, err := h.authRepo.CanUserAccessPet(ctx, cmd.UserID, cmd.PetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check pet ownership: %w", err)
+	}
+	if isOwnPet {
+		return nil, &AuthorizationError{Message: "members cannot vote for their own pets"}
+	}
+
+	vote, tallies, err := h.votingService.CastVote(ctx, cmd.GroupID, cmd.UserID, cmd.PetID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &CastPetOfTheDayVoteResult{
+		Vote:    vote,
+		Tallies: tallies,
+	}, nil
+}
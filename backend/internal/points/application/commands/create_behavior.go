@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/events"
+)
+
+// CreateBehaviorCommand represents a command to add a behavior to the global catalog
+type CreateBehaviorCommand struct {
+	UserID             uuid.UUID               `json:"user_id" validate:"required"`
+	Name               string                  `json:"name" validate:"required"`
+	Description        string                  `json:"description"`
+	Category           domain.BehaviorCategory `json:"category" validate:"required"`
+	PointValue         int                     `json:"point_value" validate:"required"`
+	MinIntervalMinutes int                     `json:"min_interval_minutes"`
+	Species            domain.Species          `json:"species" validate:"required"`
+	Icon               string                  `json:"icon"`
+}
+
+// CreateBehaviorResult represents the result of creating a behavior
+type CreateBehaviorResult struct {
+	Behavior *domain.Behavior `json:"behavior"`
+}
+
+// CreateBehaviorHandler handles the creation of global catalog behaviors
+type CreateBehaviorHandler struct {
+	behaviorRepo domain.BehaviorRepository
+	adminChecker domain.AdminChecker
+	eventBus     events.Bus
+}
+
+// NewCreateBehaviorHandler creates a new create behavior handler
+func NewCreateBehaviorHandler(
+	behaviorRepo domain.BehaviorRepository,
+	adminChecker domain.AdminChecker,
+	eventBus events.Bus,
+) *CreateBehaviorHandler {
+	return &CreateBehaviorHandler{
+		behaviorRepo: behaviorRepo,
+		adminChecker: adminChecker,
+		eventBus:     eventBus,
+	}
+}
+
+// Handle executes the create behavior command
+func (h *CreateBehaviorHandler) Handle(ctx context.Context, cmd *CreateBehaviorCommand) (*CreateBehaviorResult, error) {
+	if err := requireAdmin(ctx, h.adminChecker, cmd.UserID); err != nil {
+		return nil, err
+	}
+
+	existing, err := h.behaviorRepo.GetByName(ctx, cmd.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check behavior name: %w", err)
+	}
+	if existing != nil {
+		return nil, &domain.ValidationError{Message: fmt.Sprintf("behavior named '%s' already exists in the catalog", cmd.Name)}
+	}
+
+	minInterval := cmd.MinIntervalMinutes
+	if minInterval == 0 {
+		minInterval = defaultMinIntervalMinutes
+	}
+
+	behavior, err := domain.NewBehavior(
+		cmd.Name,
+		cmd.Description,
+		cmd.Category,
+		cmd.PointValue,
+		minInterval,
+		cmd.Species,
+		cmd.Icon,
+	)
+	if err != nil {
+		return nil, &domain.ValidationError{Message: fmt.Sprintf("invalid behavior: %v", err)}
+	}
+
+	if err := h.behaviorRepo.Create(ctx, behavior); err != nil {
+		return nil, fmt.Errorf("failed to save behavior: %w", err)
+	}
+
+	h.eventBus.Publish(ctx, domain.NewBehaviorCreatedEvent(behavior, cmd.UserID))
+
+	return &CreateBehaviorResult{
+		Behavior: behavior,
+	}, nil
+}
+
+// requireAdmin checks that the user may administer the global behavior catalog
+func requireAdmin(ctx context.Context, adminChecker domain.AdminChecker, userID uuid.UUID) error {
+	isAdmin, err := adminChecker.IsAdmin(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to check admin rights: %w", err)
+	}
+
+	if !isAdmin {
+		return &AuthorizationError{Message: "Only administrators can perform this action"}
+	}
+
+	return nil
+}
@@ -2,86 +2,167 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"mime/multipart"
 	"time"
 
 	"github.com/google/uuid"
 
+	"pet-of-the-day/internal/points/application/services"
 	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/events"
 )
 
 // CreateBehaviorLogCommand represents a command to create a new behavior log
 type CreateBehaviorLogCommand struct {
-	PetID      uuid.UUID   `json:"pet_id" validate:"required"`
-	BehaviorID uuid.UUID   `json:"behavior_id" validate:"required"`
-	UserID     uuid.UUID   `json:"user_id" validate:"required"`
-	GroupIDs   []uuid.UUID `json:"group_ids"`
-	LoggedAt   *time.Time  `json:"logged_at,omitempty"`
-	Notes      string      `json:"notes,omitempty"`
+	PetID       uuid.UUID               `json:"pet_id" validate:"required"`
+	BehaviorID  uuid.UUID               `json:"behavior_id" validate:"required"`
+	UserID      uuid.UUID               `json:"user_id" validate:"required"`
+	GroupIDs    []uuid.UUID             `json:"group_ids"`
+	LoggedAt    *time.Time              `json:"logged_at,omitempty"`
+	Notes       string                  `json:"notes,omitempty"`
+	Attachments []*multipart.FileHeader `json:"-"` // Photos uploaded with the log
 }
 
 // CreateBehaviorLogResult represents the result of creating a behavior log
 type CreateBehaviorLogResult struct {
-	BehaviorLog *domain.BehaviorLog `json:"behavior_log"`
-	Message     string              `json:"message"`
+	BehaviorLog *domain.BehaviorLog             `json:"behavior_log"`
+	Attachments []*domain.BehaviorLogAttachment `json:"attachments"`
+	Message     string                          `json:"message"`
 }
 
 // CreateBehaviorLogHandler handles the creation of behavior logs
 type CreateBehaviorLogHandler struct {
 	behaviorRepo      domain.BehaviorRepository
+	groupBehaviorRepo domain.GroupBehaviorRepository
 	behaviorLogRepo   domain.BehaviorLogRepository
 	dailyScoreRepo    domain.DailyScoreRepository
+	policyRepo        domain.VerificationPolicyRepository
 	authRepo          domain.AuthorizationRepository
 	userSettingsRepo  domain.UserSettingsRepository
+	attachmentService *services.AttachmentService
+	backfillService   *services.BackfillService
+	eventBus          events.Bus
 }
 
 // NewCreateBehaviorLogHandler creates a new create behavior log handler
 func NewCreateBehaviorLogHandler(
 	behaviorRepo domain.BehaviorRepository,
+	groupBehaviorRepo domain.GroupBehaviorRepository,
 	behaviorLogRepo domain.BehaviorLogRepository,
 	dailyScoreRepo domain.DailyScoreRepository,
+	policyRepo domain.VerificationPolicyRepository,
 	authRepo domain.AuthorizationRepository,
 	userSettingsRepo domain.UserSettingsRepository,
+	attachmentService *services.AttachmentService,
+	backfillService *services.BackfillService,
+	eventBus events.Bus,
 ) *CreateBehaviorLogHandler {
 	return &CreateBehaviorLogHandler{
 		behaviorRepo:      behaviorRepo,
+		groupBehaviorRepo: groupBehaviorRepo,
 		behaviorLogRepo:   behaviorLogRepo,
 		dailyScoreRepo:    dailyScoreRepo,
+		policyRepo:        policyRepo,
 		authRepo:          authRepo,
 		userSettingsRepo:  userSettingsRepo,
+		attachmentService: attachmentService,
+		backfillService:   backfillService,
+		eventBus:          eventBus,
 	}
 }
 
 // Handle executes the create behavior log command
 func (h *CreateBehaviorLogHandler) Handle(ctx context.Context, cmd *CreateBehaviorLogCommand) (*CreateBehaviorLogResult, error) {
+	behaviorLog, behavior, petInfo, err := h.prepare(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save the behavior log with the daily scores of its groups in one transaction, so a log is
+	// never kept without being counted
+	scoredLogs, err := h.scoreDays(ctx, []*domain.BehaviorLog{behaviorLog})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := h.dailyScoreRepo.RecordBehaviorLogs(ctx, scoredLogs); err != nil {
+		return nil, fmt.Errorf("failed to save behavior log: %w", err)
+	}
+
+	// Store the photos, dropping the log and its points if they cannot be kept
+	attachments, err := h.attachmentService.Attach(ctx, behaviorLog, cmd.Attachments)
+	if err != nil {
+		if discardErr := h.discard(ctx, scoredLogs[0]); discardErr != nil {
+			return nil, fmt.Errorf("failed to store attachments: %w (and failed to delete behavior log: %v)", err, discardErr)
+		}
+		return nil, fmt.Errorf("failed to store attachments: %w", err)
+	}
+
+	h.eventBus.Publish(ctx, domain.NewBehaviorLogCreatedEvent(behaviorLog))
+
+	return &CreateBehaviorLogResult{
+		BehaviorLog: behaviorLog,
+		Attachments: attachments,
+		Message:     fmt.Sprintf("Successfully logged behavior '%s' for %s", behavior.Name, petInfo.Name),
+	}, nil
+}
+
+// HandleScoreEvent records a score event of the legacy API as a behavior log shared with the
+// event's group, with the checks and effects of Handle. The points come from the behavior, not
+// from the event.
+func (h *CreateBehaviorLogHandler) HandleScoreEvent(ctx context.Context, event domain.ScoreEvent) (*domain.BehaviorLog, error) {
+	loggedAt := event.ActionDate
+	result, err := h.Handle(ctx, &CreateBehaviorLogCommand{
+		PetID:      event.PetID,
+		BehaviorID: event.BehaviorID,
+		UserID:     event.RecordedByID,
+		GroupIDs:   []uuid.UUID{event.GroupID},
+		LoggedAt:   &loggedAt,
+		Notes:      event.Comment,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.BehaviorLog, nil
+}
+
+// prepare validates a command and builds its behavior log with the group shares, without saving it
+func (h *CreateBehaviorLogHandler) prepare(ctx context.Context, cmd *CreateBehaviorLogCommand) (*domain.BehaviorLog, *domain.Behavior, *domain.PetInfo, error) {
 	// Validate authorization
 	if err := h.validateAuthorization(ctx, cmd); err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 
 	// Get behavior to validate and get point value
-	behavior, err := h.behaviorRepo.GetByID(ctx, cmd.BehaviorID)
+	behavior, err := h.resolveBehavior(ctx, cmd.BehaviorID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get behavior: %w", err)
+		return nil, nil, nil, err
 	}
 
 	if !behavior.IsActive {
-		return nil, fmt.Errorf("behavior is not active")
+		return nil, nil, nil, &domain.ValidationError{Message: "behavior is not active"}
 	}
 
 	// Validate pet species compatibility
 	petInfo, err := h.authRepo.GetPetInfo(ctx, cmd.PetID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get pet info: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to get pet info: %w", err)
 	}
 
 	if !behavior.IsValidForSpecies(petInfo.Species) {
-		return nil, fmt.Errorf("behavior %s is not valid for %s", behavior.Name, petInfo.Species)
+		return nil, nil, nil, &domain.ValidationError{Message: fmt.Sprintf("behavior %s is not valid for %s", behavior.Name, petInfo.Species)}
+	}
+
+	// Reject invalid photos before anything is saved
+	if err := h.attachmentService.Validate(cmd.Attachments); err != nil {
+		return nil, nil, nil, err
 	}
 
 	// Check for duplicate behavior within minimum interval
 	if err := h.checkDuplicatePrevention(ctx, cmd.PetID, cmd.BehaviorID, cmd.LoggedAt, behavior.MinIntervalMinutes); err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 
 	// Set logged at time if not provided
@@ -100,35 +181,92 @@ func (h *CreateBehaviorLogHandler) Handle(ctx context.Context, cmd *CreateBehavi
 		cmd.Notes,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create behavior log: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to create behavior log: %w", err)
 	}
 
 	// Add group shares
 	for _, groupID := range cmd.GroupIDs {
 		// Verify pet is in group and user can access group
 		if err := h.validateGroupAccess(ctx, cmd.UserID, cmd.PetID, groupID); err != nil {
-			return nil, fmt.Errorf("failed to validate group access for %s: %w", groupID, err)
+			return nil, nil, nil, fmt.Errorf("failed to validate group access for %s: %w", groupID, err)
+		}
+
+		// Each group may award its own point value for the behavior
+		points, err := h.groupPointValue(ctx, behavior, groupID)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		if err := behaviorLog.AddGroupShareWithPoints(groupID, points); err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to add group share: %w", err)
 		}
 
-		if err := behaviorLog.AddGroupShare(groupID); err != nil {
-			return nil, fmt.Errorf("failed to add group share: %w", err)
+		// Groups with peer verification hold the log until another member confirms it
+		policy, err := h.policyRepo.GetByGroup(ctx, groupID)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to get verification policy: %w", err)
+		}
+		if policy.RequiresVerification(points) {
+			if err := behaviorLog.RequireVerification(groupID); err != nil {
+				return nil, nil, nil, err
+			}
 		}
 	}
 
-	// Save behavior log
-	if err := h.behaviorLogRepo.Create(ctx, behaviorLog); err != nil {
-		return nil, fmt.Errorf("failed to save behavior log: %w", err)
+	// Each group decides how late behaviors can be logged
+	if err := h.backfillService.CheckLoggedAt(ctx, cmd.GroupIDs, loggedAt, time.Now()); err != nil {
+		return nil, nil, nil, err
 	}
 
-	// Update daily scores for each group
-	if err := h.updateDailyScores(ctx, behaviorLog); err != nil {
-		return nil, fmt.Errorf("failed to update daily scores: %w", err)
+	return behaviorLog, behavior, petInfo, nil
+}
+
+// resolveBehavior looks up a behavior in the group custom behaviors, then in the global catalog
+func (h *CreateBehaviorLogHandler) resolveBehavior(ctx context.Context, behaviorID uuid.UUID) (*domain.Behavior, error) {
+	groupBehavior, err := h.groupBehaviorRepo.GetByID(ctx, behaviorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group behavior: %w", err)
 	}
 
-	return &CreateBehaviorLogResult{
-		BehaviorLog: behaviorLog,
-		Message:     fmt.Sprintf("Successfully logged behavior '%s' for %s", behavior.Name, petInfo.Name),
-	}, nil
+	if groupBehavior != nil && !groupBehavior.IsOverride() {
+		return groupBehavior.Resolve(nil), nil
+	}
+
+	behavior, err := h.behaviorRepo.GetByID(ctx, behaviorID)
+	if errors.Is(err, domain.ErrBehaviorNotFound) || (err == nil && behavior == nil) {
+		return nil, &NotFoundError{Resource: "behavior", ID: behaviorID.String()}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get behavior: %w", err)
+	}
+
+	return behavior, nil
+}
+
+// groupPointValue returns the points a behavior awards in a group, taking group overrides into account
+func (h *CreateBehaviorLogHandler) groupPointValue(ctx context.Context, behavior *domain.Behavior, groupID uuid.UUID) (int, error) {
+	// Custom behaviors can only be shared with the group that defined them
+	if behavior.GroupID != nil {
+		if *behavior.GroupID != groupID {
+			return 0, &domain.ValidationError{Message: fmt.Sprintf("behavior %s is only available in group %s", behavior.Name, *behavior.GroupID)}
+		}
+		return behavior.PointValue, nil
+	}
+
+	override, err := h.groupBehaviorRepo.GetOverride(ctx, groupID, behavior.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get group behavior override: %w", err)
+	}
+
+	if override == nil {
+		return behavior.PointValue, nil
+	}
+
+	if !override.IsActive {
+		return 0, &domain.ValidationError{Message: fmt.Sprintf("behavior %s is disabled in group %s", behavior.Name, groupID)}
+	}
+
+	return override.PointValue, nil
 }
 
 // validateAuthorization checks if the user can access the pet
@@ -139,7 +277,7 @@ func (h *CreateBehaviorLogHandler) validateAuthorization(ctx context.Context, cm
 	}
 
 	if !canAccess {
-		return fmt.Errorf("user %s does not have access to pet %s", cmd.UserID, cmd.PetID)
+		return &AuthorizationError{Message: fmt.Sprintf("user %s does not have access to pet %s", cmd.UserID, cmd.PetID)}
 	}
 
 	return nil
@@ -154,7 +292,7 @@ func (h *CreateBehaviorLogHandler) validateGroupAccess(ctx context.Context, user
 	}
 
 	if !canAccessGroup {
-		return fmt.Errorf("user %s does not have access to group %s", userID, groupID)
+		return &AuthorizationError{Message: fmt.Sprintf("user %s does not have access to group %s", userID, groupID)}
 	}
 
 	// Check if pet is in group
@@ -164,24 +302,16 @@ func (h *CreateBehaviorLogHandler) validateGroupAccess(ctx context.Context, user
 	}
 
 	if !isPetInGroup {
-		return fmt.Errorf("pet %s is not a member of group %s", petID, groupID)
+		return &domain.ValidationError{Message: fmt.Sprintf("pet %s is not a member of group %s", petID, groupID)}
 	}
 
 	return nil
 }
 
-// checkDuplicatePrevention verifies the behavior can be logged based on minimum interval rules
+// checkDuplicatePrevention verifies the behavior can be logged based on minimum interval rules.
+// Backdated logs can fall between earlier logs, so the log is checked against the logs on either
+// side of it rather than against the latest one.
 func (h *CreateBehaviorLogHandler) checkDuplicatePrevention(ctx context.Context, petID, behaviorID uuid.UUID, loggedAt *time.Time, minIntervalMinutes int) error {
-	lastLoggedAt, err := h.behaviorLogRepo.GetLastLoggedAt(ctx, petID, behaviorID)
-	if err != nil {
-		return fmt.Errorf("failed to check last logged time: %w", err)
-	}
-
-	// If no previous log, allow this one
-	if lastLoggedAt == nil {
-		return nil
-	}
-
 	// Calculate the minimum time required between logs
 	minInterval := time.Duration(minIntervalMinutes) * time.Minute
 
@@ -191,80 +321,76 @@ func (h *CreateBehaviorLogHandler) checkDuplicatePrevention(ctx context.Context,
 		currentTime = *loggedAt
 	}
 
-	// Check if enough time has passed
-	timeSinceLastLog := currentTime.Sub(*lastLoggedAt)
-	if timeSinceLastLog < minInterval {
-		remainingTime := minInterval - timeSinceLastLog
-		return fmt.Errorf("must wait %v before logging this behavior again (last logged %v ago)",
-			remainingTime.Round(time.Minute), timeSinceLastLog.Round(time.Minute))
-	}
-
-	return nil
-}
-
-// updateDailyScores updates daily scores for all groups this behavior log is shared with
-func (h *CreateBehaviorLogHandler) updateDailyScores(ctx context.Context, behaviorLog *domain.BehaviorLog) error {
-	// Get user's timezone settings for proper daily boundary calculation
-	userSettings, err := h.userSettingsRepo.GetUserTimezone(ctx, behaviorLog.UserID)
-	if err != nil {
-		// Use default if user settings not found
-		userSettings = domain.NewUserTimezoneSettings(behaviorLog.UserID)
-	}
-
-	// Calculate the date based on user's timezone and daily reset time
-	date, err := h.calculateUserDate(behaviorLog.LoggedAt, userSettings)
+	nearbyLogs, err := h.behaviorLogRepo.Find(ctx, domain.NewBehaviorLogFilter().
+		WithPet(petID).
+		WithBehavior(behaviorID).
+		WithDateRange(currentTime.Add(-minInterval), currentTime.Add(minInterval)))
 	if err != nil {
-		return fmt.Errorf("failed to calculate user date: %w", err)
+		return fmt.Errorf("failed to check nearby logs: %w", err)
 	}
 
-	// Update daily score for each group
-	for _, groupShare := range behaviorLog.GroupShares {
-		dailyScore, err := h.dailyScoreRepo.GetOrCreate(ctx, behaviorLog.PetID, groupShare.GroupID, date)
-		if err != nil {
-			return fmt.Errorf("failed to get or create daily score: %w", err)
-		}
-
-		if err := dailyScore.AddBehaviorLog(behaviorLog); err != nil {
-			return fmt.Errorf("failed to add behavior log to daily score: %w", err)
+	// Check if enough time separates the log from the others
+	for _, other := range nearbyLogs {
+		since := currentTime.Sub(other.LoggedAt)
+		if since < 0 {
+			since = -since
 		}
-
-		if err := h.dailyScoreRepo.Update(ctx, dailyScore); err != nil {
-			return fmt.Errorf("failed to update daily score: %w", err)
+		if since < minInterval {
+			return &IntervalError{Remaining: minInterval - since, Since: since}
 		}
 	}
 
 	return nil
 }
 
-// calculateUserDate calculates the date for daily scoring based on user's timezone and reset time
-func (h *CreateBehaviorLogHandler) calculateUserDate(loggedAt time.Time, userSettings *domain.UserTimezoneSettings) (time.Time, error) {
-	location, err := time.LoadLocation(userSettings.Timezone)
-	if err != nil {
-		return time.Time{}, fmt.Errorf("invalid timezone %s: %w", userSettings.Timezone, err)
+// scoreDays finds the group day each log scores on in the groups it counts in.
+// Logs pending verification are added to their days when they are confirmed.
+func (h *CreateBehaviorLogHandler) scoreDays(ctx context.Context, behaviorLogs []*domain.BehaviorLog) ([]domain.ScoredBehaviorLog, error) {
+	// Each group counts a log on its own day, whoever logged it
+	calendar := services.NewGroupCalendar(h.authRepo, h.userSettingsRepo)
+
+	scoredLogs := make([]domain.ScoredBehaviorLog, len(behaviorLogs))
+	for i, behaviorLog := range behaviorLogs {
+		scoredLogs[i] = domain.ScoredBehaviorLog{BehaviorLog: behaviorLog, GroupDays: make(map[uuid.UUID]time.Time)}
+		for _, groupShare := range behaviorLog.GroupShares {
+			if !groupShare.IsCounted() {
+				continue
+			}
+
+			date, err := calendar.LogDay(ctx, groupShare.GroupID, behaviorLog)
+			if err != nil {
+				return nil, fmt.Errorf("failed to calculate group day: %w", err)
+			}
+			scoredLogs[i].GroupDays[groupShare.GroupID] = date
+		}
 	}
 
-	// Convert to user's timezone
-	localTime := loggedAt.In(location)
+	return scoredLogs, nil
+}
 
-	// Parse reset time
-	resetTime, err := time.Parse("15:04", userSettings.DailyResetTime)
-	if err != nil {
-		return time.Time{}, fmt.Errorf("invalid reset time %s: %w", userSettings.DailyResetTime, err)
+// discard deletes a saved behavior log that cannot be kept and recalculates the days it scored on
+func (h *CreateBehaviorLogHandler) discard(ctx context.Context, scoredLog domain.ScoredBehaviorLog) error {
+	behaviorLog := scoredLog.BehaviorLog
+	if err := h.behaviorLogRepo.Delete(ctx, behaviorLog.ID); err != nil {
+		return err
 	}
 
-	// Calculate reset time for the local date
-	resetDateTime := time.Date(
-		localTime.Year(), localTime.Month(), localTime.Day(),
-		resetTime.Hour(), resetTime.Minute(), 0, 0, location,
-	)
-
-	// If logged before reset time, it belongs to the current day
-	// If logged after reset time, it belongs to the next day
-	date := localTime
-	if localTime.After(resetDateTime) {
-		date = localTime.AddDate(0, 0, 1)
+	for groupID, date := range scoredLog.GroupDays {
+		if _, err := h.dailyScoreRepo.RecalculateFromLogs(ctx, behaviorLog.PetID, groupID, date); err != nil {
+			return fmt.Errorf("failed to recalculate daily score: %w", err)
+		}
 	}
 
-	// Return normalized date (start of day)
-	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, location), nil
-}
\ No newline at end of file
+	return nil
+}
+
+// IntervalError is returned when a behavior is logged again before its minimum interval
+type IntervalError struct {
+	Remaining time.Duration // Time left before the behavior can be logged again
+	Since     time.Duration // Time since the behavior was last logged
+}
+
+func (e *IntervalError) Error() string {
+	return fmt.Sprintf("must wait %v before logging this behavior again (last logged %v ago)",
+		e.Remaining.Round(time.Minute), e.Since.Round(time.Minute))
+}
@@ -0,0 +1,177 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+)
+
+// MaxBehaviorLogBatchSize bounds the items of a batch
+const MaxBehaviorLogBatchSize = 50
+
+// BatchErrorCode tells why an item of a batch was not logged
+type BatchErrorCode string
+
+const (
+	BatchErrorForbidden BatchErrorCode = "FORBIDDEN"     // The user cannot log for the pet or share with a group
+	BatchErrorNotFound  BatchErrorCode = "NOT_FOUND"     // The behavior does not exist
+	BatchErrorTooSoon   BatchErrorCode = "TOO_SOON"      // The behavior was logged within its minimum interval
+	BatchErrorInvalid   BatchErrorCode = "INVALID_INPUT" // Any other rule of a single log was broken
+	BatchErrorNotSaved  BatchErrorCode = "NOT_SAVED"     // The item was valid but the batch could not be saved
+)
+
+// CreateBehaviorLogBatchItem is one behavior log of a batch
+type CreateBehaviorLogBatchItem struct {
+	PetID      uuid.UUID   `json:"pet_id" validate:"required"`
+	BehaviorID uuid.UUID   `json:"behavior_id" validate:"required"`
+	GroupIDs   []uuid.UUID `json:"group_ids"`
+	LoggedAt   *time.Time  `json:"logged_at,omitempty"`
+	Notes      string      `json:"notes,omitempty"`
+}
+
+// CreateBehaviorLogBatchCommand represents a command to log several behaviors at once, e.g. the
+// same behavior for every pet of an owner
+type CreateBehaviorLogBatchCommand struct {
+	UserID uuid.UUID                    `json:"user_id" validate:"required"`
+	Items  []CreateBehaviorLogBatchItem `json:"items" validate:"required"`
+}
+
+// BehaviorLogBatchItemResult is the outcome of one item, in the order of the command
+type BehaviorLogBatchItemResult struct {
+	Index       int                 `json:"index"`
+	BehaviorLog *domain.BehaviorLog `json:"behavior_log,omitempty"`
+	ErrorCode   BatchErrorCode      `json:"error_code,omitempty"`
+	Error       string              `json:"error,omitempty"`
+}
+
+// CreateBehaviorLogBatchResult represents the result of a batch
+type CreateBehaviorLogBatchResult struct {
+	BatchID uuid.UUID                    `json:"batch_id"`
+	Results []BehaviorLogBatchItemResult `json:"results"`
+	Created int                          `json:"created"`
+	Failed  int                          `json:"failed"`
+}
+
+// CreateBehaviorLogBatchHandler logs several behaviors with partial success: each item is checked
+// with the rules of a single log, and the valid ones are saved together with their daily scores
+// in one transaction. A single event announces the batch, so rankings are refreshed once per group.
+type CreateBehaviorLogBatchHandler struct {
+	createHandler *CreateBehaviorLogHandler
+}
+
+// NewCreateBehaviorLogBatchHandler creates a new batch handler using the rules of the single log handler
+func NewCreateBehaviorLogBatchHandler(createHandler *CreateBehaviorLogHandler) *CreateBehaviorLogBatchHandler {
+	return &CreateBehaviorLogBatchHandler{
+		createHandler: createHandler,
+	}
+}
+
+// Handle executes the batch command. Invalid items are reported in the result, not as an error.
+func (h *CreateBehaviorLogBatchHandler) Handle(ctx context.Context, cmd *CreateBehaviorLogBatchCommand) (*CreateBehaviorLogBatchResult, error) {
+	if len(cmd.Items) == 0 {
+		return nil, fmt.Errorf("batch must contain at least one item")
+	}
+	if len(cmd.Items) > MaxBehaviorLogBatchSize {
+		return nil, fmt.Errorf("batch cannot contain more than %d items", MaxBehaviorLogBatchSize)
+	}
+
+	result := &CreateBehaviorLogBatchResult{
+		BatchID: uuid.New(),
+		Results: make([]BehaviorLogBatchItemResult, len(cmd.Items)),
+	}
+
+	accepted := make([]*domain.BehaviorLog, 0, len(cmd.Items))
+	acceptedIndexes := make([]int, 0, len(cmd.Items))
+	for i, item := range cmd.Items {
+		result.Results[i].Index = i
+
+		behaviorLog, behavior, _, err := h.createHandler.prepare(ctx, &CreateBehaviorLogCommand{
+			PetID:      item.PetID,
+			BehaviorID: item.BehaviorID,
+			UserID:     cmd.UserID,
+			GroupIDs:   item.GroupIDs,
+			LoggedAt:   item.LoggedAt,
+			Notes:      item.Notes,
+		})
+		if err == nil {
+			// The stored logs do not include the earlier items of the batch yet
+			err = checkBatchInterval(behaviorLog, accepted, time.Duration(behavior.MinIntervalMinutes)*time.Minute)
+		}
+		if err != nil {
+			result.Results[i].ErrorCode = batchErrorCode(err)
+			result.Results[i].Error = err.Error()
+			result.Failed++
+			continue
+		}
+
+		accepted = append(accepted, behaviorLog)
+		acceptedIndexes = append(acceptedIndexes, i)
+	}
+
+	if len(accepted) == 0 {
+		return result, nil
+	}
+
+	scoredLogs, err := h.createHandler.scoreDays(ctx, accepted)
+	if err == nil {
+		_, err = h.createHandler.dailyScoreRepo.RecordBehaviorLogs(ctx, scoredLogs)
+	}
+	if err != nil {
+		for _, i := range acceptedIndexes {
+			result.Results[i].ErrorCode = BatchErrorNotSaved
+			result.Results[i].Error = fmt.Sprintf("failed to save behavior log: %v", err)
+		}
+		result.Failed += len(accepted)
+		return result, nil
+	}
+
+	for n, i := range acceptedIndexes {
+		result.Results[i].BehaviorLog = accepted[n]
+	}
+	result.Created = len(accepted)
+
+	h.createHandler.eventBus.Publish(ctx, domain.NewBehaviorLogBatchCreatedEvent(result.BatchID, cmd.UserID, accepted))
+
+	return result, nil
+}
+
+// checkBatchInterval applies the minimum interval of a behavior to the earlier items of the batch
+func checkBatchInterval(behaviorLog *domain.BehaviorLog, accepted []*domain.BehaviorLog, minInterval time.Duration) error {
+	for _, other := range accepted {
+		if other.PetID != behaviorLog.PetID || other.BehaviorID != behaviorLog.BehaviorID {
+			continue
+		}
+
+		since := behaviorLog.LoggedAt.Sub(other.LoggedAt)
+		if since < 0 {
+			since = -since
+		}
+		if since < minInterval {
+			return &IntervalError{Remaining: minInterval - since, Since: since}
+		}
+	}
+	return nil
+}
+
+// batchErrorCode classifies the error of an item
+func batchErrorCode(err error) BatchErrorCode {
+	var authErr *AuthorizationError
+	var notFoundErr *NotFoundError
+	var intervalErr *IntervalError
+
+	switch {
+	case errors.As(err, &authErr):
+		return BatchErrorForbidden
+	case errors.As(err, &notFoundErr):
+		return BatchErrorNotFound
+	case errors.As(err, &intervalErr):
+		return BatchErrorTooSoon
+	default:
+		return BatchErrorInvalid
+	}
+}
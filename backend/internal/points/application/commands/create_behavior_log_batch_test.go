@@ -0,0 +1,142 @@
+package commands
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/services"
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+	"pet-of-the-day/internal/shared/events"
+	"pet-of-the-day/internal/shared/upload"
+)
+
+func TestCreateBehaviorLogBatchHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	behaviorRepo := mock.NewMockBehaviorRepository()
+	behaviorLogRepo := mock.NewMockBehaviorLogRepository()
+	dailyScoreRepo := mock.NewMockDailyScoreRepository().WithRecalculationSources(behaviorLogRepo, mock.NewMockPointAdjustmentRepository())
+	authRepo := mock.NewMockAuthorizationRepository()
+	bus := events.NewInMemoryEventBus()
+	created := recordEvents(bus, domain.BehaviorLogCreatedEventType)
+	batches := recordEvents(bus, domain.BehaviorLogBatchCreatedEventType)
+
+	uploadConfig := upload.DefaultImageUploadConfig()
+	uploadConfig.UploadPath = t.TempDir()
+	handler := NewCreateBehaviorLogBatchHandler(NewCreateBehaviorLogHandler(
+		behaviorRepo,
+		mock.NewMockGroupBehaviorRepository(),
+		behaviorLogRepo,
+		dailyScoreRepo,
+		mock.NewMockVerificationPolicyRepository(),
+		authRepo,
+		mock.NewMockUserSettingsRepository(),
+		services.NewAttachmentService(mock.NewMockBehaviorLogAttachmentRepository(), upload.NewFileUploadService(uploadConfig)),
+		newTestBackfillService(authRepo, bus),
+		bus,
+	))
+
+	userID, neighborID, groupID := uuid.New(), uuid.New(), uuid.New()
+	rex, luna, neighborPet := uuid.New(), uuid.New(), uuid.New()
+	authRepo.AddUserGroup(userID, groupID, &domain.GroupInfo{ID: groupID, OwnerID: userID})
+	for _, petID := range []uuid.UUID{rex, luna} {
+		authRepo.AddUserPet(userID, petID, &domain.PetInfo{ID: petID, Name: "Pet", Species: domain.SpeciesDog, OwnerID: userID})
+		authRepo.AddPetToGroup(petID, groupID)
+	}
+	authRepo.AddUserPet(neighborID, neighborPet, &domain.PetInfo{ID: neighborPet, Name: "Max", Species: domain.SpeciesDog, OwnerID: neighborID})
+
+	breakfast, _ := domain.NewBehavior("Fed breakfast", "Pet ate its breakfast", domain.BehaviorCategoryFeeding, 3, 120, domain.SpeciesDog, "bowl")
+	behaviorRepo.Create(ctx, breakfast)
+
+	// Logged at noon, before the default reset time, so every log counts for that day
+	date := time.Now().AddDate(0, 0, -1)
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	loggedAt := day.Add(12 * time.Hour)
+	item := func(petID, behaviorID uuid.UUID) CreateBehaviorLogBatchItem {
+		return CreateBehaviorLogBatchItem{PetID: petID, BehaviorID: behaviorID, GroupIDs: []uuid.UUID{groupID}, LoggedAt: &loggedAt}
+	}
+
+	result, err := handler.Handle(ctx, &CreateBehaviorLogBatchCommand{
+		UserID: userID,
+		Items: []CreateBehaviorLogBatchItem{
+			item(rex, breakfast.ID),
+			item(luna, breakfast.ID),
+			item(neighborPet, breakfast.ID),
+			item(rex, uuid.New()),
+			item(rex, breakfast.ID),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if result.Created != 2 || result.Failed != 3 {
+		t.Errorf("Expected 2 created and 3 failed, got %d and %d", result.Created, result.Failed)
+	}
+	expectedCodes := []BatchErrorCode{"", "", BatchErrorForbidden, BatchErrorNotFound, BatchErrorTooSoon}
+	for i, expected := range expectedCodes {
+		if result.Results[i].ErrorCode != expected {
+			t.Errorf("Item %d: expected error code %q, got %q (%s)", i, expected, result.Results[i].ErrorCode, result.Results[i].Error)
+		}
+		if (expected == "") != (result.Results[i].BehaviorLog != nil) {
+			t.Errorf("Item %d: expected a behavior log only for successes", i)
+		}
+	}
+
+	for _, petID := range []uuid.UUID{rex, luna} {
+		score, _ := dailyScoreRepo.GetOrCreate(ctx, petID, groupID, day)
+		if score.TotalPoints != 3 {
+			t.Errorf("Expected a daily score of 3 for pet %s, got %d", petID, score.TotalPoints)
+		}
+	}
+
+	if count, _ := behaviorLogRepo.Count(ctx, domain.NewBehaviorLogFilter().WithUser(userID)); count != 2 {
+		t.Errorf("Expected the 2 valid logs to be saved, got %d", count)
+	}
+
+	if len(created.events) != 0 {
+		t.Errorf("Expected no created event per log, got %d", len(created.events))
+	}
+	if len(batches.events) != 1 {
+		t.Fatalf("Expected a single batch event, got %d", len(batches.events))
+	}
+	event := batches.last().(*domain.BehaviorLogBatchCreatedEvent)
+	if len(event.GroupIDs) != 1 || event.GroupIDs[0] != groupID || len(event.PetIDs) != 2 || len(event.BehaviorLogs) != 2 {
+		t.Errorf("Expected the batch event to list both logs of the group, got %+v", event)
+	}
+
+	t.Run("Reports every item when the batch cannot be saved", func(t *testing.T) {
+		// Without a behavior log repository the daily score repository cannot save the batch
+		failing := NewCreateBehaviorLogBatchHandler(NewCreateBehaviorLogHandler(
+			behaviorRepo,
+			mock.NewMockGroupBehaviorRepository(),
+			mock.NewMockBehaviorLogRepository(),
+			mock.NewMockDailyScoreRepository(),
+			mock.NewMockVerificationPolicyRepository(),
+			authRepo,
+			mock.NewMockUserSettingsRepository(),
+			services.NewAttachmentService(mock.NewMockBehaviorLogAttachmentRepository(), upload.NewFileUploadService(uploadConfig)),
+			newTestBackfillService(authRepo, bus),
+			bus,
+		))
+
+		result, err := failing.Handle(ctx, &CreateBehaviorLogBatchCommand{UserID: userID, Items: []CreateBehaviorLogBatchItem{item(luna, breakfast.ID)}})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if result.Created != 0 || result.Results[0].ErrorCode != BatchErrorNotSaved {
+			t.Errorf("Expected the item not to be saved, got %+v", result.Results[0])
+		}
+	})
+
+	t.Run("Rejects oversized batches", func(t *testing.T) {
+		items := make([]CreateBehaviorLogBatchItem, MaxBehaviorLogBatchSize+1)
+		if _, err := handler.Handle(ctx, &CreateBehaviorLogBatchCommand{UserID: userID, Items: items}); err == nil {
+			t.Error("Expected an error for an oversized batch")
+		}
+	})
+}
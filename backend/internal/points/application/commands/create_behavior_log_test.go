@@ -0,0 +1,301 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/services"
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+	"pet-of-the-day/internal/shared/events"
+	"pet-of-the-day/internal/shared/upload"
+)
+
+func TestCreateBehaviorLogHandler_GroupPointValues(t *testing.T) {
+	ctx := context.Background()
+
+	behaviorRepo := mock.NewMockBehaviorRepository()
+	groupBehaviorRepo := mock.NewMockGroupBehaviorRepository()
+	behaviorLogRepo := mock.NewMockBehaviorLogRepository()
+	dailyScoreRepo := mock.NewMockDailyScoreRepository().WithRecalculationSources(behaviorLogRepo, mock.NewMockPointAdjustmentRepository())
+	authRepo := mock.NewMockAuthorizationRepository()
+	policyRepo := mock.NewMockVerificationPolicyRepository()
+	bus := events.NewInMemoryBus()
+	uploadConfig := upload.DefaultImageUploadConfig()
+	uploadConfig.UploadPath = t.TempDir()
+	handler := NewCreateBehaviorLogHandler(
+		behaviorRepo,
+		groupBehaviorRepo,
+		behaviorLogRepo,
+		dailyScoreRepo,
+		policyRepo,
+		authRepo,
+		mock.NewMockUserSettingsRepository(),
+		services.NewAttachmentService(mock.NewMockBehaviorLogAttachmentRepository(), upload.NewFileUploadService(uploadConfig)),
+		newTestBackfillService(authRepo, bus),
+		bus,
+	)
+
+	userID, petID := uuid.New(), uuid.New()
+	strictGroup, relaxedGroup := uuid.New(), uuid.New()
+	authRepo.AddUserPet(userID, petID, &domain.PetInfo{ID: petID, Name: "Rex", Species: domain.SpeciesDog, OwnerID: userID})
+	for _, groupID := range []uuid.UUID{strictGroup, relaxedGroup} {
+		authRepo.AddUserGroup(userID, groupID, &domain.GroupInfo{ID: groupID, OwnerID: userID})
+		authRepo.AddPetToGroup(petID, groupID)
+	}
+
+	sit, _ := domain.NewBehavior("Sit", "Pet sits on command", domain.BehaviorCategoryTraining, 5, 5, domain.SpeciesDog, "sit")
+	behaviorRepo.Create(ctx, sit)
+	override, _ := domain.NewGroupBehaviorOverride(strictGroup, userID, sit, 2, true)
+	groupBehaviorRepo.Create(ctx, override)
+
+	t.Run("Awards the group-specific point value per share", func(t *testing.T) {
+		loggedAt := time.Now().Add(-time.Hour)
+		result, err := handler.Handle(ctx, &CreateBehaviorLogCommand{
+			PetID:      petID,
+			BehaviorID: sit.ID,
+			UserID:     userID,
+			GroupIDs:   []uuid.UUID{strictGroup, relaxedGroup},
+			LoggedAt:   &loggedAt,
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		log := result.BehaviorLog
+		if log.PointsForGroup(strictGroup) != 2 || log.PointsForGroup(relaxedGroup) != 5 {
+			t.Errorf("Expected 2 and 5 points, got %d and %d", log.PointsForGroup(strictGroup), log.PointsForGroup(relaxedGroup))
+		}
+
+		scores, _ := dailyScoreRepo.Find(ctx, &domain.DailyScoreFilter{PetID: &petID, GroupID: &strictGroup, Limit: 10})
+		if len(scores) != 1 || scores[0].TotalPoints != 2 {
+			t.Errorf("Expected strict group daily score of 2, got %+v", scores)
+		}
+	})
+
+	t.Run("Holds logs above the verification threshold", func(t *testing.T) {
+		policy, _ := domain.NewVerificationPolicy(relaxedGroup, userID, domain.VerificationModeThreshold, 3, false)
+		policyRepo.Save(ctx, policy)
+		defer policyRepo.Save(ctx, domain.DefaultVerificationPolicy(relaxedGroup))
+
+		fetch, _ := domain.NewBehavior("Fetch", "Pet brings the ball back", domain.BehaviorCategoryPlay, 4, 5, domain.SpeciesDog, "fetch")
+		behaviorRepo.Create(ctx, fetch)
+
+		result, err := handler.Handle(ctx, &CreateBehaviorLogCommand{
+			PetID:      petID,
+			BehaviorID: fetch.ID,
+			UserID:     userID,
+			GroupIDs:   []uuid.UUID{strictGroup, relaxedGroup},
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		log := result.BehaviorLog
+		if !log.IsCountedInGroup(strictGroup) {
+			t.Error("Expected the log to count in the group without verification")
+		}
+		if status := log.GetGroupShare(relaxedGroup).Status; status != domain.ShareStatusPending {
+			t.Errorf("Expected log pending in the verification group, got %s", status)
+		}
+
+		scores, _ := dailyScoreRepo.Find(ctx, &domain.DailyScoreFilter{PetID: &petID, GroupID: &relaxedGroup, Limit: 10})
+		total := 0
+		for _, score := range scores {
+			total += score.TotalPoints
+		}
+		if total != 5 {
+			t.Errorf("Expected the pending log not to count, got %d points", total)
+		}
+	})
+
+	t.Run("Rejects behaviors disabled in a group", func(t *testing.T) {
+		override.SetActive(false)
+		defer override.SetActive(true)
+
+		_, err := handler.Handle(ctx, &CreateBehaviorLogCommand{
+			PetID:      petID,
+			BehaviorID: sit.ID,
+			UserID:     userID,
+			GroupIDs:   []uuid.UUID{strictGroup},
+		})
+		if _, ok := err.(*domain.ValidationError); !ok {
+			t.Errorf("Expected ValidationError for disabled behavior, got %v", err)
+		}
+	})
+
+	t.Run("Unknown behaviors are reported as not found", func(t *testing.T) {
+		_, err := handler.Handle(ctx, &CreateBehaviorLogCommand{
+			PetID:      petID,
+			BehaviorID: uuid.New(),
+			UserID:     userID,
+			GroupIDs:   []uuid.UUID{strictGroup},
+		})
+		if _, ok := err.(*NotFoundError); !ok {
+			t.Errorf("Expected NotFoundError, got %v", err)
+		}
+	})
+
+	t.Run("Pets outside a shared group are rejected as invalid", func(t *testing.T) {
+		otherGroup := uuid.New()
+		authRepo.AddUserGroup(userID, otherGroup, &domain.GroupInfo{ID: otherGroup, OwnerID: userID})
+
+		_, err := handler.Handle(ctx, &CreateBehaviorLogCommand{
+			PetID:      petID,
+			BehaviorID: sit.ID,
+			UserID:     userID,
+			GroupIDs:   []uuid.UUID{otherGroup},
+		})
+		var validationErr *domain.ValidationError
+		if !errors.As(err, &validationErr) {
+			t.Errorf("Expected ValidationError, got %v", err)
+		}
+	})
+
+	t.Run("Keeps no log when its daily scores cannot be saved", func(t *testing.T) {
+		// Without a behavior log repository the daily score repository cannot save the log
+		unsavedLogRepo := mock.NewMockBehaviorLogRepository()
+		failing := NewCreateBehaviorLogHandler(
+			behaviorRepo,
+			groupBehaviorRepo,
+			unsavedLogRepo,
+			mock.NewMockDailyScoreRepository(),
+			policyRepo,
+			authRepo,
+			mock.NewMockUserSettingsRepository(),
+			services.NewAttachmentService(mock.NewMockBehaviorLogAttachmentRepository(), upload.NewFileUploadService(uploadConfig)),
+			newTestBackfillService(authRepo, bus),
+			bus,
+		)
+
+		if _, err := failing.Handle(ctx, &CreateBehaviorLogCommand{
+			PetID:      petID,
+			BehaviorID: sit.ID,
+			UserID:     userID,
+			GroupIDs:   []uuid.UUID{relaxedGroup},
+		}); err == nil {
+			t.Fatal("Expected the daily scores not to be saved")
+		}
+		if behaviorLogs, _ := unsavedLogRepo.Find(ctx, domain.NewBehaviorLogFilter().WithPet(petID)); len(behaviorLogs) != 0 {
+			t.Errorf("Expected no uncounted log to be kept, got %d", len(behaviorLogs))
+		}
+	})
+
+	t.Run("Custom behaviors stay within their group", func(t *testing.T) {
+		agility, _ := domain.NewCustomGroupBehavior(strictGroup, userID, "Did agility course", "", domain.BehaviorCategoryTraining, 7, 5, domain.SpeciesDog, "agility")
+		groupBehaviorRepo.Create(ctx, agility)
+
+		if _, err := handler.Handle(ctx, &CreateBehaviorLogCommand{
+			PetID:      petID,
+			BehaviorID: agility.ID,
+			UserID:     userID,
+			GroupIDs:   []uuid.UUID{relaxedGroup},
+		}); err == nil {
+			t.Error("Expected error when sharing a custom behavior with another group")
+		}
+
+		result, err := handler.Handle(ctx, &CreateBehaviorLogCommand{
+			PetID:      petID,
+			BehaviorID: agility.ID,
+			UserID:     userID,
+			GroupIDs:   []uuid.UUID{strictGroup},
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if result.BehaviorLog.PointsForGroup(strictGroup) != 7 {
+			t.Errorf("Expected 7 points, got %d", result.BehaviorLog.PointsForGroup(strictGroup))
+		}
+	})
+}
+
+// newTestBackfillService creates a backfill service where every group keeps the default policy
+func newTestBackfillService(authRepo *mock.MockAuthorizationRepository, bus events.Bus) *services.BackfillService {
+	resetStateRepo := mock.NewMockDailyResetStateRepository()
+	userSettingsRepo := mock.NewMockUserSettingsRepository()
+	dailyScoreRepo := mock.NewMockDailyScoreRepository()
+	rankingService := services.NewRankingService(
+		dailyScoreRepo, mock.NewMockRankingReadModelRepository(dailyScoreRepo, authRepo), mock.NewMockBehaviorLogRepository(),
+		mock.NewMockPetOfTheDayRepository(), authRepo, userSettingsRepo, resetStateRepo, mock.NewMockScoringRulesRepository(), bus,
+	)
+
+	return services.NewBackfillService(
+		mock.NewMockBackfillPolicyRepository(),
+		mock.NewMockLateCorrectionRepository(),
+		resetStateRepo,
+		authRepo,
+		userSettingsRepo,
+		rankingService,
+		bus,
+	)
+}
+
+func TestCreateBehaviorLogHandler_MinimumInterval(t *testing.T) {
+	ctx := context.Background()
+
+	behaviorRepo := mock.NewMockBehaviorRepository()
+	behaviorLogRepo := mock.NewMockBehaviorLogRepository()
+	authRepo := mock.NewMockAuthorizationRepository()
+	bus := events.NewInMemoryBus()
+	uploadConfig := upload.DefaultImageUploadConfig()
+	uploadConfig.UploadPath = t.TempDir()
+	handler := NewCreateBehaviorLogHandler(
+		behaviorRepo,
+		mock.NewMockGroupBehaviorRepository(),
+		behaviorLogRepo,
+		mock.NewMockDailyScoreRepository().WithRecalculationSources(behaviorLogRepo, mock.NewMockPointAdjustmentRepository()),
+		mock.NewMockVerificationPolicyRepository(),
+		authRepo,
+		mock.NewMockUserSettingsRepository(),
+		services.NewAttachmentService(mock.NewMockBehaviorLogAttachmentRepository(), upload.NewFileUploadService(uploadConfig)),
+		newTestBackfillService(authRepo, bus),
+		bus,
+	)
+
+	userID, petID := uuid.New(), uuid.New()
+	authRepo.AddUserPet(userID, petID, &domain.PetInfo{ID: petID, Name: "Rex", Species: domain.SpeciesDog, OwnerID: userID})
+	walk, _ := domain.NewBehavior("Walk", "Pet went for a walk", domain.BehaviorCategoryPlay, 3, 30, domain.SpeciesDog, "walk")
+	behaviorRepo.Create(ctx, walk)
+
+	logAt := func(loggedAt time.Time) error {
+		_, err := handler.Handle(ctx, &CreateBehaviorLogCommand{
+			PetID:      petID,
+			BehaviorID: walk.ID,
+			UserID:     userID,
+			LoggedAt:   &loggedAt,
+		})
+		return err
+	}
+
+	now := time.Now()
+	if err := logAt(now.Add(-2 * time.Hour)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		loggedAt time.Time
+		wantErr  bool
+	}{
+		{name: "Rejects a log shortly after another", loggedAt: now.Add(-2*time.Hour + 10*time.Minute), wantErr: true},
+		{name: "Rejects a backdated log shortly before another", loggedAt: now.Add(-2*time.Hour - 10*time.Minute), wantErr: true},
+		{name: "Accepts a backdated log before the interval", loggedAt: now.Add(-3 * time.Hour)},
+		{name: "Accepts a log after the interval", loggedAt: now.Add(-time.Hour)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := logAt(tt.loggedAt)
+			if _, ok := err.(*IntervalError); tt.wantErr && !ok {
+				t.Errorf("Expected IntervalError, got %v", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
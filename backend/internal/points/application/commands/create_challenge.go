@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/services"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// CreateChallengeCommand represents a command to start a challenge in a group
+type CreateChallengeCommand struct {
+	GroupID     uuid.UUID              `json:"group_id" validate:"required"`
+	UserID      uuid.UUID              `json:"user_id" validate:"required"`
+	Name        string                 `json:"name" validate:"required"`
+	Description string                 `json:"description"`
+	Target      domain.ChallengeTarget `json:"target" validate:"required"`
+	StartsAt    time.Time              `json:"starts_at" validate:"required"`
+	EndsAt      time.Time              `json:"ends_at" validate:"required"`
+	BonusPoints int                    `json:"bonus_points"`
+}
+
+// CreateChallengeResult represents the result of creating a challenge
+type CreateChallengeResult struct {
+	Challenge *domain.Challenge `json:"challenge"`
+}
+
+// CreateChallengeHandler handles the creation of group challenges
+type CreateChallengeHandler struct {
+	challengeRepo    domain.ChallengeRepository
+	challengeService *services.ChallengeService
+	authRepo         domain.AuthorizationRepository
+}
+
+// NewCreateChallengeHandler creates a new create challenge handler
+func NewCreateChallengeHandler(
+	challengeRepo domain.ChallengeRepository,
+	challengeService *services.ChallengeService,
+	authRepo domain.AuthorizationRepository,
+) *CreateChallengeHandler {
+	return &CreateChallengeHandler{
+		challengeRepo:    challengeRepo,
+		challengeService: challengeService,
+		authRepo:         authRepo,
+	}
+}
+
+// Handle executes the create challenge command
+func (h *CreateChallengeHandler) Handle(ctx context.Context, cmd *CreateChallengeCommand) (*CreateChallengeResult, error) {
+	if err := requireGroupAdmin(ctx, h.authRepo, cmd.UserID, cmd.GroupID); err != nil {
+		return nil, err
+	}
+
+	challenge, err := domain.NewChallenge(
+		cmd.GroupID,
+		cmd.UserID,
+		cmd.Name,
+		cmd.Description,
+		cmd.Target,
+		cmd.StartsAt,
+		cmd.EndsAt,
+		cmd.BonusPoints,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid challenge: %w", err)
+	}
+
+	if err := h.challengeRepo.Create(ctx, challenge); err != nil {
+		return nil, fmt.Errorf("failed to create challenge: %w", err)
+	}
+
+	// A challenge may start in the past: count the logs already recorded
+	if _, err := h.challengeService.RecomputeChallenge(ctx, challenge); err != nil {
+		return nil, fmt.Errorf("failed to compute challenge progress: %w", err)
+	}
+
+	return &CreateChallengeResult{
+		Challenge: challenge,
+	}, nil
+}
@@ -0,0 +1,129 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+)
+
+// defaultMinIntervalMinutes is used when a custom behavior does not specify an interval
+const defaultMinIntervalMinutes = 30
+
+// CreateGroupBehaviorCommand represents a command to add a custom behavior to a group's catalog
+type CreateGroupBehaviorCommand struct {
+	GroupID            uuid.UUID               `json:"group_id" validate:"required"`
+	UserID             uuid.UUID               `json:"user_id" validate:"required"`
+	Name               string                  `json:"name" validate:"required"`
+	Description        string                  `json:"description"`
+	Category           domain.BehaviorCategory `json:"category" validate:"required"`
+	PointValue         int                     `json:"point_value" validate:"required"`
+	MinIntervalMinutes int                     `json:"min_interval_minutes"`
+	Species            domain.Species          `json:"species" validate:"required"`
+	Icon               string                  `json:"icon"`
+}
+
+// CreateGroupBehaviorResult represents the result of creating a group behavior
+type CreateGroupBehaviorResult struct {
+	Behavior *domain.Behavior `json:"behavior"`
+}
+
+// CreateGroupBehaviorHandler handles the creation of custom group behaviors
+type CreateGroupBehaviorHandler struct {
+	behaviorRepo      domain.BehaviorRepository
+	groupBehaviorRepo domain.GroupBehaviorRepository
+	authRepo          domain.AuthorizationRepository
+}
+
+// NewCreateGroupBehaviorHandler creates a new create group behavior handler
+func NewCreateGroupBehaviorHandler(
+	behaviorRepo domain.BehaviorRepository,
+	groupBehaviorRepo domain.GroupBehaviorRepository,
+	authRepo domain.AuthorizationRepository,
+) *CreateGroupBehaviorHandler {
+	return &CreateGroupBehaviorHandler{
+		behaviorRepo:      behaviorRepo,
+		groupBehaviorRepo: groupBehaviorRepo,
+		authRepo:          authRepo,
+	}
+}
+
+// Handle executes the create group behavior command
+func (h *CreateGroupBehaviorHandler) Handle(ctx context.Context, cmd *CreateGroupBehaviorCommand) (*CreateGroupBehaviorResult, error) {
+	if err := requireGroupAdmin(ctx, h.authRepo, cmd.UserID, cmd.GroupID); err != nil {
+		return nil, err
+	}
+
+	if err := h.checkNameAvailable(ctx, cmd.GroupID, cmd.Name); err != nil {
+		return nil, err
+	}
+
+	minInterval := cmd.MinIntervalMinutes
+	if minInterval == 0 {
+		minInterval = defaultMinIntervalMinutes
+	}
+
+	groupBehavior, err := domain.NewCustomGroupBehavior(
+		cmd.GroupID,
+		cmd.UserID,
+		cmd.Name,
+		cmd.Description,
+		cmd.Category,
+		cmd.PointValue,
+		minInterval,
+		cmd.Species,
+		cmd.Icon,
+	)
+	if err != nil {
+		return nil, &domain.ValidationError{Message: fmt.Sprintf("invalid group behavior: %v", err)}
+	}
+
+	if err := h.groupBehaviorRepo.Create(ctx, groupBehavior); err != nil {
+		return nil, fmt.Errorf("failed to save group behavior: %w", err)
+	}
+
+	return &CreateGroupBehaviorResult{
+		Behavior: groupBehavior.Resolve(nil),
+	}, nil
+}
+
+// checkNameAvailable ensures a custom behavior does not shadow a catalog or group behavior
+func (h *CreateGroupBehaviorHandler) checkNameAvailable(ctx context.Context, groupID uuid.UUID, name string) error {
+	existing, err := h.behaviorRepo.GetByName(ctx, name)
+	if err != nil {
+		return fmt.Errorf("failed to check behavior name: %w", err)
+	}
+	if existing != nil {
+		return &domain.ValidationError{Message: fmt.Sprintf("behavior named '%s' already exists in the catalog", name)}
+	}
+
+	groupBehaviors, err := h.groupBehaviorRepo.GetByGroup(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("failed to get group behaviors: %w", err)
+	}
+
+	for _, groupBehavior := range groupBehaviors {
+		if !groupBehavior.IsOverride() && strings.EqualFold(groupBehavior.Name, name) {
+			return &domain.ValidationError{Message: fmt.Sprintf("behavior named '%s' already exists in this group", name)}
+		}
+	}
+
+	return nil
+}
+
+// requireGroupAdmin checks that the user is the admin (owner) of the group
+func requireGroupAdmin(ctx context.Context, authRepo domain.AuthorizationRepository, userID, groupID uuid.UUID) error {
+	groupInfo, err := authRepo.GetGroupInfo(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("failed to get group info: %w", err)
+	}
+
+	if groupInfo.OwnerID != userID {
+		return &AuthorizationError{Message: "Only the group admin can manage this group"}
+	}
+
+	return nil
+}
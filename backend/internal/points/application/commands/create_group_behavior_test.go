@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+)
+
+func TestCreateGroupBehaviorHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	behaviorRepo := mock.NewMockBehaviorRepository()
+	groupBehaviorRepo := mock.NewMockGroupBehaviorRepository()
+	authRepo := mock.NewMockAuthorizationRepository()
+	handler := NewCreateGroupBehaviorHandler(behaviorRepo, groupBehaviorRepo, authRepo)
+
+	adminID, groupID := uuid.New(), uuid.New()
+	authRepo.AddUserGroup(adminID, groupID, &domain.GroupInfo{ID: groupID, OwnerID: adminID})
+
+	sit, _ := domain.NewBehavior("Sit", "Pet sits on command", domain.BehaviorCategoryTraining, 5, 30, domain.SpeciesDog, "sit")
+	behaviorRepo.Create(ctx, sit)
+	agility, _ := domain.NewCustomGroupBehavior(groupID, adminID, "Did agility course", "", domain.BehaviorCategoryTraining, 7, 5, domain.SpeciesDog, "agility")
+	groupBehaviorRepo.Create(ctx, agility)
+
+	tests := []struct {
+		name    string
+		newName string
+		wantErr bool
+	}{
+		{name: "Rejects a catalog name", newName: "Sit", wantErr: true},
+		{name: "Rejects a catalog name in another case", newName: "SIT", wantErr: true},
+		{name: "Rejects a group behavior name in another case", newName: "did Agility Course", wantErr: true},
+		{name: "Accepts a new name", newName: "Rolled over"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := handler.Handle(ctx, &CreateGroupBehaviorCommand{
+				GroupID:    groupID,
+				UserID:     adminID,
+				Name:       tt.newName,
+				Category:   domain.BehaviorCategoryTraining,
+				PointValue: 3,
+				Species:    domain.SpeciesDog,
+			})
+			if _, ok := err.(*domain.ValidationError); tt.wantErr && !ok {
+				t.Errorf("Expected ValidationError, got %v", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+)
+
+// defaultSeasonSlots is the number of pets promoted and relegated per tier when not specified
+const defaultSeasonSlots = 1
+
+// CreateSeasonCommand represents a command to schedule a new season for a group
+type CreateSeasonCommand struct {
+	GroupID         uuid.UUID `json:"group_id" validate:"required"`
+	UserID          uuid.UUID `json:"user_id" validate:"required"`
+	Name            string    `json:"name" validate:"required"`
+	StartDate       time.Time `json:"start_date" validate:"required"`
+	EndDate         time.Time `json:"end_date" validate:"required"`
+	PromotionSlots  *int      `json:"promotion_slots,omitempty"`
+	RelegationSlots *int      `json:"relegation_slots,omitempty"`
+}
+
+// CreateSeasonResult represents the result of creating a season
+type CreateSeasonResult struct {
+	Season *domain.Season `json:"season"`
+}
+
+// CreateSeasonHandler handles the creation of group seasons
+type CreateSeasonHandler struct {
+	seasonRepo domain.SeasonRepository
+	authRepo   domain.AuthorizationRepository
+}
+
+// NewCreateSeasonHandler creates a new create season handler
+func NewCreateSeasonHandler(
+	seasonRepo domain.SeasonRepository,
+	authRepo domain.AuthorizationRepository,
+) *CreateSeasonHandler {
+	return &CreateSeasonHandler{
+		seasonRepo: seasonRepo,
+		authRepo:   authRepo,
+	}
+}
+
+// Handle executes the create season command
+func (h *CreateSeasonHandler) Handle(ctx context.Context, cmd *CreateSeasonCommand) (*CreateSeasonResult, error) {
+	if err := requireGroupAdmin(ctx, h.authRepo, cmd.UserID, cmd.GroupID); err != nil {
+		return nil, err
+	}
+
+	promotionSlots := defaultSeasonSlots
+	if cmd.PromotionSlots != nil {
+		promotionSlots = *cmd.PromotionSlots
+	}
+	relegationSlots := defaultSeasonSlots
+	if cmd.RelegationSlots != nil {
+		relegationSlots = *cmd.RelegationSlots
+	}
+
+	season, err := domain.NewSeason(
+		cmd.GroupID,
+		cmd.UserID,
+		cmd.Name,
+		cmd.StartDate,
+		cmd.EndDate,
+		promotionSlots,
+		relegationSlots,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid season: %w", err)
+	}
+
+	existing, err := h.seasonRepo.GetByGroup(ctx, cmd.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group seasons: %w", err)
+	}
+	for _, other := range existing {
+		if season.Overlaps(other) {
+			return nil, fmt.Errorf("season overlaps with season '%s'", other.Name)
+		}
+	}
+
+	if err := h.seasonRepo.Create(ctx, season); err != nil {
+		return nil, fmt.Errorf("failed to save season: %w", err)
+	}
+
+	return &CreateSeasonResult{
+		Season: season,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/events"
+)
+
+// DeleteBehaviorCommand represents a command to remove a behavior from the global catalog
+type DeleteBehaviorCommand struct {
+	BehaviorID uuid.UUID `json:"behavior_id" validate:"required"`
+	UserID     uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// DeleteBehaviorResult represents the result of deleting a behavior
+type DeleteBehaviorResult struct {
+	Message     string `json:"message"`
+	Deactivated bool   `json:"deactivated"`
+}
+
+// DeleteBehaviorHandler handles the removal of global catalog behaviors.
+// Behaviors that were already logged are deactivated so their history stays intact.
+type DeleteBehaviorHandler struct {
+	behaviorRepo    domain.BehaviorRepository
+	behaviorLogRepo domain.BehaviorLogRepository
+	adminChecker    domain.AdminChecker
+	eventBus        events.Bus
+}
+
+// NewDeleteBehaviorHandler creates a new delete behavior handler
+func NewDeleteBehaviorHandler(
+	behaviorRepo domain.BehaviorRepository,
+	behaviorLogRepo domain.BehaviorLogRepository,
+	adminChecker domain.AdminChecker,
+	eventBus events.Bus,
+) *DeleteBehaviorHandler {
+	return &DeleteBehaviorHandler{
+		behaviorRepo:    behaviorRepo,
+		behaviorLogRepo: behaviorLogRepo,
+		adminChecker:    adminChecker,
+		eventBus:        eventBus,
+	}
+}
+
+// Handle executes the delete behavior command
+func (h *DeleteBehaviorHandler) Handle(ctx context.Context, cmd *DeleteBehaviorCommand) (*DeleteBehaviorResult, error) {
+	if err := requireAdmin(ctx, h.adminChecker, cmd.UserID); err != nil {
+		return nil, err
+	}
+
+	behavior, err := h.behaviorRepo.GetByID(ctx, cmd.BehaviorID)
+	if errors.Is(err, domain.ErrBehaviorNotFound) {
+		return nil, &NotFoundError{Resource: "behavior", ID: cmd.BehaviorID.String()}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get behavior: %w", err)
+	}
+
+	logs, err := h.behaviorLogRepo.Find(ctx, domain.NewBehaviorLogFilter().
+		WithBehavior(behavior.ID).
+		WithPagination(1, 0))
+	if err != nil {
+		return nil, fmt.Errorf("failed to check behavior logs: %w", err)
+	}
+
+	deactivated := len(logs) > 0
+	if deactivated {
+		err = h.behaviorRepo.Delete(ctx, behavior.ID)
+	} else {
+		err = h.behaviorRepo.HardDelete(ctx, behavior.ID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete behavior: %w", err)
+	}
+
+	h.eventBus.Publish(ctx, domain.NewBehaviorDeletedEvent(behavior, deactivated, cmd.UserID))
+
+	message := "Behavior deleted successfully"
+	if deactivated {
+		message = "Behavior has logs and was deactivated instead of deleted"
+	}
+
+	return &DeleteBehaviorResult{
+		Message:     message,
+		Deactivated: deactivated,
+	}, nil
+}
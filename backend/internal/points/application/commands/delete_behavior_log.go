@@ -3,11 +3,12 @@ package commands
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/google/uuid"
 
+	"pet-of-the-day/internal/points/application/services"
 	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/events"
 )
 
 // DeleteBehaviorLogCommand represents a command to delete a behavior log
@@ -27,6 +28,7 @@ type DeleteBehaviorLogHandler struct {
 	dailyScoreRepo   domain.DailyScoreRepository
 	authRepo         domain.AuthorizationRepository
 	userSettingsRepo domain.UserSettingsRepository
+	eventBus         events.Bus
 }
 
 // NewDeleteBehaviorLogHandler creates a new delete behavior log handler
@@ -35,12 +37,14 @@ func NewDeleteBehaviorLogHandler(
 	dailyScoreRepo domain.DailyScoreRepository,
 	authRepo domain.AuthorizationRepository,
 	userSettingsRepo domain.UserSettingsRepository,
+	eventBus events.Bus,
 ) *DeleteBehaviorLogHandler {
 	return &DeleteBehaviorLogHandler{
 		behaviorLogRepo:  behaviorLogRepo,
 		dailyScoreRepo:   dailyScoreRepo,
 		authRepo:         authRepo,
 		userSettingsRepo: userSettingsRepo,
+		eventBus:         eventBus,
 	}
 }
 
@@ -57,16 +61,18 @@ func (h *DeleteBehaviorLogHandler) Handle(ctx context.Context, cmd *DeleteBehavi
 		return nil, err
 	}
 
-	// Update daily scores by removing this behavior log's contribution
-	if err := h.updateDailyScores(ctx, behaviorLog); err != nil {
-		return nil, fmt.Errorf("failed to update daily scores: %w", err)
-	}
-
 	// Delete the behavior log
 	if err := h.behaviorLogRepo.Delete(ctx, cmd.BehaviorLogID); err != nil {
 		return nil, fmt.Errorf("failed to delete behavior log: %w", err)
 	}
 
+	// Rebuild the daily scores the log counted toward
+	if err := h.RebuildDailyScores(ctx, behaviorLog); err != nil {
+		return nil, fmt.Errorf("failed to update daily scores: %w", err)
+	}
+
+	h.eventBus.Publish(ctx, domain.NewBehaviorLogDeletedEvent(behaviorLog, cmd.UserID))
+
 	return &DeleteBehaviorLogResult{
 		Message: "Behavior log deleted successfully",
 	}, nil
@@ -86,68 +92,34 @@ func (h *DeleteBehaviorLogHandler) validateAuthorization(ctx context.Context, us
 	}
 
 	if !canAccess {
-		return fmt.Errorf("user %s does not have permission to delete this behavior log", userID)
+		return &AuthorizationError{Message: fmt.Sprintf("user %s does not have permission to delete this behavior log", userID)}
 	}
 
 	return nil
 }
 
-// updateDailyScores removes this behavior log's contribution from daily scores
-func (h *DeleteBehaviorLogHandler) updateDailyScores(ctx context.Context, behaviorLog *domain.BehaviorLog) error {
-	// Get user's timezone settings
-	userSettings, err := h.userSettingsRepo.GetUserTimezone(ctx, behaviorLog.UserID)
-	if err != nil {
-		userSettings = domain.NewUserTimezoneSettings(behaviorLog.UserID)
-	}
-
-	// Calculate the date based on user's timezone
-	date, err := h.calculateUserDate(behaviorLog.LoggedAt, userSettings)
-	if err != nil {
-		return fmt.Errorf("failed to calculate user date: %w", err)
-	}
+// RebuildDailyScores rebuilds the daily scores a deleted log counted toward from the
+// remaining logs, so the last activity used to break ties moves back too
+func (h *DeleteBehaviorLogHandler) RebuildDailyScores(ctx context.Context, behaviorLog *domain.BehaviorLog) error {
+	// The log was counted on the day of each group it was shared with
+	calendar := services.NewGroupCalendar(h.authRepo, h.userSettingsRepo)
 
 	// Update daily score for each group
 	for _, groupShare := range behaviorLog.GroupShares {
-		dailyScore, err := h.dailyScoreRepo.GetOrCreate(ctx, behaviorLog.PetID, groupShare.GroupID, date)
-		if err != nil {
-			return fmt.Errorf("failed to get daily score: %w", err)
+		// Logs that were never counted in the group have nothing to remove
+		if !groupShare.IsCounted() {
+			continue
 		}
 
-		if err := dailyScore.RemoveBehaviorLog(behaviorLog); err != nil {
-			return fmt.Errorf("failed to remove behavior log from daily score: %w", err)
+		date, err := calendar.LogDay(ctx, groupShare.GroupID, behaviorLog)
+		if err != nil {
+			return fmt.Errorf("failed to calculate group day: %w", err)
 		}
 
-		if err := h.dailyScoreRepo.Update(ctx, dailyScore); err != nil {
-			return fmt.Errorf("failed to update daily score: %w", err)
+		if _, err := h.dailyScoreRepo.RecalculateFromLogs(ctx, behaviorLog.PetID, groupShare.GroupID, date); err != nil {
+			return fmt.Errorf("failed to recalculate daily score: %w", err)
 		}
 	}
 
 	return nil
 }
-
-// calculateUserDate calculates the date for daily scoring based on user's timezone and reset time
-func (h *DeleteBehaviorLogHandler) calculateUserDate(loggedAt time.Time, userSettings *domain.UserTimezoneSettings) (time.Time, error) {
-	location, err := time.LoadLocation(userSettings.Timezone)
-	if err != nil {
-		return time.Time{}, fmt.Errorf("invalid timezone %s: %w", userSettings.Timezone, err)
-	}
-
-	localTime := loggedAt.In(location)
-
-	resetTime, err := time.Parse("15:04", userSettings.DailyResetTime)
-	if err != nil {
-		return time.Time{}, fmt.Errorf("invalid reset time %s: %w", userSettings.DailyResetTime, err)
-	}
-
-	resetDateTime := time.Date(
-		localTime.Year(), localTime.Month(), localTime.Day(),
-		resetTime.Hour(), resetTime.Minute(), 0, 0, location,
-	)
-
-	date := localTime
-	if localTime.After(resetDateTime) {
-		date = localTime.AddDate(0, 0, 1)
-	}
-
-	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, location), nil
-}
\ No newline at end of file
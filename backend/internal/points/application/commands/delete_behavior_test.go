@@ -0,0 +1,217 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+	"pet-of-the-day/internal/shared/auth"
+	"pet-of-the-day/internal/shared/events"
+)
+
+// recordedEvents collects the events published on a bus
+type recordedEvents struct {
+	mu     sync.Mutex
+	events []events.Event
+}
+
+func recordEvents(bus events.Bus, eventTypes ...string) *recordedEvents {
+	recorded := &recordedEvents{}
+	for _, eventType := range eventTypes {
+		bus.Subscribe(eventType, events.HandlerFunc(func(ctx context.Context, event events.Event) error {
+			recorded.mu.Lock()
+			defer recorded.mu.Unlock()
+			recorded.events = append(recorded.events, event)
+			return nil
+		}))
+	}
+	return recorded
+}
+
+func (r *recordedEvents) last() events.Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.events) == 0 {
+		return nil
+	}
+	return r.events[len(r.events)-1]
+}
+
+// unreachableBehaviorRepository fails to look up behaviors
+type unreachableBehaviorRepository struct {
+	*mock.MockBehaviorRepository
+}
+
+func (r *unreachableBehaviorRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Behavior, error) {
+	return nil, errors.New("connection lost")
+}
+
+func TestBehaviorCatalogAdministration(t *testing.T) {
+	ctx := context.Background()
+
+	behaviorRepo := mock.NewMockBehaviorRepository()
+	behaviorLogRepo := mock.NewMockBehaviorLogRepository()
+	eventBus := events.NewInMemoryBus()
+	recorded := recordEvents(eventBus, domain.BehaviorCatalogEventTypes...)
+
+	adminID, userID := uuid.New(), uuid.New()
+	adminChecker := auth.NewAdminAllowlist(adminID)
+
+	createHandler := NewCreateBehaviorHandler(behaviorRepo, adminChecker, eventBus)
+	updateHandler := NewUpdateBehaviorHandler(behaviorRepo, adminChecker, eventBus)
+	deleteHandler := NewDeleteBehaviorHandler(behaviorRepo, behaviorLogRepo, adminChecker, eventBus)
+
+	t.Run("Only admins can create behaviors", func(t *testing.T) {
+		_, err := createHandler.Handle(ctx, &CreateBehaviorCommand{
+			UserID: userID, Name: "Sit", Category: domain.BehaviorCategoryTraining, PointValue: 5, Species: domain.SpeciesDog,
+		})
+		if _, ok := err.(*AuthorizationError); !ok {
+			t.Errorf("Expected AuthorizationError, got %v", err)
+		}
+	})
+
+	t.Run("Create validates the behavior", func(t *testing.T) {
+		_, err := createHandler.Handle(ctx, &CreateBehaviorCommand{
+			UserID: adminID, Name: "Sit", Category: domain.BehaviorCategoryTraining, PointValue: 50, Species: domain.SpeciesDog,
+		})
+		if _, ok := err.(*domain.ValidationError); !ok {
+			t.Errorf("Expected ValidationError for out of range point value, got %v", err)
+		}
+	})
+
+	var sit, fetch *domain.Behavior
+
+	t.Run("Create adds the behavior and publishes an event", func(t *testing.T) {
+		result, err := createHandler.Handle(ctx, &CreateBehaviorCommand{
+			UserID: adminID, Name: "Sit", Category: domain.BehaviorCategoryTraining, PointValue: 5, Species: domain.SpeciesDog,
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		sit = result.Behavior
+
+		if sit.MinIntervalMinutes != defaultMinIntervalMinutes || !sit.IsActive {
+			t.Errorf("Expected an active behavior with the default interval, got %+v", sit)
+		}
+		if event := recorded.last(); event == nil || event.EventType() != domain.BehaviorCreatedEventType || event.AggregateID() != sit.ID {
+			t.Errorf("Expected behavior created event, got %v", event)
+		}
+
+		_, err = createHandler.Handle(ctx, &CreateBehaviorCommand{
+			UserID: adminID, Name: "Sit", Category: domain.BehaviorCategoryTraining, PointValue: 3, Species: domain.SpeciesDog,
+		})
+		if _, ok := err.(*domain.ValidationError); !ok {
+			t.Errorf("Expected ValidationError for duplicate behavior name, got %v", err)
+		}
+
+		result, _ = createHandler.Handle(ctx, &CreateBehaviorCommand{
+			UserID: adminID, Name: "Fetch", Category: domain.BehaviorCategoryPlay, PointValue: 3, Species: domain.SpeciesDog,
+		})
+		fetch = result.Behavior
+	})
+
+	t.Run("Update applies partial changes", func(t *testing.T) {
+		pointValue := 7
+		result, err := updateHandler.Handle(ctx, &UpdateBehaviorCommand{BehaviorID: sit.ID, UserID: adminID, PointValue: &pointValue})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if result.Behavior.PointValue != 7 || result.Behavior.Name != "Sit" {
+			t.Errorf("Expected Sit worth 7 points, got %+v", result.Behavior)
+		}
+		if event := recorded.last(); event == nil || event.EventType() != domain.BehaviorUpdatedEventType {
+			t.Errorf("Expected behavior updated event, got %v", event)
+		}
+
+		name := "Fetch"
+		_, err = updateHandler.Handle(ctx, &UpdateBehaviorCommand{BehaviorID: sit.ID, UserID: adminID, Name: &name})
+		if _, ok := err.(*domain.ValidationError); !ok {
+			t.Errorf("Expected ValidationError when renaming to an existing behavior name, got %v", err)
+		}
+
+		pointValue = 0
+		_, err = updateHandler.Handle(ctx, &UpdateBehaviorCommand{BehaviorID: sit.ID, UserID: adminID, PointValue: &pointValue})
+		if _, ok := err.(*domain.ValidationError); !ok {
+			t.Errorf("Expected ValidationError for zero point value, got %v", err)
+		}
+		stored, _ := behaviorRepo.GetByID(ctx, sit.ID)
+		if stored.PointValue != 7 {
+			t.Errorf("Expected rejected update to leave 7 points, got %d", stored.PointValue)
+		}
+	})
+
+	t.Run("Delete deactivates behaviors that have logs", func(t *testing.T) {
+		behaviorLog, _ := domain.NewBehaviorLog(uuid.New(), sit.ID, userID, 7, time.Now(), "")
+		behaviorLogRepo.Create(ctx, behaviorLog)
+
+		result, err := deleteHandler.Handle(ctx, &DeleteBehaviorCommand{BehaviorID: sit.ID, UserID: adminID})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !result.Deactivated {
+			t.Error("Expected behavior with logs to be deactivated")
+		}
+
+		stored, _ := behaviorRepo.GetByID(ctx, sit.ID)
+		if stored == nil || stored.IsActive {
+			t.Errorf("Expected behavior to be kept inactive, got %+v", stored)
+		}
+
+		event, ok := recorded.last().(*domain.BehaviorDeletedEvent)
+		if !ok || !event.Deactivated {
+			t.Errorf("Expected deactivated behavior deleted event, got %v", recorded.last())
+		}
+	})
+
+	t.Run("Delete removes behaviors that were never logged", func(t *testing.T) {
+		result, err := deleteHandler.Handle(ctx, &DeleteBehaviorCommand{BehaviorID: fetch.ID, UserID: adminID})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if result.Deactivated {
+			t.Error("Expected behavior without logs to be deleted")
+		}
+
+		if stored, _ := behaviorRepo.GetByID(ctx, fetch.ID); stored != nil {
+			t.Errorf("Expected behavior to be removed, got %+v", stored)
+		}
+	})
+
+	t.Run("Unknown behaviors are reported as not found", func(t *testing.T) {
+		_, err := deleteHandler.Handle(ctx, &DeleteBehaviorCommand{BehaviorID: uuid.New(), UserID: adminID})
+		if _, ok := err.(*NotFoundError); !ok {
+			t.Errorf("Expected NotFoundError, got %v", err)
+		}
+	})
+
+	t.Run("Repository failures are not reported as not found", func(t *testing.T) {
+		unreachableRepo := &unreachableBehaviorRepository{MockBehaviorRepository: behaviorRepo}
+		pointValue := 4
+
+		_, err := NewUpdateBehaviorHandler(unreachableRepo, adminChecker, eventBus).
+			Handle(ctx, &UpdateBehaviorCommand{BehaviorID: sit.ID, UserID: adminID, PointValue: &pointValue})
+		var notFoundErr *NotFoundError
+		if err == nil || errors.As(err, &notFoundErr) {
+			t.Errorf("Expected the lookup failure, got %v", err)
+		}
+
+		_, err = NewDeleteBehaviorHandler(unreachableRepo, behaviorLogRepo, adminChecker, eventBus).
+			Handle(ctx, &DeleteBehaviorCommand{BehaviorID: sit.ID, UserID: adminID})
+		if err == nil || errors.As(err, &notFoundErr) {
+			t.Errorf("Expected the lookup failure, got %v", err)
+		}
+	})
+
+	t.Run("Only admins can delete behaviors", func(t *testing.T) {
+		_, err := deleteHandler.Handle(ctx, &DeleteBehaviorCommand{BehaviorID: sit.ID, UserID: userID})
+		if _, ok := err.(*AuthorizationError); !ok {
+			t.Errorf("Expected AuthorizationError, got %v", err)
+		}
+	})
+}
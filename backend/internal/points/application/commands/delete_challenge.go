@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+)
+
+// DeleteChallengeCommand represents a command to remove a challenge from a group
+type DeleteChallengeCommand struct {
+	GroupID     uuid.UUID `json:"group_id" validate:"required"`
+	ChallengeID uuid.UUID `json:"challenge_id" validate:"required"`
+	UserID      uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// DeleteChallengeResult represents the result of deleting a challenge
+type DeleteChallengeResult struct {
+	Message string `json:"message"`
+}
+
+// DeleteChallengeHandler handles the removal of group challenges.
+// Bonus points already awarded by a closed challenge are kept.
+type DeleteChallengeHandler struct {
+	challengeRepo domain.ChallengeRepository
+	authRepo      domain.AuthorizationRepository
+}
+
+// NewDeleteChallengeHandler creates a new delete challenge handler
+func NewDeleteChallengeHandler(
+	challengeRepo domain.ChallengeRepository,
+	authRepo domain.AuthorizationRepository,
+) *DeleteChallengeHandler {
+	return &DeleteChallengeHandler{
+		challengeRepo: challengeRepo,
+		authRepo:      authRepo,
+	}
+}
+
+// Handle executes the delete challenge command
+func (h *DeleteChallengeHandler) Handle(ctx context.Context, cmd *DeleteChallengeCommand) (*DeleteChallengeResult, error) {
+	if err := requireGroupAdmin(ctx, h.authRepo, cmd.UserID, cmd.GroupID); err != nil {
+		return nil, err
+	}
+
+	challenge, err := getGroupChallenge(ctx, h.challengeRepo, cmd.GroupID, cmd.ChallengeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.challengeRepo.Delete(ctx, challenge.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete challenge: %w", err)
+	}
+
+	return &DeleteChallengeResult{
+		Message: "Challenge deleted successfully",
+	}, nil
+}
@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+)
+
+// DeleteCommentCommand represents a command to delete a comment, either one's own or, for the
+// group admin, any comment of the group
+type DeleteCommentCommand struct {
+	CommentID uuid.UUID `json:"comment_id" validate:"required"`
+	GroupID   uuid.UUID `json:"group_id" validate:"required"`
+	UserID    uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// DeleteCommentHandler handles comment deletion and moderation
+type DeleteCommentHandler struct {
+	commentRepo domain.CommentRepository
+	authRepo    domain.AuthorizationRepository
+}
+
+// NewDeleteCommentHandler creates a new delete comment handler
+func NewDeleteCommentHandler(
+	commentRepo domain.CommentRepository,
+	authRepo domain.AuthorizationRepository,
+) *DeleteCommentHandler {
+	return &DeleteCommentHandler{
+		commentRepo: commentRepo,
+		authRepo:    authRepo,
+	}
+}
+
+// Handle executes the delete comment command
+func (h *DeleteCommentHandler) Handle(ctx context.Context, cmd *DeleteCommentCommand) (*domain.Comment, error) {
+	comment, err := getGroupComment(ctx, h.commentRepo, h.authRepo, cmd.UserID, cmd.CommentID, cmd.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Other members' comments can only be removed by the group admin
+	if comment.UserID != cmd.UserID {
+		if err := requireGroupAdmin(ctx, h.authRepo, cmd.UserID, cmd.GroupID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := comment.Delete(cmd.UserID, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := h.commentRepo.Update(ctx, comment); err != nil {
+		return nil, fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	return comment, nil
+}
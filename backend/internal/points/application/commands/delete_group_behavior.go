@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+)
+
+// DeleteGroupBehaviorCommand represents a command to remove a custom group behavior,
+// or to reset a global behavior to its catalog values for the group
+type DeleteGroupBehaviorCommand struct {
+	GroupID    uuid.UUID `json:"group_id" validate:"required"`
+	BehaviorID uuid.UUID `json:"behavior_id" validate:"required"`
+	UserID     uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// DeleteGroupBehaviorResult represents the result of deleting a group behavior
+type DeleteGroupBehaviorResult struct {
+	Message string `json:"message"`
+}
+
+// DeleteGroupBehaviorHandler handles the removal of group behaviors and overrides.
+// Behavior logs that were already recorded keep the points they were awarded.
+type DeleteGroupBehaviorHandler struct {
+	groupBehaviorRepo domain.GroupBehaviorRepository
+	authRepo          domain.AuthorizationRepository
+}
+
+// NewDeleteGroupBehaviorHandler creates a new delete group behavior handler
+func NewDeleteGroupBehaviorHandler(
+	groupBehaviorRepo domain.GroupBehaviorRepository,
+	authRepo domain.AuthorizationRepository,
+) *DeleteGroupBehaviorHandler {
+	return &DeleteGroupBehaviorHandler{
+		groupBehaviorRepo: groupBehaviorRepo,
+		authRepo:          authRepo,
+	}
+}
+
+// Handle executes the delete group behavior command
+func (h *DeleteGroupBehaviorHandler) Handle(ctx context.Context, cmd *DeleteGroupBehaviorCommand) (*DeleteGroupBehaviorResult, error) {
+	if err := requireGroupAdmin(ctx, h.authRepo, cmd.UserID, cmd.GroupID); err != nil {
+		return nil, err
+	}
+
+	groupBehavior, err := h.groupBehaviorRepo.GetByID(ctx, cmd.BehaviorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group behavior: %w", err)
+	}
+
+	// The behavior may also be addressed by the global behavior it overrides
+	if groupBehavior == nil || groupBehavior.GroupID != cmd.GroupID {
+		groupBehavior, err = h.groupBehaviorRepo.GetOverride(ctx, cmd.GroupID, cmd.BehaviorID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get group behavior override: %w", err)
+		}
+	}
+
+	if groupBehavior == nil {
+		return nil, &NotFoundError{Resource: "group behavior", ID: cmd.BehaviorID.String()}
+	}
+
+	if err := h.groupBehaviorRepo.Delete(ctx, groupBehavior.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete group behavior: %w", err)
+	}
+
+	message := "Group behavior deleted successfully"
+	if groupBehavior.IsOverride() {
+		message = "Behavior reset to catalog values"
+	}
+
+	return &DeleteGroupBehaviorResult{
+		Message: message,
+	}, nil
+}
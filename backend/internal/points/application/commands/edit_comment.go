@@ -0,0 +1,79 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+)
+
+// EditCommentCommand represents a command to change the body of one's own comment
+type EditCommentCommand struct {
+	CommentID uuid.UUID `json:"comment_id" validate:"required"`
+	GroupID   uuid.UUID `json:"group_id" validate:"required"`
+	UserID    uuid.UUID `json:"user_id" validate:"required"`
+	Body      string    `json:"body" validate:"required"`
+}
+
+// EditCommentHandler handles comment edits
+type EditCommentHandler struct {
+	commentRepo domain.CommentRepository
+	authRepo    domain.AuthorizationRepository
+}
+
+// NewEditCommentHandler creates a new edit comment handler
+func NewEditCommentHandler(
+	commentRepo domain.CommentRepository,
+	authRepo domain.AuthorizationRepository,
+) *EditCommentHandler {
+	return &EditCommentHandler{
+		commentRepo: commentRepo,
+		authRepo:    authRepo,
+	}
+}
+
+// Handle executes the edit comment command
+func (h *EditCommentHandler) Handle(ctx context.Context, cmd *EditCommentCommand) (*domain.Comment, error) {
+	comment, err := getGroupComment(ctx, h.commentRepo, h.authRepo, cmd.UserID, cmd.CommentID, cmd.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.UserID != cmd.UserID {
+		return nil, &AuthorizationError{Message: "Only the author can edit a comment"}
+	}
+
+	if err := comment.Edit(cmd.UserID, cmd.Body, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := h.commentRepo.Update(ctx, comment); err != nil {
+		return nil, fmt.Errorf("failed to update comment: %w", err)
+	}
+
+	return comment, nil
+}
+
+// getGroupComment returns a comment of a group the user is a member of
+func getGroupComment(ctx context.Context, commentRepo domain.CommentRepository, authRepo domain.AuthorizationRepository, userID, commentID, groupID uuid.UUID) (*domain.Comment, error) {
+	canAccess, err := authRepo.CanUserAccessGroup(ctx, userID, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check group access: %w", err)
+	}
+	if !canAccess {
+		return nil, &AuthorizationError{Message: "user is not a member of this group"}
+	}
+
+	comment, err := commentRepo.GetByID(ctx, commentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comment: %w", err)
+	}
+	if comment == nil || comment.GroupID != groupID {
+		return nil, &NotFoundError{Resource: "comment", ID: commentID.String()}
+	}
+
+	return comment, nil
+}
@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+)
+
+// ReactToBehaviorLogCommand represents a command to add or remove a reaction on a behavior log
+// shared with a group
+type ReactToBehaviorLogCommand struct {
+	BehaviorLogID uuid.UUID `json:"behavior_log_id" validate:"required"`
+	GroupID       uuid.UUID `json:"group_id" validate:"required"`
+	UserID        uuid.UUID `json:"user_id" validate:"required"`
+	Emoji         string    `json:"emoji" validate:"required"`
+	Remove        bool      `json:"remove"`
+}
+
+// ReactToBehaviorLogResult represents the reactions on the log after the change
+type ReactToBehaviorLogResult struct {
+	BehaviorLogID uuid.UUID                `json:"behavior_log_id"`
+	GroupID       uuid.UUID                `json:"group_id"`
+	Reactions     []domain.ReactionSummary `json:"reactions"`
+}
+
+// ReactToBehaviorLogHandler handles reactions on behavior logs
+type ReactToBehaviorLogHandler struct {
+	behaviorLogRepo domain.BehaviorLogRepository
+	reactionRepo    domain.ReactionRepository
+	authRepo        domain.AuthorizationRepository
+}
+
+// NewReactToBehaviorLogHandler creates a new react to behavior log handler
+func NewReactToBehaviorLogHandler(
+	behaviorLogRepo domain.BehaviorLogRepository,
+	reactionRepo domain.ReactionRepository,
+	authRepo domain.AuthorizationRepository,
+) *ReactToBehaviorLogHandler {
+	return &ReactToBehaviorLogHandler{
+		behaviorLogRepo: behaviorLogRepo,
+		reactionRepo:    reactionRepo,
+		authRepo:        authRepo,
+	}
+}
+
+// Handle executes the react to behavior log command. Reacting twice with the same emoji or
+// removing a missing reaction changes nothing.
+func (h *ReactToBehaviorLogHandler) Handle(ctx context.Context, cmd *ReactToBehaviorLogCommand) (*ReactToBehaviorLogResult, error) {
+	if _, err := getSharedBehaviorLog(ctx, h.behaviorLogRepo, h.authRepo, cmd.UserID, cmd.BehaviorLogID, cmd.GroupID); err != nil {
+		return nil, err
+	}
+
+	if cmd.Remove {
+		if err := h.reactionRepo.Remove(ctx, cmd.BehaviorLogID, cmd.GroupID, cmd.UserID, cmd.Emoji); err != nil {
+			return nil, fmt.Errorf("failed to remove reaction: %w", err)
+		}
+	} else {
+		reaction, err := domain.NewReaction(cmd.BehaviorLogID, cmd.GroupID, cmd.UserID, cmd.Emoji)
+		if err != nil {
+			return nil, err
+		}
+		if err := h.reactionRepo.Add(ctx, reaction); err != nil {
+			return nil, fmt.Errorf("failed to add reaction: %w", err)
+		}
+	}
+
+	reactions, err := h.reactionRepo.GetByBehaviorLog(ctx, cmd.BehaviorLogID, cmd.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reactions: %w", err)
+	}
+
+	return &ReactToBehaviorLogResult{
+		BehaviorLogID: cmd.BehaviorLogID,
+		GroupID:       cmd.GroupID,
+		Reactions:     domain.SummarizeReactions(reactions, cmd.UserID),
+	}, nil
+}
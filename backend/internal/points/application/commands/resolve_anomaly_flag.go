@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/services"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// ResolveAnomalyFlagCommand represents a command to settle a flag of the moderation queue.
+// A confirmed flag rejects the log in the group, a dismissed one lets it count.
+type ResolveAnomalyFlagCommand struct {
+	FlagID    uuid.UUID `json:"flag_id" validate:"required"`
+	GroupID   uuid.UUID `json:"group_id" validate:"required"`
+	UserID    uuid.UUID `json:"user_id" validate:"required"`
+	Confirmed bool      `json:"confirmed"`
+}
+
+// ResolveAnomalyFlagResult represents the result of resolving a flag
+type ResolveAnomalyFlagResult struct {
+	Flag *domain.AnomalyFlag `json:"flag"`
+}
+
+// ResolveAnomalyFlagHandler handles the decisions of group admins on flagged behavior logs
+type ResolveAnomalyFlagHandler struct {
+	flagRepo       domain.AnomalyFlagRepository
+	anomalyService *services.AnomalyService
+	authRepo       domain.AuthorizationRepository
+}
+
+// NewResolveAnomalyFlagHandler creates a new resolve anomaly flag handler
+func NewResolveAnomalyFlagHandler(
+	flagRepo domain.AnomalyFlagRepository,
+	anomalyService *services.AnomalyService,
+	authRepo domain.AuthorizationRepository,
+) *ResolveAnomalyFlagHandler {
+	return &ResolveAnomalyFlagHandler{
+		flagRepo:       flagRepo,
+		anomalyService: anomalyService,
+		authRepo:       authRepo,
+	}
+}
+
+// Handle executes the resolve anomaly flag command
+func (h *ResolveAnomalyFlagHandler) Handle(ctx context.Context, cmd *ResolveAnomalyFlagCommand) (*ResolveAnomalyFlagResult, error) {
+	if err := requireGroupAdmin(ctx, h.authRepo, cmd.UserID, cmd.GroupID); err != nil {
+		return nil, err
+	}
+
+	flag, err := h.flagRepo.GetByID(ctx, cmd.FlagID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get anomaly flag: %w", err)
+	}
+	if flag == nil || flag.GroupID != cmd.GroupID {
+		return nil, &NotFoundError{Resource: "anomaly flag", ID: cmd.FlagID.String()}
+	}
+
+	if err := h.anomalyService.Resolve(ctx, flag, cmd.UserID, cmd.Confirmed); err != nil {
+		return nil, err
+	}
+
+	return &ResolveAnomalyFlagResult{
+		Flag: flag,
+	}, nil
+}
@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/services"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// ReversePointAdjustmentCommand represents a command to cancel a point ledger entry
+type ReversePointAdjustmentCommand struct {
+	GroupID      uuid.UUID `json:"group_id" validate:"required"`
+	AdjustmentID uuid.UUID `json:"adjustment_id" validate:"required"`
+	UserID       uuid.UUID `json:"user_id" validate:"required"`
+	Reason       string    `json:"reason" validate:"required"`
+}
+
+// ReversePointAdjustmentResult represents the result of reversing a point adjustment
+type ReversePointAdjustmentResult struct {
+	Reversal *domain.PointAdjustment `json:"reversal"`
+}
+
+// ReversePointAdjustmentHandler handles reversals of point ledger entries. The entry is kept
+// and a compensating entry is recorded on the same day, so the ledger stays a full audit trail.
+type ReversePointAdjustmentHandler struct {
+	adjustmentRepo domain.PointAdjustmentRepository
+	ledgerService  *services.PointLedgerService
+	authRepo       domain.AuthorizationRepository
+}
+
+// NewReversePointAdjustmentHandler creates a new reverse point adjustment handler
+func NewReversePointAdjustmentHandler(
+	adjustmentRepo domain.PointAdjustmentRepository,
+	ledgerService *services.PointLedgerService,
+	authRepo domain.AuthorizationRepository,
+) *ReversePointAdjustmentHandler {
+	return &ReversePointAdjustmentHandler{
+		adjustmentRepo: adjustmentRepo,
+		ledgerService:  ledgerService,
+		authRepo:       authRepo,
+	}
+}
+
+// Handle executes the reverse point adjustment command
+func (h *ReversePointAdjustmentHandler) Handle(ctx context.Context, cmd *ReversePointAdjustmentCommand) (*ReversePointAdjustmentResult, error) {
+	if err := requireGroupAdmin(ctx, h.authRepo, cmd.UserID, cmd.GroupID); err != nil {
+		return nil, err
+	}
+
+	adjustment, err := h.adjustmentRepo.GetByID(ctx, cmd.AdjustmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get point adjustment: %w", err)
+	}
+	if adjustment == nil || adjustment.GroupID != cmd.GroupID {
+		return nil, &NotFoundError{Resource: "point adjustment", ID: cmd.AdjustmentID.String()}
+	}
+
+	existing, err := h.adjustmentRepo.GetReversal(ctx, adjustment.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get point adjustment reversal: %w", err)
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("point adjustment is already reversed")
+	}
+
+	reversal, err := adjustment.Reverse(cmd.UserID, cmd.Reason)
+	if err != nil {
+		return nil, fmt.Errorf("invalid reversal: %w", err)
+	}
+
+	if err := h.ledgerService.Record(ctx, reversal); err != nil {
+		return nil, err
+	}
+
+	return &ReversePointAdjustmentResult{
+		Reversal: reversal,
+	}, nil
+}
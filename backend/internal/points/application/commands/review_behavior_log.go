@@ -0,0 +1,159 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/services"
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/events"
+)
+
+// ReviewAction is what a member does with a behavior log shared with a group in verification mode
+type ReviewAction string
+
+const (
+	// ReviewActionConfirm verifies a pending log so that it counts in the group
+	ReviewActionConfirm ReviewAction = "confirm"
+	// ReviewActionDispute sends a log to the group admin
+	ReviewActionDispute ReviewAction = "dispute"
+	// ReviewActionUphold is the admin keeping a disputed log
+	ReviewActionUphold ReviewAction = "uphold"
+	// ReviewActionReject is the admin turning down a disputed log
+	ReviewActionReject ReviewAction = "reject"
+)
+
+// ReviewBehaviorLogCommand represents a command to review a behavior log in a group
+type ReviewBehaviorLogCommand struct {
+	BehaviorLogID uuid.UUID    `json:"behavior_log_id" validate:"required"`
+	GroupID       uuid.UUID    `json:"group_id" validate:"required"`
+	UserID        uuid.UUID    `json:"user_id" validate:"required"`
+	Action        ReviewAction `json:"action" validate:"required"`
+	Reason        string       `json:"reason,omitempty"` // Required to dispute
+}
+
+// ReviewBehaviorLogResult represents the result of reviewing a behavior log
+type ReviewBehaviorLogResult struct {
+	BehaviorLog *domain.BehaviorLog          `json:"behavior_log"`
+	Share       domain.BehaviorLogGroupShare `json:"share"`
+}
+
+// ReviewBehaviorLogHandler handles the peer verification of behavior logs. Members other than the
+// pet's owners confirm or dispute logs, and the group admin resolves disputes. The group's daily
+// score is updated whenever a log starts or stops counting.
+type ReviewBehaviorLogHandler struct {
+	behaviorLogRepo  domain.BehaviorLogRepository
+	dailyScoreRepo   domain.DailyScoreRepository
+	authRepo         domain.AuthorizationRepository
+	userSettingsRepo domain.UserSettingsRepository
+	eventBus         events.Bus
+}
+
+// NewReviewBehaviorLogHandler creates a new review behavior log handler
+func NewReviewBehaviorLogHandler(
+	behaviorLogRepo domain.BehaviorLogRepository,
+	dailyScoreRepo domain.DailyScoreRepository,
+	authRepo domain.AuthorizationRepository,
+	userSettingsRepo domain.UserSettingsRepository,
+	eventBus events.Bus,
+) *ReviewBehaviorLogHandler {
+	return &ReviewBehaviorLogHandler{
+		behaviorLogRepo:  behaviorLogRepo,
+		dailyScoreRepo:   dailyScoreRepo,
+		authRepo:         authRepo,
+		userSettingsRepo: userSettingsRepo,
+		eventBus:         eventBus,
+	}
+}
+
+// Handle executes the review behavior log command
+func (h *ReviewBehaviorLogHandler) Handle(ctx context.Context, cmd *ReviewBehaviorLogCommand) (*ReviewBehaviorLogResult, error) {
+	behaviorLog, err := h.behaviorLogRepo.GetByID(ctx, cmd.BehaviorLogID)
+	if err != nil {
+		return nil, &NotFoundError{Resource: "behavior log", ID: cmd.BehaviorLogID.String()}
+	}
+
+	share := behaviorLog.GetGroupShare(cmd.GroupID)
+	if share == nil {
+		return nil, &NotFoundError{Resource: "behavior log", ID: cmd.BehaviorLogID.String()}
+	}
+
+	wasCounted := share.IsCounted()
+	now := time.Now()
+
+	switch cmd.Action {
+	case ReviewActionConfirm, ReviewActionDispute:
+		if err := h.validateReviewer(ctx, cmd.UserID, cmd.GroupID, behaviorLog); err != nil {
+			return nil, err
+		}
+		if cmd.Action == ReviewActionConfirm {
+			err = behaviorLog.ConfirmInGroup(cmd.GroupID, cmd.UserID, now)
+		} else {
+			err = behaviorLog.DisputeInGroup(cmd.GroupID, cmd.UserID, cmd.Reason, now)
+		}
+	case ReviewActionUphold, ReviewActionReject:
+		if err := requireGroupAdmin(ctx, h.authRepo, cmd.UserID, cmd.GroupID); err != nil {
+			return nil, err
+		}
+		err = behaviorLog.ResolveDisputeInGroup(cmd.GroupID, cmd.UserID, cmd.Action == ReviewActionUphold, now)
+	default:
+		return nil, fmt.Errorf("invalid review action: %s", cmd.Action)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.behaviorLogRepo.Update(ctx, behaviorLog); err != nil {
+		return nil, fmt.Errorf("failed to update behavior log: %w", err)
+	}
+
+	if share.IsCounted() != wasCounted {
+		if err := h.updateDailyScore(ctx, behaviorLog, cmd.GroupID); err != nil {
+			return nil, fmt.Errorf("failed to update daily score: %w", err)
+		}
+	}
+
+	h.eventBus.Publish(ctx, domain.NewBehaviorLogReviewedEvent(behaviorLog, share, cmd.UserID))
+
+	return &ReviewBehaviorLogResult{
+		BehaviorLog: behaviorLog,
+		Share:       *share,
+	}, nil
+}
+
+// validateReviewer checks that the user is a member of the group who does not own the pet
+func (h *ReviewBehaviorLogHandler) validateReviewer(ctx context.Context, userID, groupID uuid.UUID, behaviorLog *domain.BehaviorLog) error {
+	canAccess, err := h.authRepo.CanUserAccessGroup(ctx, userID, groupID)
+	if err != nil {
+		return fmt.Errorf("failed to check group access: %w", err)
+	}
+	if !canAccess {
+		return &AuthorizationError{Message: "user is not a member of this group"}
+	}
+
+	ownsPet, err := h.authRepo.CanUserAccessPet(ctx, userID, behaviorLog.PetID)
+	if err != nil {
+		return fmt.Errorf("failed to check pet access: %w", err)
+	}
+	if ownsPet {
+		return &AuthorizationError{Message: "behavior logs cannot be reviewed by the pet's owners"}
+	}
+
+	return nil
+}
+
+// updateDailyScore rebuilds the group's daily score of the day the log was logged, using the
+// day boundaries of the group like when it was created. Rebuilding rather than removing the
+// log keeps the last activity used to break ties accurate.
+func (h *ReviewBehaviorLogHandler) updateDailyScore(ctx context.Context, behaviorLog *domain.BehaviorLog, groupID uuid.UUID) error {
+	date, err := services.NewGroupCalendar(h.authRepo, h.userSettingsRepo).LogDay(ctx, groupID, behaviorLog)
+	if err != nil {
+		return fmt.Errorf("failed to calculate group day: %w", err)
+	}
+
+	_, err = h.dailyScoreRepo.RecalculateFromLogs(ctx, behaviorLog.PetID, groupID, date)
+	return err
+}
@@ -0,0 +1,122 @@
+package commands
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+	"pet-of-the-day/internal/shared/events"
+)
+
+func TestReviewBehaviorLogHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	behaviorLogRepo := mock.NewMockBehaviorLogRepository()
+	dailyScoreRepo := mock.NewMockDailyScoreRepository().WithRecalculationSources(behaviorLogRepo, mock.NewMockPointAdjustmentRepository())
+	authRepo := mock.NewMockAuthorizationRepository()
+	bus := events.NewInMemoryEventBus()
+	reviewed := recordEvents(bus, domain.BehaviorLogReviewedEventType)
+
+	handler := NewReviewBehaviorLogHandler(behaviorLogRepo, dailyScoreRepo, authRepo, mock.NewMockUserSettingsRepository(), bus)
+
+	adminID, ownerID, memberID, outsiderID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	groupID, petID := uuid.New(), uuid.New()
+	group := &domain.GroupInfo{ID: groupID, Name: "Park", OwnerID: adminID}
+	for _, userID := range []uuid.UUID{adminID, ownerID, memberID} {
+		authRepo.AddUserGroup(userID, groupID, group)
+	}
+	authRepo.AddUserPet(ownerID, petID, &domain.PetInfo{ID: petID, Name: "Rex", Species: domain.SpeciesDog, OwnerID: ownerID})
+	authRepo.AddPetToGroup(petID, groupID)
+
+	// Logged at noon, before the default reset time, so it counts for that day
+	date := time.Now().AddDate(0, 0, -2)
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	behaviorLog := &domain.BehaviorLog{ID: uuid.New(), PetID: petID, BehaviorID: uuid.New(), UserID: ownerID, PointsAwarded: 8, LoggedAt: day.Add(12 * time.Hour)}
+	behaviorLog.AddGroupShare(groupID)
+	behaviorLog.RequireVerification(groupID)
+	behaviorLogRepo.Create(ctx, behaviorLog)
+
+	review := func(userID uuid.UUID, action ReviewAction, reason string) (*ReviewBehaviorLogResult, error) {
+		return handler.Handle(ctx, &ReviewBehaviorLogCommand{
+			BehaviorLogID: behaviorLog.ID,
+			GroupID:       groupID,
+			UserID:        userID,
+			Action:        action,
+			Reason:        reason,
+		})
+	}
+	groupPoints := func() int {
+		score, _ := dailyScoreRepo.GetOrCreate(ctx, petID, groupID, day)
+		return score.TotalPoints
+	}
+
+	t.Run("Only other members can confirm", func(t *testing.T) {
+		for name, userID := range map[string]uuid.UUID{"Pet owner": ownerID, "Outsider": outsiderID} {
+			if _, err := review(userID, ReviewActionConfirm, ""); err == nil {
+				t.Errorf("%s: expected authorization error", name)
+			} else if _, ok := err.(*AuthorizationError); !ok {
+				t.Errorf("%s: expected AuthorizationError, got %T", name, err)
+			}
+		}
+		if groupPoints() != 0 {
+			t.Errorf("Expected the pending log not to count, got %d points", groupPoints())
+		}
+	})
+
+	t.Run("Confirming counts the log", func(t *testing.T) {
+		result, err := review(memberID, ReviewActionConfirm, "")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if result.Share.Status != domain.ShareStatusVerified {
+			t.Errorf("Expected verified share, got %s", result.Share.Status)
+		}
+		if groupPoints() != 8 {
+			t.Errorf("Expected 8 points, got %d", groupPoints())
+		}
+
+		event, ok := reviewed.last().(*domain.BehaviorLogReviewedEvent)
+		if !ok || event.GroupID != groupID || event.Status != domain.ShareStatusVerified {
+			t.Errorf("Expected reviewed event for the group, got %+v", reviewed.last())
+		}
+	})
+
+	t.Run("Disputing takes the points back until the admin resolves it", func(t *testing.T) {
+		if _, err := review(memberID, ReviewActionDispute, "Rex was at the vet"); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if groupPoints() != 0 {
+			t.Errorf("Expected the disputed log not to count, got %d points", groupPoints())
+		}
+
+		if _, err := review(memberID, ReviewActionUphold, ""); err == nil {
+			t.Error("Expected error when a member resolves a dispute")
+		}
+
+		if _, err := review(adminID, ReviewActionUphold, ""); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if groupPoints() != 8 {
+			t.Errorf("Expected the upheld log to count again, got %d points", groupPoints())
+		}
+	})
+
+	t.Run("Rejected logs stay out of the score", func(t *testing.T) {
+		review(memberID, ReviewActionDispute, "Logged twice")
+		if _, err := review(adminID, ReviewActionReject, ""); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if groupPoints() != 0 {
+			t.Errorf("Expected the rejected log not to count, got %d points", groupPoints())
+		}
+
+		stored, _ := behaviorLogRepo.GetByID(ctx, behaviorLog.ID)
+		if stored.GetGroupShare(groupID).Status != domain.ShareStatusRejected {
+			t.Errorf("Expected the rejected status to be saved, got %s", stored.GetGroupShare(groupID).Status)
+		}
+	})
+}
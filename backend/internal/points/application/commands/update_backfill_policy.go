@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+)
+
+// UpdateBackfillPolicyCommand represents a command to change how late behaviors can be logged
+// in a group. Late corrections already recorded are kept.
+type UpdateBackfillPolicyCommand struct {
+	GroupID          uuid.UUID `json:"group_id" validate:"required"`
+	UserID           uuid.UUID `json:"user_id" validate:"required"`
+	MaxBackfillHours int       `json:"max_backfill_hours"`
+	AllowClosedDays  bool      `json:"allow_closed_days"`
+	RerunPetOfTheDay bool      `json:"rerun_pet_of_the_day"`
+}
+
+// UpdateBackfillPolicyResult represents the result of updating a backfill policy
+type UpdateBackfillPolicyResult struct {
+	Policy *domain.BackfillPolicy `json:"policy"`
+}
+
+// UpdateBackfillPolicyHandler handles changes to the backfill policy of a group
+type UpdateBackfillPolicyHandler struct {
+	policyRepo domain.BackfillPolicyRepository
+	authRepo   domain.AuthorizationRepository
+}
+
+// NewUpdateBackfillPolicyHandler creates a new update backfill policy handler
+func NewUpdateBackfillPolicyHandler(
+	policyRepo domain.BackfillPolicyRepository,
+	authRepo domain.AuthorizationRepository,
+) *UpdateBackfillPolicyHandler {
+	return &UpdateBackfillPolicyHandler{
+		policyRepo: policyRepo,
+		authRepo:   authRepo,
+	}
+}
+
+// Handle executes the update backfill policy command
+func (h *UpdateBackfillPolicyHandler) Handle(ctx context.Context, cmd *UpdateBackfillPolicyCommand) (*UpdateBackfillPolicyResult, error) {
+	if err := requireGroupAdmin(ctx, h.authRepo, cmd.UserID, cmd.GroupID); err != nil {
+		return nil, err
+	}
+
+	policy, err := domain.NewBackfillPolicy(cmd.GroupID, cmd.UserID, cmd.MaxBackfillHours, cmd.AllowClosedDays, cmd.RerunPetOfTheDay)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.policyRepo.Save(ctx, policy); err != nil {
+		return nil, fmt.Errorf("failed to save backfill policy: %w", err)
+	}
+
+	return &UpdateBackfillPolicyResult{
+		Policy: policy,
+	}, nil
+}
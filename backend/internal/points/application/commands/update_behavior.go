@@ -0,0 +1,114 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/events"
+)
+
+// UpdateBehaviorCommand represents a command to change a behavior of the global catalog.
+// Category and species are part of the behavior's identity and cannot be changed.
+type UpdateBehaviorCommand struct {
+	BehaviorID         uuid.UUID `json:"behavior_id" validate:"required"`
+	UserID             uuid.UUID `json:"user_id" validate:"required"`
+	Name               *string   `json:"name,omitempty"`
+	Description        *string   `json:"description,omitempty"`
+	PointValue         *int      `json:"point_value,omitempty"`
+	MinIntervalMinutes *int      `json:"min_interval_minutes,omitempty"`
+	Icon               *string   `json:"icon,omitempty"`
+	IsActive           *bool     `json:"is_active,omitempty"`
+}
+
+// UpdateBehaviorResult represents the result of updating a behavior
+type UpdateBehaviorResult struct {
+	Behavior *domain.Behavior `json:"behavior"`
+}
+
+// UpdateBehaviorHandler handles changes to the global behavior catalog.
+// Behavior logs that were already recorded keep the points they were awarded.
+type UpdateBehaviorHandler struct {
+	behaviorRepo domain.BehaviorRepository
+	adminChecker domain.AdminChecker
+	eventBus     events.Bus
+}
+
+// NewUpdateBehaviorHandler creates a new update behavior handler
+func NewUpdateBehaviorHandler(
+	behaviorRepo domain.BehaviorRepository,
+	adminChecker domain.AdminChecker,
+	eventBus events.Bus,
+) *UpdateBehaviorHandler {
+	return &UpdateBehaviorHandler{
+		behaviorRepo: behaviorRepo,
+		adminChecker: adminChecker,
+		eventBus:     eventBus,
+	}
+}
+
+// Handle executes the update behavior command
+func (h *UpdateBehaviorHandler) Handle(ctx context.Context, cmd *UpdateBehaviorCommand) (*UpdateBehaviorResult, error) {
+	if err := requireAdmin(ctx, h.adminChecker, cmd.UserID); err != nil {
+		return nil, err
+	}
+
+	behavior, err := h.behaviorRepo.GetByID(ctx, cmd.BehaviorID)
+	if errors.Is(err, domain.ErrBehaviorNotFound) {
+		return nil, &NotFoundError{Resource: "behavior", ID: cmd.BehaviorID.String()}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get behavior: %w", err)
+	}
+
+	name := behavior.Name
+	if cmd.Name != nil && *cmd.Name != behavior.Name {
+		existing, err := h.behaviorRepo.GetByName(ctx, *cmd.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check behavior name: %w", err)
+		}
+		if existing != nil && existing.ID != behavior.ID {
+			return nil, &domain.ValidationError{Message: fmt.Sprintf("behavior named '%s' already exists in the catalog", *cmd.Name)}
+		}
+		name = *cmd.Name
+	}
+	description := behavior.Description
+	if cmd.Description != nil {
+		description = *cmd.Description
+	}
+	pointValue := behavior.PointValue
+	if cmd.PointValue != nil {
+		pointValue = *cmd.PointValue
+	}
+	minInterval := behavior.MinIntervalMinutes
+	if cmd.MinIntervalMinutes != nil {
+		minInterval = *cmd.MinIntervalMinutes
+	}
+	icon := behavior.Icon
+	if cmd.Icon != nil {
+		icon = *cmd.Icon
+	}
+	isActive := behavior.IsActive
+	if cmd.IsActive != nil {
+		isActive = *cmd.IsActive
+	}
+
+	// Work on a copy so a rejected update does not leak into the repository's instance
+	updated := *behavior
+	if err := updated.Update(name, description, pointValue, minInterval, icon, isActive); err != nil {
+		return nil, &domain.ValidationError{Message: fmt.Sprintf("invalid behavior: %v", err)}
+	}
+
+	if err := h.behaviorRepo.Update(ctx, &updated); err != nil {
+		return nil, fmt.Errorf("failed to update behavior: %w", err)
+	}
+
+	h.eventBus.Publish(ctx, domain.NewBehaviorUpdatedEvent(&updated, cmd.UserID))
+
+	return &UpdateBehaviorResult{
+		Behavior: &updated,
+	}, nil
+}
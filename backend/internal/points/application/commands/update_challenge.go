@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/services"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// UpdateChallengeCommand represents a command to change a running challenge
+type UpdateChallengeCommand struct {
+	GroupID     uuid.UUID              `json:"group_id" validate:"required"`
+	ChallengeID uuid.UUID              `json:"challenge_id" validate:"required"`
+	UserID      uuid.UUID              `json:"user_id" validate:"required"`
+	Name        string                 `json:"name" validate:"required"`
+	Description string                 `json:"description"`
+	Target      domain.ChallengeTarget `json:"target" validate:"required"`
+	StartsAt    time.Time              `json:"starts_at" validate:"required"`
+	EndsAt      time.Time              `json:"ends_at" validate:"required"`
+	BonusPoints int                    `json:"bonus_points"`
+}
+
+// UpdateChallengeResult represents the result of updating a challenge
+type UpdateChallengeResult struct {
+	Challenge *domain.Challenge `json:"challenge"`
+}
+
+// UpdateChallengeHandler handles changes to group challenges. Closed challenges cannot be
+// changed, and the progress of running ones is recomputed for the new definition.
+type UpdateChallengeHandler struct {
+	challengeRepo    domain.ChallengeRepository
+	challengeService *services.ChallengeService
+	authRepo         domain.AuthorizationRepository
+}
+
+// NewUpdateChallengeHandler creates a new update challenge handler
+func NewUpdateChallengeHandler(
+	challengeRepo domain.ChallengeRepository,
+	challengeService *services.ChallengeService,
+	authRepo domain.AuthorizationRepository,
+) *UpdateChallengeHandler {
+	return &UpdateChallengeHandler{
+		challengeRepo:    challengeRepo,
+		challengeService: challengeService,
+		authRepo:         authRepo,
+	}
+}
+
+// Handle executes the update challenge command
+func (h *UpdateChallengeHandler) Handle(ctx context.Context, cmd *UpdateChallengeCommand) (*UpdateChallengeResult, error) {
+	if err := requireGroupAdmin(ctx, h.authRepo, cmd.UserID, cmd.GroupID); err != nil {
+		return nil, err
+	}
+
+	challenge, err := getGroupChallenge(ctx, h.challengeRepo, cmd.GroupID, cmd.ChallengeID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = challenge.Update(cmd.Name, cmd.Description, cmd.Target, cmd.StartsAt, cmd.EndsAt, cmd.BonusPoints)
+	if err != nil {
+		return nil, fmt.Errorf("invalid challenge: %w", err)
+	}
+
+	if err := h.challengeRepo.Update(ctx, challenge); err != nil {
+		return nil, fmt.Errorf("failed to update challenge: %w", err)
+	}
+
+	if _, err := h.challengeService.RecomputeChallenge(ctx, challenge); err != nil {
+		return nil, fmt.Errorf("failed to compute challenge progress: %w", err)
+	}
+
+	return &UpdateChallengeResult{
+		Challenge: challenge,
+	}, nil
+}
+
+// getGroupChallenge loads a challenge and checks it belongs to the group
+func getGroupChallenge(ctx context.Context, challengeRepo domain.ChallengeRepository, groupID, challengeID uuid.UUID) (*domain.Challenge, error) {
+	challenge, err := challengeRepo.GetByID(ctx, challengeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get challenge: %w", err)
+	}
+
+	if challenge == nil || challenge.GroupID != groupID {
+		return nil, &NotFoundError{Resource: "challenge", ID: challengeID.String()}
+	}
+
+	return challenge, nil
+}
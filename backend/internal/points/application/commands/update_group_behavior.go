@@ -0,0 +1,168 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+)
+
+// UpdateGroupBehaviorCommand represents a command to change a behavior in a group's catalog.
+// BehaviorID is either a custom group behavior or a global behavior, which is then overridden
+// for the group. Only PointValue and IsActive can be changed for global behaviors.
+type UpdateGroupBehaviorCommand struct {
+	GroupID            uuid.UUID `json:"group_id" validate:"required"`
+	BehaviorID         uuid.UUID `json:"behavior_id" validate:"required"`
+	UserID             uuid.UUID `json:"user_id" validate:"required"`
+	Name               *string   `json:"name,omitempty"`
+	Description        *string   `json:"description,omitempty"`
+	PointValue         *int      `json:"point_value,omitempty"`
+	MinIntervalMinutes *int      `json:"min_interval_minutes,omitempty"`
+	Icon               *string   `json:"icon,omitempty"`
+	IsActive           *bool     `json:"is_active,omitempty"`
+}
+
+// UpdateGroupBehaviorResult represents the result of updating a group behavior
+type UpdateGroupBehaviorResult struct {
+	Behavior *domain.Behavior `json:"behavior"`
+}
+
+// UpdateGroupBehaviorHandler handles changes to a group's behavior catalog
+type UpdateGroupBehaviorHandler struct {
+	behaviorRepo      domain.BehaviorRepository
+	groupBehaviorRepo domain.GroupBehaviorRepository
+	authRepo          domain.AuthorizationRepository
+}
+
+// NewUpdateGroupBehaviorHandler creates a new update group behavior handler
+func NewUpdateGroupBehaviorHandler(
+	behaviorRepo domain.BehaviorRepository,
+	groupBehaviorRepo domain.GroupBehaviorRepository,
+	authRepo domain.AuthorizationRepository,
+) *UpdateGroupBehaviorHandler {
+	return &UpdateGroupBehaviorHandler{
+		behaviorRepo:      behaviorRepo,
+		groupBehaviorRepo: groupBehaviorRepo,
+		authRepo:          authRepo,
+	}
+}
+
+// Handle executes the update group behavior command
+func (h *UpdateGroupBehaviorHandler) Handle(ctx context.Context, cmd *UpdateGroupBehaviorCommand) (*UpdateGroupBehaviorResult, error) {
+	if err := requireGroupAdmin(ctx, h.authRepo, cmd.UserID, cmd.GroupID); err != nil {
+		return nil, err
+	}
+
+	// Custom behavior of this group
+	groupBehavior, err := h.groupBehaviorRepo.GetByID(ctx, cmd.BehaviorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group behavior: %w", err)
+	}
+
+	if groupBehavior != nil && groupBehavior.GroupID == cmd.GroupID && !groupBehavior.IsOverride() {
+		return h.updateCustom(ctx, groupBehavior, cmd)
+	}
+
+	// Otherwise a global behavior is being overridden for the group
+	return h.overrideGlobal(ctx, cmd)
+}
+
+// updateCustom applies changes to a custom group behavior
+func (h *UpdateGroupBehaviorHandler) updateCustom(ctx context.Context, groupBehavior *domain.GroupBehavior, cmd *UpdateGroupBehaviorCommand) (*UpdateGroupBehaviorResult, error) {
+	name := groupBehavior.Name
+	if cmd.Name != nil {
+		name = *cmd.Name
+	}
+	description := groupBehavior.Description
+	if cmd.Description != nil {
+		description = *cmd.Description
+	}
+	pointValue := groupBehavior.PointValue
+	if cmd.PointValue != nil {
+		pointValue = *cmd.PointValue
+	}
+	minInterval := groupBehavior.MinIntervalMinutes
+	if cmd.MinIntervalMinutes != nil {
+		minInterval = *cmd.MinIntervalMinutes
+	}
+	icon := groupBehavior.Icon
+	if cmd.Icon != nil {
+		icon = *cmd.Icon
+	}
+	isActive := groupBehavior.IsActive
+	if cmd.IsActive != nil {
+		isActive = *cmd.IsActive
+	}
+
+	if err := groupBehavior.Update(name, description, pointValue, minInterval, icon, isActive); err != nil {
+		return nil, &domain.ValidationError{Message: fmt.Sprintf("invalid group behavior: %v", err)}
+	}
+
+	if err := h.groupBehaviorRepo.Update(ctx, groupBehavior); err != nil {
+		return nil, fmt.Errorf("failed to update group behavior: %w", err)
+	}
+
+	return &UpdateGroupBehaviorResult{
+		Behavior: groupBehavior.Resolve(nil),
+	}, nil
+}
+
+// overrideGlobal creates or updates the group's override of a global behavior
+func (h *UpdateGroupBehaviorHandler) overrideGlobal(ctx context.Context, cmd *UpdateGroupBehaviorCommand) (*UpdateGroupBehaviorResult, error) {
+	if cmd.Name != nil || cmd.Description != nil || cmd.MinIntervalMinutes != nil || cmd.Icon != nil {
+		return nil, &domain.ValidationError{Message: "only the point value and status of a catalog behavior can be changed"}
+	}
+
+	base, err := h.behaviorRepo.GetByID(ctx, cmd.BehaviorID)
+	if errors.Is(err, domain.ErrBehaviorNotFound) {
+		return nil, &NotFoundError{Resource: "behavior", ID: cmd.BehaviorID.String()}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get behavior: %w", err)
+	}
+
+	override, err := h.groupBehaviorRepo.GetOverride(ctx, cmd.GroupID, base.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group behavior override: %w", err)
+	}
+
+	if override == nil {
+		pointValue := base.PointValue
+		if cmd.PointValue != nil {
+			pointValue = *cmd.PointValue
+		}
+		isActive := true
+		if cmd.IsActive != nil {
+			isActive = *cmd.IsActive
+		}
+
+		override, err = domain.NewGroupBehaviorOverride(cmd.GroupID, cmd.UserID, base, pointValue, isActive)
+		if err != nil {
+			return nil, &domain.ValidationError{Message: fmt.Sprintf("invalid group behavior override: %v", err)}
+		}
+
+		if err := h.groupBehaviorRepo.Create(ctx, override); err != nil {
+			return nil, fmt.Errorf("failed to save group behavior override: %w", err)
+		}
+	} else {
+		if cmd.PointValue != nil {
+			if err := override.SetPointValue(*cmd.PointValue); err != nil {
+				return nil, &domain.ValidationError{Message: fmt.Sprintf("invalid group behavior override: %v", err)}
+			}
+		}
+		if cmd.IsActive != nil {
+			override.SetActive(*cmd.IsActive)
+		}
+
+		if err := h.groupBehaviorRepo.Update(ctx, override); err != nil {
+			return nil, fmt.Errorf("failed to update group behavior override: %w", err)
+		}
+	}
+
+	return &UpdateGroupBehaviorResult{
+		Behavior: override.Resolve(base),
+	}, nil
+}
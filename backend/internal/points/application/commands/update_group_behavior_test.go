@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+)
+
+func TestUpdateGroupBehaviorHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	behaviorRepo := mock.NewMockBehaviorRepository()
+	groupBehaviorRepo := mock.NewMockGroupBehaviorRepository()
+	authRepo := mock.NewMockAuthorizationRepository()
+	handler := NewUpdateGroupBehaviorHandler(behaviorRepo, groupBehaviorRepo, authRepo)
+
+	adminID, memberID, groupID := uuid.New(), uuid.New(), uuid.New()
+	authRepo.AddUserGroup(adminID, groupID, &domain.GroupInfo{ID: groupID, OwnerID: adminID})
+	authRepo.AddUserGroup(memberID, groupID, &domain.GroupInfo{ID: groupID, OwnerID: adminID})
+
+	sit, _ := domain.NewBehavior("Sit", "Pet sits on command", domain.BehaviorCategoryTraining, 5, 30, domain.SpeciesDog, "sit")
+	behaviorRepo.Create(ctx, sit)
+
+	pointValue := 8
+
+	t.Run("Only the group admin can change behaviors", func(t *testing.T) {
+		_, err := handler.Handle(ctx, &UpdateGroupBehaviorCommand{GroupID: groupID, BehaviorID: sit.ID, UserID: memberID, PointValue: &pointValue})
+		if _, ok := err.(*AuthorizationError); !ok {
+			t.Errorf("Expected AuthorizationError, got %v", err)
+		}
+	})
+
+	t.Run("Re-pointing a catalog behavior creates an override", func(t *testing.T) {
+		result, err := handler.Handle(ctx, &UpdateGroupBehaviorCommand{GroupID: groupID, BehaviorID: sit.ID, UserID: adminID, PointValue: &pointValue})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if result.Behavior.ID != sit.ID || result.Behavior.PointValue != 8 {
+			t.Errorf("Expected Sit worth 8 points, got %+v", result.Behavior)
+		}
+		if sit.PointValue != 5 {
+			t.Errorf("Expected global catalog to be unchanged, got %d", sit.PointValue)
+		}
+
+		// Disabling updates the same override
+		disabled := false
+		if _, err := handler.Handle(ctx, &UpdateGroupBehaviorCommand{GroupID: groupID, BehaviorID: sit.ID, UserID: adminID, IsActive: &disabled}); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		groupBehaviors, _ := groupBehaviorRepo.GetByGroup(ctx, groupID)
+		if len(groupBehaviors) != 1 || groupBehaviors[0].IsActive || groupBehaviors[0].PointValue != 8 {
+			t.Errorf("Expected a single disabled override worth 8 points, got %+v", groupBehaviors)
+		}
+	})
+
+	t.Run("Catalog behavior names cannot be changed", func(t *testing.T) {
+		name := "Sit nicely"
+		_, err := handler.Handle(ctx, &UpdateGroupBehaviorCommand{GroupID: groupID, BehaviorID: sit.ID, UserID: adminID, Name: &name})
+		if _, ok := err.(*domain.ValidationError); !ok {
+			t.Errorf("Expected ValidationError when renaming a catalog behavior, got %v", err)
+		}
+	})
+
+	t.Run("Deleting an override resets the behavior", func(t *testing.T) {
+		deleteHandler := NewDeleteGroupBehaviorHandler(groupBehaviorRepo, authRepo)
+		if _, err := deleteHandler.Handle(ctx, &DeleteGroupBehaviorCommand{GroupID: groupID, BehaviorID: sit.ID, UserID: adminID}); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		override, _ := groupBehaviorRepo.GetOverride(ctx, groupID, sit.ID)
+		if override != nil {
+			t.Error("Expected override to be removed")
+		}
+	})
+}
@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+)
+
+// UpdateScoringRulesCommand represents a command to change how pets are ranked in a group.
+// Pet of the Day winners already selected are kept. Mode defaults to raw points.
+type UpdateScoringRulesCommand struct {
+	GroupID          uuid.UUID           `json:"group_id" validate:"required"`
+	UserID           uuid.UUID           `json:"user_id" validate:"required"`
+	Mode             domain.ScoringMode  `json:"mode"`
+	CategoryCap      int                 `json:"category_cap"`
+	DiminishingAfter int                 `json:"diminishing_after"`
+	TieBreakers      []domain.TieBreaker `json:"tie_breakers"`
+	SharedTitles     bool                `json:"shared_titles"`
+}
+
+// UpdateScoringRulesResult represents the result of updating scoring rules
+type UpdateScoringRulesResult struct {
+	Rules *domain.ScoringRules `json:"rules"`
+}
+
+// UpdateScoringRulesHandler handles changes to the scoring rules of a group
+type UpdateScoringRulesHandler struct {
+	rulesRepo domain.ScoringRulesRepository
+	authRepo  domain.AuthorizationRepository
+}
+
+// NewUpdateScoringRulesHandler creates a new update scoring rules handler
+func NewUpdateScoringRulesHandler(
+	rulesRepo domain.ScoringRulesRepository,
+	authRepo domain.AuthorizationRepository,
+) *UpdateScoringRulesHandler {
+	return &UpdateScoringRulesHandler{
+		rulesRepo: rulesRepo,
+		authRepo:  authRepo,
+	}
+}
+
+// Handle executes the update scoring rules command
+func (h *UpdateScoringRulesHandler) Handle(ctx context.Context, cmd *UpdateScoringRulesCommand) (*UpdateScoringRulesResult, error) {
+	if err := requireGroupAdmin(ctx, h.authRepo, cmd.UserID, cmd.GroupID); err != nil {
+		return nil, err
+	}
+
+	mode := cmd.Mode
+	if mode == "" {
+		mode = domain.ScoringModeRaw
+	}
+
+	rules, err := domain.NewScoringRules(cmd.GroupID, cmd.UserID, mode, cmd.CategoryCap, cmd.DiminishingAfter, cmd.TieBreakers, cmd.SharedTitles)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.rulesRepo.Save(ctx, rules); err != nil {
+		return nil, fmt.Errorf("failed to save scoring rules: %w", err)
+	}
+
+	return &UpdateScoringRulesResult{
+		Rules: rules,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+)
+
+// UpdateShareCardSettingsCommand represents a command to change the share card style of a group.
+// Cards already rendered are replaced the next time they are requested.
+type UpdateShareCardSettingsCommand struct {
+	GroupID  uuid.UUID           `json:"group_id" validate:"required"`
+	UserID   uuid.UUID           `json:"user_id" validate:"required"`
+	Template domain.CardTemplate `json:"template"`
+	Font     domain.CardFont     `json:"font"`
+}
+
+// UpdateShareCardSettingsResult represents the result of updating share card settings
+type UpdateShareCardSettingsResult struct {
+	Settings *domain.ShareCardSettings `json:"settings"`
+}
+
+// UpdateShareCardSettingsHandler handles changes to the share card settings of a group
+type UpdateShareCardSettingsHandler struct {
+	settingsRepo domain.ShareCardSettingsRepository
+	authRepo     domain.AuthorizationRepository
+}
+
+// NewUpdateShareCardSettingsHandler creates a new update share card settings handler
+func NewUpdateShareCardSettingsHandler(
+	settingsRepo domain.ShareCardSettingsRepository,
+	authRepo domain.AuthorizationRepository,
+) *UpdateShareCardSettingsHandler {
+	return &UpdateShareCardSettingsHandler{
+		settingsRepo: settingsRepo,
+		authRepo:     authRepo,
+	}
+}
+
+// Handle executes the update share card settings command
+func (h *UpdateShareCardSettingsHandler) Handle(ctx context.Context, cmd *UpdateShareCardSettingsCommand) (*UpdateShareCardSettingsResult, error) {
+	if err := requireGroupAdmin(ctx, h.authRepo, cmd.UserID, cmd.GroupID); err != nil {
+		return nil, err
+	}
+
+	settings, err := domain.NewShareCardSettings(cmd.GroupID, cmd.UserID, cmd.Template, cmd.Font)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.settingsRepo.Save(ctx, settings); err != nil {
+		return nil, fmt.Errorf("failed to save share card settings: %w", err)
+	}
+
+	return &UpdateShareCardSettingsResult{
+		Settings: settings,
+	}, nil
+}
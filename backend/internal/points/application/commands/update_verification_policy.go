@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+)
+
+// UpdateVerificationPolicyCommand represents a command to change the peer verification mode of a group.
+// Logs already shared with the group keep their status.
+type UpdateVerificationPolicyCommand struct {
+	GroupID         uuid.UUID               `json:"group_id" validate:"required"`
+	UserID          uuid.UUID               `json:"user_id" validate:"required"`
+	Mode            domain.VerificationMode `json:"mode" validate:"required"`
+	Threshold       int                     `json:"threshold"`
+	AutoHoldFlagged bool                    `json:"auto_hold_flagged"`
+}
+
+// UpdateVerificationPolicyResult represents the result of updating a verification policy
+type UpdateVerificationPolicyResult struct {
+	Policy *domain.VerificationPolicy `json:"policy"`
+}
+
+// UpdateVerificationPolicyHandler handles changes to the verification policy of a group
+type UpdateVerificationPolicyHandler struct {
+	policyRepo domain.VerificationPolicyRepository
+	authRepo   domain.AuthorizationRepository
+}
+
+// NewUpdateVerificationPolicyHandler creates a new update verification policy handler
+func NewUpdateVerificationPolicyHandler(
+	policyRepo domain.VerificationPolicyRepository,
+	authRepo domain.AuthorizationRepository,
+) *UpdateVerificationPolicyHandler {
+	return &UpdateVerificationPolicyHandler{
+		policyRepo: policyRepo,
+		authRepo:   authRepo,
+	}
+}
+
+// Handle executes the update verification policy command
+func (h *UpdateVerificationPolicyHandler) Handle(ctx context.Context, cmd *UpdateVerificationPolicyCommand) (*UpdateVerificationPolicyResult, error) {
+	if err := requireGroupAdmin(ctx, h.authRepo, cmd.UserID, cmd.GroupID); err != nil {
+		return nil, err
+	}
+
+	policy, err := domain.NewVerificationPolicy(cmd.GroupID, cmd.UserID, cmd.Mode, cmd.Threshold, cmd.AutoHoldFlagged)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.policyRepo.Save(ctx, policy); err != nil {
+		return nil, fmt.Errorf("failed to save verification policy: %w", err)
+	}
+
+	return &UpdateVerificationPolicyResult{
+		Policy: policy,
+	}, nil
+}
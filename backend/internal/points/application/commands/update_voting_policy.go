@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+)
+
+// UpdateVotingPolicyCommand represents a command to change how a group selects Pet of the Day.
+// Votes already cast are kept.
+type UpdateVotingPolicyCommand struct {
+	GroupID        uuid.UUID `json:"group_id" validate:"required"`
+	UserID         uuid.UUID `json:"user_id" validate:"required"`
+	Enabled        bool      `json:"enabled"`
+	WindowHours    int       `json:"window_hours"`
+	WeightByPoints bool      `json:"weight_by_points"`
+}
+
+// UpdateVotingPolicyResult represents the result of updating a voting policy
+type UpdateVotingPolicyResult struct {
+	Policy *domain.VotingPolicy `json:"policy"`
+}
+
+// UpdateVotingPolicyHandler handles changes to the voting policy of a group
+type UpdateVotingPolicyHandler struct {
+	policyRepo domain.VotingPolicyRepository
+	authRepo   domain.AuthorizationRepository
+}
+
+// NewUpdateVotingPolicyHandler creates a new update voting policy handler
+func NewUpdateVotingPolicyHandler(
+	policyRepo domain.VotingPolicyRepository,
+	authRepo domain.AuthorizationRepository,
+) *UpdateVotingPolicyHandler {
+	return &UpdateVotingPolicyHandler{
+		policyRepo: policyRepo,
+		authRepo:   authRepo,
+	}
+}
+
+// Handle executes the update voting policy command
+func (h *UpdateVotingPolicyHandler) Handle(ctx context.Context, cmd *UpdateVotingPolicyCommand) (*UpdateVotingPolicyResult, error) {
+	if err := requireGroupAdmin(ctx, h.authRepo, cmd.UserID, cmd.GroupID); err != nil {
+		return nil, err
+	}
+
+	policy, err := domain.NewVotingPolicy(cmd.GroupID, cmd.UserID, cmd.Enabled, cmd.WindowHours, cmd.WeightByPoints)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.policyRepo.Save(ctx, policy); err != nil {
+		return nil, fmt.Errorf("failed to save voting policy: %w", err)
+	}
+
+	return &UpdateVotingPolicyResult{
+		Policy: policy,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetAllBehaviorsQuery represents an admin query for the whole behavior catalog, including inactive behaviors
+type GetAllBehaviorsQuery struct {
+	UserID uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// GetAllBehaviorsResult represents the result of getting the whole behavior catalog
+type GetAllBehaviorsResult struct {
+	Behaviors []*domain.Behavior `json:"behaviors"`
+}
+
+// GetAllBehaviorsHandler handles admin queries for the behavior catalog
+type GetAllBehaviorsHandler struct {
+	behaviorRepo domain.BehaviorRepository
+	adminChecker domain.AdminChecker
+}
+
+// NewGetAllBehaviorsHandler creates a new get all behaviors handler
+func NewGetAllBehaviorsHandler(
+	behaviorRepo domain.BehaviorRepository,
+	adminChecker domain.AdminChecker,
+) *GetAllBehaviorsHandler {
+	return &GetAllBehaviorsHandler{
+		behaviorRepo: behaviorRepo,
+		adminChecker: adminChecker,
+	}
+}
+
+// Handle executes the get all behaviors query
+func (h *GetAllBehaviorsHandler) Handle(ctx context.Context, query *GetAllBehaviorsQuery) (*GetAllBehaviorsResult, error) {
+	isAdmin, err := h.adminChecker.IsAdmin(ctx, query.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check admin rights: %w", err)
+	}
+	if !isAdmin {
+		return nil, &commands.AuthorizationError{Message: "user is not allowed to manage the behavior catalog"}
+	}
+
+	behaviors, err := h.behaviorRepo.GetAllIncludingInactive(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get behaviors: %w", err)
+	}
+
+	return &GetAllBehaviorsResult{
+		Behaviors: behaviors,
+	}, nil
+}
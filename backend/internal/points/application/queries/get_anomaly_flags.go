@@ -0,0 +1,90 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetAnomalyFlagsQuery represents a query for the moderation queue of a group
+type GetAnomalyFlagsQuery struct {
+	GroupID uuid.UUID                `json:"group_id" validate:"required"`
+	UserID  uuid.UUID                `json:"user_id" validate:"required"`
+	Status  domain.AnomalyFlagStatus `json:"status"`
+	Limit   int                      `json:"limit"`
+	Offset  int                      `json:"offset"`
+}
+
+// GetAnomalyFlagsResult represents flags of a group, most recent first
+type GetAnomalyFlagsResult struct {
+	GroupID uuid.UUID                `json:"group_id"`
+	Status  domain.AnomalyFlagStatus `json:"status"`
+	Flags   []*domain.AnomalyFlag    `json:"flags"`
+	Limit   int                      `json:"limit"`
+	Offset  int                      `json:"offset"`
+}
+
+// GetAnomalyFlagsHandler handles moderation queue queries
+type GetAnomalyFlagsHandler struct {
+	flagRepo domain.AnomalyFlagRepository
+	authRepo domain.AuthorizationRepository
+}
+
+// NewGetAnomalyFlagsHandler creates a new get anomaly flags handler
+func NewGetAnomalyFlagsHandler(
+	flagRepo domain.AnomalyFlagRepository,
+	authRepo domain.AuthorizationRepository,
+) *GetAnomalyFlagsHandler {
+	return &GetAnomalyFlagsHandler{
+		flagRepo: flagRepo,
+		authRepo: authRepo,
+	}
+}
+
+// Handle processes the get anomaly flags query. Only the group admin moderates the group.
+func (h *GetAnomalyFlagsHandler) Handle(ctx context.Context, query *GetAnomalyFlagsQuery) (*GetAnomalyFlagsResult, error) {
+	groupInfo, err := h.authRepo.GetGroupInfo(ctx, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group info: %w", err)
+	}
+	if groupInfo.OwnerID != query.UserID {
+		return nil, &commands.AuthorizationError{Message: "only the group admin can view the moderation queue"}
+	}
+
+	switch query.Status {
+	case "":
+		query.Status = domain.AnomalyFlagStatusOpen
+	case domain.AnomalyFlagStatusOpen, domain.AnomalyFlagStatusDismissed, domain.AnomalyFlagStatusConfirmed:
+	default:
+		return nil, fmt.Errorf("invalid anomaly flag status: %s", query.Status)
+	}
+
+	if query.Limit <= 0 {
+		query.Limit = 50
+	}
+	if query.Limit > 100 {
+		query.Limit = 100
+	}
+
+	filter := domain.NewAnomalyFlagFilter().
+		WithGroup(query.GroupID).
+		WithStatus(query.Status).
+		WithPagination(query.Limit, query.Offset)
+
+	flags, err := h.flagRepo.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get anomaly flags: %w", err)
+	}
+
+	return &GetAnomalyFlagsResult{
+		GroupID: query.GroupID,
+		Status:  query.Status,
+		Flags:   flags,
+		Limit:   query.Limit,
+		Offset:  query.Offset,
+	}, nil
+}
@@ -0,0 +1,52 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetBackfillPolicyQuery represents a query for the backfill policy of a group
+type GetBackfillPolicyQuery struct {
+	GroupID uuid.UUID `json:"group_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// GetBackfillPolicyHandler handles backfill policy queries
+type GetBackfillPolicyHandler struct {
+	policyRepo domain.BackfillPolicyRepository
+	authRepo   domain.AuthorizationRepository
+}
+
+// NewGetBackfillPolicyHandler creates a new get backfill policy handler
+func NewGetBackfillPolicyHandler(
+	policyRepo domain.BackfillPolicyRepository,
+	authRepo domain.AuthorizationRepository,
+) *GetBackfillPolicyHandler {
+	return &GetBackfillPolicyHandler{
+		policyRepo: policyRepo,
+		authRepo:   authRepo,
+	}
+}
+
+// Handle processes the get backfill policy query
+func (h *GetBackfillPolicyHandler) Handle(ctx context.Context, query *GetBackfillPolicyQuery) (*domain.BackfillPolicy, error) {
+	canAccess, err := h.authRepo.CanUserAccessGroup(ctx, query.UserID, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check group access: %w", err)
+	}
+	if !canAccess {
+		return nil, &commands.AuthorizationError{Message: "user does not have access to specified group"}
+	}
+
+	policy, err := h.policyRepo.GetByGroup(ctx, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get backfill policy: %w", err)
+	}
+
+	return policy, nil
+}
@@ -0,0 +1,163 @@
+package queries
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/application/services"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetBehaviorLogAttachmentsQuery represents a query for the photos attached to a behavior log
+type GetBehaviorLogAttachmentsQuery struct {
+	BehaviorLogID uuid.UUID `json:"behavior_log_id" validate:"required"`
+	UserID        uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// GetBehaviorLogAttachmentsResult represents the attachments of a behavior log, oldest first
+type GetBehaviorLogAttachmentsResult struct {
+	BehaviorLogID uuid.UUID                       `json:"behavior_log_id"`
+	Attachments   []*domain.BehaviorLogAttachment `json:"attachments"`
+}
+
+// GetBehaviorLogAttachmentsHandler handles behavior log attachment queries
+type GetBehaviorLogAttachmentsHandler struct {
+	behaviorLogRepo   domain.BehaviorLogRepository
+	authRepo          domain.AuthorizationRepository
+	attachmentService *services.AttachmentService
+}
+
+// NewGetBehaviorLogAttachmentsHandler creates a new get behavior log attachments handler
+func NewGetBehaviorLogAttachmentsHandler(
+	behaviorLogRepo domain.BehaviorLogRepository,
+	authRepo domain.AuthorizationRepository,
+	attachmentService *services.AttachmentService,
+) *GetBehaviorLogAttachmentsHandler {
+	return &GetBehaviorLogAttachmentsHandler{
+		behaviorLogRepo:   behaviorLogRepo,
+		authRepo:          authRepo,
+		attachmentService: attachmentService,
+	}
+}
+
+// Handle processes the get behavior log attachments query
+func (h *GetBehaviorLogAttachmentsHandler) Handle(ctx context.Context, query *GetBehaviorLogAttachmentsQuery) (*GetBehaviorLogAttachmentsResult, error) {
+	if _, err := getVisibleBehaviorLog(ctx, h.behaviorLogRepo, h.authRepo, query.UserID, query.BehaviorLogID); err != nil {
+		return nil, err
+	}
+
+	attachments, err := h.attachmentService.GetAttachments(ctx, query.BehaviorLogID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetBehaviorLogAttachmentsResult{
+		BehaviorLogID: query.BehaviorLogID,
+		Attachments:   attachments,
+	}, nil
+}
+
+// GetAttachmentContentQuery represents a query for the file of an attachment or its thumbnail
+type GetAttachmentContentQuery struct {
+	BehaviorLogID uuid.UUID `json:"behavior_log_id" validate:"required"`
+	AttachmentID  uuid.UUID `json:"attachment_id" validate:"required"`
+	UserID        uuid.UUID `json:"user_id" validate:"required"`
+	Thumbnail     bool      `json:"thumbnail"`
+}
+
+// GetAttachmentContentResult holds the content of an attachment. The caller closes it.
+type GetAttachmentContentResult struct {
+	Attachment *domain.BehaviorLogAttachment
+	MimeType   string
+	Content    io.ReadCloser
+}
+
+// GetAttachmentContentHandler handles attachment downloads
+type GetAttachmentContentHandler struct {
+	behaviorLogRepo   domain.BehaviorLogRepository
+	attachmentRepo    domain.BehaviorLogAttachmentRepository
+	authRepo          domain.AuthorizationRepository
+	attachmentService *services.AttachmentService
+}
+
+// NewGetAttachmentContentHandler creates a new get attachment content handler
+func NewGetAttachmentContentHandler(
+	behaviorLogRepo domain.BehaviorLogRepository,
+	attachmentRepo domain.BehaviorLogAttachmentRepository,
+	authRepo domain.AuthorizationRepository,
+	attachmentService *services.AttachmentService,
+) *GetAttachmentContentHandler {
+	return &GetAttachmentContentHandler{
+		behaviorLogRepo:   behaviorLogRepo,
+		attachmentRepo:    attachmentRepo,
+		authRepo:          authRepo,
+		attachmentService: attachmentService,
+	}
+}
+
+// Handle processes the get attachment content query
+func (h *GetAttachmentContentHandler) Handle(ctx context.Context, query *GetAttachmentContentQuery) (*GetAttachmentContentResult, error) {
+	if _, err := getVisibleBehaviorLog(ctx, h.behaviorLogRepo, h.authRepo, query.UserID, query.BehaviorLogID); err != nil {
+		return nil, err
+	}
+
+	attachment, err := h.attachmentRepo.GetByID(ctx, query.AttachmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachment: %w", err)
+	}
+	if attachment == nil || attachment.BehaviorLogID != query.BehaviorLogID {
+		return nil, &commands.NotFoundError{Resource: "attachment", ID: query.AttachmentID.String()}
+	}
+
+	content, mimeType, err := h.attachmentService.Open(attachment, query.Thumbnail)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetAttachmentContentResult{
+		Attachment: attachment,
+		MimeType:   mimeType,
+		Content:    content,
+	}, nil
+}
+
+// getVisibleBehaviorLog returns a behavior log if the user can see it: the user logged it,
+// can access the pet, or is a member of a group it is shared with
+func getVisibleBehaviorLog(ctx context.Context, behaviorLogRepo domain.BehaviorLogRepository, authRepo domain.AuthorizationRepository, userID, behaviorLogID uuid.UUID) (*domain.BehaviorLog, error) {
+	behaviorLog, err := behaviorLogRepo.GetByID(ctx, behaviorLogID)
+	if errors.Is(err, domain.ErrBehaviorLogNotFound) {
+		return nil, &commands.NotFoundError{Resource: "behavior log", ID: behaviorLogID.String()}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get behavior log: %w", err)
+	}
+
+	if behaviorLog.UserID == userID {
+		return behaviorLog, nil
+	}
+
+	canAccessPet, err := authRepo.CanUserAccessPet(ctx, userID, behaviorLog.PetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check pet access: %w", err)
+	}
+	if canAccessPet {
+		return behaviorLog, nil
+	}
+
+	for _, share := range behaviorLog.GroupShares {
+		canAccessGroup, err := authRepo.CanUserAccessGroup(ctx, userID, share.GroupID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check group access: %w", err)
+		}
+		if canAccessGroup {
+			return behaviorLog, nil
+		}
+	}
+
+	return nil, &commands.AuthorizationError{Message: "user does not have access to specified behavior log"}
+}
@@ -0,0 +1,116 @@
+package queries
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetBehaviorLogCommentsQuery represents a query for the comments and reactions on a behavior
+// log in one of the groups it is shared with
+type GetBehaviorLogCommentsQuery struct {
+	BehaviorLogID uuid.UUID `json:"behavior_log_id" validate:"required"`
+	GroupID       uuid.UUID `json:"group_id" validate:"required"`
+	UserID        uuid.UUID `json:"user_id" validate:"required"`
+	Limit         int       `json:"limit"`
+	Offset        int       `json:"offset"`
+}
+
+// GetBehaviorLogCommentsResult represents a page of comments, oldest first. Replies carry the
+// ID of the comment they answer so that clients can build the threads.
+type GetBehaviorLogCommentsResult struct {
+	BehaviorLogID uuid.UUID                `json:"behavior_log_id"`
+	GroupID       uuid.UUID                `json:"group_id"`
+	Comments      []*domain.Comment        `json:"comments"`
+	Reactions     []domain.ReactionSummary `json:"reactions"`
+	Total         int                      `json:"total"`
+	Limit         int                      `json:"limit"`
+	Offset        int                      `json:"offset"`
+}
+
+// GetBehaviorLogCommentsHandler handles behavior log comment queries
+type GetBehaviorLogCommentsHandler struct {
+	behaviorLogRepo domain.BehaviorLogRepository
+	commentRepo     domain.CommentRepository
+	reactionRepo    domain.ReactionRepository
+	authRepo        domain.AuthorizationRepository
+}
+
+// NewGetBehaviorLogCommentsHandler creates a new get behavior log comments handler
+func NewGetBehaviorLogCommentsHandler(
+	behaviorLogRepo domain.BehaviorLogRepository,
+	commentRepo domain.CommentRepository,
+	reactionRepo domain.ReactionRepository,
+	authRepo domain.AuthorizationRepository,
+) *GetBehaviorLogCommentsHandler {
+	return &GetBehaviorLogCommentsHandler{
+		behaviorLogRepo: behaviorLogRepo,
+		commentRepo:     commentRepo,
+		reactionRepo:    reactionRepo,
+		authRepo:        authRepo,
+	}
+}
+
+// Handle processes the get behavior log comments query
+func (h *GetBehaviorLogCommentsHandler) Handle(ctx context.Context, query *GetBehaviorLogCommentsQuery) (*GetBehaviorLogCommentsResult, error) {
+	canAccess, err := h.authRepo.CanUserAccessGroup(ctx, query.UserID, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check group access: %w", err)
+	}
+	if !canAccess {
+		return nil, &commands.AuthorizationError{Message: "user does not have access to specified group"}
+	}
+
+	// Comments are scoped to the group share of the log
+	behaviorLog, err := h.behaviorLogRepo.GetByID(ctx, query.BehaviorLogID)
+	if errors.Is(err, domain.ErrBehaviorLogNotFound) {
+		return nil, &commands.NotFoundError{Resource: "behavior log", ID: query.BehaviorLogID.String()}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get behavior log: %w", err)
+	}
+	if behaviorLog.GetGroupShare(query.GroupID) == nil {
+		return nil, &commands.NotFoundError{Resource: "behavior log", ID: query.BehaviorLogID.String()}
+	}
+
+	if query.Limit <= 0 {
+		query.Limit = 50
+	}
+	if query.Limit > 100 {
+		query.Limit = 100
+	}
+
+	filter := domain.NewCommentFilter().
+		WithBehaviorLog(query.BehaviorLogID).
+		WithGroup(query.GroupID)
+
+	total, err := h.commentRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count comments: %w", err)
+	}
+
+	comments, err := h.commentRepo.Find(ctx, filter.WithPagination(query.Limit, query.Offset))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comments: %w", err)
+	}
+
+	reactions, err := h.reactionRepo.GetByBehaviorLog(ctx, query.BehaviorLogID, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reactions: %w", err)
+	}
+
+	return &GetBehaviorLogCommentsResult{
+		BehaviorLogID: query.BehaviorLogID,
+		GroupID:       query.GroupID,
+		Comments:      comments,
+		Reactions:     domain.SummarizeReactions(reactions, query.UserID),
+		Total:         total,
+		Limit:         query.Limit,
+		Offset:        query.Offset,
+	}, nil
+}
@@ -0,0 +1,155 @@
+package queries
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+	"pet-of-the-day/internal/shared/events"
+)
+
+func TestBehaviorLogComments(t *testing.T) {
+	ctx := context.Background()
+
+	behaviorLogRepo := mock.NewMockBehaviorLogRepository()
+	commentRepo := mock.NewMockCommentRepository()
+	reactionRepo := mock.NewMockReactionRepository()
+	authRepo := mock.NewMockAuthorizationRepository()
+	bus := events.NewInMemoryEventBus()
+	var posted events.Event
+	bus.Subscribe(domain.CommentPostedEventType, events.HandlerFunc(func(ctx context.Context, event events.Event) error {
+		posted = event
+		return nil
+	}))
+
+	addComment := commands.NewAddCommentHandler(behaviorLogRepo, commentRepo, authRepo, bus)
+	editComment := commands.NewEditCommentHandler(commentRepo, authRepo)
+	deleteComment := commands.NewDeleteCommentHandler(commentRepo, authRepo)
+	react := commands.NewReactToBehaviorLogHandler(behaviorLogRepo, reactionRepo, authRepo)
+	getComments := NewGetBehaviorLogCommentsHandler(behaviorLogRepo, commentRepo, reactionRepo, authRepo)
+
+	adminID, ownerID, memberID, otherGroupMemberID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	parkGroup, clubGroup := uuid.New(), uuid.New()
+	park := &domain.GroupInfo{ID: parkGroup, Name: "Park", OwnerID: adminID}
+	club := &domain.GroupInfo{ID: clubGroup, Name: "Club", OwnerID: otherGroupMemberID}
+	for _, userID := range []uuid.UUID{adminID, ownerID, memberID} {
+		authRepo.AddUserGroup(userID, parkGroup, park)
+	}
+	authRepo.AddUserGroup(ownerID, clubGroup, club)
+	authRepo.AddUserGroup(otherGroupMemberID, clubGroup, club)
+
+	behaviorLog := &domain.BehaviorLog{ID: uuid.New(), PetID: uuid.New(), BehaviorID: uuid.New(), UserID: ownerID, PointsAwarded: 5, LoggedAt: time.Now()}
+	behaviorLog.AddGroupShare(parkGroup)
+	behaviorLog.AddGroupShare(clubGroup)
+	behaviorLogRepo.Create(ctx, behaviorLog)
+
+	comment := func(userID, groupID uuid.UUID, parentID *uuid.UUID, body string) (*domain.Comment, error) {
+		return addComment.Handle(ctx, &commands.AddCommentCommand{
+			BehaviorLogID: behaviorLog.ID,
+			GroupID:       groupID,
+			UserID:        userID,
+			ParentID:      parentID,
+			Body:          body,
+		})
+	}
+	thread := func(userID, groupID uuid.UUID) *GetBehaviorLogCommentsResult {
+		t.Helper()
+		result, err := getComments.Handle(ctx, &GetBehaviorLogCommentsQuery{BehaviorLogID: behaviorLog.ID, GroupID: groupID, UserID: userID})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		return result
+	}
+
+	var first *domain.Comment
+	t.Run("Comments and replies stay in their group share", func(t *testing.T) {
+		var err error
+		if first, err = comment(memberID, parkGroup, nil, "Good boy!"); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if _, err := comment(ownerID, parkGroup, &first.ID, "Thanks!"); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if _, err := comment(otherGroupMemberID, clubGroup, &first.ID, "Replying across groups"); err == nil {
+			t.Error("Expected an error when replying to a comment of another group")
+		}
+		if _, err := comment(otherGroupMemberID, parkGroup, nil, "Not a member"); err == nil {
+			t.Error("Expected an error for a user outside the group")
+		}
+
+		park := thread(memberID, parkGroup)
+		if park.Total != 2 || *park.Comments[1].ParentID != first.ID {
+			t.Errorf("Expected a comment and its reply in the park group, got %d comments", park.Total)
+		}
+		if club := thread(otherGroupMemberID, clubGroup); club.Total != 0 {
+			t.Errorf("Expected no comment in the club group, got %d", club.Total)
+		}
+
+		event, ok := posted.(*domain.CommentPostedEvent)
+		if !ok || event.GroupID != parkGroup || event.Body != "Thanks!" {
+			t.Errorf("Expected the reply to be published for the park group, got %+v", posted)
+		}
+	})
+
+	t.Run("Only the author edits a comment", func(t *testing.T) {
+		if _, err := editComment.Handle(ctx, &commands.EditCommentCommand{CommentID: first.ID, GroupID: parkGroup, UserID: adminID, Body: "Changed"}); err == nil {
+			t.Error("Expected an error when editing another member's comment")
+		}
+
+		edited, err := editComment.Handle(ctx, &commands.EditCommentCommand{CommentID: first.ID, GroupID: parkGroup, UserID: memberID, Body: "Very good boy!"})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if edited.Body != "Very good boy!" || edited.EditedAt == nil {
+			t.Errorf("Expected the edited body with an edit time, got %q", edited.Body)
+		}
+	})
+
+	t.Run("The group admin moderates comments", func(t *testing.T) {
+		reply := thread(memberID, parkGroup).Comments[1]
+		if _, err := deleteComment.Handle(ctx, &commands.DeleteCommentCommand{CommentID: reply.ID, GroupID: parkGroup, UserID: memberID}); err == nil {
+			t.Error("Expected an error when a member deletes another member's comment")
+		}
+
+		deleted, err := deleteComment.Handle(ctx, &commands.DeleteCommentCommand{CommentID: reply.ID, GroupID: parkGroup, UserID: adminID})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !deleted.IsDeleted() || deleted.Body != "" || *deleted.DeletedBy != adminID {
+			t.Errorf("Expected the comment to be removed by the admin, got %+v", deleted)
+		}
+	})
+
+	t.Run("Reactions are counted per group", func(t *testing.T) {
+		for _, userID := range []uuid.UUID{memberID, memberID, adminID} {
+			if _, err := react.Handle(ctx, &commands.ReactToBehaviorLogCommand{BehaviorLogID: behaviorLog.ID, GroupID: parkGroup, UserID: userID, Emoji: "🐾"}); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		}
+		if _, err := react.Handle(ctx, &commands.ReactToBehaviorLogCommand{BehaviorLogID: behaviorLog.ID, GroupID: parkGroup, UserID: memberID, Emoji: "🦖"}); err == nil {
+			t.Error("Expected an error for an emoji outside the reaction set")
+		}
+
+		reactions := thread(memberID, parkGroup).Reactions
+		if len(reactions) != 1 || reactions[0].Count != 2 || !reactions[0].Reacted {
+			t.Errorf("Expected 2 paw reactions including the member's, got %+v", reactions)
+		}
+		if club := thread(ownerID, clubGroup).Reactions; len(club) != 0 {
+			t.Errorf("Expected no reaction in the club group, got %+v", club)
+		}
+
+		result, err := react.Handle(ctx, &commands.ReactToBehaviorLogCommand{BehaviorLogID: behaviorLog.ID, GroupID: parkGroup, UserID: memberID, Emoji: "🐾", Remove: true})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(result.Reactions) != 1 || result.Reactions[0].Count != 1 || result.Reactions[0].Reacted {
+			t.Errorf("Expected the admin's reaction only, got %+v", result.Reactions)
+		}
+	})
+}
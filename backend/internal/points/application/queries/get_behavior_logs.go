@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"pet-of-the-day/internal/points/application/commands"
 	"pet-of-the-day/internal/points/domain"
 )
 
@@ -86,7 +87,7 @@ func (h *GetBehaviorLogsHandler) Handle(ctx context.Context, query *GetBehaviorL
 			return nil, fmt.Errorf("failed to check pet access: %w", err)
 		}
 		if !canAccess {
-			return nil, fmt.Errorf("user does not have access to specified pet")
+			return nil, &commands.AuthorizationError{Message: "user does not have access to specified pet"}
 		}
 	}
 
@@ -96,7 +97,7 @@ func (h *GetBehaviorLogsHandler) Handle(ctx context.Context, query *GetBehaviorL
 			return nil, fmt.Errorf("failed to check group access: %w", err)
 		}
 		if !canAccess {
-			return nil, fmt.Errorf("user does not have access to specified group")
+			return nil, &commands.AuthorizationError{Message: "user does not have access to specified group"}
 		}
 	}
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"pet-of-the-day/internal/points/application/commands"
 	"pet-of-the-day/internal/points/domain"
 )
 
@@ -13,6 +14,7 @@ import (
 type GetBehaviorsQuery struct {
 	Species  *domain.Species         `json:"species,omitempty"`
 	Category *domain.BehaviorCategory `json:"category,omitempty"`
+	GroupID  *uuid.UUID              `json:"group_id,omitempty"`
 	UserID   uuid.UUID               `json:"user_id" validate:"required"`
 }
 
@@ -23,18 +25,21 @@ type GetBehaviorsResult struct {
 
 // GetBehaviorsHandler handles queries for getting behaviors
 type GetBehaviorsHandler struct {
-	behaviorRepo domain.BehaviorRepository
-	authRepo     domain.AuthorizationRepository
+	behaviorRepo      domain.BehaviorRepository
+	groupBehaviorRepo domain.GroupBehaviorRepository
+	authRepo          domain.AuthorizationRepository
 }
 
 // NewGetBehaviorsHandler creates a new get behaviors handler
 func NewGetBehaviorsHandler(
 	behaviorRepo domain.BehaviorRepository,
+	groupBehaviorRepo domain.GroupBehaviorRepository,
 	authRepo domain.AuthorizationRepository,
 ) *GetBehaviorsHandler {
 	return &GetBehaviorsHandler{
-		behaviorRepo: behaviorRepo,
-		authRepo:     authRepo,
+		behaviorRepo:      behaviorRepo,
+		groupBehaviorRepo: groupBehaviorRepo,
+		authRepo:          authRepo,
 	}
 }
 
@@ -54,7 +59,47 @@ func (h *GetBehaviorsHandler) Handle(ctx context.Context, query *GetBehaviorsQue
 		return nil, fmt.Errorf("failed to get behaviors: %w", err)
 	}
 
+	// Layer the group's own behaviors over the global catalog
+	if query.GroupID != nil {
+		behaviors, err = h.mergeGroupCatalog(ctx, query, behaviors)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &GetBehaviorsResult{
 		Behaviors: behaviors,
 	}, nil
 }
+
+// mergeGroupCatalog merges a group's custom behaviors and overrides into the global behaviors
+func (h *GetBehaviorsHandler) mergeGroupCatalog(ctx context.Context, query *GetBehaviorsQuery, global []*domain.Behavior) ([]*domain.Behavior, error) {
+	canAccess, err := h.authRepo.CanUserAccessGroup(ctx, query.UserID, *query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check group access: %w", err)
+	}
+	if !canAccess {
+		return nil, &commands.AuthorizationError{Message: "user does not have access to specified group"}
+	}
+
+	groupBehaviors, err := h.groupBehaviorRepo.GetByGroup(ctx, *query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group behaviors: %w", err)
+	}
+
+	// Global behaviors are already filtered by the repository, custom ones are filtered here
+	filtered := make([]*domain.GroupBehavior, 0, len(groupBehaviors))
+	for _, groupBehavior := range groupBehaviors {
+		if !groupBehavior.IsOverride() {
+			if query.Category != nil && groupBehavior.Category != *query.Category {
+				continue
+			}
+			if query.Species != nil && groupBehavior.Species != domain.SpeciesBoth && groupBehavior.Species != *query.Species {
+				continue
+			}
+		}
+		filtered = append(filtered, groupBehavior)
+	}
+
+	return domain.MergeBehaviorCatalog(global, filtered), nil
+}
@@ -2,10 +2,12 @@ package queries
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"pet-of-the-day/internal/points/application/commands"
 	"pet-of-the-day/internal/points/domain"
 	"pet-of-the-day/internal/points/infrastructure"
 	"pet-of-the-day/internal/points/infrastructure/mock"
@@ -15,7 +17,7 @@ func TestGetBehaviorsHandler_Handle(t *testing.T) {
 	// Setup
 	behaviorRepo := infrastructure.NewMockBehaviorRepository()
 	authRepo := mock.NewMockAuthorizationRepository()
-	handler := NewGetBehaviorsHandler(behaviorRepo, authRepo)
+	handler := NewGetBehaviorsHandler(behaviorRepo, mock.NewMockGroupBehaviorRepository(), authRepo)
 
 	// Test data
 	behavior1 := domain.Behavior{
@@ -65,7 +67,7 @@ func TestGetBehaviorsHandler_HandleWithSpecies(t *testing.T) {
 	// Setup
 	behaviorRepo := infrastructure.NewMockBehaviorRepository()
 	authRepo := mock.NewMockAuthorizationRepository()
-	handler := NewGetBehaviorsHandler(behaviorRepo, authRepo)
+	handler := NewGetBehaviorsHandler(behaviorRepo, mock.NewMockGroupBehaviorRepository(), authRepo)
 
 	// Test data
 	dogBehavior := domain.Behavior{
@@ -130,3 +132,71 @@ func TestGetBehaviorsHandler_HandleWithSpecies(t *testing.T) {
 	})
 }
 
+
+func TestGetBehaviorsHandler_HandleWithGroup(t *testing.T) {
+	// Setup
+	behaviorRepo := infrastructure.NewMockBehaviorRepository()
+	groupBehaviorRepo := mock.NewMockGroupBehaviorRepository()
+	authRepo := mock.NewMockAuthorizationRepository()
+	handler := NewGetBehaviorsHandler(behaviorRepo, groupBehaviorRepo, authRepo)
+
+	userID := uuid.New()
+	groupID := uuid.New()
+	authRepo.AddUserGroup(userID, groupID, &domain.GroupInfo{ID: groupID, Name: "Agility club", OwnerID: userID})
+
+	sit, _ := domain.NewBehavior("Sit", "Pet sits on command", domain.BehaviorCategoryTraining, 5, 30, domain.SpeciesDog, "sit")
+	scratch, _ := domain.NewBehavior("Scratched furniture", "Pet scratched the furniture", domain.BehaviorCategoryPlay, -3, 30, domain.SpeciesBoth, "scratch")
+	behaviorRepo.AddBehavior(*sit)
+	behaviorRepo.AddBehavior(*scratch)
+
+	// Sit is worth more in this group, scratching is not tracked, and the group adds its own behavior
+	override, _ := domain.NewGroupBehaviorOverride(groupID, userID, sit, 8, true)
+	disabled, _ := domain.NewGroupBehaviorOverride(groupID, userID, scratch, -3, false)
+	agility, _ := domain.NewCustomGroupBehavior(groupID, userID, "Did agility course", "", domain.BehaviorCategoryTraining, 7, 60, domain.SpeciesDog, "agility")
+	groupBehaviorRepo.Create(context.Background(), override)
+	groupBehaviorRepo.Create(context.Background(), disabled)
+	groupBehaviorRepo.Create(context.Background(), agility)
+
+	t.Run("Global catalog is unchanged without a group", func(t *testing.T) {
+		result, err := handler.Handle(context.Background(), &GetBehaviorsQuery{UserID: userID})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(result.Behaviors) != 2 {
+			t.Errorf("Expected 2 behaviors, got %d", len(result.Behaviors))
+		}
+	})
+
+	t.Run("Group catalog is merged", func(t *testing.T) {
+		result, err := handler.Handle(context.Background(), &GetBehaviorsQuery{UserID: userID, GroupID: &groupID})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		byName := make(map[string]*domain.Behavior)
+		for _, behavior := range result.Behaviors {
+			byName[behavior.Name] = behavior
+		}
+
+		if len(byName) != 2 {
+			t.Fatalf("Expected 2 behaviors, got %d", len(byName))
+		}
+		if byName["Sit"] == nil || byName["Sit"].PointValue != 8 || byName["Sit"].ID != sit.ID {
+			t.Errorf("Expected Sit to keep its ID and be worth 8 points, got %+v", byName["Sit"])
+		}
+		if _, exists := byName["Scratched furniture"]; exists {
+			t.Error("Expected disabled behavior to be excluded")
+		}
+		if custom := byName["Did agility course"]; custom == nil || custom.GroupID == nil || *custom.GroupID != groupID {
+			t.Errorf("Expected custom behavior scoped to the group, got %+v", custom)
+		}
+	})
+
+	t.Run("Group catalog requires group access", func(t *testing.T) {
+		_, err := handler.Handle(context.Background(), &GetBehaviorsQuery{UserID: uuid.New(), GroupID: &groupID})
+		var authorizationErr *commands.AuthorizationError
+		if !errors.As(err, &authorizationErr) {
+			t.Errorf("Expected AuthorizationError for user outside the group, got %v", err)
+		}
+	})
+}
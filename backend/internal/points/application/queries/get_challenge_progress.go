@@ -0,0 +1,109 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/application/services"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetChallengeProgressQuery represents a query to get a challenge and the progress of its pets
+type GetChallengeProgressQuery struct {
+	GroupID     uuid.UUID `json:"group_id" validate:"required"`
+	ChallengeID uuid.UUID `json:"challenge_id" validate:"required"`
+	UserID      uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// PetChallengeProgress represents the progress of one pet in a challenge
+type PetChallengeProgress struct {
+	PetID        uuid.UUID               `json:"pet_id"`
+	PetName      string                  `json:"pet_name"`
+	Count        int                     `json:"count"`
+	Points       int                     `json:"points"`
+	Outcome      domain.ChallengeOutcome `json:"outcome"`
+	BonusAwarded int                     `json:"bonus_awarded"`
+}
+
+// GetChallengeProgressResult represents a challenge and the progress of its pets, best first
+type GetChallengeProgressResult struct {
+	Challenge *domain.Challenge       `json:"challenge"`
+	Progress  []*PetChallengeProgress `json:"progress"`
+	IsFinal   bool                    `json:"is_final"`
+	UpdatedAt string                  `json:"updated_at"`
+}
+
+// GetChallengeProgressHandler handles queries for challenge progress
+type GetChallengeProgressHandler struct {
+	challengeRepo    domain.ChallengeRepository
+	challengeService *services.ChallengeService
+	authRepo         domain.AuthorizationRepository
+}
+
+// NewGetChallengeProgressHandler creates a new get challenge progress handler
+func NewGetChallengeProgressHandler(
+	challengeRepo domain.ChallengeRepository,
+	challengeService *services.ChallengeService,
+	authRepo domain.AuthorizationRepository,
+) *GetChallengeProgressHandler {
+	return &GetChallengeProgressHandler{
+		challengeRepo:    challengeRepo,
+		challengeService: challengeService,
+		authRepo:         authRepo,
+	}
+}
+
+// Handle processes the get challenge progress query
+func (h *GetChallengeProgressHandler) Handle(ctx context.Context, query *GetChallengeProgressQuery) (*GetChallengeProgressResult, error) {
+	// Authorization: Check if user has access to the group
+	canAccess, err := h.authRepo.CanUserAccessGroup(ctx, query.UserID, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check group access: %w", err)
+	}
+	if !canAccess {
+		return nil, &commands.AuthorizationError{Message: "user does not have access to specified group"}
+	}
+
+	challenge, err := h.challengeRepo.GetByID(ctx, query.ChallengeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get challenge: %w", err)
+	}
+	if challenge == nil || challenge.GroupID != query.GroupID {
+		return nil, fmt.Errorf("challenge not found")
+	}
+
+	progress, err := h.challengeService.GetProgress(ctx, challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*PetChallengeProgress, 0, len(progress))
+	for _, petProgress := range progress {
+		entry := &PetChallengeProgress{
+			PetID:        petProgress.PetID,
+			Count:        petProgress.Count,
+			Points:       petProgress.Points,
+			Outcome:      petProgress.Outcome,
+			BonusAwarded: petProgress.BonusAwarded,
+		}
+
+		// Get pet name, progress of removed pets is kept without it
+		petInfo, err := h.authRepo.GetPetInfo(ctx, petProgress.PetID)
+		if err == nil && petInfo != nil {
+			entry.PetName = petInfo.Name
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return &GetChallengeProgressResult{
+		Challenge: challenge,
+		Progress:  entries,
+		IsFinal:   challenge.IsClosed(),
+		UpdatedAt: time.Now().Format(time.RFC3339),
+	}, nil
+}
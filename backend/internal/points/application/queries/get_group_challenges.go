@@ -0,0 +1,62 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetGroupChallengesQuery represents a query to list the challenges of a group
+type GetGroupChallengesQuery struct {
+	GroupID uuid.UUID `json:"group_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// GetGroupChallengesResult represents the challenges of a group, latest deadline first
+type GetGroupChallengesResult struct {
+	GroupID    uuid.UUID           `json:"group_id"`
+	Challenges []*domain.Challenge `json:"challenges"`
+}
+
+// GetGroupChallengesHandler handles queries for listing group challenges
+type GetGroupChallengesHandler struct {
+	challengeRepo domain.ChallengeRepository
+	authRepo      domain.AuthorizationRepository
+}
+
+// NewGetGroupChallengesHandler creates a new get group challenges handler
+func NewGetGroupChallengesHandler(
+	challengeRepo domain.ChallengeRepository,
+	authRepo domain.AuthorizationRepository,
+) *GetGroupChallengesHandler {
+	return &GetGroupChallengesHandler{
+		challengeRepo: challengeRepo,
+		authRepo:      authRepo,
+	}
+}
+
+// Handle processes the get group challenges query
+func (h *GetGroupChallengesHandler) Handle(ctx context.Context, query *GetGroupChallengesQuery) (*GetGroupChallengesResult, error) {
+	// Authorization: Check if user has access to the group
+	canAccess, err := h.authRepo.CanUserAccessGroup(ctx, query.UserID, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check group access: %w", err)
+	}
+	if !canAccess {
+		return nil, &commands.AuthorizationError{Message: "user does not have access to specified group"}
+	}
+
+	challenges, err := h.challengeRepo.GetByGroup(ctx, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group challenges: %w", err)
+	}
+
+	return &GetGroupChallengesResult{
+		GroupID:    query.GroupID,
+		Challenges: challenges,
+	}, nil
+}
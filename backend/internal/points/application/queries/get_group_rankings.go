@@ -7,24 +7,32 @@ import (
 
 	"github.com/google/uuid"
 
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/application/services"
 	"pet-of-the-day/internal/points/domain"
 )
 
-// GetGroupRankingsQuery represents a query to get group rankings
+// GetGroupRankingsQuery represents a query to get group rankings.
+// Period defaults to the day of Date (today if unset), or to a custom range when DateFrom and DateTo are set.
 type GetGroupRankingsQuery struct {
-	GroupID  uuid.UUID  `json:"group_id" validate:"required"`
-	Date     *time.Time `json:"date,omitempty"`
-	DateFrom *time.Time `json:"date_from,omitempty"`
-	DateTo   *time.Time `json:"date_to,omitempty"`
-	UserID   uuid.UUID  `json:"user_id" validate:"required"`
+	GroupID  uuid.UUID            `json:"group_id" validate:"required"`
+	Period   domain.RankingPeriod `json:"period,omitempty"`
+	Date     *time.Time           `json:"date,omitempty"`
+	DateFrom *time.Time           `json:"date_from,omitempty"`
+	DateTo   *time.Time           `json:"date_to,omitempty"`
+	UserID   uuid.UUID            `json:"user_id" validate:"required"`
 }
 
 // GetGroupRankingsResult represents the result of getting group rankings
 type GetGroupRankingsResult struct {
-	GroupID   string                  `json:"group_id"`
-	GroupName string                  `json:"group_name"`
-	Rankings  []*domain.PetRanking    `json:"rankings"`
-	UpdatedAt string                  `json:"updated_at"`
+	GroupID      string               `json:"group_id"`
+	GroupName    string               `json:"group_name"`
+	Period       domain.RankingPeriod `json:"period"`
+	DateFrom     string               `json:"date_from,omitempty"`
+	DateTo       string               `json:"date_to"`
+	Rankings     []*domain.PetRanking `json:"rankings"`
+	ScoringRules *domain.ScoringRules `json:"scoring_rules"` // Rules the rankings were ranked with
+	UpdatedAt    string               `json:"updated_at"`
 }
 
 // GetGroupRankingsHandler handles queries for getting group rankings
@@ -32,6 +40,8 @@ type GetGroupRankingsHandler struct {
 	dailyScoreRepo   domain.DailyScoreRepository
 	authRepo         domain.AuthorizationRepository
 	userSettingsRepo domain.UserSettingsRepository
+	scoringRulesRepo domain.ScoringRulesRepository
+	rankingService   *services.RankingService
 }
 
 // NewGetGroupRankingsHandler creates a new get group rankings handler
@@ -39,11 +49,15 @@ func NewGetGroupRankingsHandler(
 	dailyScoreRepo domain.DailyScoreRepository,
 	authRepo domain.AuthorizationRepository,
 	userSettingsRepo domain.UserSettingsRepository,
+	scoringRulesRepo domain.ScoringRulesRepository,
+	rankingService *services.RankingService,
 ) *GetGroupRankingsHandler {
 	return &GetGroupRankingsHandler{
 		dailyScoreRepo:   dailyScoreRepo,
 		authRepo:         authRepo,
 		userSettingsRepo: userSettingsRepo,
+		scoringRulesRepo: scoringRulesRepo,
+		rankingService:   rankingService,
 	}
 }
 
@@ -55,7 +69,7 @@ func (h *GetGroupRankingsHandler) Handle(ctx context.Context, query *GetGroupRan
 		return nil, fmt.Errorf("failed to check group access: %w", err)
 	}
 	if !canAccess {
-		return nil, fmt.Errorf("user does not have access to group")
+		return nil, &commands.AuthorizationError{Message: "user does not have access to group"}
 	}
 
 	// Get group info
@@ -64,90 +78,80 @@ func (h *GetGroupRankingsHandler) Handle(ctx context.Context, query *GetGroupRan
 		return nil, fmt.Errorf("failed to get group info: %w", err)
 	}
 
-	// Get user timezone settings
-	userSettings, err := h.userSettingsRepo.GetUserTimezone(ctx, query.UserID)
-	if err != nil {
-		userSettings = domain.NewUserTimezoneSettings(query.UserID)
-	}
-
-	// Calculate date range
-	var rankings []*domain.PetRanking
-	if query.DateFrom != nil && query.DateTo != nil {
-		// Date range query
-		rankings, err = h.dailyScoreRepo.GetRankingsByDateRange(ctx, query.GroupID, *query.DateFrom, *query.DateTo)
+	// Dates are days of the group's calendar; without a date the rankings are those of the
+	// group day in progress
+	calendar := services.NewGroupCalendar(h.authRepo, h.userSettingsRepo)
+	var reference time.Time
+	if query.Date != nil {
+		reference, err = calendar.Date(ctx, query.GroupID, *query.Date)
 	} else {
-		// Single date query (default to today)
-		targetDate, err := h.calculateTargetDate(query.Date, userSettings)
-		if err != nil {
-			return nil, fmt.Errorf("failed to calculate target date: %w", err)
-		}
-		rankings, err = h.dailyScoreRepo.GetRankings(ctx, query.GroupID, targetDate)
+		reference, err = calendar.Day(ctx, query.GroupID, time.Now())
 	}
-
 	if err != nil {
-		return nil, fmt.Errorf("failed to get rankings: %w", err)
+		return nil, fmt.Errorf("failed to calculate target date: %w", err)
 	}
 
-	// Sort and assign ranks
-	rankings = h.assignRanks(rankings)
-
-	return &GetGroupRankingsResult{
-		GroupID:   query.GroupID.String(),
-		GroupName: groupInfo.Name,
-		Rankings:  rankings,
-		UpdatedAt: time.Now().Format(time.RFC3339),
-	}, nil
-}
+	// Resolve the days covered by the requested period
+	window, err := h.resolveWindow(query, reference)
+	if err != nil {
+		return nil, err
+	}
 
-// calculateTargetDate calculates the target date for ranking calculation
-func (h *GetGroupRankingsHandler) calculateTargetDate(date *time.Time, userSettings *domain.UserTimezoneSettings) (time.Time, error) {
-	location, err := time.LoadLocation(userSettings.Timezone)
+	rules, err := h.scoringRulesRepo.GetByGroup(ctx, query.GroupID)
 	if err != nil {
-		return time.Time{}, fmt.Errorf("invalid timezone: %w", err)
+		return nil, fmt.Errorf("failed to get scoring rules: %w", err)
 	}
 
-	var targetDate time.Time
-	if date != nil {
-		targetDate = *date
+	var rankings []*domain.PetRanking
+	if window.IsSingleDay() {
+		// Days are ranked from the rankings read model, normalized and ranked with the group's rules
+		rankings, err = h.rankingService.CalculateGroupRankings(ctx, query.GroupID, window.From)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get rankings: %w", err)
+		}
 	} else {
-		targetDate = time.Now()
+		rankings, err = h.dailyScoreRepo.GetRankingsByDateRange(ctx, query.GroupID, window.From, window.To)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get rankings: %w", err)
+		}
+
+		// Normalize points, then sort and assign ranks with the group's scoring rules
+		if err := h.rankingService.NormalizeRankings(ctx, query.GroupID, rules, rankings, window.From, window.To); err != nil {
+			return nil, fmt.Errorf("failed to normalize rankings: %w", err)
+		}
+		rankings = rules.AssignRanks(rankings)
 	}
 
-	// Convert to user's timezone and normalize to start of day
-	localTime := targetDate.In(location)
-	normalized := time.Date(localTime.Year(), localTime.Month(), localTime.Day(), 0, 0, 0, 0, location)
+	result := &GetGroupRankingsResult{
+		GroupID:      query.GroupID.String(),
+		GroupName:    groupInfo.Name,
+		Period:       window.Period,
+		DateTo:       window.To.Format("2006-01-02"),
+		Rankings:     rankings,
+		ScoringRules: rules,
+		UpdatedAt:    time.Now().Format(time.RFC3339),
+	}
+	if !window.IsAllTime() {
+		result.DateFrom = window.From.Format("2006-01-02")
+	}
 
-	return normalized, nil
+	return result, nil
 }
 
-// assignRanks sorts rankings and assigns rank positions with tie handling
-func (h *GetGroupRankingsHandler) assignRanks(rankings []*domain.PetRanking) []*domain.PetRanking {
-	if len(rankings) == 0 {
-		return rankings
+// resolveWindow determines the ranking window of a query around the reference day
+func (h *GetGroupRankingsHandler) resolveWindow(query *GetGroupRankingsQuery, reference time.Time) (*domain.RankingWindow, error) {
+	period := query.Period
+	if period == "" {
+		period = domain.RankingPeriodDay
+		if query.DateFrom != nil && query.DateTo != nil {
+			period = domain.RankingPeriodCustom
+		}
 	}
 
-	// Sort by ranking criteria (already done in repository, but ensure consistency)
-	// Rankings should be sorted by total points DESC, then negative behaviors ASC
-
-	currentRank := 1
-	for i, ranking := range rankings {
-		if i == 0 {
-			// First place
-			ranking.SetRank(currentRank, false)
-		} else {
-			// Check if tied with previous
-			prevRanking := rankings[i-1]
-			if ranking.CompareForRanking(prevRanking) == 0 {
-				// Tied - same rank
-				ranking.SetRank(currentRank, true)
-				prevRanking.SetRank(currentRank, true) // Mark previous as tied too
-			} else {
-				// Not tied - increment rank by number of tied positions
-				currentRank = i + 1
-				ranking.SetRank(currentRank, false)
-			}
-		}
+	window, err := domain.NewRankingWindow(period, reference, query.DateFrom, query.DateTo)
+	if err != nil {
+		return nil, &domain.ValidationError{Message: fmt.Sprintf("invalid ranking period: %v", err)}
 	}
 
-	return rankings
+	return window, nil
 }
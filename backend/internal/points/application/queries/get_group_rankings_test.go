@@ -0,0 +1,213 @@
+package queries
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"pet-of-the-day/internal/points/application/services"
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+	"pet-of-the-day/internal/shared/events"
+)
+
+// newTestGetGroupRankingsHandler creates a rankings handler backed by mock repositories
+func newTestGetGroupRankingsHandler(dailyScoreRepo *mock.MockDailyScoreRepository, authRepo *mock.MockAuthorizationRepository, scoringRulesRepo *mock.MockScoringRulesRepository) *GetGroupRankingsHandler {
+	userSettingsRepo := mock.NewMockUserSettingsRepository()
+	rankingService := services.NewRankingService(
+		dailyScoreRepo, mock.NewMockRankingReadModelRepository(dailyScoreRepo, authRepo), mock.NewMockBehaviorLogRepository(), mock.NewMockPetOfTheDayRepository(), authRepo, userSettingsRepo,
+		mock.NewMockDailyResetStateRepository(), scoringRulesRepo, events.NewInMemoryBus(),
+	)
+
+	return NewGetGroupRankingsHandler(dailyScoreRepo, authRepo, userSettingsRepo, scoringRulesRepo, rankingService)
+}
+
+// addPets registers Rex and Milo, the pets of a member ranked by the tests
+func addPets(authRepo *mock.MockAuthorizationRepository, ownerID, rex, milo uuid.UUID) {
+	authRepo.AddUser(ownerID, &domain.UserInfo{ID: ownerID, Name: "Alice"})
+	authRepo.AddUserPet(ownerID, rex, &domain.PetInfo{ID: rex, Name: "Rex", Species: domain.SpeciesDog, OwnerID: ownerID})
+	authRepo.AddUserPet(ownerID, milo, &domain.PetInfo{ID: milo, Name: "Milo", Species: domain.SpeciesCat, OwnerID: ownerID})
+}
+
+func TestGetGroupRankingsHandler_HandlePeriods(t *testing.T) {
+	ctx := context.Background()
+
+	dailyScoreRepo := mock.NewMockDailyScoreRepository()
+	authRepo := mock.NewMockAuthorizationRepository()
+	handler := newTestGetGroupRankingsHandler(dailyScoreRepo, authRepo, mock.NewMockScoringRulesRepository())
+
+	userID, groupID := uuid.New(), uuid.New()
+	authRepo.AddUserGroup(userID, groupID, &domain.GroupInfo{ID: groupID, Name: "Park friends", OwnerID: userID})
+
+	rex, milo := uuid.New(), uuid.New()
+	addPets(authRepo, userID, rex, milo)
+	addScore := func(petID uuid.UUID, date time.Time, points int) {
+		dailyScore, _ := dailyScoreRepo.GetOrCreate(ctx, petID, groupID, date)
+		dailyScore.AddBehaviorLog(&domain.BehaviorLog{PetID: petID, PointsAwarded: points, LoggedAt: date})
+	}
+	day := func(month time.Month, d int) time.Time {
+		return time.Date(2026, month, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	// Wednesday 2026-03-11 is in the ISO week of Monday 2026-03-09
+	addScore(rex, day(time.March, 11), 8)
+	addScore(milo, day(time.March, 11), 3)
+	addScore(milo, day(time.March, 9), 6)
+	addScore(rex, day(time.March, 2), 10)
+	addScore(milo, day(time.February, 20), 20)
+
+	reference := day(time.March, 11)
+
+	tests := []struct {
+		name     string
+		query    *GetGroupRankingsQuery
+		leader   uuid.UUID
+		points   int
+		dateFrom string
+		dateTo   string
+	}{
+		{"Day", &GetGroupRankingsQuery{Date: &reference}, rex, 8, "2026-03-11", "2026-03-11"},
+		{"Week", &GetGroupRankingsQuery{Period: domain.RankingPeriodWeek, Date: &reference}, milo, 9, "2026-03-09", "2026-03-15"},
+		{"Month", &GetGroupRankingsQuery{Period: domain.RankingPeriodMonth, Date: &reference}, rex, 18, "2026-03-01", "2026-03-31"},
+		{"All time", &GetGroupRankingsQuery{Period: domain.RankingPeriodAllTime, Date: &reference}, milo, 29, "", "2026-03-11"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.query.GroupID = groupID
+			test.query.UserID = userID
+
+			result, err := handler.Handle(ctx, test.query)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if len(result.Rankings) != 2 {
+				t.Fatalf("Expected 2 ranked pets, got %d", len(result.Rankings))
+			}
+			leader := result.Rankings[0]
+			if leader.PetID != test.leader || leader.TotalPoints != test.points || leader.Rank != 1 {
+				t.Errorf("Expected leader with %d points, got %+v", test.points, leader)
+			}
+			if result.Rankings[1].Rank != 2 {
+				t.Errorf("Expected runner-up at rank 2, got %d", result.Rankings[1].Rank)
+			}
+			if result.DateFrom != test.dateFrom || result.DateTo != test.dateTo {
+				t.Errorf("Expected %s..%s, got %s..%s", test.dateFrom, test.dateTo, result.DateFrom, result.DateTo)
+			}
+		})
+	}
+
+	t.Run("Date range without period is a custom ranking", func(t *testing.T) {
+		from, to := day(time.March, 2), day(time.March, 9)
+		result, err := handler.Handle(ctx, &GetGroupRankingsQuery{GroupID: groupID, UserID: userID, DateFrom: &from, DateTo: &to})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if result.Period != domain.RankingPeriodCustom || len(result.Rankings) != 2 || result.Rankings[0].PetID != rex {
+			t.Errorf("Expected custom ranking led by Rex, got %+v", result)
+		}
+	})
+
+	t.Run("Invalid custom range is rejected", func(t *testing.T) {
+		from, to := day(time.March, 9), day(time.March, 2)
+		_, err := handler.Handle(ctx, &GetGroupRankingsQuery{GroupID: groupID, UserID: userID, Period: domain.RankingPeriodCustom, DateFrom: &from, DateTo: &to})
+		if _, ok := err.(*domain.ValidationError); !ok {
+			t.Errorf("Expected ValidationError for inverted range, got %v", err)
+		}
+	})
+}
+
+func TestGetGroupRankingsHandler_HandleGroupCalendar(t *testing.T) {
+	ctx := context.Background()
+
+	dailyScoreRepo := mock.NewMockDailyScoreRepository()
+	authRepo := mock.NewMockAuthorizationRepository()
+	userSettingsRepo := mock.NewMockUserSettingsRepository()
+	scoringRulesRepo := mock.NewMockScoringRulesRepository()
+	rankingService := services.NewRankingService(
+		dailyScoreRepo, mock.NewMockRankingReadModelRepository(dailyScoreRepo, authRepo), mock.NewMockBehaviorLogRepository(), mock.NewMockPetOfTheDayRepository(), authRepo, userSettingsRepo,
+		mock.NewMockDailyResetStateRepository(), scoringRulesRepo, events.NewInMemoryBus(),
+	)
+	handler := NewGetGroupRankingsHandler(dailyScoreRepo, authRepo, userSettingsRepo, scoringRulesRepo, rankingService)
+
+	// The group follows the default UTC settings of its owner, the member lives in Los Angeles
+	ownerID, memberID, groupID := uuid.New(), uuid.New(), uuid.New()
+	authRepo.AddUserGroup(ownerID, groupID, &domain.GroupInfo{ID: groupID, Name: "Park friends", OwnerID: ownerID})
+	authRepo.AddUserGroup(memberID, groupID, &domain.GroupInfo{ID: groupID, Name: "Park friends", OwnerID: ownerID})
+	memberSettings := domain.NewUserTimezoneSettings(memberID)
+	memberSettings.Timezone = "America/Los_Angeles"
+	userSettingsRepo.UpdateUserTimezone(ctx, memberID, memberSettings)
+
+	rex, milo := uuid.New(), uuid.New()
+	addPets(authRepo, ownerID, rex, milo)
+	march11 := time.Date(2026, time.March, 11, 0, 0, 0, 0, time.UTC)
+	dailyScore, _ := dailyScoreRepo.GetOrCreate(ctx, rex, groupID, march11)
+	dailyScore.AddBehaviorLog(&domain.BehaviorLog{PetID: rex, PointsAwarded: 4, LoggedAt: march11})
+
+	t.Run("Dates are days of the group whoever asks", func(t *testing.T) {
+		result, err := handler.Handle(ctx, &GetGroupRankingsQuery{GroupID: groupID, UserID: memberID, Date: &march11})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if result.DateFrom != "2026-03-11" || result.DateTo != "2026-03-11" {
+			t.Errorf("Expected the rankings of March 11, got %s..%s", result.DateFrom, result.DateTo)
+		}
+		if len(result.Rankings) != 1 || result.Rankings[0].PetID != rex || result.Rankings[0].TotalPoints != 4 {
+			t.Errorf("Expected Rex with 4 points, got %+v", result.Rankings)
+		}
+	})
+}
+
+func TestGetGroupRankingsHandler_HandleScoringMode(t *testing.T) {
+	ctx := context.Background()
+
+	dailyScoreRepo := mock.NewMockDailyScoreRepository()
+	authRepo := mock.NewMockAuthorizationRepository()
+	scoringRulesRepo := mock.NewMockScoringRulesRepository()
+	handler := newTestGetGroupRankingsHandler(dailyScoreRepo, authRepo, scoringRulesRepo)
+
+	userID, groupID := uuid.New(), uuid.New()
+	authRepo.AddUserGroup(userID, groupID, &domain.GroupInfo{ID: groupID, Name: "Park friends", OwnerID: userID})
+
+	// Rex earns 12 points over three logs, Milo 10 points in a single log
+	rex, milo := uuid.New(), uuid.New()
+	addPets(authRepo, userID, rex, milo)
+	date := time.Date(2026, time.March, 11, 0, 0, 0, 0, time.UTC)
+	for petID, logs := range map[uuid.UUID][]int{rex: {4, 4, 4}, milo: {10}} {
+		dailyScore, _ := dailyScoreRepo.GetOrCreate(ctx, petID, groupID, date)
+		for _, points := range logs {
+			dailyScore.AddBehaviorLog(&domain.BehaviorLog{PetID: petID, PointsAwarded: points, LoggedAt: date})
+		}
+	}
+
+	query := &GetGroupRankingsQuery{GroupID: groupID, UserID: userID, Date: &date}
+
+	result, err := handler.Handle(ctx, query)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Rankings[0].PetID != rex || result.ScoringRules.Mode != domain.ScoringModeRaw {
+		t.Errorf("Expected Rex to lead on raw points, got %+v", result.Rankings[0])
+	}
+
+	rules, err := domain.NewScoringRules(groupID, userID, domain.ScoringModePerBehavior, 0, 0, nil, true)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	scoringRulesRepo.Save(ctx, rules)
+
+	result, err = handler.Handle(ctx, query)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	leader, runnerUp := result.Rankings[0], result.Rankings[1]
+	if leader.PetID != milo || leader.TotalPoints != 10 || leader.RankingPoints() != 10 {
+		t.Errorf("Expected Milo to lead with 10 points per log, got %+v", leader)
+	}
+	if runnerUp.PetID != rex || runnerUp.TotalPoints != 12 || runnerUp.RankingPoints() != 4 {
+		t.Errorf("Expected Rex to keep 12 raw points and rank by 4 points per log, got %+v", runnerUp)
+	}
+}
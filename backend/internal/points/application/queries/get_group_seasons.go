@@ -0,0 +1,62 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetGroupSeasonsQuery represents a query to list the seasons of a group
+type GetGroupSeasonsQuery struct {
+	GroupID uuid.UUID `json:"group_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// GetGroupSeasonsResult represents the seasons of a group, most recent first
+type GetGroupSeasonsResult struct {
+	GroupID uuid.UUID        `json:"group_id"`
+	Seasons []*domain.Season `json:"seasons"`
+}
+
+// GetGroupSeasonsHandler handles queries for listing group seasons
+type GetGroupSeasonsHandler struct {
+	seasonRepo domain.SeasonRepository
+	authRepo   domain.AuthorizationRepository
+}
+
+// NewGetGroupSeasonsHandler creates a new get group seasons handler
+func NewGetGroupSeasonsHandler(
+	seasonRepo domain.SeasonRepository,
+	authRepo domain.AuthorizationRepository,
+) *GetGroupSeasonsHandler {
+	return &GetGroupSeasonsHandler{
+		seasonRepo: seasonRepo,
+		authRepo:   authRepo,
+	}
+}
+
+// Handle processes the get group seasons query
+func (h *GetGroupSeasonsHandler) Handle(ctx context.Context, query *GetGroupSeasonsQuery) (*GetGroupSeasonsResult, error) {
+	// Authorization: Check if user has access to the group
+	canAccess, err := h.authRepo.CanUserAccessGroup(ctx, query.UserID, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check group access: %w", err)
+	}
+	if !canAccess {
+		return nil, &commands.AuthorizationError{Message: "user does not have access to specified group"}
+	}
+
+	seasons, err := h.seasonRepo.GetByGroup(ctx, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group seasons: %w", err)
+	}
+
+	return &GetGroupSeasonsResult{
+		GroupID: query.GroupID,
+		Seasons: seasons,
+	}, nil
+}
@@ -0,0 +1,94 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetHallOfFameQuery represents a query for the Pet of the Day hall of fame of a group
+type GetHallOfFameQuery struct {
+	GroupID uuid.UUID            `json:"group_id" validate:"required"`
+	UserID  uuid.UUID            `json:"user_id" validate:"required"`
+	Period  domain.HistoryPeriod `json:"period"` // Aggregation period, months by default
+	Limit   int                  `json:"limit"`  // Number of most decorated pets
+}
+
+// GetHallOfFameResult represents the hall of fame of a group: its Pet of the Day statistics,
+// its most decorated pets of all time and its wins per month or year, most recent first
+type GetHallOfFameResult struct {
+	GroupID       uuid.UUID                     `json:"group_id"`
+	Stats         *domain.GroupPetOfTheDayStats `json:"stats"`
+	MostDecorated []*domain.DecoratedPet        `json:"most_decorated"`
+	Period        domain.HistoryPeriod          `json:"period"`
+	Periods       []*domain.HallOfFamePeriod    `json:"periods"`
+}
+
+// GetHallOfFameHandler handles hall of fame queries
+type GetHallOfFameHandler struct {
+	petOfTheDayRepo domain.PetOfTheDayRepository
+	authRepo        domain.AuthorizationRepository
+}
+
+// NewGetHallOfFameHandler creates a new get hall of fame handler
+func NewGetHallOfFameHandler(
+	petOfTheDayRepo domain.PetOfTheDayRepository,
+	authRepo domain.AuthorizationRepository,
+) *GetHallOfFameHandler {
+	return &GetHallOfFameHandler{
+		petOfTheDayRepo: petOfTheDayRepo,
+		authRepo:        authRepo,
+	}
+}
+
+// Handle processes the get hall of fame query
+func (h *GetHallOfFameHandler) Handle(ctx context.Context, query *GetHallOfFameQuery) (*GetHallOfFameResult, error) {
+	// Authorization: Check if user has access to the group
+	canAccess, err := h.authRepo.CanUserAccessGroup(ctx, query.UserID, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check group access: %w", err)
+	}
+	if !canAccess {
+		return nil, &commands.AuthorizationError{Message: "user does not have access to specified group"}
+	}
+
+	if query.Period == "" {
+		query.Period = domain.HistoryPeriodMonth
+	}
+	if !domain.IsValidHistoryPeriod(query.Period) {
+		return nil, fmt.Errorf("invalid history period: %s", query.Period)
+	}
+	if query.Limit <= 0 {
+		query.Limit = 10
+	}
+	if query.Limit > 100 {
+		query.Limit = 100
+	}
+
+	stats, err := h.petOfTheDayRepo.GetGroupStats(ctx, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Pet of the Day stats: %w", err)
+	}
+
+	mostDecorated, err := h.petOfTheDayRepo.GetMostDecorated(ctx, query.GroupID, query.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get most decorated pets: %w", err)
+	}
+
+	periods, err := h.petOfTheDayRepo.GetHallOfFamePeriods(ctx, query.GroupID, query.Period)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Pet of the Day periods: %w", err)
+	}
+
+	return &GetHallOfFameResult{
+		GroupID:       query.GroupID,
+		Stats:         stats,
+		MostDecorated: mostDecorated,
+		Period:        query.Period,
+		Periods:       periods,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetLateCorrectionsQuery represents a query for the corrections of a group's closed days
+type GetLateCorrectionsQuery struct {
+	GroupID uuid.UUID `json:"group_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"`
+	Limit   int       `json:"limit"`
+	Offset  int       `json:"offset"`
+}
+
+// GetLateCorrectionsResult represents the late corrections of a group, most recent first
+type GetLateCorrectionsResult struct {
+	GroupID     uuid.UUID                `json:"group_id"`
+	Corrections []*domain.LateCorrection `json:"corrections"`
+	Limit       int                      `json:"limit"`
+	Offset      int                      `json:"offset"`
+}
+
+// GetLateCorrectionsHandler handles late correction queries
+type GetLateCorrectionsHandler struct {
+	correctionRepo domain.LateCorrectionRepository
+	authRepo       domain.AuthorizationRepository
+}
+
+// NewGetLateCorrectionsHandler creates a new get late corrections handler
+func NewGetLateCorrectionsHandler(
+	correctionRepo domain.LateCorrectionRepository,
+	authRepo domain.AuthorizationRepository,
+) *GetLateCorrectionsHandler {
+	return &GetLateCorrectionsHandler{
+		correctionRepo: correctionRepo,
+		authRepo:       authRepo,
+	}
+}
+
+// Handle processes the get late corrections query
+func (h *GetLateCorrectionsHandler) Handle(ctx context.Context, query *GetLateCorrectionsQuery) (*GetLateCorrectionsResult, error) {
+	canAccess, err := h.authRepo.CanUserAccessGroup(ctx, query.UserID, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check group access: %w", err)
+	}
+	if !canAccess {
+		return nil, &commands.AuthorizationError{Message: "user does not have access to specified group"}
+	}
+
+	if query.Limit <= 0 {
+		query.Limit = 50
+	}
+	if query.Limit > 100 {
+		query.Limit = 100
+	}
+
+	corrections, err := h.correctionRepo.GetByGroup(ctx, query.GroupID, query.Limit, query.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get late corrections: %w", err)
+	}
+
+	return &GetLateCorrectionsResult{
+		GroupID:     query.GroupID,
+		Corrections: corrections,
+		Limit:       query.Limit,
+		Offset:      query.Offset,
+	}, nil
+}
@@ -0,0 +1,88 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetLatestWinnersQuery represents a query for the latest Pet of the Day winners of the
+// groups of a user
+type GetLatestWinnersQuery struct {
+	UserID uuid.UUID `json:"user_id" validate:"required"`
+	Limit  int       `json:"limit"`
+}
+
+// GroupWinner is a Pet of the Day winner with the name of its group
+type GroupWinner struct {
+	Winner    *domain.PetOfTheDayWinner `json:"winner"`
+	GroupName string                    `json:"group_name"`
+}
+
+// GetLatestWinnersResult represents the latest winners across the groups of a user, most
+// recent first
+type GetLatestWinnersResult struct {
+	Winners []*GroupWinner `json:"winners"`
+}
+
+// GetLatestWinnersHandler handles latest winners feed queries
+type GetLatestWinnersHandler struct {
+	petOfTheDayRepo domain.PetOfTheDayRepository
+	authRepo        domain.AuthorizationRepository
+}
+
+// NewGetLatestWinnersHandler creates a new get latest winners handler
+func NewGetLatestWinnersHandler(
+	petOfTheDayRepo domain.PetOfTheDayRepository,
+	authRepo domain.AuthorizationRepository,
+) *GetLatestWinnersHandler {
+	return &GetLatestWinnersHandler{
+		petOfTheDayRepo: petOfTheDayRepo,
+		authRepo:        authRepo,
+	}
+}
+
+// Handle processes the get latest winners query
+func (h *GetLatestWinnersHandler) Handle(ctx context.Context, query *GetLatestWinnersQuery) (*GetLatestWinnersResult, error) {
+	if query.Limit <= 0 {
+		query.Limit = 20
+	}
+	if query.Limit > 100 {
+		query.Limit = 100
+	}
+
+	// Users only see the winners of their own groups
+	groupIDs, err := h.authRepo.GetUserGroups(ctx, query.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user groups: %w", err)
+	}
+	if len(groupIDs) == 0 {
+		return &GetLatestWinnersResult{Winners: []*GroupWinner{}}, nil
+	}
+
+	winners, err := h.petOfTheDayRepo.GetLatestGroupWinners(ctx, groupIDs, query.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest winners: %w", err)
+	}
+
+	groupNames := make(map[uuid.UUID]string, len(groupIDs))
+	feed := make([]*GroupWinner, 0, len(winners))
+	for _, winner := range winners {
+		groupName, exists := groupNames[winner.GroupID]
+		if !exists {
+			groupInfo, err := h.authRepo.GetGroupInfo(ctx, winner.GroupID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get group info: %w", err)
+			}
+			groupName = groupInfo.Name
+			groupNames[winner.GroupID] = groupName
+		}
+
+		feed = append(feed, &GroupWinner{Winner: winner, GroupName: groupName})
+	}
+
+	return &GetLatestWinnersResult{Winners: feed}, nil
+}
@@ -0,0 +1,171 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/timezone"
+)
+
+const (
+	// defaultAnalyticsDays is the range analytics cover when none is given
+	defaultAnalyticsDays = 30
+	// defaultAnalyticsWindow is the number of days rolling averages cover when none is given
+	defaultAnalyticsWindow = 7
+	// maxAnalyticsWindow is the longest window rolling averages can cover
+	maxAnalyticsWindow = 31
+)
+
+// GetPetAnalyticsQuery represents a query for the behavior analytics of a pet
+type GetPetAnalyticsQuery struct {
+	PetID    uuid.UUID                `json:"pet_id" validate:"required"`
+	UserID   uuid.UUID                `json:"user_id" validate:"required"`
+	GroupID  *uuid.UUID               `json:"group_id,omitempty"` // Only logs shared with and scores in this group
+	From     *time.Time               `json:"from,omitempty"`     // 30 days before To by default
+	To       *time.Time               `json:"to,omitempty"`       // Today in the owner's timezone by default
+	Interval domain.AnalyticsInterval `json:"interval"`           // Category mix periods, weeks by default
+	Window   int                      `json:"window"`             // Days of rolling averages, 7 by default
+}
+
+// GetPetAnalyticsResult represents the behavior analytics of a pet over a range of days, in the
+// timezone of its owner
+type GetPetAnalyticsResult struct {
+	PetID       uuid.UUID                   `json:"pet_id"`
+	PetName     string                      `json:"pet_name"`
+	GroupID     *uuid.UUID                  `json:"group_id,omitempty"`
+	From        time.Time                   `json:"from"`
+	To          time.Time                   `json:"to"`
+	Timezone    string                      `json:"timezone"`
+	Interval    domain.AnalyticsInterval    `json:"interval"`
+	Window      int                         `json:"window"`
+	Heatmap     *domain.ActivityHeatmap     `json:"heatmap"`
+	CategoryMix []*domain.CategoryMixPeriod `json:"category_mix"`
+	RatioTrend  []*domain.RatioTrendPoint   `json:"ratio_trend"`
+	ScoreTrend  []*domain.ScoreTrendPoint   `json:"score_trend"`
+}
+
+// GetPetAnalyticsHandler handles pet analytics queries
+type GetPetAnalyticsHandler struct {
+	analyticsRepo    domain.PetAnalyticsRepository
+	authRepo         domain.AuthorizationRepository
+	userSettingsRepo domain.UserSettingsRepository
+}
+
+// NewGetPetAnalyticsHandler creates a new get pet analytics handler
+func NewGetPetAnalyticsHandler(
+	analyticsRepo domain.PetAnalyticsRepository,
+	authRepo domain.AuthorizationRepository,
+	userSettingsRepo domain.UserSettingsRepository,
+) *GetPetAnalyticsHandler {
+	return &GetPetAnalyticsHandler{
+		analyticsRepo:    analyticsRepo,
+		authRepo:         authRepo,
+		userSettingsRepo: userSettingsRepo,
+	}
+}
+
+// Handle processes the get pet analytics query. Only owners and co-owners can see the analytics
+// of a pet.
+func (h *GetPetAnalyticsHandler) Handle(ctx context.Context, query *GetPetAnalyticsQuery) (*GetPetAnalyticsResult, error) {
+	// Authorization: Check if user can access the pet
+	canAccess, err := h.authRepo.CanUserAccessPet(ctx, query.UserID, query.PetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check pet access: %w", err)
+	}
+	if !canAccess {
+		return nil, &commands.AuthorizationError{Message: "user does not have access to specified pet"}
+	}
+
+	if query.GroupID != nil {
+		inGroup, err := h.authRepo.IsPetInGroup(ctx, query.PetID, *query.GroupID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check pet group: %w", err)
+		}
+		if !inGroup {
+			return nil, &domain.ValidationError{Message: "pet is not a member of specified group"}
+		}
+	}
+
+	if query.Interval == "" {
+		query.Interval = domain.AnalyticsIntervalWeek
+	}
+	if !domain.IsValidAnalyticsInterval(query.Interval) {
+		return nil, fmt.Errorf("invalid analytics interval: %s", query.Interval)
+	}
+	if query.Window <= 0 {
+		query.Window = defaultAnalyticsWindow
+	}
+	if query.Window > maxAnalyticsWindow {
+		return nil, fmt.Errorf("rolling window cannot exceed %d days", maxAnalyticsWindow)
+	}
+
+	petInfo, err := h.authRepo.GetPetInfo(ctx, query.PetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pet info: %w", err)
+	}
+
+	// Logs are bucketed by the wall clock of the owner
+	settings, err := h.userSettingsRepo.GetUserTimezone(ctx, petInfo.OwnerID)
+	if err != nil {
+		settings = domain.NewUserTimezoneSettings(petInfo.OwnerID)
+	}
+	location, err := timezone.GetUserLocation(settings.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	now := time.Now().In(location)
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if query.To != nil {
+		to = *query.To
+	}
+	from := to.AddDate(0, 0, -(defaultAnalyticsDays - 1))
+	if query.From != nil {
+		from = *query.From
+	}
+
+	filter, err := domain.NewPetAnalyticsFilter(query.PetID, query.GroupID, from, to, location)
+	if err != nil {
+		return nil, err
+	}
+
+	cells, err := h.analyticsRepo.GetHourlyHeatmap(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get activity heatmap: %w", err)
+	}
+
+	buckets, err := h.analyticsRepo.GetCategoryMix(ctx, filter, query.Interval)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category mix: %w", err)
+	}
+
+	totals, err := h.analyticsRepo.GetDailyBehaviorTotals(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily behavior totals: %w", err)
+	}
+
+	scores, err := h.analyticsRepo.GetDailyScoreTotals(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily scores: %w", err)
+	}
+
+	return &GetPetAnalyticsResult{
+		PetID:       query.PetID,
+		PetName:     petInfo.Name,
+		GroupID:     query.GroupID,
+		From:        filter.From,
+		To:          filter.To,
+		Timezone:    location.String(),
+		Interval:    query.Interval,
+		Window:      query.Window,
+		Heatmap:     domain.BuildHeatmap(cells),
+		CategoryMix: domain.BuildCategoryMix(buckets, filter.From, filter.To, query.Interval),
+		RatioTrend:  domain.BuildRatioTrend(totals, filter.From, filter.To, query.Window),
+		ScoreTrend:  domain.BuildScoreTrend(scores, filter.From, filter.To, query.Window),
+	}, nil
+}
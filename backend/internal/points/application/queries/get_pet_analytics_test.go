@@ -0,0 +1,124 @@
+package queries
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+)
+
+func TestGetPetAnalyticsHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+	behaviorLogRepo := mock.NewMockBehaviorLogRepository()
+	behaviorRepo := mock.NewMockBehaviorRepository()
+	dailyScoreRepo := mock.NewMockDailyScoreRepository()
+	authRepo := mock.NewMockAuthorizationRepository()
+	userSettingsRepo := mock.NewMockUserSettingsRepository()
+	handler := NewGetPetAnalyticsHandler(
+		mock.NewMockPetAnalyticsRepository(behaviorLogRepo, behaviorRepo, dailyScoreRepo),
+		authRepo,
+		userSettingsRepo,
+	)
+
+	ownerID, memberID := uuid.New(), uuid.New()
+	parkID, clubID := uuid.New(), uuid.New()
+	petID := uuid.New()
+
+	authRepo.AddUserPet(ownerID, petID, &domain.PetInfo{ID: petID, Name: "Rex", Species: domain.SpeciesDog, OwnerID: ownerID})
+	authRepo.AddUserGroup(ownerID, parkID, &domain.GroupInfo{ID: parkID, Name: "Park friends", OwnerID: ownerID})
+	authRepo.AddUserGroup(memberID, parkID, &domain.GroupInfo{ID: parkID, Name: "Park friends", OwnerID: ownerID})
+	authRepo.AddPetToGroup(petID, parkID)
+
+	// The owner lives in New York, four hours behind UTC in mid March
+	settings := domain.NewUserTimezoneSettings(ownerID)
+	settings.Timezone = "America/New_York"
+	userSettingsRepo.UpdateUserTimezone(ctx, ownerID, settings)
+
+	fetch := &domain.Behavior{ID: uuid.New(), Name: "Fetch", Category: domain.BehaviorCategoryPlay, PointValue: 3, Species: domain.SpeciesDog, IsActive: true}
+	accident := &domain.Behavior{ID: uuid.New(), Name: "Accident", Category: domain.BehaviorCategoryPottyTraining, PointValue: -2, Species: domain.SpeciesDog, IsActive: true}
+	behaviorRepo.Create(ctx, fetch)
+	behaviorRepo.Create(ctx, accident)
+
+	logBehavior := func(behavior *domain.Behavior, loggedAt time.Time, groupIDs ...uuid.UUID) {
+		behaviorLog := &domain.BehaviorLog{
+			ID:            uuid.New(),
+			PetID:         petID,
+			BehaviorID:    behavior.ID,
+			UserID:        ownerID,
+			PointsAwarded: behavior.PointValue,
+			LoggedAt:      loggedAt,
+			CreatedAt:     loggedAt,
+		}
+		for _, groupID := range groupIDs {
+			behaviorLog.AddGroupShare(groupID)
+		}
+		behaviorLogRepo.Create(ctx, behaviorLog)
+	}
+
+	// Tuesday March 11 at 01:30 UTC is Monday March 10 at 21:30 in New York
+	logBehavior(fetch, time.Date(2025, time.March, 11, 1, 30, 0, 0, time.UTC), parkID)
+	logBehavior(fetch, time.Date(2025, time.March, 11, 1, 45, 0, 0, time.UTC), parkID)
+	logBehavior(accident, time.Date(2025, time.March, 12, 14, 0, 0, 0, time.UTC), clubID)
+
+	score, _ := domain.NewDailyScore(petID, parkID, time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC))
+	score.TotalPoints = 6
+	dailyScoreRepo.Create(ctx, score)
+
+	from := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, time.March, 16, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Logs are bucketed in the owner's timezone", func(t *testing.T) {
+		result, err := handler.Handle(ctx, &GetPetAnalyticsQuery{PetID: petID, UserID: ownerID, From: &from, To: &to})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if result.Heatmap.Logs[time.Monday][21] != 2 || result.Heatmap.PeakWeekday != time.Monday || result.Heatmap.PeakHour != 21 {
+			t.Errorf("Expected the Monday 21:00 peak, got %v at %d:00", result.Heatmap.PeakWeekday, result.Heatmap.PeakHour)
+		}
+		if len(result.RatioTrend) != 7 || result.RatioTrend[0].PositiveLogs != 2 || result.RatioTrend[2].NegativeLogs != 1 {
+			t.Errorf("Expected 2 good logs on the 10th and a bad one on the 12th, got %+v", result.RatioTrend[:3])
+		}
+		if ratio := result.RatioTrend[2].RollingRatio; ratio == nil || *ratio < 0.66 || *ratio > 0.67 {
+			t.Errorf("Expected a rolling ratio of 2/3 on the 12th, got %v", ratio)
+		}
+		if len(result.CategoryMix) != 1 || result.CategoryMix[0].TotalLogs != 3 {
+			t.Errorf("Expected a single week with 3 logs, got %+v", result.CategoryMix)
+		}
+		if result.ScoreTrend[0].Score != 6 || result.ScoreTrend[1].RollingAverage != 3 {
+			t.Errorf("Expected a score of 6 averaging 3 over two days, got %+v", result.ScoreTrend[:2])
+		}
+	})
+
+	t.Run("Group filter only counts logs shared with the group", func(t *testing.T) {
+		result, err := handler.Handle(ctx, &GetPetAnalyticsQuery{PetID: petID, UserID: ownerID, GroupID: &parkID, From: &from, To: &to, Interval: domain.AnalyticsIntervalDay})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(result.CategoryMix) != 7 || result.CategoryMix[2].TotalLogs != 0 || result.RatioTrend[2].PositiveRatio != nil {
+			t.Errorf("Expected the club accident to be left out, got %+v", result.CategoryMix[2])
+		}
+	})
+
+	t.Run("Pet outside the group", func(t *testing.T) {
+		if _, err := handler.Handle(ctx, &GetPetAnalyticsQuery{PetID: petID, UserID: ownerID, GroupID: &clubID}); err == nil {
+			t.Error("Expected error for a group the pet is not in")
+		}
+	})
+
+	t.Run("Range beyond the retention window", func(t *testing.T) {
+		longAgo := to.AddDate(-1, 0, 0)
+		if _, err := handler.Handle(ctx, &GetPetAnalyticsQuery{PetID: petID, UserID: ownerID, From: &longAgo, To: &to}); err == nil {
+			t.Error("Expected error for a range longer than the retention window")
+		}
+	})
+
+	t.Run("Only owners see analytics", func(t *testing.T) {
+		if _, err := handler.Handle(ctx, &GetPetAnalyticsQuery{PetID: petID, UserID: memberID}); err == nil {
+			t.Error("Expected error for a group member who does not own the pet")
+		}
+	})
+}
@@ -0,0 +1,85 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetPetBadgesQuery represents a query to get the badges awarded to a pet
+type GetPetBadgesQuery struct {
+	PetID  uuid.UUID `json:"pet_id" validate:"required"`
+	UserID uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// AwardedBadge is an awarded badge with its catalog details
+type AwardedBadge struct {
+	domain.BadgeRule
+	AwardedAt time.Time `json:"awarded_at"`
+}
+
+// GetPetBadgesResult represents the badges of a pet
+type GetPetBadgesResult struct {
+	PetID  uuid.UUID       `json:"pet_id"`
+	Badges []*AwardedBadge `json:"badges"`
+}
+
+// GetPetBadgesHandler handles queries for pet badges
+type GetPetBadgesHandler struct {
+	badgeRepo domain.BadgeRepository
+	authRepo  domain.AuthorizationRepository
+}
+
+// NewGetPetBadgesHandler creates a new get pet badges handler
+func NewGetPetBadgesHandler(
+	badgeRepo domain.BadgeRepository,
+	authRepo domain.AuthorizationRepository,
+) *GetPetBadgesHandler {
+	return &GetPetBadgesHandler{
+		badgeRepo: badgeRepo,
+		authRepo:  authRepo,
+	}
+}
+
+// Handle processes the get pet badges query
+func (h *GetPetBadgesHandler) Handle(ctx context.Context, query *GetPetBadgesQuery) (*GetPetBadgesResult, error) {
+	// Authorization: Check if user has access to the pet
+	canAccess, err := h.authRepo.CanUserAccessPet(ctx, query.UserID, query.PetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check pet access: %w", err)
+	}
+	if !canAccess {
+		return nil, &commands.AuthorizationError{Message: "user does not have access to specified pet"}
+	}
+
+	badges, err := h.badgeRepo.GetByPet(ctx, query.PetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pet badges: %w", err)
+	}
+
+	return &GetPetBadgesResult{
+		PetID:  query.PetID,
+		Badges: toAwardedBadges(badges),
+	}, nil
+}
+
+// toAwardedBadges adds the catalog details to awarded badges, skipping retired codes
+func toAwardedBadges(badges []*domain.Badge) []*AwardedBadge {
+	awarded := make([]*AwardedBadge, 0, len(badges))
+	for _, badge := range badges {
+		rule, exists := domain.GetBadgeRule(badge.Code)
+		if !exists {
+			continue
+		}
+		awarded = append(awarded, &AwardedBadge{
+			BadgeRule: rule,
+			AwardedAt: badge.AwardedAt,
+		})
+	}
+	return awarded
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"pet-of-the-day/internal/points/application/commands"
 	"pet-of-the-day/internal/points/domain"
 )
 
@@ -61,7 +62,7 @@ func (h *GetPetDailyScoreHandler) Handle(ctx context.Context, query *GetPetDaily
 		return nil, fmt.Errorf("failed to check pet access: %w", err)
 	}
 	if !canAccess {
-		return nil, fmt.Errorf("user does not have access to pet")
+		return nil, &commands.AuthorizationError{Message: "user does not have access to pet"}
 	}
 
 	// Get pet info
@@ -7,15 +7,16 @@ import (
 
 	"github.com/google/uuid"
 
+	"pet-of-the-day/internal/points/application/commands"
 	"pet-of-the-day/internal/points/application/services"
 	"pet-of-the-day/internal/points/domain"
 )
 
 // GetPetOfTheDayQuery represents a query to get Pet of the Day winner
 type GetPetOfTheDayQuery struct {
-	GroupID uuid.UUID `json:"group_id" validate:"required"`
-	Date    time.Time `json:"date"`
-	UserID  uuid.UUID `json:"user_id" validate:"required"`
+	GroupID uuid.UUID  `json:"group_id" validate:"required"`
+	Date    *time.Time `json:"date,omitempty"` // Defaults to the last closed day of the group
+	UserID  uuid.UUID  `json:"user_id" validate:"required"`
 }
 
 // GetPetOfTheDayResult represents the result of getting Pet of the Day winner
@@ -50,11 +51,22 @@ func (h *GetPetOfTheDayHandler) Handle(ctx context.Context, query *GetPetOfTheDa
 		return nil, fmt.Errorf("failed to check group access: %w", err)
 	}
 	if !canAccess {
-		return nil, fmt.Errorf("user does not have access to specified group")
+		return nil, &commands.AuthorizationError{Message: "user does not have access to specified group"}
+	}
+
+	// Pet of the Day is awarded when a day closes on the group's calendar
+	var date time.Time
+	if query.Date != nil {
+		date = *query.Date
+	} else {
+		date, err = h.rankingService.LastClosedDay(ctx, query.GroupID, time.Now())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get last closed day: %w", err)
+		}
 	}
 
 	// Get Pet of the Day winners for the date
-	winners, err := h.rankingService.GetPetOfTheDayWinners(ctx, query.GroupID, query.Date)
+	winners, err := h.rankingService.GetPetOfTheDayWinners(ctx, query.GroupID, date)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get Pet of the Day winners: %w", err)
 	}
@@ -68,6 +80,6 @@ func (h *GetPetOfTheDayHandler) Handle(ctx context.Context, query *GetPetOfTheDa
 	return &GetPetOfTheDayResult{
 		Winner:  winner,
 		GroupID: query.GroupID,
-		Date:    query.Date,
+		Date:    date,
 	}, nil
-}
\ No newline at end of file
+}
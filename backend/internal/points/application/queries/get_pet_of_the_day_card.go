@@ -0,0 +1,65 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/application/services"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetPetOfTheDayCardQuery represents a query for the share card of a Pet of the Day
+type GetPetOfTheDayCardQuery struct {
+	GroupID uuid.UUID  `json:"group_id" validate:"required"`
+	Date    time.Time  `json:"date" validate:"required"`
+	UserID  uuid.UUID  `json:"user_id" validate:"required"`
+	PetID   *uuid.UUID `json:"pet_id,omitempty"` // One of tied winners, the first by default
+}
+
+// GetPetOfTheDayCardResult holds the PNG content of a share card. The caller closes it.
+type GetPetOfTheDayCardResult struct {
+	Content io.ReadCloser
+}
+
+// GetPetOfTheDayCardHandler handles share card queries
+type GetPetOfTheDayCardHandler struct {
+	shareCardService *services.ShareCardService
+	authRepo         domain.AuthorizationRepository
+}
+
+// NewGetPetOfTheDayCardHandler creates a new get Pet of the Day card handler
+func NewGetPetOfTheDayCardHandler(
+	shareCardService *services.ShareCardService,
+	authRepo domain.AuthorizationRepository,
+) *GetPetOfTheDayCardHandler {
+	return &GetPetOfTheDayCardHandler{
+		shareCardService: shareCardService,
+		authRepo:         authRepo,
+	}
+}
+
+// Handle processes the get Pet of the Day card query
+func (h *GetPetOfTheDayCardHandler) Handle(ctx context.Context, query *GetPetOfTheDayCardQuery) (*GetPetOfTheDayCardResult, error) {
+	// Authorization: Check if user has access to the group
+	canAccess, err := h.authRepo.CanUserAccessGroup(ctx, query.UserID, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check group access: %w", err)
+	}
+	if !canAccess {
+		return nil, &commands.AuthorizationError{Message: "user does not have access to specified group"}
+	}
+
+	content, err := h.shareCardService.GetCard(ctx, query.GroupID, query.Date, query.PetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetPetOfTheDayCardResult{
+		Content: content,
+	}, nil
+}
@@ -0,0 +1,104 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetPetOfTheDayHistoryQuery represents a query for the Pet of the Day calendar of a group
+type GetPetOfTheDayHistoryQuery struct {
+	GroupID uuid.UUID  `json:"group_id" validate:"required"`
+	UserID  uuid.UUID  `json:"user_id" validate:"required"`
+	From    *time.Time `json:"from,omitempty"`
+	To      *time.Time `json:"to,omitempty"`
+	Limit   int        `json:"limit"`  // Days per page
+	Offset  int        `json:"offset"` // Days to skip
+}
+
+// GetPetOfTheDayHistoryResult represents a page of the Pet of the Day calendar of a group,
+// most recent day first
+type GetPetOfTheDayHistoryResult struct {
+	GroupID   uuid.UUID                   `json:"group_id"`
+	Days      []*domain.WinnerCalendarDay `json:"days"`
+	TotalDays int                         `json:"total_days"`
+	Limit     int                         `json:"limit"`
+	Offset    int                         `json:"offset"`
+}
+
+// GetPetOfTheDayHistoryHandler handles Pet of the Day calendar queries
+type GetPetOfTheDayHistoryHandler struct {
+	petOfTheDayRepo domain.PetOfTheDayRepository
+	authRepo        domain.AuthorizationRepository
+}
+
+// NewGetPetOfTheDayHistoryHandler creates a new get Pet of the Day history handler
+func NewGetPetOfTheDayHistoryHandler(
+	petOfTheDayRepo domain.PetOfTheDayRepository,
+	authRepo domain.AuthorizationRepository,
+) *GetPetOfTheDayHistoryHandler {
+	return &GetPetOfTheDayHistoryHandler{
+		petOfTheDayRepo: petOfTheDayRepo,
+		authRepo:        authRepo,
+	}
+}
+
+// Handle processes the get Pet of the Day history query
+func (h *GetPetOfTheDayHistoryHandler) Handle(ctx context.Context, query *GetPetOfTheDayHistoryQuery) (*GetPetOfTheDayHistoryResult, error) {
+	// Authorization: Check if user has access to the group
+	canAccess, err := h.authRepo.CanUserAccessGroup(ctx, query.UserID, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check group access: %w", err)
+	}
+	if !canAccess {
+		return nil, &commands.AuthorizationError{Message: "user does not have access to specified group"}
+	}
+
+	if query.Limit <= 0 {
+		query.Limit = 31
+	}
+	if query.Limit > 366 {
+		query.Limit = 366
+	}
+
+	// Without a range the whole history of the group is paged through
+	from, to := time.Time{}, time.Now()
+	if query.From != nil {
+		from = *query.From
+	}
+	if query.To != nil {
+		to = *query.To
+	}
+	if to.Before(from) {
+		return nil, fmt.Errorf("history range ends before it starts")
+	}
+
+	winners, err := h.petOfTheDayRepo.GetWinnerHistory(ctx, query.GroupID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Pet of the Day history: %w", err)
+	}
+
+	days := domain.BuildWinnerCalendar(winners)
+	totalDays := len(days)
+	if query.Offset >= totalDays {
+		days = []*domain.WinnerCalendarDay{}
+	} else {
+		days = days[query.Offset:]
+		if len(days) > query.Limit {
+			days = days[:query.Limit]
+		}
+	}
+
+	return &GetPetOfTheDayHistoryResult{
+		GroupID:   query.GroupID,
+		Days:      days,
+		TotalDays: totalDays,
+		Limit:     query.Limit,
+		Offset:    query.Offset,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package queries
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"pet-of-the-day/internal/points/application/services"
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+	"pet-of-the-day/internal/shared/events"
+)
+
+func TestGetPetOfTheDayHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	dailyScoreRepo := mock.NewMockDailyScoreRepository()
+	authRepo := mock.NewMockAuthorizationRepository()
+	rankingService := services.NewRankingService(
+		dailyScoreRepo, mock.NewMockRankingReadModelRepository(dailyScoreRepo, authRepo), mock.NewMockBehaviorLogRepository(), mock.NewMockPetOfTheDayRepository(), authRepo, mock.NewMockUserSettingsRepository(),
+		mock.NewMockDailyResetStateRepository(), mock.NewMockScoringRulesRepository(), events.NewInMemoryBus(),
+	)
+	handler := NewGetPetOfTheDayHandler(rankingService, authRepo)
+
+	userID, groupID := uuid.New(), uuid.New()
+	authRepo.AddUserGroup(userID, groupID, &domain.GroupInfo{ID: groupID, Name: "Park friends", OwnerID: userID})
+	rex, milo := uuid.New(), uuid.New()
+	addPets(authRepo, userID, rex, milo)
+	for _, petID := range []uuid.UUID{rex, milo} {
+		authRepo.AddPetToGroup(petID, groupID)
+	}
+
+	// Rex wins the last closed day of the group, Milo the day before
+	closedDay, err := rankingService.LastClosedDay(ctx, groupID, time.Now())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	for petID, day := range map[uuid.UUID]time.Time{rex: closedDay, milo: closedDay.AddDate(0, 0, -1)} {
+		dailyScore, _ := dailyScoreRepo.GetOrCreate(ctx, petID, groupID, day)
+		dailyScore.AddBehaviorLog(&domain.BehaviorLog{PetID: petID, PointsAwarded: 5, LoggedAt: day})
+		if _, err := rankingService.SelectPetOfTheDay(ctx, groupID, day); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+
+	dayBefore := closedDay.AddDate(0, 0, -1)
+	tests := []struct {
+		name       string
+		date       *time.Time
+		wantWinner uuid.UUID
+		wantDate   time.Time
+	}{
+		{name: "Defaults to the last closed day of the group", wantWinner: rex, wantDate: closedDay},
+		{name: "Uses the requested day", date: &dayBefore, wantWinner: milo, wantDate: dayBefore},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := handler.Handle(ctx, &GetPetOfTheDayQuery{GroupID: groupID, Date: tt.date, UserID: userID})
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if result.Winner == nil || result.Winner.PetID != tt.wantWinner {
+				t.Errorf("Expected winner %s, got %+v", tt.wantWinner, result.Winner)
+			}
+			if !result.Date.Equal(tt.wantDate) {
+				t.Errorf("Expected date %v, got %v", tt.wantDate, result.Date)
+			}
+		})
+	}
+}
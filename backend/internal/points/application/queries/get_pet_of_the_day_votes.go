@@ -0,0 +1,89 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/application/services"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetPetOfTheDayVotesQuery represents a query for the Pet of the Day vote of the group day in progress
+type GetPetOfTheDayVotesQuery struct {
+	GroupID uuid.UUID `json:"group_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// GetPetOfTheDayVotesResult represents the live state of a group's vote
+type GetPetOfTheDayVotesResult struct {
+	GroupID uuid.UUID               `json:"group_id"`
+	Voting  bool                    `json:"voting"` // Whether the group selects Pet of the Day by vote
+	Window  *domain.VotingWindow    `json:"window"`
+	IsOpen  bool                    `json:"is_open"`
+	Tallies []*domain.VoteTally     `json:"tallies"`
+	MyVote  *domain.PetOfTheDayVote `json:"my_vote,omitempty"`
+}
+
+// GetPetOfTheDayVotesHandler handles Pet of the Day vote queries
+type GetPetOfTheDayVotesHandler struct {
+	votingService *services.VotingService
+	voteRepo      domain.PetOfTheDayVoteRepository
+	authRepo      domain.AuthorizationRepository
+}
+
+// NewGetPetOfTheDayVotesHandler creates a new get Pet of the Day votes handler
+func NewGetPetOfTheDayVotesHandler(
+	votingService *services.VotingService,
+	voteRepo domain.PetOfTheDayVoteRepository,
+	authRepo domain.AuthorizationRepository,
+) *GetPetOfTheDayVotesHandler {
+	return &GetPetOfTheDayVotesHandler{
+		votingService: votingService,
+		voteRepo:      voteRepo,
+		authRepo:      authRepo,
+	}
+}
+
+// Handle processes the get Pet of the Day votes query
+func (h *GetPetOfTheDayVotesHandler) Handle(ctx context.Context, query *GetPetOfTheDayVotesQuery) (*GetPetOfTheDayVotesResult, error) {
+	canAccess, err := h.authRepo.CanUserAccessGroup(ctx, query.UserID, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check group access: %w", err)
+	}
+	if !canAccess {
+		return nil, &commands.AuthorizationError{Message: "user does not have access to specified group"}
+	}
+
+	now := time.Now()
+	window, err := h.votingService.CurrentWindow(ctx, query.GroupID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	tallies, voting, err := h.votingService.TallyVotes(ctx, query.GroupID, window.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	myVote, err := h.voteRepo.GetByVoter(ctx, query.GroupID, window.Date, query.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vote: %w", err)
+	}
+
+	if tallies == nil {
+		tallies = []*domain.VoteTally{}
+	}
+
+	return &GetPetOfTheDayVotesResult{
+		GroupID: query.GroupID,
+		Voting:  voting,
+		Window:  window,
+		IsOpen:  voting && window.IsOpen(now),
+		Tallies: tallies,
+		MyVote:  myVote,
+	}, nil
+}
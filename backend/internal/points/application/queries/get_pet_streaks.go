@@ -0,0 +1,69 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/application/services"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetPetStreaksQuery represents a query to get the current and best streaks of a pet
+type GetPetStreaksQuery struct {
+	PetID  uuid.UUID `json:"pet_id" validate:"required"`
+	UserID uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// GetPetStreaksResult represents the streaks of a pet on its owner's current day
+type GetPetStreaksResult struct {
+	PetID     uuid.UUID              `json:"pet_id"`
+	Date      string                 `json:"date"`
+	Streaks   []*domain.StreakStatus `json:"streaks"`
+	UpdatedAt string                 `json:"updated_at"`
+}
+
+// GetPetStreaksHandler handles queries for pet streaks
+type GetPetStreaksHandler struct {
+	streakService *services.StreakService
+	authRepo      domain.AuthorizationRepository
+}
+
+// NewGetPetStreaksHandler creates a new get pet streaks handler
+func NewGetPetStreaksHandler(
+	streakService *services.StreakService,
+	authRepo domain.AuthorizationRepository,
+) *GetPetStreaksHandler {
+	return &GetPetStreaksHandler{
+		streakService: streakService,
+		authRepo:      authRepo,
+	}
+}
+
+// Handle processes the get pet streaks query
+func (h *GetPetStreaksHandler) Handle(ctx context.Context, query *GetPetStreaksQuery) (*GetPetStreaksResult, error) {
+	// Authorization: Check if user has access to the pet
+	canAccess, err := h.authRepo.CanUserAccessPet(ctx, query.UserID, query.PetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check pet access: %w", err)
+	}
+	if !canAccess {
+		return nil, &commands.AuthorizationError{Message: "user does not have access to specified pet"}
+	}
+
+	now := time.Now()
+	today, streaks, err := h.streakService.GetPetStreaks(ctx, query.PetID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pet streaks: %w", err)
+	}
+
+	return &GetPetStreaksResult{
+		PetID:     query.PetID,
+		Date:      today.Format("2006-01-02"),
+		Streaks:   streaks,
+		UpdatedAt: now.Format(time.RFC3339),
+	}, nil
+}
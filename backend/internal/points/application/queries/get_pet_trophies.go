@@ -0,0 +1,113 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetPetTrophiesQuery represents a query for the Pet of the Day trophy case of a pet
+type GetPetTrophiesQuery struct {
+	PetID  uuid.UUID `json:"pet_id" validate:"required"`
+	UserID uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// GetPetTrophiesResult represents the Pet of the Day wins of a pet across the groups the user
+// belongs to, per group and per year, with the most recent wins first
+type GetPetTrophiesResult struct {
+	PetID     uuid.UUID                   `json:"pet_id"`
+	PetName   string                      `json:"pet_name"`
+	TotalWins int                         `json:"total_wins"`
+	Groups    []*domain.GroupTrophies     `json:"groups"`
+	Years     []*domain.HallOfFamePeriod  `json:"years"`
+	Wins      []*domain.PetOfTheDayWinner `json:"wins"`
+}
+
+// GetPetTrophiesHandler handles pet trophy case queries
+type GetPetTrophiesHandler struct {
+	petOfTheDayRepo domain.PetOfTheDayRepository
+	authRepo        domain.AuthorizationRepository
+}
+
+// NewGetPetTrophiesHandler creates a new get pet trophies handler
+func NewGetPetTrophiesHandler(
+	petOfTheDayRepo domain.PetOfTheDayRepository,
+	authRepo domain.AuthorizationRepository,
+) *GetPetTrophiesHandler {
+	return &GetPetTrophiesHandler{
+		petOfTheDayRepo: petOfTheDayRepo,
+		authRepo:        authRepo,
+	}
+}
+
+// Handle processes the get pet trophies query. Owners and members of a group the pet belongs
+// to can see its trophy case, which only lists wins in the user's own groups.
+func (h *GetPetTrophiesHandler) Handle(ctx context.Context, query *GetPetTrophiesQuery) (*GetPetTrophiesResult, error) {
+	groupIDs, err := h.authRepo.GetUserGroups(ctx, query.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user groups: %w", err)
+	}
+
+	inUserGroups := make(map[uuid.UUID]bool, len(groupIDs))
+	for _, groupID := range groupIDs {
+		inUserGroups[groupID] = true
+	}
+
+	// Authorization: Check if user owns the pet or shares a group with it
+	canAccess, err := h.authRepo.CanUserAccessPet(ctx, query.UserID, query.PetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check pet access: %w", err)
+	}
+	if !canAccess {
+		petGroupIDs, err := h.authRepo.GetPetGroups(ctx, query.PetID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pet groups: %w", err)
+		}
+		for _, groupID := range petGroupIDs {
+			canAccess = canAccess || inUserGroups[groupID]
+		}
+	}
+	if !canAccess {
+		return nil, &commands.AuthorizationError{Message: "user does not have access to specified pet"}
+	}
+
+	petInfo, err := h.authRepo.GetPetInfo(ctx, query.PetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pet info: %w", err)
+	}
+
+	allWins, err := h.petOfTheDayRepo.GetPetWins(ctx, query.PetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pet wins: %w", err)
+	}
+
+	// Wins come oldest first, the trophy case lists the most recent first
+	wins := make([]*domain.PetOfTheDayWinner, 0, len(allWins))
+	for i := len(allWins) - 1; i >= 0; i-- {
+		if inUserGroups[allWins[i].GroupID] {
+			wins = append(wins, allWins[i])
+		}
+	}
+
+	groups := domain.CountTrophiesByGroup(wins)
+	for _, group := range groups {
+		groupInfo, err := h.authRepo.GetGroupInfo(ctx, group.GroupID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get group info: %w", err)
+		}
+		group.GroupName = groupInfo.Name
+	}
+
+	return &GetPetTrophiesResult{
+		PetID:     query.PetID,
+		PetName:   petInfo.Name,
+		TotalWins: len(wins),
+		Groups:    groups,
+		Years:     domain.AggregateWinners(wins, domain.HistoryPeriodYear),
+		Wins:      wins,
+	}, nil
+}
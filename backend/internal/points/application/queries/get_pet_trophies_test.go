@@ -0,0 +1,68 @@
+package queries
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+)
+
+func TestGetPetTrophiesHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+	petOfTheDayRepo := mock.NewMockPetOfTheDayRepository()
+	authRepo := mock.NewMockAuthorizationRepository()
+	handler := NewGetPetTrophiesHandler(petOfTheDayRepo, authRepo)
+
+	ownerID, memberID, strangerID := uuid.New(), uuid.New(), uuid.New()
+	parkID, clubID := uuid.New(), uuid.New()
+	petID := uuid.New()
+
+	// Rex belongs to both groups, the member only to the park
+	authRepo.AddUserPet(ownerID, petID, &domain.PetInfo{ID: petID, Name: "Rex", Species: domain.SpeciesDog, OwnerID: ownerID})
+	authRepo.AddUserGroup(ownerID, parkID, &domain.GroupInfo{ID: parkID, Name: "Park friends", OwnerID: ownerID})
+	authRepo.AddUserGroup(ownerID, clubID, &domain.GroupInfo{ID: clubID, Name: "Dog club", OwnerID: ownerID})
+	authRepo.AddUserGroup(memberID, parkID, &domain.GroupInfo{ID: parkID, Name: "Park friends", OwnerID: ownerID})
+	authRepo.AddPetToGroup(petID, parkID)
+	authRepo.AddPetToGroup(petID, clubID)
+
+	for _, win := range []struct {
+		groupID uuid.UUID
+		day     int
+	}{{parkID, 10}, {parkID, 12}, {clubID, 11}} {
+		date := time.Date(2025, time.March, win.day, 0, 0, 0, 0, time.UTC)
+		petOfTheDayRepo.Create(ctx, domain.NewPetOfTheDayWinner(win.groupID, petID, "Rex", "Alice", date, 5, 2, 0))
+	}
+
+	t.Run("Owner sees the wins of every group", func(t *testing.T) {
+		result, err := handler.Handle(ctx, &GetPetTrophiesQuery{PetID: petID, UserID: ownerID})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if result.TotalWins != 3 || len(result.Groups) != 2 || result.Groups[0].GroupName != "Park friends" {
+			t.Errorf("Expected 3 wins in 2 groups, got %+v", result)
+		}
+		if !result.Wins[0].Date.Equal(time.Date(2025, time.March, 12, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("Expected the most recent win first, got %v", result.Wins[0].Date)
+		}
+	})
+
+	t.Run("Members only see the wins of their groups", func(t *testing.T) {
+		result, err := handler.Handle(ctx, &GetPetTrophiesQuery{PetID: petID, UserID: memberID})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if result.TotalWins != 2 || len(result.Groups) != 1 || result.Groups[0].GroupID != parkID {
+			t.Errorf("Expected 2 wins in the park, got %+v", result)
+		}
+	})
+
+	t.Run("Strangers cannot see the trophy case", func(t *testing.T) {
+		if _, err := handler.Handle(ctx, &GetPetTrophiesQuery{PetID: petID, UserID: strangerID}); err == nil {
+			t.Error("Expected error for a user outside the pet's groups")
+		}
+	})
+}
@@ -0,0 +1,88 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetPointAdjustmentsQuery represents a query to read the point ledger of a group
+type GetPointAdjustmentsQuery struct {
+	GroupID  uuid.UUID  `json:"group_id" validate:"required"`
+	UserID   uuid.UUID  `json:"user_id" validate:"required"`
+	PetID    *uuid.UUID `json:"pet_id,omitempty"`
+	DateFrom *time.Time `json:"date_from,omitempty"`
+	DateTo   *time.Time `json:"date_to,omitempty"`
+	Limit    int        `json:"limit"`
+	Offset   int        `json:"offset"`
+}
+
+// GetPointAdjustmentsResult represents ledger entries of a group, most recent first
+type GetPointAdjustmentsResult struct {
+	GroupID     uuid.UUID                 `json:"group_id"`
+	Adjustments []*domain.PointAdjustment `json:"adjustments"`
+	Limit       int                       `json:"limit"`
+	Offset      int                       `json:"offset"`
+}
+
+// GetPointAdjustmentsHandler handles queries for the point ledger
+type GetPointAdjustmentsHandler struct {
+	adjustmentRepo domain.PointAdjustmentRepository
+	authRepo       domain.AuthorizationRepository
+}
+
+// NewGetPointAdjustmentsHandler creates a new get point adjustments handler
+func NewGetPointAdjustmentsHandler(
+	adjustmentRepo domain.PointAdjustmentRepository,
+	authRepo domain.AuthorizationRepository,
+) *GetPointAdjustmentsHandler {
+	return &GetPointAdjustmentsHandler{
+		adjustmentRepo: adjustmentRepo,
+		authRepo:       authRepo,
+	}
+}
+
+// Handle processes the get point adjustments query
+func (h *GetPointAdjustmentsHandler) Handle(ctx context.Context, query *GetPointAdjustmentsQuery) (*GetPointAdjustmentsResult, error) {
+	// Authorization: Check if user has access to the group
+	canAccess, err := h.authRepo.CanUserAccessGroup(ctx, query.UserID, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check group access: %w", err)
+	}
+	if !canAccess {
+		return nil, &commands.AuthorizationError{Message: "user does not have access to specified group"}
+	}
+
+	if query.Limit <= 0 {
+		query.Limit = 50
+	}
+	if query.Limit > 100 {
+		query.Limit = 100
+	}
+
+	filter := domain.NewPointAdjustmentFilter().
+		WithGroup(query.GroupID).
+		WithPagination(query.Limit, query.Offset)
+	if query.PetID != nil {
+		filter.WithPet(*query.PetID)
+	}
+	filter.DateFrom = query.DateFrom
+	filter.DateTo = query.DateTo
+
+	adjustments, err := h.adjustmentRepo.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get point adjustments: %w", err)
+	}
+
+	return &GetPointAdjustmentsResult{
+		GroupID:     query.GroupID,
+		Adjustments: adjustments,
+		Limit:       query.Limit,
+		Offset:      query.Offset,
+	}, nil
+}
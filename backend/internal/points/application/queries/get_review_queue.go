@@ -0,0 +1,91 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetReviewQueueQuery represents a query for the behavior logs of a group waiting for review.
+// Members look at pending logs to confirm them, the group admin at disputed ones.
+type GetReviewQueueQuery struct {
+	GroupID uuid.UUID          `json:"group_id" validate:"required"`
+	UserID  uuid.UUID          `json:"user_id" validate:"required"`
+	Status  domain.ShareStatus `json:"status"`
+	Limit   int                `json:"limit"`
+	Offset  int                `json:"offset"`
+}
+
+// GetReviewQueueResult represents the behavior logs of a group with a verification status, most recent first
+type GetReviewQueueResult struct {
+	GroupID      uuid.UUID             `json:"group_id"`
+	Status       domain.ShareStatus    `json:"status"`
+	BehaviorLogs []*domain.BehaviorLog `json:"behavior_logs"`
+	Limit        int                   `json:"limit"`
+	Offset       int                   `json:"offset"`
+}
+
+// GetReviewQueueHandler handles review queue queries
+type GetReviewQueueHandler struct {
+	behaviorLogRepo domain.BehaviorLogRepository
+	authRepo        domain.AuthorizationRepository
+}
+
+// NewGetReviewQueueHandler creates a new get review queue handler
+func NewGetReviewQueueHandler(
+	behaviorLogRepo domain.BehaviorLogRepository,
+	authRepo domain.AuthorizationRepository,
+) *GetReviewQueueHandler {
+	return &GetReviewQueueHandler{
+		behaviorLogRepo: behaviorLogRepo,
+		authRepo:        authRepo,
+	}
+}
+
+// Handle processes the get review queue query
+func (h *GetReviewQueueHandler) Handle(ctx context.Context, query *GetReviewQueueQuery) (*GetReviewQueueResult, error) {
+	canAccess, err := h.authRepo.CanUserAccessGroup(ctx, query.UserID, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check group access: %w", err)
+	}
+	if !canAccess {
+		return nil, &commands.AuthorizationError{Message: "user does not have access to specified group"}
+	}
+
+	switch query.Status {
+	case "":
+		query.Status = domain.ShareStatusPending
+	case domain.ShareStatusPending, domain.ShareStatusDisputed, domain.ShareStatusRejected, domain.ShareStatusVerified:
+	default:
+		return nil, fmt.Errorf("invalid verification status: %s", query.Status)
+	}
+
+	if query.Limit <= 0 {
+		query.Limit = 50
+	}
+	if query.Limit > 100 {
+		query.Limit = 100
+	}
+
+	filter := domain.NewBehaviorLogFilter().
+		WithGroup(query.GroupID).
+		WithShareStatus(query.Status).
+		WithPagination(query.Limit, query.Offset)
+
+	behaviorLogs, err := h.behaviorLogRepo.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get behavior logs: %w", err)
+	}
+
+	return &GetReviewQueueResult{
+		GroupID:      query.GroupID,
+		Status:       query.Status,
+		BehaviorLogs: behaviorLogs,
+		Limit:        query.Limit,
+		Offset:       query.Offset,
+	}, nil
+}
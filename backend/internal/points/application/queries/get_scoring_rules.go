@@ -0,0 +1,52 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetScoringRulesQuery represents a query for the scoring rules of a group
+type GetScoringRulesQuery struct {
+	GroupID uuid.UUID `json:"group_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// GetScoringRulesHandler handles scoring rules queries
+type GetScoringRulesHandler struct {
+	rulesRepo domain.ScoringRulesRepository
+	authRepo  domain.AuthorizationRepository
+}
+
+// NewGetScoringRulesHandler creates a new get scoring rules handler
+func NewGetScoringRulesHandler(
+	rulesRepo domain.ScoringRulesRepository,
+	authRepo domain.AuthorizationRepository,
+) *GetScoringRulesHandler {
+	return &GetScoringRulesHandler{
+		rulesRepo: rulesRepo,
+		authRepo:  authRepo,
+	}
+}
+
+// Handle processes the get scoring rules query
+func (h *GetScoringRulesHandler) Handle(ctx context.Context, query *GetScoringRulesQuery) (*domain.ScoringRules, error) {
+	canAccess, err := h.authRepo.CanUserAccessGroup(ctx, query.UserID, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check group access: %w", err)
+	}
+	if !canAccess {
+		return nil, &commands.AuthorizationError{Message: "user does not have access to specified group"}
+	}
+
+	rules, err := h.rulesRepo.GetByGroup(ctx, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scoring rules: %w", err)
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,104 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/application/services"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetSeasonStandingsQuery represents a query to get the standings of a season.
+// Without a season ID the group's current season is used.
+type GetSeasonStandingsQuery struct {
+	GroupID  uuid.UUID  `json:"group_id" validate:"required"`
+	SeasonID *uuid.UUID `json:"season_id,omitempty"`
+	UserID   uuid.UUID  `json:"user_id" validate:"required"`
+}
+
+// GetSeasonStandingsResult represents the standings of a season, highest tier first
+type GetSeasonStandingsResult struct {
+	Season    *domain.Season           `json:"season"`
+	Standings []*domain.SeasonStanding `json:"standings"`
+	IsFinal   bool                     `json:"is_final"`
+	UpdatedAt string                   `json:"updated_at"`
+}
+
+// GetSeasonStandingsHandler handles queries for season standings
+type GetSeasonStandingsHandler struct {
+	seasonRepo    domain.SeasonRepository
+	seasonService *services.SeasonService
+	authRepo      domain.AuthorizationRepository
+}
+
+// NewGetSeasonStandingsHandler creates a new get season standings handler
+func NewGetSeasonStandingsHandler(
+	seasonRepo domain.SeasonRepository,
+	seasonService *services.SeasonService,
+	authRepo domain.AuthorizationRepository,
+) *GetSeasonStandingsHandler {
+	return &GetSeasonStandingsHandler{
+		seasonRepo:    seasonRepo,
+		seasonService: seasonService,
+		authRepo:      authRepo,
+	}
+}
+
+// Handle processes the get season standings query
+func (h *GetSeasonStandingsHandler) Handle(ctx context.Context, query *GetSeasonStandingsQuery) (*GetSeasonStandingsResult, error) {
+	// Authorization: Check if user has access to the group
+	canAccess, err := h.authRepo.CanUserAccessGroup(ctx, query.UserID, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check group access: %w", err)
+	}
+	if !canAccess {
+		return nil, &commands.AuthorizationError{Message: "user does not have access to specified group"}
+	}
+
+	now := time.Now()
+	season, err := h.findSeason(ctx, query, now)
+	if err != nil {
+		return nil, err
+	}
+
+	standings, err := h.seasonService.GetStandings(ctx, season, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season standings: %w", err)
+	}
+
+	return &GetSeasonStandingsResult{
+		Season:    season,
+		Standings: standings,
+		IsFinal:   season.IsClosed(),
+		UpdatedAt: now.Format(time.RFC3339),
+	}, nil
+}
+
+// findSeason resolves the requested season, or the current one when none is given
+func (h *GetSeasonStandingsHandler) findSeason(ctx context.Context, query *GetSeasonStandingsQuery, now time.Time) (*domain.Season, error) {
+	if query.SeasonID != nil {
+		season, err := h.seasonRepo.GetByID(ctx, *query.SeasonID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get season: %w", err)
+		}
+		if season == nil || season.GroupID != query.GroupID {
+			return nil, fmt.Errorf("season not found")
+		}
+		return season, nil
+	}
+
+	seasons, err := h.seasonRepo.GetByGroup(ctx, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group seasons: %w", err)
+	}
+
+	season := domain.CurrentSeason(seasons, now)
+	if season == nil {
+		return nil, fmt.Errorf("no current season for this group")
+	}
+	return season, nil
+}
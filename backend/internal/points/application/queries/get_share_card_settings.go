@@ -0,0 +1,52 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetShareCardSettingsQuery represents a query for the share card settings of a group
+type GetShareCardSettingsQuery struct {
+	GroupID uuid.UUID `json:"group_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// GetShareCardSettingsHandler handles share card settings queries
+type GetShareCardSettingsHandler struct {
+	settingsRepo domain.ShareCardSettingsRepository
+	authRepo     domain.AuthorizationRepository
+}
+
+// NewGetShareCardSettingsHandler creates a new get share card settings handler
+func NewGetShareCardSettingsHandler(
+	settingsRepo domain.ShareCardSettingsRepository,
+	authRepo domain.AuthorizationRepository,
+) *GetShareCardSettingsHandler {
+	return &GetShareCardSettingsHandler{
+		settingsRepo: settingsRepo,
+		authRepo:     authRepo,
+	}
+}
+
+// Handle processes the get share card settings query
+func (h *GetShareCardSettingsHandler) Handle(ctx context.Context, query *GetShareCardSettingsQuery) (*domain.ShareCardSettings, error) {
+	canAccess, err := h.authRepo.CanUserAccessGroup(ctx, query.UserID, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check group access: %w", err)
+	}
+	if !canAccess {
+		return nil, &commands.AuthorizationError{Message: "user does not have access to specified group"}
+	}
+
+	settings, err := h.settingsRepo.GetByGroup(ctx, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get share card settings: %w", err)
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,69 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/application/services"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetTrendingPetsQuery represents a query to get the trending pets of a group
+type GetTrendingPetsQuery struct {
+	GroupID uuid.UUID `json:"group_id" validate:"required"`
+	Days    int       `json:"days"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// GetTrendingPetsResult represents the result of getting trending pets
+type GetTrendingPetsResult struct {
+	GroupID   uuid.UUID             `json:"group_id"`
+	Days      int                   `json:"days"`
+	Pets      []*domain.TrendingPet `json:"pets"`
+	UpdatedAt string                `json:"updated_at"`
+}
+
+// GetTrendingPetsHandler handles queries for getting trending pets
+type GetTrendingPetsHandler struct {
+	rankingService *services.RankingService
+	authRepo       domain.AuthorizationRepository
+}
+
+// NewGetTrendingPetsHandler creates a new get trending pets handler
+func NewGetTrendingPetsHandler(
+	rankingService *services.RankingService,
+	authRepo domain.AuthorizationRepository,
+) *GetTrendingPetsHandler {
+	return &GetTrendingPetsHandler{
+		rankingService: rankingService,
+		authRepo:       authRepo,
+	}
+}
+
+// Handle processes the get trending pets query
+func (h *GetTrendingPetsHandler) Handle(ctx context.Context, query *GetTrendingPetsQuery) (*GetTrendingPetsResult, error) {
+	// Authorization: Check if user has access to the group
+	canAccess, err := h.authRepo.CanUserAccessGroup(ctx, query.UserID, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check group access: %w", err)
+	}
+	if !canAccess {
+		return nil, &commands.AuthorizationError{Message: "user does not have access to specified group"}
+	}
+
+	pets, err := h.rankingService.GetTrendingPets(ctx, query.GroupID, query.Days)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trending pets: %w", err)
+	}
+
+	return &GetTrendingPetsResult{
+		GroupID:   query.GroupID,
+		Days:      query.Days,
+		Pets:      pets,
+		UpdatedAt: time.Now().Format(time.RFC3339),
+	}, nil
+}
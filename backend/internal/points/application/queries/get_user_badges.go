@@ -0,0 +1,48 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetUserBadgesQuery represents a query to get the badges awarded to a user
+type GetUserBadgesQuery struct {
+	UserID uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// GetUserBadgesResult represents the badges of a user and the badge catalog
+type GetUserBadgesResult struct {
+	UserID    uuid.UUID          `json:"user_id"`
+	Badges    []*AwardedBadge    `json:"badges"`
+	Available []domain.BadgeRule `json:"available"`
+}
+
+// GetUserBadgesHandler handles queries for user badges
+type GetUserBadgesHandler struct {
+	badgeRepo domain.BadgeRepository
+}
+
+// NewGetUserBadgesHandler creates a new get user badges handler
+func NewGetUserBadgesHandler(badgeRepo domain.BadgeRepository) *GetUserBadgesHandler {
+	return &GetUserBadgesHandler{
+		badgeRepo: badgeRepo,
+	}
+}
+
+// Handle processes the get user badges query
+func (h *GetUserBadgesHandler) Handle(ctx context.Context, query *GetUserBadgesQuery) (*GetUserBadgesResult, error) {
+	badges, err := h.badgeRepo.GetByUser(ctx, query.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user badges: %w", err)
+	}
+
+	return &GetUserBadgesResult{
+		UserID:    query.UserID,
+		Badges:    toAwardedBadges(badges),
+		Available: domain.GetBadgeRules(),
+	}, nil
+}
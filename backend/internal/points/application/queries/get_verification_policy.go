@@ -0,0 +1,52 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetVerificationPolicyQuery represents a query for the peer verification policy of a group
+type GetVerificationPolicyQuery struct {
+	GroupID uuid.UUID `json:"group_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// GetVerificationPolicyHandler handles verification policy queries
+type GetVerificationPolicyHandler struct {
+	policyRepo domain.VerificationPolicyRepository
+	authRepo   domain.AuthorizationRepository
+}
+
+// NewGetVerificationPolicyHandler creates a new get verification policy handler
+func NewGetVerificationPolicyHandler(
+	policyRepo domain.VerificationPolicyRepository,
+	authRepo domain.AuthorizationRepository,
+) *GetVerificationPolicyHandler {
+	return &GetVerificationPolicyHandler{
+		policyRepo: policyRepo,
+		authRepo:   authRepo,
+	}
+}
+
+// Handle processes the get verification policy query
+func (h *GetVerificationPolicyHandler) Handle(ctx context.Context, query *GetVerificationPolicyQuery) (*domain.VerificationPolicy, error) {
+	canAccess, err := h.authRepo.CanUserAccessGroup(ctx, query.UserID, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check group access: %w", err)
+	}
+	if !canAccess {
+		return nil, &commands.AuthorizationError{Message: "user does not have access to specified group"}
+	}
+
+	policy, err := h.policyRepo.GetByGroup(ctx, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get verification policy: %w", err)
+	}
+
+	return policy, nil
+}
@@ -0,0 +1,52 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GetVotingPolicyQuery represents a query for the voting policy of a group
+type GetVotingPolicyQuery struct {
+	GroupID uuid.UUID `json:"group_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// GetVotingPolicyHandler handles voting policy queries
+type GetVotingPolicyHandler struct {
+	policyRepo domain.VotingPolicyRepository
+	authRepo   domain.AuthorizationRepository
+}
+
+// NewGetVotingPolicyHandler creates a new get voting policy handler
+func NewGetVotingPolicyHandler(
+	policyRepo domain.VotingPolicyRepository,
+	authRepo domain.AuthorizationRepository,
+) *GetVotingPolicyHandler {
+	return &GetVotingPolicyHandler{
+		policyRepo: policyRepo,
+		authRepo:   authRepo,
+	}
+}
+
+// Handle processes the get voting policy query
+func (h *GetVotingPolicyHandler) Handle(ctx context.Context, query *GetVotingPolicyQuery) (*domain.VotingPolicy, error) {
+	canAccess, err := h.authRepo.CanUserAccessGroup(ctx, query.UserID, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check group access: %w", err)
+	}
+	if !canAccess {
+		return nil, &commands.AuthorizationError{Message: "user does not have access to specified group"}
+	}
+
+	policy, err := h.policyRepo.GetByGroup(ctx, query.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get voting policy: %w", err)
+	}
+
+	return policy, nil
+}
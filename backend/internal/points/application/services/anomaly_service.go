@@ -0,0 +1,291 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/events"
+)
+
+// AnomalyService inspects new behavior logs for score farming. Flagged logs enter the
+// moderation queue of each group they are shared with, and groups that enabled it hold
+// them from their rankings until the admin resolves the flag.
+type AnomalyService struct {
+	behaviorLogRepo  domain.BehaviorLogRepository
+	dailyScoreRepo   domain.DailyScoreRepository
+	flagRepo         domain.AnomalyFlagRepository
+	policyRepo       domain.VerificationPolicyRepository
+	authRepo         domain.AuthorizationRepository
+	userSettingsRepo domain.UserSettingsRepository
+	eventBus         events.Bus
+	thresholds       domain.AnomalyThresholds
+}
+
+// NewAnomalyService creates a new anomaly service with the default thresholds
+func NewAnomalyService(
+	behaviorLogRepo domain.BehaviorLogRepository,
+	dailyScoreRepo domain.DailyScoreRepository,
+	flagRepo domain.AnomalyFlagRepository,
+	policyRepo domain.VerificationPolicyRepository,
+	authRepo domain.AuthorizationRepository,
+	userSettingsRepo domain.UserSettingsRepository,
+	eventBus events.Bus,
+) *AnomalyService {
+	return &AnomalyService{
+		behaviorLogRepo:  behaviorLogRepo,
+		dailyScoreRepo:   dailyScoreRepo,
+		flagRepo:         flagRepo,
+		policyRepo:       policyRepo,
+		authRepo:         authRepo,
+		userSettingsRepo: userSettingsRepo,
+		eventBus:         eventBus,
+		thresholds:       domain.DefaultAnomalyThresholds(),
+	}
+}
+
+// WithThresholds replaces the thresholds of the anomaly rules
+func (s *AnomalyService) WithThresholds(thresholds domain.AnomalyThresholds) *AnomalyService {
+	s.thresholds = thresholds
+	return s
+}
+
+// Subscribe registers the service for behavior log events
+func (s *AnomalyService) Subscribe(bus events.Bus) {
+	bus.Subscribe(domain.BehaviorLogCreatedEventType, events.HandlerFunc(s.handleBehaviorLogCreated))
+	bus.Subscribe(domain.BehaviorLogBatchCreatedEventType, eachCreatedLog(s.handleBehaviorLogCreated))
+}
+
+// Inspect runs the anomaly rules on a behavior log and flags it in the groups where a rule
+// matched. A log is only flagged once per group.
+func (s *AnomalyService) Inspect(ctx context.Context, behaviorLog *domain.BehaviorLog) ([]*domain.AnomalyFlag, error) {
+	reasons, err := s.detectLogAnomalies(ctx, behaviorLog)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := NewGroupCalendar(s.authRepo, s.userSettingsRepo)
+
+	var flags []*domain.AnomalyFlag
+	for _, share := range behaviorLog.GroupShares {
+		day, err := calendar.LogDay(ctx, share.GroupID, behaviorLog)
+		if err != nil {
+			return nil, err
+		}
+
+		groupReasons := append([]domain.AnomalyReason{}, reasons...)
+		spike, err := s.detectScoreSpike(ctx, behaviorLog, share.GroupID, day)
+		if err != nil {
+			return nil, err
+		}
+		if spike != nil {
+			groupReasons = append(groupReasons, *spike)
+		}
+		if len(groupReasons) == 0 {
+			continue
+		}
+
+		existing, err := s.flagRepo.Find(ctx, domain.NewAnomalyFlagFilter().
+			WithGroup(share.GroupID).
+			WithBehaviorLog(behaviorLog.ID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to find anomaly flags: %w", err)
+		}
+		if len(existing) > 0 {
+			continue
+		}
+
+		flag, err := domain.NewAnomalyFlag(behaviorLog, share.GroupID, groupReasons)
+		if err != nil {
+			return nil, err
+		}
+
+		policy, err := s.policyRepo.GetByGroup(ctx, share.GroupID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get verification policy: %w", err)
+		}
+		if policy.AutoHoldFlagged {
+			if err := s.hold(ctx, behaviorLog, flag, day); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := s.flagRepo.Create(ctx, flag); err != nil {
+			return nil, fmt.Errorf("failed to create anomaly flag: %w", err)
+		}
+		flags = append(flags, flag)
+	}
+
+	for _, flag := range flags {
+		s.eventBus.Publish(ctx, domain.NewAnomalyFlaggedEvent(flag))
+		if flag.Held {
+			s.eventBus.Publish(ctx, domain.NewBehaviorLogReviewedEvent(behaviorLog, behaviorLog.GetGroupShare(flag.GroupID), uuid.Nil))
+		}
+	}
+
+	return flags, nil
+}
+
+// Resolve records the decision of the group admin on a flag. A dismissed flag releases the
+// log if it was held, a confirmed flag rejects the log in the group. The flag is saved last,
+// so if the log or its score cannot be updated the flag stays open and can be resolved again.
+func (s *AnomalyService) Resolve(ctx context.Context, flag *domain.AnomalyFlag, adminID uuid.UUID, confirmed bool) error {
+	now := time.Now()
+	if err := flag.Resolve(adminID, confirmed, now); err != nil {
+		return err
+	}
+
+	behaviorLog, err := s.behaviorLogRepo.GetByID(ctx, flag.BehaviorLogID)
+	if err != nil {
+		return fmt.Errorf("failed to get behavior log: %w", err)
+	}
+
+	share := behaviorLog.GetGroupShare(flag.GroupID)
+	if share == nil {
+		return fmt.Errorf("behavior log is no longer shared with group %s", flag.GroupID)
+	}
+	expectedStatus := share.CurrentStatus()
+
+	// A member may have disputed the log meanwhile: the admin's decision settles that too
+	switch status := share.CurrentStatus(); {
+	case status == domain.ShareStatusDisputed:
+		err = behaviorLog.ResolveDisputeInGroup(flag.GroupID, adminID, !confirmed, now)
+	case confirmed && status != domain.ShareStatusRejected:
+		if err = behaviorLog.HoldInGroup(flag.GroupID, flag.Summary()); err == nil {
+			err = behaviorLog.ResolveDisputeInGroup(flag.GroupID, adminID, false, now)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if share.CurrentStatus() != expectedStatus {
+		if err := s.behaviorLogRepo.UpdateGroupShare(ctx, share, expectedStatus); err != nil {
+			return fmt.Errorf("failed to update group share: %w", err)
+		}
+	}
+
+	// The score is rebuilt rather than patched, so resolving again after a failure repairs it
+	day, err := NewGroupCalendar(s.authRepo, s.userSettingsRepo).LogDay(ctx, flag.GroupID, behaviorLog)
+	if err != nil {
+		return err
+	}
+	if _, err := s.dailyScoreRepo.RecalculateFromLogs(ctx, behaviorLog.PetID, flag.GroupID, day); err != nil {
+		return fmt.Errorf("failed to recalculate daily score: %w", err)
+	}
+
+	if err := s.flagRepo.Update(ctx, flag); err != nil {
+		return fmt.Errorf("failed to update anomaly flag: %w", err)
+	}
+
+	s.eventBus.Publish(ctx, domain.NewBehaviorLogReviewedEvent(behaviorLog, share, adminID))
+
+	return nil
+}
+
+// detectLogAnomalies runs the rules that do not depend on the group
+func (s *AnomalyService) detectLogAnomalies(ctx context.Context, behaviorLog *domain.BehaviorLog) ([]domain.AnomalyReason, error) {
+	petLogs, err := findAllLogs(ctx, s.behaviorLogRepo, domain.NewBehaviorLogFilter().
+		WithPet(behaviorLog.PetID).
+		WithDateRange(behaviorLog.LoggedAt.Add(-time.Hour), behaviorLog.LoggedAt))
+	if err != nil {
+		return nil, err
+	}
+
+	// No group accepts logs backdated beyond the largest backfill window, so a burst entered
+	// around this log was logged within that window before it was created
+	maxBackfill := time.Duration(domain.MaxBackfillWindowHours) * time.Hour
+	userLogs, err := findAllLogs(ctx, s.behaviorLogRepo, domain.NewBehaviorLogFilter().
+		WithUser(behaviorLog.UserID).
+		WithDateRange(behaviorLog.CreatedAt.Add(-maxBackfill-time.Hour), behaviorLog.CreatedAt.Add(time.Hour)))
+	if err != nil {
+		return nil, err
+	}
+
+	var reasons []domain.AnomalyReason
+	for _, reason := range []*domain.AnomalyReason{
+		s.thresholds.CheckLogRate(behaviorLog, petLogs),
+		s.thresholds.CheckBackdatedBurst(behaviorLog, userLogs),
+		s.thresholds.CheckSynchronizedLogs(behaviorLog, userLogs),
+	} {
+		if reason != nil {
+			reasons = append(reasons, *reason)
+		}
+	}
+
+	return reasons, nil
+}
+
+// detectScoreSpike compares the day of the log with the pet's history in a group
+func (s *AnomalyService) detectScoreSpike(ctx context.Context, behaviorLog *domain.BehaviorLog, groupID uuid.UUID, day time.Time) (*domain.AnomalyReason, error) {
+	history, err := s.dailyScoreRepo.GetHistoricalData(ctx, behaviorLog.PetID, groupID, s.thresholds.SpikeHistoryDays+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get score history: %w", err)
+	}
+
+	var dayScore *domain.DailyScore
+	for _, score := range history {
+		if score.Date.Year() == day.Year() && score.Date.YearDay() == day.YearDay() {
+			dayScore = score
+			break
+		}
+	}
+
+	return s.thresholds.CheckScoreSpike(behaviorLog, dayScore, history), nil
+}
+
+// hold takes a flagged log out of the group's rankings until the admin resolves the flag
+func (s *AnomalyService) hold(ctx context.Context, behaviorLog *domain.BehaviorLog, flag *domain.AnomalyFlag, day time.Time) error {
+	share := behaviorLog.GetGroupShare(flag.GroupID)
+	if share.CurrentStatus() == domain.ShareStatusDisputed || share.CurrentStatus() == domain.ShareStatusRejected {
+		return nil
+	}
+
+	unheld := *share
+	if err := behaviorLog.HoldInGroup(flag.GroupID, flag.Summary()); err != nil {
+		return err
+	}
+	if err := s.behaviorLogRepo.UpdateGroupShare(ctx, share, unheld.CurrentStatus()); err != nil {
+		if errors.Is(err, domain.ErrShareStatusChanged) {
+			// A member reviewed the log meanwhile: the flag goes to the admin without holding it
+			*share = unheld
+			return nil
+		}
+		return fmt.Errorf("failed to update group share: %w", err)
+	}
+	flag.Held = true
+
+	if unheld.IsCounted() {
+		return s.removeFromDailyScore(ctx, behaviorLog, flag.GroupID, day)
+	}
+	return nil
+}
+
+// removeFromDailyScore takes a held log out of the group's daily score of its day by rebuilding
+// it from the logs still counted, so the last activity used to break ties moves back too
+func (s *AnomalyService) removeFromDailyScore(ctx context.Context, behaviorLog *domain.BehaviorLog, groupID uuid.UUID, day time.Time) error {
+	if _, err := s.dailyScoreRepo.RecalculateFromLogs(ctx, behaviorLog.PetID, groupID, day); err != nil {
+		return fmt.Errorf("failed to recalculate daily score: %w", err)
+	}
+	return nil
+}
+
+// handleBehaviorLogCreated inspects a new behavior log
+func (s *AnomalyService) handleBehaviorLogCreated(ctx context.Context, event events.Event) error {
+	created, ok := event.(*domain.BehaviorLogCreatedEvent)
+	if !ok {
+		return nil
+	}
+
+	behaviorLog, err := s.behaviorLogRepo.GetByID(ctx, created.BehaviorLogID)
+	if err != nil {
+		return fmt.Errorf("failed to get behavior log: %w", err)
+	}
+
+	_, err = s.Inspect(ctx, behaviorLog)
+	return err
+}
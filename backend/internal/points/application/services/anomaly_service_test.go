@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+	"pet-of-the-day/internal/shared/events"
+)
+
+func TestAnomalyService_FlagHoldAndResolve(t *testing.T) {
+	ctx := context.Background()
+
+	behaviorLogRepo := mock.NewMockBehaviorLogRepository()
+	dailyScoreRepo := mock.NewMockDailyScoreRepository().WithRecalculationSources(behaviorLogRepo, mock.NewMockPointAdjustmentRepository())
+	flagRepo := mock.NewMockAnomalyFlagRepository()
+	policyRepo := mock.NewMockVerificationPolicyRepository()
+	authRepo := mock.NewMockAuthorizationRepository()
+	bus := events.NewInMemoryEventBus()
+	service := NewAnomalyService(behaviorLogRepo, dailyScoreRepo, flagRepo, policyRepo, authRepo, mock.NewMockUserSettingsRepository(), bus)
+
+	userID, adminID := uuid.New(), uuid.New()
+	strictGroup, relaxedGroup := uuid.New(), uuid.New()
+	for _, groupID := range []uuid.UUID{strictGroup, relaxedGroup} {
+		authRepo.AddUserGroup(adminID, groupID, &domain.GroupInfo{ID: groupID, Name: "Park friends", OwnerID: adminID})
+	}
+	policy, _ := domain.NewVerificationPolicy(strictGroup, adminID, domain.VerificationModeOff, 0, true)
+	policyRepo.Save(ctx, policy)
+
+	// Logged at noon, before the default reset time, so every log counts for that day
+	date := time.Now().AddDate(0, 0, -2)
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	loggedAt := day.Add(12 * time.Hour)
+
+	var suspicious *domain.BehaviorLog
+	var flags []*domain.AnomalyFlag
+	for i := 0; i < 3; i++ {
+		behaviorLog := &domain.BehaviorLog{ID: uuid.New(), PetID: uuid.New(), BehaviorID: uuid.New(), UserID: userID,
+			PointsAwarded: 5, LoggedAt: loggedAt, CreatedAt: loggedAt.Add(time.Minute)}
+		behaviorLog.AddGroupShare(strictGroup)
+		behaviorLog.AddGroupShare(relaxedGroup)
+		behaviorLogRepo.Create(ctx, behaviorLog)
+		for _, groupID := range []uuid.UUID{strictGroup, relaxedGroup} {
+			score, _ := dailyScoreRepo.GetOrCreate(ctx, behaviorLog.PetID, groupID, day)
+			score.AddBehaviorLog(behaviorLog)
+		}
+
+		var err error
+		suspicious = behaviorLog
+		if flags, err = service.Inspect(ctx, behaviorLog); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if i < 2 && len(flags) != 0 {
+			t.Fatalf("Expected no flag before the third pet, got %d", len(flags))
+		}
+	}
+	groupPoints := func(groupID uuid.UUID) int {
+		score, _ := dailyScoreRepo.GetOrCreate(ctx, suspicious.PetID, groupID, day)
+		return score.TotalPoints
+	}
+
+	if len(flags) != 2 {
+		t.Fatalf("Expected a flag per group, got %d", len(flags))
+	}
+
+	var strictFlag *domain.AnomalyFlag
+	for _, flag := range flags {
+		if flag.Reasons[0].Rule != domain.AnomalyRuleSynchronizedLogs {
+			t.Errorf("Expected synchronized logs, got %s", flag.Reasons[0].Rule)
+		}
+		if flag.GroupID == strictGroup {
+			strictFlag = flag
+		}
+	}
+
+	t.Run("Held only where the group asked for it", func(t *testing.T) {
+		if !strictFlag.Held || groupPoints(strictGroup) != 0 {
+			t.Errorf("Expected the log held from the strict group, got %d points", groupPoints(strictGroup))
+		}
+		if !suspicious.IsCountedInGroup(relaxedGroup) || groupPoints(relaxedGroup) != 5 {
+			t.Errorf("Expected the log to still count in the relaxed group, got %d points", groupPoints(relaxedGroup))
+		}
+	})
+
+	t.Run("Flags are raised once", func(t *testing.T) {
+		again, err := service.Inspect(ctx, suspicious)
+		if err != nil || len(again) != 0 {
+			t.Errorf("Expected no new flag, got %d (err %v)", len(again), err)
+		}
+	})
+
+	t.Run("Dismissing releases a held log", func(t *testing.T) {
+		if err := service.Resolve(ctx, strictFlag, adminID, false); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if strictFlag.Status != domain.AnomalyFlagStatusDismissed || groupPoints(strictGroup) != 5 {
+			t.Errorf("Expected the dismissed log to count again, got %d points", groupPoints(strictGroup))
+		}
+		if err := service.Resolve(ctx, strictFlag, adminID, true); err == nil {
+			t.Error("Expected error when resolving a flag twice")
+		}
+	})
+
+	t.Run("Confirming rejects a counted log", func(t *testing.T) {
+		var relaxedFlag *domain.AnomalyFlag
+		for _, flag := range flags {
+			if flag.GroupID == relaxedGroup {
+				relaxedFlag = flag
+			}
+		}
+
+		if err := service.Resolve(ctx, relaxedFlag, adminID, true); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if suspicious.GetGroupShare(relaxedGroup).Status != domain.ShareStatusRejected || groupPoints(relaxedGroup) != 0 {
+			t.Errorf("Expected the log rejected from the relaxed group, got %d points", groupPoints(relaxedGroup))
+		}
+	})
+}
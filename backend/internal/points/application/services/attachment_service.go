@@ -0,0 +1,194 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime/multipart"
+	"path"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/events"
+	"pet-of-the-day/internal/shared/upload"
+)
+
+// ThumbnailSize is the largest side of the thumbnails generated for attachments, in pixels
+const ThumbnailSize = 320
+
+// AttachmentService stores the photos attached to behavior logs through the upload service,
+// which checks their size and sniffed MIME type, and removes them when the log is deleted
+type AttachmentService struct {
+	attachmentRepo domain.BehaviorLogAttachmentRepository
+	uploadService  *upload.FileUploadService
+}
+
+// NewAttachmentService creates a new attachment service
+func NewAttachmentService(
+	attachmentRepo domain.BehaviorLogAttachmentRepository,
+	uploadService *upload.FileUploadService,
+) *AttachmentService {
+	return &AttachmentService{
+		attachmentRepo: attachmentRepo,
+		uploadService:  uploadService,
+	}
+}
+
+// Subscribe registers the service for behavior log events
+func (s *AttachmentService) Subscribe(bus events.Bus) {
+	bus.Subscribe(domain.BehaviorLogDeletedEventType, events.HandlerFunc(s.handleBehaviorLogDeleted))
+}
+
+// Validate checks uploaded files before anything is stored
+func (s *AttachmentService) Validate(files []*multipart.FileHeader) error {
+	if len(files) > domain.MaxBehaviorLogAttachments {
+		return fmt.Errorf("a behavior log cannot have more than %d attachments", domain.MaxBehaviorLogAttachments)
+	}
+
+	for _, file := range files {
+		if err := s.uploadService.ValidateFile(file); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Attach stores uploaded files as attachments of a behavior log. Either every file is
+// attached or, on failure, nothing stored by the call is kept.
+func (s *AttachmentService) Attach(ctx context.Context, behaviorLog *domain.BehaviorLog, files []*multipart.FileHeader) ([]*domain.BehaviorLogAttachment, error) {
+	if err := s.Validate(files); err != nil {
+		return nil, err
+	}
+
+	attachments := make([]*domain.BehaviorLogAttachment, 0, len(files))
+	for _, file := range files {
+		attachment, err := s.store(behaviorLog, file)
+		if err == nil {
+			if err = s.attachmentRepo.Create(ctx, attachment); err != nil {
+				err = fmt.Errorf("failed to save attachment: %w", err)
+				s.deleteFiles(attachment)
+			}
+		}
+
+		if err != nil {
+			for _, stored := range attachments {
+				if removeErr := s.remove(ctx, stored); removeErr != nil {
+					log.Printf("Failed to clean up attachment %s: %v", stored.ID, removeErr)
+				}
+			}
+			return nil, err
+		}
+
+		attachments = append(attachments, attachment)
+	}
+
+	return attachments, nil
+}
+
+// GetAttachments returns the attachments of a behavior log
+func (s *AttachmentService) GetAttachments(ctx context.Context, behaviorLogID uuid.UUID) ([]*domain.BehaviorLogAttachment, error) {
+	attachments, err := s.attachmentRepo.GetByBehaviorLog(ctx, behaviorLogID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachments: %w", err)
+	}
+	return attachments, nil
+}
+
+// Open returns the content and MIME type of an attachment or of its thumbnail. Attachments
+// without a thumbnail are served in full.
+func (s *AttachmentService) Open(attachment *domain.BehaviorLogAttachment, thumbnail bool) (io.ReadCloser, string, error) {
+	filePath, mimeType := attachment.Path, attachment.MimeType
+	if thumbnail && attachment.HasThumbnail() {
+		filePath, mimeType = attachment.ThumbnailPath, "image/jpeg"
+	}
+
+	file, err := s.uploadService.OpenFile(filePath)
+	if err != nil {
+		return nil, "", err
+	}
+	return file, mimeType, nil
+}
+
+// RemoveAll deletes the attachments of a behavior log and their files
+func (s *AttachmentService) RemoveAll(ctx context.Context, behaviorLogID uuid.UUID) error {
+	attachments, err := s.GetAttachments(ctx, behaviorLogID)
+	if err != nil {
+		return err
+	}
+
+	var removeErrors []error
+	for _, attachment := range attachments {
+		if err := s.remove(ctx, attachment); err != nil {
+			removeErrors = append(removeErrors, err)
+		}
+	}
+
+	return errors.Join(removeErrors...)
+}
+
+// store writes an uploaded file and its thumbnail under the directory of the behavior log
+func (s *AttachmentService) store(behaviorLog *domain.BehaviorLog, file *multipart.FileHeader) (*domain.BehaviorLogAttachment, error) {
+	stored, err := s.uploadService.SaveFile(file, path.Join("behavior-logs", behaviorLog.ID.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	attachment, err := domain.NewBehaviorLogAttachment(behaviorLog.ID, behaviorLog.UserID,
+		stored.OriginalName, stored.MimeType, stored.Size, stored.Path)
+	if err != nil {
+		s.uploadService.DeleteFile(stored.Path)
+		return nil, err
+	}
+
+	thumbnail, err := s.uploadService.CreateThumbnail(stored, ThumbnailSize)
+	switch {
+	case err == nil:
+		attachment.ThumbnailPath = thumbnail.Path
+	case errors.Is(err, upload.ErrThumbnailUnsupported):
+		// The original is served instead
+	case errors.Is(err, upload.ErrImageTooLarge):
+		s.uploadService.DeleteFile(stored.Path)
+		return nil, &domain.ValidationError{Message: fmt.Sprintf("image %s is too large", stored.OriginalName)}
+	default:
+		s.uploadService.DeleteFile(stored.Path)
+		return nil, fmt.Errorf("failed to create thumbnail: %w", err)
+	}
+
+	return attachment, nil
+}
+
+// remove deletes an attachment and its files
+func (s *AttachmentService) remove(ctx context.Context, attachment *domain.BehaviorLogAttachment) error {
+	if err := s.deleteFiles(attachment); err != nil {
+		return err
+	}
+
+	if err := s.attachmentRepo.Delete(ctx, attachment.ID); err != nil {
+		return fmt.Errorf("failed to delete attachment: %w", err)
+	}
+	return nil
+}
+
+// deleteFiles deletes the stored files of an attachment
+func (s *AttachmentService) deleteFiles(attachment *domain.BehaviorLogAttachment) error {
+	if attachment.HasThumbnail() {
+		if err := s.uploadService.DeleteFile(attachment.ThumbnailPath); err != nil {
+			return err
+		}
+	}
+	return s.uploadService.DeleteFile(attachment.Path)
+}
+
+// handleBehaviorLogDeleted removes the attachments of a deleted behavior log
+func (s *AttachmentService) handleBehaviorLogDeleted(ctx context.Context, event events.Event) error {
+	e, ok := event.(*domain.BehaviorLogDeletedEvent)
+	if !ok {
+		return nil
+	}
+
+	return s.RemoveAll(ctx, e.BehaviorLogID)
+}
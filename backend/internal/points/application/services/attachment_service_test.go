@@ -0,0 +1,154 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/png"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+	"pet-of-the-day/internal/shared/events"
+	"pet-of-the-day/internal/shared/upload"
+)
+
+// uploadedFiles builds the file headers of a multipart form holding the given files
+func uploadedFiles(t *testing.T, files map[string][]byte) []*multipart.FileHeader {
+	t.Helper()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for name, content := range files {
+		part, err := writer.CreateFormFile("attachments", name)
+		if err != nil {
+			t.Fatalf("Failed to create form file: %v", err)
+		}
+		part.Write(content)
+	}
+	writer.Close()
+
+	form, err := multipart.NewReader(&body, writer.Boundary()).ReadForm(1 << 20)
+	if err != nil {
+		t.Fatalf("Failed to read form: %v", err)
+	}
+	return form.File["attachments"]
+}
+
+func pngImage(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		img.Set(x, x*height/width, color.RGBA{R: 200, A: 255})
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("Failed to encode image: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestAttachmentService_AttachAndRemove(t *testing.T) {
+	ctx := context.Background()
+
+	config := upload.DefaultImageUploadConfig()
+	config.UploadPath = t.TempDir()
+	attachmentRepo := mock.NewMockBehaviorLogAttachmentRepository()
+	bus := events.NewInMemoryEventBus()
+	service := NewAttachmentService(attachmentRepo, upload.NewFileUploadService(config))
+	service.Subscribe(bus)
+
+	behaviorLog, _ := domain.NewBehaviorLog(uuid.New(), uuid.New(), uuid.New(), 5, time.Now(), "")
+	stored := func(path string) bool {
+		_, err := os.Stat(filepath.Join(config.UploadPath, path))
+		return err == nil
+	}
+
+	t.Run("Stores the photo with a thumbnail", func(t *testing.T) {
+		attachments, err := service.Attach(ctx, behaviorLog, uploadedFiles(t, map[string][]byte{"walk.png": pngImage(t, 1200, 800)}))
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(attachments) != 1 {
+			t.Fatalf("Expected 1 attachment, got %d", len(attachments))
+		}
+
+		attachment := attachments[0]
+		if attachment.MimeType != "image/png" || attachment.OriginalName != "walk.png" {
+			t.Errorf("Expected walk.png as image/png, got %s as %s", attachment.OriginalName, attachment.MimeType)
+		}
+		if filepath.Ext(attachment.Path) != ".png" {
+			t.Errorf("Expected the stored name to take the extension of the content, got %s", attachment.Path)
+		}
+		if !stored(attachment.Path) || !attachment.HasThumbnail() || !stored(attachment.ThumbnailPath) {
+			t.Fatalf("Expected the photo and its thumbnail to be stored")
+		}
+
+		thumbnail, mimeType, err := service.Open(attachment, true)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		defer thumbnail.Close()
+		decoded, _, err := image.DecodeConfig(thumbnail)
+		if err != nil {
+			t.Fatalf("Expected a decodable thumbnail, got %v", err)
+		}
+		if mimeType != "image/jpeg" || decoded.Width != ThumbnailSize || decoded.Height != ThumbnailSize*800/1200 {
+			t.Errorf("Expected a %dpx wide JPEG thumbnail, got %dx%d %s", ThumbnailSize, decoded.Width, decoded.Height, mimeType)
+		}
+	})
+
+	t.Run("Rejects files whose content is not an image", func(t *testing.T) {
+		files := uploadedFiles(t, map[string][]byte{"fake.png": []byte("#!/bin/sh\necho not a photo\n")})
+		if _, err := service.Attach(ctx, behaviorLog, files); err == nil {
+			t.Fatal("Expected an error for a disguised file")
+		}
+
+		attachments, _ := service.GetAttachments(ctx, behaviorLog.ID)
+		if len(attachments) != 1 {
+			t.Errorf("Expected the rejected file not to be attached, got %d attachments", len(attachments))
+		}
+	})
+
+	t.Run("Rejects images too large to decode", func(t *testing.T) {
+		// A tiny PNG whose header claims 20000x20000 pixels
+		content := pngImage(t, 1, 1)
+		binary.BigEndian.PutUint32(content[16:], 20000)
+		binary.BigEndian.PutUint32(content[20:], 20000)
+		binary.BigEndian.PutUint32(content[29:], crc32.ChecksumIEEE(content[12:29]))
+
+		_, err := service.Attach(ctx, behaviorLog, uploadedFiles(t, map[string][]byte{"huge.png": content}))
+		var validationErr *domain.ValidationError
+		if !errors.As(err, &validationErr) {
+			t.Fatalf("Expected a validation error, got %v", err)
+		}
+	})
+
+	t.Run("Deleting the log removes its files", func(t *testing.T) {
+		attachments, _ := service.GetAttachments(ctx, behaviorLog.ID)
+
+		bus.Publish(ctx, domain.NewBehaviorLogDeletedEvent(behaviorLog, behaviorLog.UserID))
+
+		remaining, _ := service.GetAttachments(ctx, behaviorLog.ID)
+		if len(remaining) != 0 {
+			t.Errorf("Expected no attachment left, got %d", len(remaining))
+		}
+		for _, attachment := range attachments {
+			if stored(attachment.Path) || stored(attachment.ThumbnailPath) {
+				t.Errorf("Expected the files of attachment %s to be deleted", attachment.ID)
+			}
+		}
+	})
+}
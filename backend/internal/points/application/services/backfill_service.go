@@ -0,0 +1,167 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/events"
+)
+
+// BackfillService applies the retroactive logging policy of groups. It rejects logs outside
+// the window a group allows and records a late correction whenever a behavior log changes a
+// group day whose Pet of the Day was already selected, selecting it again if the group wants to.
+type BackfillService struct {
+	policyRepo       domain.BackfillPolicyRepository
+	correctionRepo   domain.LateCorrectionRepository
+	resetStateRepo   domain.DailyResetStateRepository
+	authRepo         domain.AuthorizationRepository
+	userSettingsRepo domain.UserSettingsRepository
+	rankingService   *RankingService
+	eventBus         events.Bus
+}
+
+// NewBackfillService creates a new backfill service
+func NewBackfillService(
+	policyRepo domain.BackfillPolicyRepository,
+	correctionRepo domain.LateCorrectionRepository,
+	resetStateRepo domain.DailyResetStateRepository,
+	authRepo domain.AuthorizationRepository,
+	userSettingsRepo domain.UserSettingsRepository,
+	rankingService *RankingService,
+	eventBus events.Bus,
+) *BackfillService {
+	return &BackfillService{
+		policyRepo:       policyRepo,
+		correctionRepo:   correctionRepo,
+		resetStateRepo:   resetStateRepo,
+		authRepo:         authRepo,
+		userSettingsRepo: userSettingsRepo,
+		rankingService:   rankingService,
+		eventBus:         eventBus,
+	}
+}
+
+// Subscribe registers the service for the behavior log events that can change a closed day
+func (s *BackfillService) Subscribe(bus events.Bus) {
+	bus.Subscribe(domain.BehaviorLogCreatedEventType, events.HandlerFunc(s.handleBehaviorLogEvent))
+	bus.Subscribe(domain.BehaviorLogBatchCreatedEventType, eachCreatedLog(s.handleBehaviorLogEvent))
+	bus.Subscribe(domain.BehaviorLogDeletedEventType, events.HandlerFunc(s.handleBehaviorLogEvent))
+	bus.Subscribe(domain.BehaviorLogReviewedEventType, events.HandlerFunc(s.handleBehaviorLogEvent))
+}
+
+// CheckLoggedAt checks that a behavior logged at the given time can be shared with every
+// group. Logs that are not shared with any group follow the default window.
+func (s *BackfillService) CheckLoggedAt(ctx context.Context, groupIDs []uuid.UUID, loggedAt, now time.Time) error {
+	if len(groupIDs) == 0 {
+		return domain.DefaultBackfillPolicy(uuid.Nil).CheckLoggedAt(loggedAt, now)
+	}
+
+	for _, groupID := range groupIDs {
+		policy, err := s.policyRepo.GetByGroup(ctx, groupID)
+		if err != nil {
+			return fmt.Errorf("failed to get backfill policy: %w", err)
+		}
+
+		if err := policy.CheckLoggedAt(loggedAt, now); err != nil {
+			return fmt.Errorf("group %s: %w", groupID, err)
+		}
+
+		if policy.AllowClosedDays {
+			continue
+		}
+
+		day, closed, err := s.closedDay(ctx, groupID, loggedAt)
+		if err != nil {
+			return err
+		}
+		if closed {
+			return fmt.Errorf("group %s: Pet of the Day of %s is already selected", groupID, day.Format("2006-01-02"))
+		}
+	}
+
+	return nil
+}
+
+// closedDay returns the group day a moment belongs to and whether its Pet of the Day was selected
+func (s *BackfillService) closedDay(ctx context.Context, groupID uuid.UUID, t time.Time) (time.Time, bool, error) {
+	day, err := NewGroupCalendar(s.authRepo, s.userSettingsRepo).Day(ctx, groupID, t)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	state, err := s.resetStateRepo.GetByGroup(ctx, groupID)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("failed to get reset state: %w", err)
+	}
+
+	return day, state != nil && state.HasResetFor(day), nil
+}
+
+// correct records a late correction if a behavior log changed a closed day of a group
+func (s *BackfillService) correct(ctx context.Context, groupID uuid.UUID, cause domain.LateCorrectionCause, behaviorLogID, petID uuid.UUID, loggedAt time.Time) error {
+	day, closed, err := s.closedDay(ctx, groupID, loggedAt)
+	if err != nil || !closed {
+		return err
+	}
+
+	policy, err := s.policyRepo.GetByGroup(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("failed to get backfill policy: %w", err)
+	}
+
+	winners, err := s.rankingService.GetPetOfTheDayWinners(ctx, groupID, day)
+	if err != nil {
+		return err
+	}
+
+	correction := domain.NewLateCorrection(groupID, day, cause, behaviorLogID, petID, winners)
+	if policy.RerunPetOfTheDay {
+		winners, err := s.rankingService.ReselectPetOfTheDay(ctx, groupID, day)
+		if err != nil {
+			return err
+		}
+		correction.Reselect(winners)
+	}
+
+	if err := s.correctionRepo.Create(ctx, correction); err != nil {
+		return fmt.Errorf("failed to record late correction: %w", err)
+	}
+
+	if correction.WinnersChanged() {
+		log.Printf("Late correction of %s in group %s changed Pet of the Day", day.Format("2006-01-02"), groupID)
+	}
+
+	s.eventBus.Publish(ctx, domain.NewLateCorrectionEvent(correction))
+	return nil
+}
+
+// handleBehaviorLogEvent checks the groups of a created, deleted or reviewed log for closed days
+func (s *BackfillService) handleBehaviorLogEvent(ctx context.Context, event events.Event) error {
+	var cause domain.LateCorrectionCause
+	var behaviorLogID, petID uuid.UUID
+	var loggedAt time.Time
+	var groupIDs []uuid.UUID
+	switch e := event.(type) {
+	case *domain.BehaviorLogCreatedEvent:
+		cause, behaviorLogID, petID, loggedAt, groupIDs = domain.LateCorrectionCauseLogCreated, e.BehaviorLogID, e.PetID, e.LoggedAt, e.GroupIDs
+	case *domain.BehaviorLogDeletedEvent:
+		cause, behaviorLogID, petID, loggedAt, groupIDs = domain.LateCorrectionCauseLogDeleted, e.BehaviorLogID, e.PetID, e.LoggedAt, e.GroupIDs
+	case *domain.BehaviorLogReviewedEvent:
+		cause, behaviorLogID, petID, loggedAt, groupIDs = domain.LateCorrectionCauseLogReviewed, e.BehaviorLogID, e.PetID, e.LoggedAt, []uuid.UUID{e.GroupID}
+	default:
+		return nil
+	}
+
+	for _, groupID := range groupIDs {
+		if err := s.correct(ctx, groupID, cause, behaviorLogID, petID, loggedAt); err != nil {
+			return fmt.Errorf("group %s: %w", groupID, err)
+		}
+	}
+
+	return nil
+}
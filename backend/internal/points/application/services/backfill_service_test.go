@@ -0,0 +1,267 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+	"pet-of-the-day/internal/shared/events"
+)
+
+func TestBackfillService_CheckLoggedAt(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup: the daily reset of March 10 has run
+	authRepo := mock.NewMockAuthorizationRepository()
+	resetStateRepo := mock.NewMockDailyResetStateRepository()
+	policyRepo := mock.NewMockBackfillPolicyRepository()
+	service := NewBackfillService(
+		policyRepo,
+		mock.NewMockLateCorrectionRepository(),
+		resetStateRepo,
+		authRepo,
+		mock.NewMockUserSettingsRepository(),
+		nil,
+		events.NewInMemoryBus(),
+	)
+
+	// Test data
+	ownerID := uuid.New()
+	groupID := uuid.New()
+	authRepo.AddUserGroup(ownerID, groupID, &domain.GroupInfo{ID: groupID, Name: "Park friends", OwnerID: ownerID})
+
+	state, err := domain.NewGroupResetState(groupID, time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC), time.Now())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	resetStateRepo.Save(ctx, state)
+
+	now := time.Date(2025, time.March, 11, 10, 0, 0, 0, time.UTC)
+	closedDay := time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC)
+	openDay := time.Date(2025, time.March, 11, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		policy   *domain.BackfillPolicy
+		groupIDs []uuid.UUID
+		loggedAt time.Time
+		wantErr  bool
+	}{
+		{
+			name:     "Default window accepts a log 22 hours old",
+			policy:   domain.DefaultBackfillPolicy(groupID),
+			groupIDs: []uuid.UUID{groupID},
+			loggedAt: closedDay,
+		},
+		{
+			name:     "Default window rejects a log 30 hours old",
+			policy:   domain.DefaultBackfillPolicy(groupID),
+			groupIDs: []uuid.UUID{groupID},
+			loggedAt: now.Add(-30 * time.Hour),
+			wantErr:  true,
+		},
+		{
+			name:     "Rejects logs older than the window of the group",
+			policy:   &domain.BackfillPolicy{GroupID: groupID, MaxBackfillHours: 12, AllowClosedDays: true},
+			groupIDs: []uuid.UUID{groupID},
+			loggedAt: closedDay,
+			wantErr:  true,
+		},
+		{
+			name:     "Unshared logs follow the default window",
+			policy:   &domain.BackfillPolicy{GroupID: groupID, MaxBackfillHours: 48, AllowClosedDays: true},
+			loggedAt: now.Add(-30 * time.Hour),
+			wantErr:  true,
+		},
+		{
+			name:     "Rejects closed days when the group does not allow them",
+			policy:   &domain.BackfillPolicy{GroupID: groupID, MaxBackfillHours: 48},
+			groupIDs: []uuid.UUID{groupID},
+			loggedAt: closedDay,
+			wantErr:  true,
+		},
+		{
+			name:     "Accepts the current day when closed days are not allowed",
+			policy:   &domain.BackfillPolicy{GroupID: groupID, MaxBackfillHours: 48},
+			groupIDs: []uuid.UUID{groupID},
+			loggedAt: openDay,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policyRepo.Save(ctx, tt.policy)
+
+			err := service.CheckLoggedAt(ctx, tt.groupIDs, tt.loggedAt, now)
+			if tt.wantErr && err == nil {
+				t.Error("Expected error, got nil")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestBackfillService_LateCorrections(t *testing.T) {
+	ctx := context.Background()
+	day := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		policy         *domain.BackfillPolicy
+		loggedAt       time.Time
+		wantCorrection bool
+		wantReselected bool
+		wantWinner     string
+	}{
+		{
+			name:           "Selects Pet of the Day again when the group asks for it",
+			policy:         &domain.BackfillPolicy{MaxBackfillHours: 48, AllowClosedDays: true, RerunPetOfTheDay: true},
+			loggedAt:       time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC),
+			wantCorrection: true,
+			wantReselected: true,
+			wantWinner:     "Luna",
+		},
+		{
+			name:           "Selects Pet of the Day again under the default policy",
+			policy:         domain.DefaultBackfillPolicy(uuid.Nil),
+			loggedAt:       time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC),
+			wantCorrection: true,
+			wantReselected: true,
+			wantWinner:     "Luna",
+		},
+		{
+			name:           "Keeps the winners when the group does not ask for it",
+			policy:         &domain.BackfillPolicy{MaxBackfillHours: 48, AllowClosedDays: true},
+			loggedAt:       time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC),
+			wantCorrection: true,
+			wantWinner:     "Rex",
+		},
+		{
+			name:       "Ignores days that are still open",
+			policy:     &domain.BackfillPolicy{MaxBackfillHours: 48, AllowClosedDays: true, RerunPetOfTheDay: true},
+			loggedAt:   time.Date(2025, time.March, 11, 8, 0, 0, 0, time.UTC),
+			wantWinner: "Rex",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup: Rex won March 10 ahead of Luna
+			dailyScoreRepo := mock.NewMockDailyScoreRepository()
+			petOfTheDayRepo := mock.NewMockPetOfTheDayRepository()
+			authRepo := mock.NewMockAuthorizationRepository()
+			resetStateRepo := mock.NewMockDailyResetStateRepository()
+			policyRepo := mock.NewMockBackfillPolicyRepository()
+			correctionRepo := mock.NewMockLateCorrectionRepository()
+			rankingService := NewRankingService(
+				dailyScoreRepo,
+				mock.NewMockRankingReadModelRepository(dailyScoreRepo, authRepo),
+				mock.NewMockBehaviorLogRepository(),
+				petOfTheDayRepo,
+				authRepo,
+				mock.NewMockUserSettingsRepository(),
+				resetStateRepo,
+				mock.NewMockScoringRulesRepository(),
+				events.NewInMemoryBus(),
+			)
+
+			// The synchronous bus delivers notifications before the service returns
+			var notifications []*domain.LateCorrectionEvent
+			bus := events.NewInMemoryEventBus()
+			bus.Subscribe(domain.LateCorrectionEventType, events.HandlerFunc(func(ctx context.Context, event events.Event) error {
+				notifications = append(notifications, event.(*domain.LateCorrectionEvent))
+				return nil
+			}))
+
+			service := NewBackfillService(
+				policyRepo,
+				correctionRepo,
+				resetStateRepo,
+				authRepo,
+				mock.NewMockUserSettingsRepository(),
+				rankingService,
+				bus,
+			)
+
+			// Test data
+			ownerID, groupID := uuid.New(), uuid.New()
+			names := map[uuid.UUID]string{uuid.New(): "Rex", uuid.New(): "Luna"}
+			petIDs := make(map[string]uuid.UUID)
+			authRepo.AddUser(ownerID, &domain.UserInfo{ID: ownerID, Name: "Alice"})
+			authRepo.AddUserGroup(ownerID, groupID, &domain.GroupInfo{ID: groupID, Name: "Park friends", OwnerID: ownerID})
+			for petID, name := range names {
+				petIDs[name] = petID
+				authRepo.AddUserPet(ownerID, petID, &domain.PetInfo{ID: petID, Name: name, Species: domain.SpeciesDog, OwnerID: ownerID})
+				authRepo.AddPetToGroup(petID, groupID)
+			}
+
+			addPoints := func(petID uuid.UUID, points int) {
+				dailyScore, err := dailyScoreRepo.GetOrCreate(ctx, petID, groupID, day)
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				dailyScore.AddBehaviorLog(&domain.BehaviorLog{PetID: petID, PointsAwarded: points, LoggedAt: day})
+			}
+
+			addPoints(petIDs["Rex"], 5)
+			addPoints(petIDs["Luna"], 3)
+			if err := rankingService.runDailyReset(ctx, time.Date(2025, time.March, 10, 21, 30, 0, 0, time.UTC)); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			policy := *tt.policy
+			policy.GroupID = groupID
+			policyRepo.Save(ctx, &policy)
+
+			// Luna gets a backdated log that puts her ahead of Rex on March 10
+			addPoints(petIDs["Luna"], 5)
+			err := service.handleBehaviorLogEvent(ctx, &domain.BehaviorLogCreatedEvent{
+				BehaviorLogID: uuid.New(),
+				PetID:         petIDs["Luna"],
+				LoggedAt:      tt.loggedAt,
+				GroupIDs:      []uuid.UUID{groupID},
+			})
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			corrections, err := correctionRepo.GetByGroup(ctx, groupID, 10, 0)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !tt.wantCorrection {
+				if len(corrections) != 0 || len(notifications) != 0 {
+					t.Errorf("Expected no late correction, got %d and %d notifications", len(corrections), len(notifications))
+				}
+				return
+			}
+
+			if len(corrections) != 1 {
+				t.Fatalf("Expected 1 late correction, got %d", len(corrections))
+			}
+			if corrections[0].Reselected != tt.wantReselected || corrections[0].WinnersChanged() != tt.wantReselected {
+				t.Errorf("Expected reselected %v, got %+v", tt.wantReselected, corrections[0])
+			}
+			if len(notifications) != 1 {
+				t.Fatalf("Expected the group to be notified, got %d notifications", len(notifications))
+			}
+			if tt.wantReselected {
+				dethroned := notifications[0].DethronedPetIDs
+				if len(dethroned) != 1 || dethroned[0] != petIDs["Rex"] {
+					t.Errorf("Expected a notification dethroning Rex, got %+v", notifications[0])
+				}
+			}
+
+			winners, _ := petOfTheDayRepo.GetByGroupAndDate(ctx, groupID, day)
+			if len(winners) != 1 || names[winners[0].PetID] != tt.wantWinner {
+				t.Errorf("Expected %s to win, got %+v", tt.wantWinner, winners)
+			}
+		})
+	}
+}
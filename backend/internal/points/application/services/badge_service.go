@@ -0,0 +1,252 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	communityDomain "pet-of-the-day/internal/community/domain"
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/events"
+)
+
+// BadgeService evaluates the badge rules of pets and users and awards the unlocked badges.
+// Evaluation recomputes every metric from the full history, so it can be triggered by any
+// event and replayed safely: badges already held are never awarded twice.
+type BadgeService struct {
+	badgeRepo       domain.BadgeRepository
+	behaviorLogRepo domain.BehaviorLogRepository
+	petOfTheDayRepo domain.PetOfTheDayRepository
+	authRepo        domain.AuthorizationRepository
+	streakService   *StreakService
+	eventBus        events.Bus
+}
+
+// NewBadgeService creates a new badge service
+func NewBadgeService(
+	badgeRepo domain.BadgeRepository,
+	behaviorLogRepo domain.BehaviorLogRepository,
+	petOfTheDayRepo domain.PetOfTheDayRepository,
+	authRepo domain.AuthorizationRepository,
+	streakService *StreakService,
+	eventBus events.Bus,
+) *BadgeService {
+	return &BadgeService{
+		badgeRepo:       badgeRepo,
+		behaviorLogRepo: behaviorLogRepo,
+		petOfTheDayRepo: petOfTheDayRepo,
+		authRepo:        authRepo,
+		streakService:   streakService,
+		eventBus:        eventBus,
+	}
+}
+
+// Subscribe registers the service for the points and community events that can unlock badges
+func (s *BadgeService) Subscribe(bus events.Bus) {
+	bus.Subscribe(domain.BehaviorLogCreatedEventType, events.HandlerFunc(s.handleBehaviorLogCreated))
+	bus.Subscribe(domain.BehaviorLogBatchCreatedEventType, events.HandlerFunc(s.handleBehaviorLogCreated))
+	bus.Subscribe(domain.PetStreaksUpdatedEventType, events.HandlerFunc(s.handlePetStreaksUpdated))
+	bus.Subscribe(domain.PetOfTheDaySelectedEventType, events.HandlerFunc(s.handlePetOfTheDaySelected))
+	bus.Subscribe(communityDomain.GroupCreatedEventType, events.HandlerFunc(s.handleGroupCreated))
+	bus.Subscribe(communityDomain.MembershipAcceptedEventType, events.HandlerFunc(s.handleMembershipAccepted))
+}
+
+// EvaluatePet awards the pet badges unlocked by a pet and returns the newly awarded ones
+func (s *BadgeService) EvaluatePet(ctx context.Context, petID uuid.UUID) ([]*domain.Badge, error) {
+	petInfo, err := s.authRepo.GetPetInfo(ctx, petID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pet info: %w", err)
+	}
+
+	progress, err := s.petProgress(ctx, petID)
+	if err != nil {
+		return nil, err
+	}
+
+	awarded := make([]*domain.Badge, 0)
+	for _, rule := range domain.UnlockedBadgeRules(domain.BadgeScopePet, progress) {
+		badge := domain.NewPetBadge(petID, rule)
+		created, err := s.award(ctx, badge, rule, petInfo.OwnerID)
+		if err != nil {
+			return awarded, err
+		}
+		if created {
+			awarded = append(awarded, badge)
+		}
+	}
+
+	return awarded, nil
+}
+
+// EvaluateUser awards the user badges unlocked by a user and returns the newly awarded ones
+func (s *BadgeService) EvaluateUser(ctx context.Context, userID uuid.UUID) ([]*domain.Badge, error) {
+	progress, err := s.userProgress(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	awarded := make([]*domain.Badge, 0)
+	for _, rule := range domain.UnlockedBadgeRules(domain.BadgeScopeUser, progress) {
+		badge := domain.NewUserBadge(userID, rule)
+		created, err := s.award(ctx, badge, rule, userID)
+		if err != nil {
+			return awarded, err
+		}
+		if created {
+			awarded = append(awarded, badge)
+		}
+	}
+
+	return awarded, nil
+}
+
+// award saves a badge and announces it to the user it belongs to.
+// Returns false when the holder already had the badge.
+func (s *BadgeService) award(ctx context.Context, badge *domain.Badge, rule domain.BadgeRule, userID uuid.UUID) (bool, error) {
+	created, err := s.badgeRepo.Award(ctx, badge)
+	if err != nil {
+		return false, fmt.Errorf("failed to award badge %s: %w", rule.Code, err)
+	}
+
+	if created {
+		s.eventBus.Publish(ctx, domain.NewBadgeAwardedEvent(badge, rule, userID))
+	}
+	return created, nil
+}
+
+// petProgress computes the pet metrics badge rules are evaluated against
+func (s *BadgeService) petProgress(ctx context.Context, petID uuid.UUID) (domain.BadgeProgress, error) {
+	progress := make(domain.BadgeProgress)
+
+	wins, err := s.petOfTheDayRepo.GetPetWins(ctx, petID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Pet of the Day wins: %w", err)
+	}
+	winningGroups := make(map[uuid.UUID]bool)
+	for _, win := range wins {
+		winningGroups[win.GroupID] = true
+	}
+	progress[domain.BadgeMetricPetOfTheDayWins] = len(wins)
+	progress[domain.BadgeMetricWinningGroups] = len(winningGroups)
+
+	logs, err := findAllLogs(ctx, s.behaviorLogRepo, domain.NewBehaviorLogFilter().WithPet(petID))
+	if err != nil {
+		return nil, err
+	}
+	for _, behaviorLog := range logs {
+		if behaviorLog.IsPositive() {
+			progress[domain.BadgeMetricPositiveBehaviors]++
+		}
+	}
+
+	_, streaks, err := s.streakService.GetPetStreaks(ctx, petID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pet streaks: %w", err)
+	}
+	for _, streak := range streaks {
+		switch {
+		case streak.Type == domain.StreakTypePositiveScore:
+			progress[domain.BadgeMetricBestPositiveStreak] = streak.Best
+		case streak.Type == domain.StreakTypeNoNegative && streak.Category == domain.BehaviorCategoryPottyTraining:
+			progress[domain.BadgeMetricBestPottyStreak] = streak.Best
+		}
+	}
+
+	return progress, nil
+}
+
+// userProgress computes the user metrics badge rules are evaluated against
+func (s *BadgeService) userProgress(ctx context.Context, userID uuid.UUID) (domain.BadgeProgress, error) {
+	progress := make(domain.BadgeProgress)
+
+	behaviorsLogged, err := s.behaviorLogRepo.Count(ctx, domain.NewBehaviorLogFilter().WithUser(userID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to count behavior logs: %w", err)
+	}
+	progress[domain.BadgeMetricBehaviorsLogged] = behaviorsLogged
+
+	groupIDs, err := s.authRepo.GetUserGroups(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user groups: %w", err)
+	}
+	progress[domain.BadgeMetricGroupsJoined] = len(groupIDs)
+
+	for _, groupID := range groupIDs {
+		groupInfo, err := s.authRepo.GetGroupInfo(ctx, groupID)
+		if err != nil {
+			continue
+		}
+		if groupInfo.OwnerID == userID {
+			progress[domain.BadgeMetricGroupsCreated]++
+		}
+	}
+
+	return progress, nil
+}
+
+// handleBehaviorLogCreated evaluates the user who logged a behavior or a batch of behaviors.
+// The pet is evaluated once its streaks have been updated for the new log.
+func (s *BadgeService) handleBehaviorLogCreated(ctx context.Context, event events.Event) error {
+	var userID uuid.UUID
+	switch e := event.(type) {
+	case *domain.BehaviorLogCreatedEvent:
+		userID = e.UserID
+	case *domain.BehaviorLogBatchCreatedEvent:
+		userID = e.UserID
+	default:
+		return nil
+	}
+
+	_, err := s.EvaluateUser(ctx, userID)
+	return err
+}
+
+// handlePetStreaksUpdated evaluates a pet after its streaks changed
+func (s *BadgeService) handlePetStreaksUpdated(ctx context.Context, event events.Event) error {
+	e, ok := event.(*domain.PetStreaksUpdatedEvent)
+	if !ok {
+		return nil
+	}
+
+	_, err := s.EvaluatePet(ctx, e.PetID)
+	return err
+}
+
+// handlePetOfTheDaySelected evaluates the winners of a group's day
+func (s *BadgeService) handlePetOfTheDaySelected(ctx context.Context, event events.Event) error {
+	e, ok := event.(*domain.PetOfTheDaySelectedEvent)
+	if !ok {
+		return nil
+	}
+
+	for _, petID := range e.WinnerPetIDs {
+		if _, err := s.EvaluatePet(ctx, petID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// handleGroupCreated evaluates the creator of a new group
+func (s *BadgeService) handleGroupCreated(ctx context.Context, event events.Event) error {
+	e, ok := event.(*communityDomain.GroupCreatedEvent)
+	if !ok {
+		return nil
+	}
+
+	_, err := s.EvaluateUser(ctx, e.CreatorID)
+	return err
+}
+
+// handleMembershipAccepted evaluates a user who joined a group
+func (s *BadgeService) handleMembershipAccepted(ctx context.Context, event events.Event) error {
+	e, ok := event.(*communityDomain.MembershipAcceptedEvent)
+	if !ok {
+		return nil
+	}
+
+	_, err := s.EvaluateUser(ctx, e.UserID)
+	return err
+}
@@ -0,0 +1,197 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	communityDomain "pet-of-the-day/internal/community/domain"
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+	"pet-of-the-day/internal/shared/events"
+)
+
+// badgeRecorder collects the badge unlocks published on the bus
+type badgeRecorder struct {
+	mu     sync.Mutex
+	awards []*domain.BadgeAwardedEvent
+}
+
+func (r *badgeRecorder) Handle(ctx context.Context, event events.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if awarded, ok := event.(*domain.BadgeAwardedEvent); ok {
+		r.awards = append(r.awards, awarded)
+	}
+	return nil
+}
+
+func (r *badgeRecorder) codes() map[string]int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	codes := make(map[string]int)
+	for _, awarded := range r.awards {
+		codes[awarded.Rule.Code]++
+	}
+	return codes
+}
+
+func TestBadgeService_AwardsFromEvents(t *testing.T) {
+	ctx := context.Background()
+
+	behaviorLogRepo := mock.NewMockBehaviorLogRepository()
+	petOfTheDayRepo := mock.NewMockPetOfTheDayRepository()
+	authRepo := mock.NewMockAuthorizationRepository()
+	badgeRepo := mock.NewMockBadgeRepository()
+
+	// Handlers run synchronously so the test can assert right after publishing
+	bus := events.NewInMemoryEventBus()
+	recorder := &badgeRecorder{}
+	bus.Subscribe(domain.BadgeAwardedEventType, recorder)
+
+	streakService := NewStreakService(
+		mock.NewMockStreakRepository(),
+		behaviorLogRepo,
+		mock.NewMockBehaviorRepository(),
+		mock.NewMockGroupBehaviorRepository(),
+		petOfTheDayRepo,
+		authRepo,
+		mock.NewMockUserSettingsRepository(),
+		bus,
+	)
+	streakService.Subscribe(bus)
+
+	service := NewBadgeService(badgeRepo, behaviorLogRepo, petOfTheDayRepo, authRepo, streakService, bus)
+	service.Subscribe(bus)
+
+	ownerID, petID := uuid.New(), uuid.New()
+	authRepo.AddUser(ownerID, &domain.UserInfo{ID: ownerID, Name: "Alice"})
+	authRepo.AddUserPet(ownerID, petID, &domain.PetInfo{ID: petID, Name: "Rex", Species: domain.SpeciesDog, OwnerID: ownerID})
+
+	// Logging a first behavior unlocks the owner's first log badge
+	behaviorLog := &domain.BehaviorLog{
+		ID:            uuid.New(),
+		PetID:         petID,
+		BehaviorID:    uuid.New(),
+		UserID:        ownerID,
+		PointsAwarded: 5,
+		LoggedAt:      time.Now(),
+	}
+	behaviorLogRepo.Create(ctx, behaviorLog)
+	bus.Publish(ctx, domain.NewBehaviorLogCreatedEvent(behaviorLog))
+
+	if codes := recorder.codes(); len(codes) != 1 || codes["first_log"] != 1 {
+		t.Fatalf("expected only first_log after the first log, got %v", codes)
+	}
+
+	// Creating a group and joining two others unlocks the community badges
+	groupIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	authRepo.AddUserGroup(ownerID, groupIDs[0], &domain.GroupInfo{ID: groupIDs[0], Name: "Park", OwnerID: ownerID})
+	bus.Publish(ctx, communityDomain.NewGroupCreatedEvent(groupIDs[0], "Park", ownerID))
+	for _, groupID := range groupIDs[1:] {
+		authRepo.AddUserGroup(ownerID, groupID, &domain.GroupInfo{ID: groupID, Name: "Other", OwnerID: uuid.New()})
+		authRepo.AddPetToGroup(petID, groupID)
+	}
+	bus.Publish(ctx, communityDomain.NewMembershipAcceptedEvent(groupIDs[2], ownerID, []uuid.UUID{petID}))
+
+	codes := recorder.codes()
+	if codes["group_founder"] != 1 || codes["groups_joined_3"] != 1 {
+		t.Fatalf("expected group_founder and groups_joined_3, got %v", codes)
+	}
+
+	// Winning in three groups unlocks the first win and multi-group badges
+	day := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+	for i, groupID := range groupIDs {
+		winner := domain.NewPetOfTheDayWinner(groupID, petID, "Rex", "Alice", day.AddDate(0, 0, i), 10, 2, 0)
+		petOfTheDayRepo.Create(ctx, winner)
+		bus.Publish(ctx, domain.NewPetOfTheDaySelectedEvent(groupID, winner.Date, []*domain.PetOfTheDayWinner{winner}))
+	}
+
+	codes = recorder.codes()
+	for _, code := range []string{"first_pet_of_the_day", "winner_in_3_groups"} {
+		if codes[code] != 1 {
+			t.Errorf("expected %s to be awarded once, got %d", code, codes[code])
+		}
+	}
+
+	petBadges, _ := badgeRepo.GetByPet(ctx, petID)
+	if len(petBadges) != 2 {
+		t.Errorf("expected 2 pet badges, got %d", len(petBadges))
+	}
+	for _, awarded := range recorder.awards {
+		if awarded.UserID != ownerID {
+			t.Errorf("badge %s must be pushed to the owner", awarded.Rule.Code)
+		}
+	}
+
+	// Replaying events never awards a badge twice
+	bus.Publish(ctx, domain.NewBehaviorLogCreatedEvent(behaviorLog))
+	bus.Publish(ctx, domain.NewPetStreaksUpdatedEvent(petID))
+	if total := len(recorder.awards); total != 5 {
+		t.Errorf("expected 5 awards after replaying events, got %d", total)
+	}
+}
+
+func TestBadgeService_Backfill(t *testing.T) {
+	ctx := context.Background()
+
+	behaviorLogRepo := mock.NewMockBehaviorLogRepository()
+	petOfTheDayRepo := mock.NewMockPetOfTheDayRepository()
+	authRepo := mock.NewMockAuthorizationRepository()
+	bus := events.NewInMemoryEventBus()
+
+	streakService := NewStreakService(
+		mock.NewMockStreakRepository(),
+		behaviorLogRepo,
+		mock.NewMockBehaviorRepository(),
+		mock.NewMockGroupBehaviorRepository(),
+		petOfTheDayRepo,
+		authRepo,
+		mock.NewMockUserSettingsRepository(),
+		bus,
+	)
+	service := NewBadgeService(mock.NewMockBadgeRepository(), behaviorLogRepo, petOfTheDayRepo, authRepo, streakService, bus)
+
+	ownerID, petID := uuid.New(), uuid.New()
+	authRepo.AddUserPet(ownerID, petID, &domain.PetInfo{ID: petID, Name: "Rex", Species: domain.SpeciesDog, OwnerID: ownerID})
+
+	// History recorded before badges existed: 100 positive logs over 7 days in a row
+	start := time.Now().AddDate(0, 0, -7)
+	for i := 0; i < 100; i++ {
+		behaviorLogRepo.Create(ctx, &domain.BehaviorLog{
+			ID:            uuid.New(),
+			PetID:         petID,
+			BehaviorID:    uuid.New(),
+			UserID:        ownerID,
+			PointsAwarded: 2,
+			LoggedAt:      start.AddDate(0, 0, i%7),
+		})
+	}
+
+	awarded, err := service.EvaluatePet(ctx, petID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	codes := make(map[string]bool)
+	for _, badge := range awarded {
+		codes[badge.Code] = true
+	}
+	if len(awarded) != 2 || !codes["positive_behaviors_100"] || !codes["positive_streak_7"] {
+		t.Errorf("expected positive_behaviors_100 and positive_streak_7, got %v", codes)
+	}
+
+	// A second run finds nothing new
+	awarded, err = service.EvaluatePet(ctx, petID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(awarded) != 0 {
+		t.Errorf("expected no new badges on the second run, got %d", len(awarded))
+	}
+}
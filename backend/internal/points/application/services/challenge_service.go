@@ -0,0 +1,327 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/events"
+)
+
+// ChallengeService tracks the progress of pets in group challenges and closes challenges at
+// their deadline. Progress is recomputed from the behavior logs of the challenge window, so
+// backdated and deleted logs are handled like new ones.
+type ChallengeService struct {
+	challengeRepo     domain.ChallengeRepository
+	behaviorLogRepo   domain.BehaviorLogRepository
+	behaviorRepo      domain.BehaviorRepository
+	groupBehaviorRepo domain.GroupBehaviorRepository
+	ledgerService     *PointLedgerService
+}
+
+// NewChallengeService creates a new challenge service
+func NewChallengeService(
+	challengeRepo domain.ChallengeRepository,
+	behaviorLogRepo domain.BehaviorLogRepository,
+	behaviorRepo domain.BehaviorRepository,
+	groupBehaviorRepo domain.GroupBehaviorRepository,
+	ledgerService *PointLedgerService,
+) *ChallengeService {
+	return &ChallengeService{
+		challengeRepo:     challengeRepo,
+		behaviorLogRepo:   behaviorLogRepo,
+		behaviorRepo:      behaviorRepo,
+		groupBehaviorRepo: groupBehaviorRepo,
+		ledgerService:     ledgerService,
+	}
+}
+
+// Subscribe registers the service for behavior log events
+func (s *ChallengeService) Subscribe(bus events.Bus) {
+	bus.Subscribe(domain.BehaviorLogCreatedEventType, events.HandlerFunc(s.handleBehaviorLogEvent))
+	bus.Subscribe(domain.BehaviorLogBatchCreatedEventType, eachCreatedLog(s.handleBehaviorLogEvent))
+	bus.Subscribe(domain.BehaviorLogDeletedEventType, events.HandlerFunc(s.handleBehaviorLogEvent))
+	bus.Subscribe(domain.BehaviorLogReviewedEventType, events.HandlerFunc(s.handleBehaviorLogEvent))
+}
+
+// ScheduleChallengeClosing closes every active challenge whose deadline has passed.
+// It is safe to call repeatedly: closed challenges are skipped and bonuses are only awarded once.
+func (s *ChallengeService) ScheduleChallengeClosing(ctx context.Context) error {
+	return s.closeDueChallenges(ctx, time.Now())
+}
+
+// GetProgress returns the progress of the pets taking part in a challenge, best first
+func (s *ChallengeService) GetProgress(ctx context.Context, challenge *domain.Challenge) ([]*domain.ChallengeProgress, error) {
+	progress, err := s.challengeRepo.GetProgress(ctx, challenge.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get challenge progress: %w", err)
+	}
+
+	metric := challenge.Target.Metric
+	sort.SliceStable(progress, func(i, j int) bool {
+		if challenge.Target.Goal == domain.ChallengeGoalAtMost {
+			return progress[i].Measure(metric) < progress[j].Measure(metric)
+		}
+		return progress[i].Measure(metric) > progress[j].Measure(metric)
+	})
+
+	return progress, nil
+}
+
+// RecomputeChallenge rebuilds the progress of every pet that logged a behavior shared with the
+// challenge's group during its window. It is used when a challenge is created or changed.
+func (s *ChallengeService) RecomputeChallenge(ctx context.Context, challenge *domain.Challenge) ([]*domain.ChallengeProgress, error) {
+	filter := domain.NewBehaviorLogFilter().
+		WithGroup(challenge.GroupID).
+		WithDateRange(challenge.StartsAt, challenge.EndsAt)
+	logs, err := findAllLogs(ctx, s.behaviorLogRepo, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	measured, err := s.measure(ctx, challenge, logs)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.challengeRepo.GetProgress(ctx, challenge.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get challenge progress: %w", err)
+	}
+
+	// Pets whose logs left the window after a change keep an empty progress entry
+	for _, previous := range existing {
+		if _, exists := measured[previous.PetID]; !exists {
+			measured[previous.PetID] = domain.NewChallengeProgress(challenge.ID, previous.PetID)
+		}
+	}
+
+	progress := make([]*domain.ChallengeProgress, 0, len(measured))
+	for _, petProgress := range measured {
+		if err := s.saveProgress(ctx, petProgress, existing); err != nil {
+			return nil, err
+		}
+		progress = append(progress, petProgress)
+	}
+
+	return progress, nil
+}
+
+// recomputePet rebuilds the progress of one pet in a challenge
+func (s *ChallengeService) recomputePet(ctx context.Context, challenge *domain.Challenge, petID uuid.UUID) error {
+	filter := domain.NewBehaviorLogFilter().
+		WithPet(petID).
+		WithGroup(challenge.GroupID).
+		WithDateRange(challenge.StartsAt, challenge.EndsAt)
+	logs, err := findAllLogs(ctx, s.behaviorLogRepo, filter)
+	if err != nil {
+		return err
+	}
+
+	measured, err := s.measure(ctx, challenge, logs)
+	if err != nil {
+		return err
+	}
+
+	progress, exists := measured[petID]
+	if !exists {
+		progress = domain.NewChallengeProgress(challenge.ID, petID)
+	}
+
+	existing, err := s.challengeRepo.GetProgress(ctx, challenge.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get challenge progress: %w", err)
+	}
+
+	return s.saveProgress(ctx, progress, existing)
+}
+
+// saveProgress saves recomputed progress, keeping the bonus already awarded to the pet
+// so that retrying an interrupted close never awards it twice
+func (s *ChallengeService) saveProgress(ctx context.Context, progress *domain.ChallengeProgress, existing []*domain.ChallengeProgress) error {
+	for _, previous := range existing {
+		if previous.PetID == progress.PetID {
+			progress.ID = previous.ID
+			progress.BonusAwarded = previous.BonusAwarded
+			break
+		}
+	}
+
+	if err := s.challengeRepo.SaveProgress(ctx, progress); err != nil {
+		return fmt.Errorf("failed to save challenge progress: %w", err)
+	}
+
+	return nil
+}
+
+// measure computes the progress of each pet from its behavior logs
+func (s *ChallengeService) measure(ctx context.Context, challenge *domain.Challenge, logs []*domain.BehaviorLog) (map[uuid.UUID]*domain.ChallengeProgress, error) {
+	progress := make(map[uuid.UUID]*domain.ChallengeProgress)
+	categories := make(map[uuid.UUID]domain.BehaviorCategory)
+
+	for _, behaviorLog := range logs {
+		if !challenge.Contains(behaviorLog.LoggedAt) || !behaviorLog.IsCountedInGroup(challenge.GroupID) {
+			continue
+		}
+
+		petProgress, exists := progress[behaviorLog.PetID]
+		if !exists {
+			petProgress = domain.NewChallengeProgress(challenge.ID, behaviorLog.PetID)
+			progress[behaviorLog.PetID] = petProgress
+		}
+
+		// Categories are only needed by category challenges
+		var category domain.BehaviorCategory
+		if challenge.Target.Category != nil {
+			var cached bool
+			if category, cached = categories[behaviorLog.BehaviorID]; !cached {
+				var err error
+				category, err = lookupBehaviorCategory(ctx, s.groupBehaviorRepo, s.behaviorRepo, behaviorLog.BehaviorID)
+				if err != nil {
+					return nil, err
+				}
+				categories[behaviorLog.BehaviorID] = category
+			}
+		}
+
+		points := behaviorLog.PointsForGroup(challenge.GroupID)
+		if challenge.Target.Matches(behaviorLog, category, points) {
+			petProgress.Count++
+			petProgress.Points += points
+		}
+	}
+
+	return progress, nil
+}
+
+// closeDueChallenges closes the challenges whose deadline has passed as of the given time
+func (s *ChallengeService) closeDueChallenges(ctx context.Context, now time.Time) error {
+	challenges, err := s.challengeRepo.GetDue(ctx, now)
+	if err != nil {
+		return fmt.Errorf("failed to get due challenges: %w", err)
+	}
+
+	var closeErrors []error
+	for _, challenge := range challenges {
+		if err := s.closeChallenge(ctx, challenge, now); err != nil {
+			closeErrors = append(closeErrors, fmt.Errorf("challenge %s: %w", challenge.ID, err))
+		}
+	}
+
+	if len(closeErrors) > 0 {
+		return fmt.Errorf("closing failed for %d of %d challenges: %w",
+			len(closeErrors), len(challenges), errors.Join(closeErrors...))
+	}
+
+	return nil
+}
+
+// closeChallenge settles the outcome of every pet and records a bonus in the point ledger for
+// those who completed the challenge. The bonus counts for the group day the deadline falls on.
+func (s *ChallengeService) closeChallenge(ctx context.Context, challenge *domain.Challenge, now time.Time) error {
+	progress, err := s.RecomputeChallenge(ctx, challenge)
+	if err != nil {
+		return err
+	}
+
+	bonusDay, err := s.ledgerService.GroupDay(ctx, challenge.GroupID, challenge.EndsAt)
+	if err != nil {
+		return err
+	}
+
+	completed := 0
+	for _, petProgress := range progress {
+		petProgress.Outcome = challenge.Outcome(petProgress.Measure(challenge.Target.Metric))
+		if petProgress.Outcome != domain.ChallengeOutcomeCompleted {
+			if err := s.challengeRepo.SaveProgress(ctx, petProgress); err != nil {
+				return fmt.Errorf("failed to save challenge progress: %w", err)
+			}
+			continue
+		}
+		completed++
+
+		if challenge.BonusPoints > 0 && petProgress.BonusAwarded == 0 {
+			if err := s.awardBonus(ctx, challenge, petProgress, bonusDay); err != nil {
+				return err
+			}
+		}
+
+		if err := s.challengeRepo.SaveProgress(ctx, petProgress); err != nil {
+			return fmt.Errorf("failed to save challenge progress: %w", err)
+		}
+	}
+
+	if err := challenge.Close(now); err != nil {
+		return err
+	}
+	if err := s.challengeRepo.Update(ctx, challenge); err != nil {
+		return fmt.Errorf("failed to close challenge: %w", err)
+	}
+
+	log.Printf("Challenge %q of group %s closed, %d of %d pets completed it",
+		challenge.Name, challenge.GroupID, completed, len(progress))
+	return nil
+}
+
+// awardBonus records the bonus of a pet that completed a challenge, unless the ledger already has it
+func (s *ChallengeService) awardBonus(ctx context.Context, challenge *domain.Challenge, progress *domain.ChallengeProgress, bonusDay time.Time) error {
+	bonus, err := s.ledgerService.GetChallengeBonus(ctx, challenge.ID, progress.PetID)
+	if err != nil {
+		return err
+	}
+
+	if bonus == nil {
+		bonus, err = domain.NewChallengeBonus(challenge, progress.PetID, bonusDay)
+		if err != nil {
+			return err
+		}
+		if err := s.ledgerService.Record(ctx, bonus); err != nil {
+			return fmt.Errorf("failed to award challenge bonus: %w", err)
+		}
+	}
+
+	progress.BonusAwarded = bonus.Points
+	return nil
+}
+
+// handleBehaviorLogEvent updates the progress of the pet in the running challenges of the
+// groups a log is shared with, or of the group where it was reviewed
+func (s *ChallengeService) handleBehaviorLogEvent(ctx context.Context, event events.Event) error {
+	var petID uuid.UUID
+	var loggedAt time.Time
+	var groupIDs []uuid.UUID
+	switch e := event.(type) {
+	case *domain.BehaviorLogCreatedEvent:
+		petID, loggedAt, groupIDs = e.PetID, e.LoggedAt, e.GroupIDs
+	case *domain.BehaviorLogDeletedEvent:
+		petID, loggedAt, groupIDs = e.PetID, e.LoggedAt, e.GroupIDs
+	case *domain.BehaviorLogReviewedEvent:
+		petID, loggedAt, groupIDs = e.PetID, e.LoggedAt, []uuid.UUID{e.GroupID}
+	default:
+		return nil
+	}
+
+	for _, groupID := range groupIDs {
+		challenges, err := s.challengeRepo.GetByGroup(ctx, groupID)
+		if err != nil {
+			return fmt.Errorf("failed to get group challenges: %w", err)
+		}
+
+		for _, challenge := range challenges {
+			if challenge.IsClosed() || !challenge.Contains(loggedAt) {
+				continue
+			}
+			if err := s.recomputePet(ctx, challenge, petID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
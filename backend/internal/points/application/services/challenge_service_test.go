@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+	"pet-of-the-day/internal/shared/events"
+)
+
+func TestChallengeService_ProgressAndClosing(t *testing.T) {
+	ctx := context.Background()
+
+	challengeRepo := mock.NewMockChallengeRepository()
+	behaviorRepo := mock.NewMockBehaviorRepository()
+	behaviorLogRepo := mock.NewMockBehaviorLogRepository()
+	adjustmentRepo := mock.NewMockPointAdjustmentRepository()
+	dailyScoreRepo := mock.NewMockDailyScoreRepository().WithRecalculationSources(behaviorLogRepo, adjustmentRepo)
+	authRepo := mock.NewMockAuthorizationRepository()
+	bus := events.NewInMemoryEventBus()
+
+	ledgerService := NewPointLedgerService(adjustmentRepo, dailyScoreRepo, authRepo, mock.NewMockUserSettingsRepository(), bus)
+	service := NewChallengeService(
+		challengeRepo,
+		behaviorLogRepo,
+		behaviorRepo,
+		mock.NewMockGroupBehaviorRepository(),
+		ledgerService,
+	)
+	service.Subscribe(bus)
+
+	ownerID, groupID := uuid.New(), uuid.New()
+	rexID, lunaID := uuid.New(), uuid.New()
+	authRepo.AddUserGroup(ownerID, groupID, &domain.GroupInfo{ID: groupID, Name: "Park", OwnerID: ownerID})
+	authRepo.AddUserPet(ownerID, rexID, &domain.PetInfo{ID: rexID, Name: "Rex", Species: domain.SpeciesDog, OwnerID: ownerID})
+	authRepo.AddUserPet(ownerID, lunaID, &domain.PetInfo{ID: lunaID, Name: "Luna", Species: domain.SpeciesDog, OwnerID: ownerID})
+
+	sit, _ := domain.NewBehavior("Sit", "Pet sits on command", domain.BehaviorCategoryTraining, 5, 5, domain.SpeciesDog, "sit")
+	cuddle, _ := domain.NewBehavior("Cuddle", "Pet cuddles", domain.BehaviorCategorySocial, 3, 5, domain.SpeciesDog, "cuddle")
+	behaviorRepo.Create(ctx, sit)
+	behaviorRepo.Create(ctx, cuddle)
+
+	start := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 7)
+	training := domain.BehaviorCategoryTraining
+
+	challenge, _ := domain.NewChallenge(groupID, ownerID, "Training week", "", domain.ChallengeTarget{
+		Category: &training,
+		Polarity: domain.ChallengePolarityPositive,
+		Metric:   domain.ChallengeMetricCount,
+		Goal:     domain.ChallengeGoalAtLeast,
+		Value:    2,
+	}, start, end, 10)
+	challengeRepo.Create(ctx, challenge)
+
+	logBehavior := func(petID uuid.UUID, behavior *domain.Behavior, loggedAt time.Time) *domain.BehaviorLog {
+		behaviorLog := &domain.BehaviorLog{
+			ID:            uuid.New(),
+			PetID:         petID,
+			BehaviorID:    behavior.ID,
+			UserID:        ownerID,
+			PointsAwarded: behavior.PointValue,
+			LoggedAt:      loggedAt,
+		}
+		behaviorLog.AddGroupShare(groupID)
+		behaviorLogRepo.Create(ctx, behaviorLog)
+		bus.Publish(ctx, domain.NewBehaviorLogCreatedEvent(behaviorLog))
+		return behaviorLog
+	}
+
+	progressOf := func(petID uuid.UUID) *domain.ChallengeProgress {
+		t.Helper()
+		progress, err := service.GetProgress(ctx, challenge)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		for _, petProgress := range progress {
+			if petProgress.PetID == petID {
+				return petProgress
+			}
+		}
+		t.Fatalf("Progress of pet %s not found", petID)
+		return nil
+	}
+
+	logBehavior(rexID, sit, start.Add(time.Hour))
+	lateLog := logBehavior(rexID, sit, start.AddDate(0, 0, 1))
+	logBehavior(rexID, sit, end.Add(time.Hour))
+	logBehavior(lunaID, sit, start.Add(2*time.Hour))
+	logBehavior(lunaID, cuddle, start.Add(3*time.Hour))
+
+	t.Run("Only matching logs within the window count", func(t *testing.T) {
+		if got := progressOf(rexID); got.Count != 2 || got.Points != 10 {
+			t.Errorf("Expected 2 training logs for Rex, got %+v", got)
+		}
+		if got := progressOf(lunaID); got.Count != 1 || got.Outcome != domain.ChallengeOutcomeInProgress {
+			t.Errorf("Expected 1 training log in progress for Luna, got %+v", got)
+		}
+	})
+
+	t.Run("Deleted logs are removed from the progress", func(t *testing.T) {
+		behaviorLogRepo.Delete(ctx, lateLog.ID)
+		bus.Publish(ctx, domain.NewBehaviorLogDeletedEvent(lateLog, ownerID))
+
+		if got := progressOf(rexID); got.Count != 1 {
+			t.Errorf("Expected 1 training log for Rex, got %+v", got)
+		}
+		logBehavior(rexID, sit, start.AddDate(0, 0, 2))
+	})
+
+	t.Run("Challenges are not closed before the deadline", func(t *testing.T) {
+		if err := service.closeDueChallenges(ctx, end.Add(-time.Minute)); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if challenge.IsClosed() {
+			t.Error("Expected the challenge to still be active")
+		}
+	})
+
+	t.Run("Closing awards the bonus to the pets that completed it", func(t *testing.T) {
+		if err := service.closeDueChallenges(ctx, end); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		closed, _ := challengeRepo.GetByID(ctx, challenge.ID)
+		if !closed.IsClosed() {
+			t.Fatal("Expected the challenge to be closed")
+		}
+		if got := progressOf(rexID); got.Outcome != domain.ChallengeOutcomeCompleted || got.BonusAwarded != 10 {
+			t.Errorf("Expected Rex to complete the challenge with a bonus, got %+v", got)
+		}
+		if got := progressOf(lunaID); got.Outcome != domain.ChallengeOutcomeFailed || got.BonusAwarded != 0 {
+			t.Errorf("Expected Luna to fail the challenge, got %+v", got)
+		}
+
+		scores, _ := dailyScoreRepo.Find(ctx, domain.NewDailyScoreFilter().WithPet(rexID).WithGroup(groupID))
+		if len(scores) != 1 || scores[0].TotalPoints != 10 || scores[0].BehaviorPointTotal != 0 {
+			t.Errorf("Expected a 10 point bonus outside the behavior total, got %+v", scores)
+		}
+
+		bonus, _ := ledgerService.GetChallengeBonus(ctx, challenge.ID, rexID)
+		if bonus == nil || bonus.Points != 10 || bonus.Kind != domain.PointAdjustmentKindChallengeBonus {
+			t.Errorf("Expected the bonus in the point ledger, got %+v", bonus)
+		}
+	})
+
+	t.Run("Closing again does not award the bonus twice", func(t *testing.T) {
+		if err := service.closeDueChallenges(ctx, end.Add(time.Hour)); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		// A close interrupted before saving the progress finds the bonus in the ledger
+		reopened, _ := challengeRepo.GetByID(ctx, challenge.ID)
+		reopened.Status = domain.ChallengeStatusActive
+		rexProgress := progressOf(rexID)
+		rexProgress.BonusAwarded = 0
+		challengeRepo.SaveProgress(ctx, rexProgress)
+		if err := service.closeChallenge(ctx, reopened, end.Add(time.Hour)); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		scores, _ := dailyScoreRepo.Find(ctx, domain.NewDailyScoreFilter().WithPet(rexID).WithGroup(groupID))
+		if len(scores) != 1 || scores[0].TotalPoints != 10 {
+			t.Errorf("Expected the bonus to be awarded once, got %+v", scores)
+		}
+	})
+}
@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/timezone"
+)
+
+// GroupCalendar tells which day of a group a moment belongs to. Groups follow the timezone
+// settings of their owner, so the daily scores, the daily reset and the late corrections of a
+// group agree on the day of a log, whoever logged it. The settings of a group are read once per
+// calendar: a calendar is meant to last one command or one run and is not safe for concurrent use.
+type GroupCalendar struct {
+	authRepo         domain.AuthorizationRepository
+	userSettingsRepo domain.UserSettingsRepository
+	configs          map[uuid.UUID]timezone.UserTimeConfig
+}
+
+// NewGroupCalendar creates a new group calendar
+func NewGroupCalendar(
+	authRepo domain.AuthorizationRepository,
+	userSettingsRepo domain.UserSettingsRepository,
+) *GroupCalendar {
+	return &GroupCalendar{
+		authRepo:         authRepo,
+		userSettingsRepo: userSettingsRepo,
+		configs:          make(map[uuid.UUID]timezone.UserTimeConfig),
+	}
+}
+
+// Config returns the timezone configuration of a group, the settings of its owner
+func (c *GroupCalendar) Config(ctx context.Context, groupID uuid.UUID) (timezone.UserTimeConfig, error) {
+	if config, exists := c.configs[groupID]; exists {
+		return config, nil
+	}
+
+	settings, err := domain.GetGroupTimezoneSettings(ctx, c.authRepo, c.userSettingsRepo, groupID)
+	if err != nil {
+		return timezone.UserTimeConfig{}, err
+	}
+
+	config := timezone.UserTimeConfig{
+		DailyResetTime: settings.DailyResetTime,
+		Timezone:       settings.Timezone,
+	}
+	c.configs[groupID] = config
+	return config, nil
+}
+
+// Day returns the group day a moment belongs to, normalized to midnight in the group's timezone
+func (c *GroupCalendar) Day(ctx context.Context, groupID uuid.UUID, t time.Time) (time.Time, error) {
+	config, err := c.Config(ctx, groupID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	day, err := timezone.GetUserDate(t, config)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to calculate group day: %w", err)
+	}
+
+	return day, nil
+}
+
+// Date returns the group day of a calendar date, normalized to midnight in the group's timezone
+func (c *GroupCalendar) Date(ctx context.Context, groupID uuid.UUID, date time.Time) (time.Time, error) {
+	config, err := c.Config(ctx, groupID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	location, err := timezone.GetUserLocation(config.Timezone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to load group timezone: %w", err)
+	}
+
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, location), nil
+}
+
+// LogDay returns the group day a behavior log counts on, the day of the moment it was logged at
+// rather than the day it was recorded
+func (c *GroupCalendar) LogDay(ctx context.Context, groupID uuid.UUID, behaviorLog *domain.BehaviorLog) (time.Time, error) {
+	return c.Day(ctx, groupID, behaviorLog.LoggedAt)
+}
@@ -0,0 +1,274 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+)
+
+const (
+	// logRetentionPageSize is the number of behavior logs read at once
+	logRetentionPageSize = 500
+	// logDayMarginDays covers the logs around a window that count on the same group days as its
+	// logs, for groups whose timezone and reset time move their day past midnight UTC
+	logDayMarginDays = 2
+)
+
+// LogArchiveWriter exports behavior logs to an archive file
+type LogArchiveWriter interface {
+	// WriteLogs writes logs to the archive file of a name and returns its path
+	WriteLogs(name string, behaviorLogs []*domain.BehaviorLog) (string, error)
+}
+
+// LogRetentionReport summarizes a run of the log retention job
+type LogRetentionReport struct {
+	Cutoff       time.Time `json:"cutoff"`
+	Windows      int       `json:"windows"`       // Days of logs processed, one at a time
+	ScannedLogs  int       `json:"scanned_logs"`  // Logs read to summarize the days of the windows
+	ArchivedDays int       `json:"archived_days"` // Daily scores summarized and frozen by this run
+	ExportedLogs int       `json:"exported_logs"`
+	ArchiveFiles []string  `json:"archive_files,omitempty"`
+	PurgedLogs   int       `json:"purged_logs"`
+}
+
+// LogRetentionService purges behavior logs past the retention period of the deployment. Before
+// any log is deleted, the days it counted on are summarized into archived daily scores, the
+// daily scores are frozen so they are never recalculated without their logs, and the logs are
+// exported when the policy has an archive directory. Logs are handled one day window at a time, so
+// a run holds at most the logs around one day whatever the backlog.
+type LogRetentionService struct {
+	policy           *domain.LogRetentionPolicy
+	behaviorLogRepo  domain.BehaviorLogRepository
+	dailyScoreRepo   domain.DailyScoreRepository
+	archivedRepo     domain.ArchivedDailyScoreRepository
+	behaviorRepo     domain.BehaviorRepository
+	authRepo         domain.AuthorizationRepository
+	userSettingsRepo domain.UserSettingsRepository
+	archiveWriter    LogArchiveWriter
+}
+
+// NewLogRetentionService creates a new log retention service. The archive writer is only used
+// when the policy exports logs and may be nil otherwise.
+func NewLogRetentionService(
+	policy *domain.LogRetentionPolicy,
+	behaviorLogRepo domain.BehaviorLogRepository,
+	dailyScoreRepo domain.DailyScoreRepository,
+	archivedRepo domain.ArchivedDailyScoreRepository,
+	behaviorRepo domain.BehaviorRepository,
+	authRepo domain.AuthorizationRepository,
+	userSettingsRepo domain.UserSettingsRepository,
+	archiveWriter LogArchiveWriter,
+) *LogRetentionService {
+	return &LogRetentionService{
+		policy:           policy,
+		behaviorLogRepo:  behaviorLogRepo,
+		dailyScoreRepo:   dailyScoreRepo,
+		archivedRepo:     archivedRepo,
+		behaviorRepo:     behaviorRepo,
+		authRepo:         authRepo,
+		userSettingsRepo: userSettingsRepo,
+		archiveWriter:    archiveWriter,
+	}
+}
+
+// Run archives the days of the logs logged before the retention cutoff, then purges those logs.
+// Windows are UTC days handled from the oldest: the group days their logs count on are archived
+// and frozen, then the logs of the window are exported and purged. Runs can be repeated and resume
+// where a failed run stopped: archived days are skipped, and the logs of a window are only deleted
+// once its days are archived.
+func (s *LogRetentionService) Run(ctx context.Context, now time.Time) (*LogRetentionReport, error) {
+	cutoff := s.policy.Cutoff(now)
+	report := &LogRetentionReport{Cutoff: cutoff, ArchiveFiles: make([]string, 0)}
+
+	windows, err := s.windows(ctx, cutoff)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := NewGroupCalendar(s.authRepo, s.userSettingsRepo)
+	behaviors := make(map[uuid.UUID]*domain.Behavior)
+	for _, start := range windows {
+		end := start.AddDate(0, 0, 1)
+		if end.After(cutoff) {
+			end = cutoff
+		}
+		if err := s.runWindow(ctx, calendar, start, end, behaviors, now, report); err != nil {
+			return nil, fmt.Errorf("failed to purge behavior logs of %s: %w", start.Format("2006-01-02"), err)
+		}
+		report.Windows++
+	}
+
+	return report, nil
+}
+
+// windows returns the UTC days that have logs before the cutoff, oldest first. Logs are found
+// newest first, so each lookup jumps to the day of the newest log before the last day found.
+func (s *LogRetentionService) windows(ctx context.Context, cutoff time.Time) ([]time.Time, error) {
+	windows := make([]time.Time, 0)
+	before := cutoff
+	for {
+		filter := domain.NewBehaviorLogFilter().
+			WithDateRange(time.Time{}, before.Add(-time.Nanosecond)).
+			WithPagination(1, 0)
+		behaviorLogs, err := s.behaviorLogRepo.Find(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find behavior logs: %w", err)
+		}
+		if len(behaviorLogs) == 0 {
+			break
+		}
+
+		loggedAt := behaviorLogs[0].LoggedAt.UTC()
+		before = time.Date(loggedAt.Year(), loggedAt.Month(), loggedAt.Day(), 0, 0, 0, 0, time.UTC)
+		windows = append(windows, before)
+	}
+
+	sort.Slice(windows, func(i, j int) bool {
+		return windows[i].Before(windows[j])
+	})
+	return windows, nil
+}
+
+// runWindow archives the group days the logs of a window count on, then exports and purges them
+func (s *LogRetentionService) runWindow(
+	ctx context.Context,
+	calendar *GroupCalendar,
+	start, end time.Time,
+	behaviors map[uuid.UUID]*domain.Behavior,
+	now time.Time,
+	report *LogRetentionReport,
+) error {
+	// Collect the logs of the window, and every log of the group days they count on
+	logsByDay := make(map[scoreDay][]*domain.BehaviorLog)
+	purgedDays := make(map[scoreDay]bool)
+	purgedLogs := make([]*domain.BehaviorLog, 0)
+
+	scanFrom := start.AddDate(0, 0, -logDayMarginDays)
+	scanTo := end.AddDate(0, 0, logDayMarginDays).Add(-time.Nanosecond)
+	for offset := 0; ; offset += logRetentionPageSize {
+		filter := domain.NewBehaviorLogFilter().
+			WithDateRange(scanFrom, scanTo).
+			WithPagination(logRetentionPageSize, offset)
+		behaviorLogs, err := s.behaviorLogRepo.Find(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("failed to find behavior logs: %w", err)
+		}
+
+		for _, behaviorLog := range behaviorLogs {
+			report.ScannedLogs++
+			purged := !behaviorLog.LoggedAt.Before(start) && behaviorLog.LoggedAt.Before(end)
+			if purged {
+				purgedLogs = append(purgedLogs, behaviorLog)
+			}
+
+			for _, share := range behaviorLog.GroupShares {
+				date, err := calendar.LogDay(ctx, share.GroupID, behaviorLog)
+				if err != nil {
+					return err
+				}
+				day := scoreDay{petID: behaviorLog.PetID, groupID: share.GroupID, date: date}
+				logsByDay[day] = append(logsByDay[day], behaviorLog)
+				if purged {
+					purgedDays[day] = true
+				}
+			}
+		}
+
+		if len(behaviorLogs) < logRetentionPageSize {
+			break
+		}
+	}
+
+	if len(purgedLogs) == 0 {
+		return nil
+	}
+
+	archiveFile := ""
+	if s.policy.ExportsLogs() && s.archiveWriter != nil {
+		path, err := s.archiveWriter.WriteLogs("behavior-logs-"+start.Format("2006-01-02"), purgedLogs)
+		if err != nil {
+			return fmt.Errorf("failed to export behavior logs: %w", err)
+		}
+		archiveFile = path
+		report.ExportedLogs += len(purgedLogs)
+		report.ArchiveFiles = append(report.ArchiveFiles, path)
+	}
+
+	days := make([]scoreDay, 0, len(purgedDays))
+	for day := range purgedDays {
+		days = append(days, day)
+	}
+	sort.Slice(days, func(i, j int) bool {
+		if !days[i].date.Equal(days[j].date) {
+			return days[i].date.Before(days[j].date)
+		}
+		if days[i].groupID != days[j].groupID {
+			return days[i].groupID.String() < days[j].groupID.String()
+		}
+		return days[i].petID.String() < days[j].petID.String()
+	})
+
+	// Summarize the days that are not archived yet, a day spanning two windows is archived by
+	// the first while all its logs are still there
+	summaries := make([]*domain.ArchivedDailyScore, 0, len(days))
+	frozen := make([]*domain.DailyScore, 0, len(days))
+	for _, day := range days {
+		dailyScore, err := s.dailyScoreRepo.GetOrCreate(ctx, day.petID, day.groupID, day.date)
+		if err != nil {
+			return fmt.Errorf("failed to get daily score: %w", err)
+		}
+
+		existing, err := s.archivedRepo.GetByPetGroupAndDate(ctx, day.petID, day.groupID, day.date)
+		if err != nil {
+			return fmt.Errorf("failed to get archived daily score: %w", err)
+		}
+		if existing == nil {
+			s.addBehaviors(ctx, logsByDay[day], behaviors)
+			summaries = append(summaries, domain.NewArchivedDailyScore(dailyScore, logsByDay[day], behaviors, archiveFile, now))
+		}
+		if !dailyScore.IsArchived() {
+			frozen = append(frozen, dailyScore)
+		}
+	}
+
+	if err := s.archivedRepo.CreateBatch(ctx, summaries); err != nil {
+		return fmt.Errorf("failed to save archived daily scores: %w", err)
+	}
+	report.ArchivedDays += len(summaries)
+
+	for _, dailyScore := range frozen {
+		dailyScore.Archive(now)
+		if err := s.dailyScoreRepo.Update(ctx, dailyScore); err != nil {
+			return fmt.Errorf("failed to archive daily score: %w", err)
+		}
+	}
+
+	// Older windows are already purged, so this deletes the logs of the window
+	purged, err := s.behaviorLogRepo.CleanupOldLogs(ctx, end)
+	if err != nil {
+		return fmt.Errorf("failed to purge behavior logs: %w", err)
+	}
+	report.PurgedLogs += purged
+
+	return nil
+}
+
+// addBehaviors looks up the behaviors of logs that are not known yet, for breakdown names
+func (s *LogRetentionService) addBehaviors(ctx context.Context, behaviorLogs []*domain.BehaviorLog, behaviors map[uuid.UUID]*domain.Behavior) {
+	for _, behaviorLog := range behaviorLogs {
+		if _, exists := behaviors[behaviorLog.BehaviorID]; exists {
+			continue
+		}
+		// Deleted behaviors are remembered as missing, they are archived without a name
+		behavior, err := s.behaviorRepo.GetByID(ctx, behaviorLog.BehaviorID)
+		if err != nil {
+			behavior = nil
+		}
+		behaviors[behaviorLog.BehaviorID] = behavior
+	}
+}
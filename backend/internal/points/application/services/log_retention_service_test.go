@@ -0,0 +1,240 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+)
+
+// recordingArchiveWriter keeps the logs it is asked to export, and fails once on a file name
+type recordingArchiveWriter struct {
+	names  []string
+	logs   []*domain.BehaviorLog
+	failOn string
+}
+
+func (w *recordingArchiveWriter) WriteLogs(name string, behaviorLogs []*domain.BehaviorLog) (string, error) {
+	if name == w.failOn {
+		w.failOn = ""
+		return "", errors.New("disk full")
+	}
+	w.names = append(w.names, name)
+	w.logs = append(w.logs, behaviorLogs...)
+	return "/archive/" + name + ".jsonl.gz", nil
+}
+
+func TestLogRetentionService_Run(t *testing.T) {
+	ctx := context.Background()
+	petID, groupID, ownerID := uuid.New(), uuid.New(), uuid.New()
+	fetch := &domain.Behavior{ID: uuid.New(), Name: "Fetch session", Category: domain.BehaviorCategoryPlay, PointValue: 3}
+
+	// A run on October 1 2025 with a 30 day retention purges the logs before September 1. The
+	// group follows the default settings of its owner, so its days start at 21:00 UTC.
+	now := time.Date(2025, time.October, 1, 12, 0, 0, 0, time.UTC)
+	cutoff := time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC)
+	logs := []struct {
+		loggedAt time.Time
+		points   int
+	}{
+		{loggedAt: time.Date(2025, time.August, 20, 10, 0, 0, 0, time.UTC), points: 3},
+		{loggedAt: time.Date(2025, time.August, 20, 10, 0, 0, 0, time.UTC), points: 3},
+		{loggedAt: time.Date(2025, time.August, 20, 22, 0, 0, 0, time.UTC), points: -2}, // Counts on August 21
+		{loggedAt: time.Date(2025, time.August, 21, 10, 0, 0, 0, time.UTC), points: 3},
+		{loggedAt: time.Date(2025, time.August, 31, 22, 0, 0, 0, time.UTC), points: 3}, // Counts on September 1
+		{loggedAt: time.Date(2025, time.September, 1, 10, 0, 0, 0, time.UTC), points: 3},
+		{loggedAt: time.Date(2025, time.September, 15, 10, 0, 0, 0, time.UTC), points: 3},
+	}
+
+	// Each case runs against its own copy of the logs, with their daily scores up to date
+	type retention struct {
+		service         *LogRetentionService
+		writer          *recordingArchiveWriter
+		behaviorLogRepo *mock.MockBehaviorLogRepository
+		dailyScoreRepo  *mock.MockDailyScoreRepository
+		archivedRepo    *mock.MockArchivedDailyScoreRepository
+	}
+	newRetention := func(t *testing.T, archiveDir string) *retention {
+		t.Helper()
+
+		r := &retention{
+			writer:          &recordingArchiveWriter{},
+			behaviorLogRepo: mock.NewMockBehaviorLogRepository(),
+			archivedRepo:    mock.NewMockArchivedDailyScoreRepository(),
+		}
+		r.dailyScoreRepo = mock.NewMockDailyScoreRepository().
+			WithRecalculationSources(r.behaviorLogRepo, mock.NewMockPointAdjustmentRepository())
+		behaviorRepo := mock.NewMockBehaviorRepository()
+		behaviorRepo.Create(ctx, fetch)
+		authRepo := mock.NewMockAuthorizationRepository()
+		authRepo.AddUserGroup(ownerID, groupID, &domain.GroupInfo{ID: groupID, Name: "Park friends", OwnerID: ownerID})
+		userSettingsRepo := mock.NewMockUserSettingsRepository()
+
+		calendar := NewGroupCalendar(authRepo, userSettingsRepo)
+		for _, l := range logs {
+			behaviorLog := &domain.BehaviorLog{
+				ID:            uuid.New(),
+				PetID:         petID,
+				BehaviorID:    fetch.ID,
+				UserID:        ownerID,
+				PointsAwarded: l.points,
+				LoggedAt:      l.loggedAt,
+				CreatedAt:     l.loggedAt,
+			}
+			behaviorLog.AddGroupShare(groupID)
+			r.behaviorLogRepo.Create(ctx, behaviorLog)
+
+			date, err := calendar.LogDay(ctx, groupID, behaviorLog)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			dailyScore, _ := r.dailyScoreRepo.GetOrCreate(ctx, petID, groupID, date)
+			if err := dailyScore.AddBehaviorLog(behaviorLog); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			r.dailyScoreRepo.Update(ctx, dailyScore)
+		}
+
+		policy, err := domain.NewLogRetentionPolicy(30, archiveDir)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		r.service = NewLogRetentionService(
+			policy, r.behaviorLogRepo, r.dailyScoreRepo, r.archivedRepo, behaviorRepo, authRepo, userSettingsRepo, r.writer,
+		)
+		return r
+	}
+	remainingLogs := func(r *retention) int {
+		behaviorLogs, _ := r.behaviorLogRepo.Find(ctx, domain.NewBehaviorLogFilter().WithPet(petID).WithPagination(100, 0))
+		return len(behaviorLogs)
+	}
+	archivedDay := func(t *testing.T, r *retention, month time.Month, day int) *domain.ArchivedDailyScore {
+		t.Helper()
+
+		archived, err := r.archivedRepo.GetByPetGroupAndDate(ctx, petID, groupID, time.Date(2025, month, day, 0, 0, 0, 0, time.UTC))
+		if err != nil || archived == nil {
+			t.Fatalf("Expected %s %d to be archived, got %v", month, day, err)
+		}
+		return archived
+	}
+
+	t.Run("Archives the group days of each window before purging it", func(t *testing.T) {
+		r := newRetention(t, "/archive")
+
+		report, err := r.service.Run(ctx, now)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !report.Cutoff.Equal(cutoff) {
+			t.Errorf("Expected a September 1 cutoff, got %v", report.Cutoff)
+		}
+		if report.Windows != 3 || report.ArchivedDays != 3 || report.ExportedLogs != 5 || report.PurgedLogs != 5 {
+			t.Errorf("Expected 3 windows, 3 days archived and 5 logs exported and purged, got %+v", report)
+		}
+		wantFiles := []string{"behavior-logs-2025-08-20", "behavior-logs-2025-08-21", "behavior-logs-2025-08-31"}
+		if len(r.writer.names) != len(wantFiles) || len(report.ArchiveFiles) != len(wantFiles) {
+			t.Fatalf("Expected one archive file per window, got %v", r.writer.names)
+		}
+		for i, name := range wantFiles {
+			if r.writer.names[i] != name {
+				t.Errorf("Expected archive file %s, got %s", name, r.writer.names[i])
+			}
+		}
+		if remaining := remainingLogs(r); remaining != 2 {
+			t.Errorf("Expected the September logs to be kept, got %d logs", remaining)
+		}
+
+		august20 := archivedDay(t, r, time.August, 20)
+		if august20.TotalPoints != 6 || august20.LogCount != 2 || august20.ArchiveFile != "/archive/behavior-logs-2025-08-20.jsonl.gz" {
+			t.Errorf("Expected 6 points from 2 logs, got %+v", august20)
+		}
+		if len(august20.Breakdown) != 1 || august20.Breakdown[0].BehaviorName != "Fetch session" || august20.Breakdown[0].Count != 2 {
+			t.Errorf("Expected the fetch sessions in the breakdown, got %+v", august20.Breakdown)
+		}
+
+		// Group days spanning two windows are archived by the first with all their logs
+		if august21 := archivedDay(t, r, time.August, 21); august21.TotalPoints != 1 || august21.LogCount != 2 {
+			t.Errorf("Expected 1 point from 2 logs on August 21, got %+v", august21)
+		}
+		if september1 := archivedDay(t, r, time.September, 1); september1.TotalPoints != 6 || september1.LogCount != 2 {
+			t.Errorf("Expected the kept log of September 1 in its summary, got %+v", september1)
+		}
+
+		// Purged days keep their score and cannot change without their logs
+		for _, day := range []int{20, 21} {
+			dailyScore, _ := r.dailyScoreRepo.GetOrCreate(ctx, petID, groupID, time.Date(2025, time.August, day, 0, 0, 0, 0, time.UTC))
+			if !dailyScore.IsArchived() {
+				t.Errorf("Expected August %d to be frozen", day)
+			}
+			if _, err := r.dailyScoreRepo.RecalculateFromLogs(ctx, petID, groupID, dailyScore.Date); !errors.Is(err, domain.ErrDailyScoreArchived) {
+				t.Errorf("Expected ErrDailyScoreArchived, got %v", err)
+			}
+		}
+
+		// Recent days are still recalculated
+		if _, err := r.dailyScoreRepo.RecalculateFromLogs(ctx, petID, groupID, time.Date(2025, time.September, 15, 0, 0, 0, 0, time.UTC)); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+	})
+
+	t.Run("Can be run again", func(t *testing.T) {
+		r := newRetention(t, "/archive")
+		if _, err := r.service.Run(ctx, now); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		report, err := r.service.Run(ctx, now)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if report.Windows != 0 || report.ArchivedDays != 0 || report.PurgedLogs != 0 || len(r.writer.names) != 3 {
+			t.Errorf("Expected nothing left to archive, got %+v", report)
+		}
+	})
+
+	t.Run("Archives without exporting", func(t *testing.T) {
+		r := newRetention(t, "")
+
+		report, err := r.service.Run(ctx, now)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if report.ExportedLogs != 0 || len(report.ArchiveFiles) != 0 || len(r.writer.names) != 0 {
+			t.Errorf("Expected no export, got %+v", report)
+		}
+		if report.ArchivedDays != 3 || report.PurgedLogs != 5 {
+			t.Errorf("Expected days to be archived before purging, got %+v", report)
+		}
+		if august20 := archivedDay(t, r, time.August, 20); august20.ArchiveFile != "" {
+			t.Errorf("Expected no archive file, got %s", august20.ArchiveFile)
+		}
+	})
+
+	t.Run("Resumes after a failed window", func(t *testing.T) {
+		r := newRetention(t, "/archive")
+		r.writer.failOn = "behavior-logs-2025-08-21"
+
+		if _, err := r.service.Run(ctx, now); err == nil {
+			t.Fatal("Expected the failed export to stop the run")
+		}
+		if remaining := remainingLogs(r); remaining != 4 {
+			t.Errorf("Expected only the first window to be purged, got %d logs left", remaining)
+		}
+
+		report, err := r.service.Run(ctx, now)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if report.Windows != 2 || report.ArchivedDays != 1 || report.PurgedLogs != 2 {
+			t.Errorf("Expected the remaining 2 windows to be purged, got %+v", report)
+		}
+		if august21 := archivedDay(t, r, time.August, 21); august21.LogCount != 2 {
+			t.Errorf("Expected August 21 to keep the summary of its 2 logs, got %+v", august21)
+		}
+	})
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/events"
+)
+
+// PointLedgerService records the points that do not come from behavior logs, such as admin
+// corrections, penalties and challenge bonuses, and applies them to the pets' daily scores
+type PointLedgerService struct {
+	adjustmentRepo   domain.PointAdjustmentRepository
+	dailyScoreRepo   domain.DailyScoreRepository
+	authRepo         domain.AuthorizationRepository
+	userSettingsRepo domain.UserSettingsRepository
+	eventBus         events.Bus
+}
+
+// NewPointLedgerService creates a new point ledger service
+func NewPointLedgerService(
+	adjustmentRepo domain.PointAdjustmentRepository,
+	dailyScoreRepo domain.DailyScoreRepository,
+	authRepo domain.AuthorizationRepository,
+	userSettingsRepo domain.UserSettingsRepository,
+	eventBus events.Bus,
+) *PointLedgerService {
+	return &PointLedgerService{
+		adjustmentRepo:   adjustmentRepo,
+		dailyScoreRepo:   dailyScoreRepo,
+		authRepo:         authRepo,
+		userSettingsRepo: userSettingsRepo,
+		eventBus:         eventBus,
+	}
+}
+
+// Record adds an entry to the ledger and applies it to the daily score of its day, both or neither
+func (s *PointLedgerService) Record(ctx context.Context, adjustment *domain.PointAdjustment) error {
+	if _, err := s.dailyScoreRepo.RecordAdjustment(ctx, adjustment); err != nil {
+		return fmt.Errorf("failed to record point adjustment: %w", err)
+	}
+
+	s.eventBus.Publish(ctx, domain.NewPointsAdjustedEvent(adjustment))
+
+	return nil
+}
+
+// GetChallengeBonus returns the bonus already recorded for a pet in a challenge, nil if none
+func (s *PointLedgerService) GetChallengeBonus(ctx context.Context, challengeID, petID uuid.UUID) (*domain.PointAdjustment, error) {
+	filter := domain.NewPointAdjustmentFilter().
+		WithChallenge(challengeID).
+		WithPet(petID)
+	adjustments, err := s.adjustmentRepo.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find challenge bonus: %w", err)
+	}
+
+	for _, adjustment := range adjustments {
+		if adjustment.Kind == domain.PointAdjustmentKindChallengeBonus {
+			return adjustment, nil
+		}
+	}
+	return nil, nil
+}
+
+// GroupDay returns the group day a moment belongs to, using the timezone and reset time of the group
+func (s *PointLedgerService) GroupDay(ctx context.Context, groupID uuid.UUID, t time.Time) (time.Time, error) {
+	return NewGroupCalendar(s.authRepo, s.userSettingsRepo).Day(ctx, groupID, t)
+}
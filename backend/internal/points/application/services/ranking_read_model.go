@@ -0,0 +1,453 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/events"
+)
+
+const (
+	// maxCachedRankingDays bounds the group days kept in memory by the rankings read model, the
+	// days loaded first are dropped first
+	maxCachedRankingDays = 1000
+	// rankingDayTTL is how long a cached group day is kept before it is read again whole. Changes
+	// made outside the tracked repository, by another server or a script, show up after it.
+	rankingDayTTL = 5 * time.Minute
+)
+
+// rankingDayKey identifies a group day of the rankings read model
+type rankingDayKey struct {
+	groupID uuid.UUID
+	date    string // YYYY-MM-DD, like the date index of daily scores
+}
+
+func newRankingDayKey(groupID uuid.UUID, date time.Time) rankingDayKey {
+	return rankingDayKey{groupID: groupID, date: date.Format("2006-01-02")}
+}
+
+// cachedRankingDay holds the entries of a group day by pet, with the pets whose daily score
+// changed since their entry was read
+type cachedRankingDay struct {
+	date     time.Time
+	entries  map[uuid.UUID]*domain.RankingEntry
+	ordered  []*domain.RankingEntry // Entries by points
+	stale    map[uuid.UUID]uint64   // Version of the last change of a stale pet
+	loadedAt time.Time
+}
+
+// loadingRankingDay records the changes to a group day while it is being read
+type loadingRankingDay struct {
+	loads int
+	stale map[uuid.UUID]bool
+	all   bool // A deleted score could not be told apart, the read is not cached
+}
+
+// RankingReadModel keeps the rankings read model of the group days being ranked in memory. A day
+// is read once with the names of its pets and owners, then kept up to date pet by pet: changes
+// to daily scores mark the entries of their pets stale, and each stale entry is read again on
+// its own when a behavior log event comes in or when the day is next read. Days expire after
+// rankingDayTTL. It implements domain.RankingReadModelRepository, so it can be used wherever the
+// repository is.
+type RankingReadModel struct {
+	repo    domain.RankingReadModelRepository
+	mu      sync.Mutex
+	days    map[rankingDayKey]*cachedRankingDay
+	loading map[rankingDayKey]*loadingRankingDay
+	maxDays int
+	ttl     time.Duration
+	version uint64 // Incremented on every change, so that a read racing with a change is not kept
+}
+
+// NewRankingReadModel creates a new in-memory rankings read model over its repository
+func NewRankingReadModel(repo domain.RankingReadModelRepository) *RankingReadModel {
+	return &RankingReadModel{
+		repo:    repo,
+		days:    make(map[rankingDayKey]*cachedRankingDay),
+		loading: make(map[rankingDayKey]*loadingRankingDay),
+		maxDays: maxCachedRankingDays,
+		ttl:     rankingDayTTL,
+	}
+}
+
+// Subscribe registers the read model for the events that follow daily score changes
+func (m *RankingReadModel) Subscribe(bus events.Bus) {
+	bus.Subscribe(domain.BehaviorLogCreatedEventType, events.HandlerFunc(m.handleBehaviorLogCreated))
+	bus.Subscribe(domain.BehaviorLogBatchCreatedEventType, eachCreatedLog(m.handleBehaviorLogCreated))
+	bus.Subscribe(domain.BehaviorLogDeletedEventType, events.HandlerFunc(m.handleBehaviorLogDeleted))
+	bus.Subscribe(domain.BehaviorLogReviewedEventType, events.HandlerFunc(m.handleBehaviorLogReviewed))
+	bus.Subscribe(domain.PointsAdjustedEventType, events.HandlerFunc(m.handlePointsAdjusted))
+}
+
+// TrackDailyScores returns a daily score repository that marks the entries of the scores it
+// changes stale. Every daily score change must go through it for the read model to stay fresh.
+func (m *RankingReadModel) TrackDailyScores(repo domain.DailyScoreRepository) domain.DailyScoreRepository {
+	return &trackedDailyScoreRepository{
+		DailyScoreRepository: repo,
+		readModel:            m,
+	}
+}
+
+// GetGroupDay returns the entries of a group day by points, reading the day on first use or once
+// it expired, and the stale entries of a cached day. Entries are shared and must not be modified.
+func (m *RankingReadModel) GetGroupDay(ctx context.Context, groupID uuid.UUID, date time.Time) ([]*domain.RankingEntry, error) {
+	key := newRankingDayKey(groupID, date)
+
+	m.mu.Lock()
+	day, cached := m.days[key]
+	if cached && time.Since(day.loadedAt) >= m.ttl {
+		delete(m.days, key)
+		cached = false
+	}
+	m.mu.Unlock()
+
+	if !cached {
+		return m.load(ctx, key, groupID, date)
+	}
+
+	if err := m.refreshStale(ctx, key, day, nil); err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entries := make([]*domain.RankingEntry, len(day.ordered))
+	copy(entries, day.ordered)
+	return entries, nil
+}
+
+// rankingEntryBefore orders entries by points, highest first, then by pet for a stable order
+func rankingEntryBefore(entry, other *domain.RankingEntry) bool {
+	if entry.DailyScore.TotalPoints != other.DailyScore.TotalPoints {
+		return entry.DailyScore.TotalPoints > other.DailyScore.TotalPoints
+	}
+	return bytes.Compare(entry.DailyScore.PetID[:], other.DailyScore.PetID[:]) < 0
+}
+
+// replaceRankingEntry moves a pet to the place of its new entry in entries ordered by points,
+// without sorting the others again. A nil entry removes the pet.
+func replaceRankingEntry(ordered []*domain.RankingEntry, previous, entry *domain.RankingEntry) []*domain.RankingEntry {
+	if previous != nil {
+		for i, existing := range ordered {
+			if existing == previous {
+				ordered = append(ordered[:i], ordered[i+1:]...)
+				break
+			}
+		}
+	}
+
+	if entry != nil {
+		i := sort.Search(len(ordered), func(i int) bool {
+			return rankingEntryBefore(entry, ordered[i])
+		})
+		ordered = append(ordered, nil)
+		copy(ordered[i+1:], ordered[i:])
+		ordered[i] = entry
+	}
+
+	return ordered
+}
+
+// GetPetDay returns the entry of a pet on a group day, nil if the pet has no score that day
+func (m *RankingReadModel) GetPetDay(ctx context.Context, petID, groupID uuid.UUID, date time.Time) (*domain.RankingEntry, error) {
+	entries, err := m.GetGroupDay(ctx, groupID, date)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if entry.DailyScore.PetID == petID {
+			return entry, nil
+		}
+	}
+	return nil, nil
+}
+
+// Invalidate marks the entry of a pet on a group day stale, if the day is cached
+func (m *RankingReadModel) Invalidate(petID, groupID uuid.UUID, date time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.invalidate(newRankingDayKey(groupID, date), petID)
+}
+
+// invalidateMissing marks a pet stale on a group day it has no entry in yet
+func (m *RankingReadModel) invalidateMissing(petID, groupID uuid.UUID, date time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := newRankingDayKey(groupID, date)
+	if day, cached := m.days[key]; cached {
+		if _, exists := day.entries[petID]; exists {
+			return
+		}
+	}
+	m.invalidate(key, petID)
+}
+
+// invalidateScore marks the entry of a daily score stale, wherever it is cached
+func (m *RankingReadModel) invalidateScore(id uuid.UUID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.version++
+	for _, day := range m.days {
+		for petID, entry := range day.entries {
+			if entry.DailyScore.ID == id {
+				day.stale[petID] = m.version
+			}
+		}
+	}
+	for _, loading := range m.loading {
+		loading.all = true
+	}
+}
+
+// invalidate marks a pet stale on a group day, cached or being read. The lock must be held.
+func (m *RankingReadModel) invalidate(key rankingDayKey, petID uuid.UUID) {
+	m.version++
+	if day, cached := m.days[key]; cached {
+		day.stale[petID] = m.version
+	}
+	if loading, exists := m.loading[key]; exists {
+		loading.stale[petID] = true
+	}
+}
+
+// load reads a group day and caches it, with the pets that changed during the read marked stale
+func (m *RankingReadModel) load(ctx context.Context, key rankingDayKey, groupID uuid.UUID, date time.Time) ([]*domain.RankingEntry, error) {
+	m.mu.Lock()
+	loading, exists := m.loading[key]
+	if !exists {
+		loading = &loadingRankingDay{stale: make(map[uuid.UUID]bool)}
+		m.loading[key] = loading
+	}
+	loading.loads++
+	m.mu.Unlock()
+
+	entries, err := m.repo.GetGroupDay(ctx, groupID, date)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	loading.loads--
+	if loading.loads == 0 {
+		delete(m.loading, key)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rankings: %w", err)
+	}
+	// Another read cached the day first, or a change could not be placed
+	if _, cached := m.days[key]; cached || loading.all {
+		return entries, nil
+	}
+
+	day := &cachedRankingDay{
+		date:     date,
+		entries:  make(map[uuid.UUID]*domain.RankingEntry, len(entries)),
+		ordered:  make([]*domain.RankingEntry, len(entries)),
+		stale:    make(map[uuid.UUID]uint64, len(loading.stale)),
+		loadedAt: time.Now(),
+	}
+	for _, entry := range entries {
+		day.entries[entry.DailyScore.PetID] = entry
+	}
+	copy(day.ordered, entries)
+	sort.SliceStable(day.ordered, func(i, j int) bool {
+		return rankingEntryBefore(day.ordered[i], day.ordered[j])
+	})
+	for petID := range loading.stale {
+		day.stale[petID] = m.version
+	}
+
+	if len(m.days) >= m.maxDays {
+		m.evictOldest()
+	}
+	m.days[key] = day
+
+	return entries, nil
+}
+
+// evictOldest drops the cached day loaded first. The lock must be held.
+func (m *RankingReadModel) evictOldest() {
+	var oldestKey rankingDayKey
+	var oldest *cachedRankingDay
+	for key, day := range m.days {
+		if oldest == nil || day.loadedAt.Before(oldest.loadedAt) {
+			oldestKey, oldest = key, day
+		}
+	}
+	delete(m.days, oldestKey)
+}
+
+// refreshStale reads again the stale entries of a cached group day, only those of a pet if one is given
+func (m *RankingReadModel) refreshStale(ctx context.Context, key rankingDayKey, day *cachedRankingDay, petID *uuid.UUID) error {
+	m.mu.Lock()
+	stale := make(map[uuid.UUID]uint64, len(day.stale))
+	for stalePetID, version := range day.stale {
+		if petID == nil || stalePetID == *petID {
+			stale[stalePetID] = version
+		}
+	}
+	m.mu.Unlock()
+
+	for stalePetID, version := range stale {
+		entry, err := m.repo.GetPetDay(ctx, stalePetID, key.groupID, day.date)
+		if err != nil {
+			return fmt.Errorf("failed to read ranking entry: %w", err)
+		}
+
+		m.mu.Lock()
+		// A pet that changed again during the read stays stale, the entry read may be older
+		if day.stale[stalePetID] == version {
+			day.ordered = replaceRankingEntry(day.ordered, day.entries[stalePetID], entry)
+			if entry == nil {
+				delete(day.entries, stalePetID)
+			} else {
+				day.entries[stalePetID] = entry
+			}
+			delete(day.stale, stalePetID)
+		}
+		m.mu.Unlock()
+	}
+
+	return nil
+}
+
+// refreshPet reads again the stale entries of a pet on the cached days of its groups
+func (m *RankingReadModel) refreshPet(ctx context.Context, petID uuid.UUID, groupIDs []uuid.UUID) error {
+	inGroups := make(map[uuid.UUID]bool, len(groupIDs))
+	for _, groupID := range groupIDs {
+		inGroups[groupID] = true
+	}
+
+	m.mu.Lock()
+	days := make(map[rankingDayKey]*cachedRankingDay)
+	for key, day := range m.days {
+		if _, stale := day.stale[petID]; stale && inGroups[key.groupID] {
+			days[key] = day
+		}
+	}
+	m.mu.Unlock()
+
+	for key, day := range days {
+		if err := m.refreshStale(ctx, key, day, &petID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// handleBehaviorLogCreated updates the entries of a pet whose behavior was logged
+func (m *RankingReadModel) handleBehaviorLogCreated(ctx context.Context, event events.Event) error {
+	createdEvent, ok := event.(*domain.BehaviorLogCreatedEvent)
+	if !ok {
+		return nil
+	}
+	return m.refreshPet(ctx, createdEvent.PetID, createdEvent.GroupIDs)
+}
+
+// handleBehaviorLogDeleted updates the entries of a pet whose behavior log was deleted
+func (m *RankingReadModel) handleBehaviorLogDeleted(ctx context.Context, event events.Event) error {
+	deletedEvent, ok := event.(*domain.BehaviorLogDeletedEvent)
+	if !ok {
+		return nil
+	}
+	return m.refreshPet(ctx, deletedEvent.PetID, deletedEvent.GroupIDs)
+}
+
+// handleBehaviorLogReviewed updates the entries of a pet whose behavior log was verified or rejected
+func (m *RankingReadModel) handleBehaviorLogReviewed(ctx context.Context, event events.Event) error {
+	reviewedEvent, ok := event.(*domain.BehaviorLogReviewedEvent)
+	if !ok {
+		return nil
+	}
+	return m.refreshPet(ctx, reviewedEvent.PetID, []uuid.UUID{reviewedEvent.GroupID})
+}
+
+// handlePointsAdjusted updates the entries of a pet whose points were adjusted
+func (m *RankingReadModel) handlePointsAdjusted(ctx context.Context, event events.Event) error {
+	adjustedEvent, ok := event.(*domain.PointsAdjustedEvent)
+	if !ok {
+		return nil
+	}
+	return m.refreshPet(ctx, adjustedEvent.PetID, []uuid.UUID{adjustedEvent.GroupID})
+}
+
+// trackedDailyScoreRepository is a daily score repository that tells the rankings read model
+// which scores it changes
+type trackedDailyScoreRepository struct {
+	domain.DailyScoreRepository
+	readModel *RankingReadModel
+}
+
+func (r *trackedDailyScoreRepository) Create(ctx context.Context, dailyScore *domain.DailyScore) error {
+	if err := r.DailyScoreRepository.Create(ctx, dailyScore); err != nil {
+		return err
+	}
+	r.readModel.Invalidate(dailyScore.PetID, dailyScore.GroupID, dailyScore.Date)
+	return nil
+}
+
+func (r *trackedDailyScoreRepository) GetOrCreate(ctx context.Context, petID, groupID uuid.UUID, date time.Time) (*domain.DailyScore, error) {
+	dailyScore, err := r.DailyScoreRepository.GetOrCreate(ctx, petID, groupID, date)
+	if err != nil {
+		return nil, err
+	}
+	// Only a pet without an entry can have had its score created
+	r.readModel.invalidateMissing(petID, groupID, date)
+	return dailyScore, nil
+}
+
+func (r *trackedDailyScoreRepository) Update(ctx context.Context, dailyScore *domain.DailyScore) error {
+	if err := r.DailyScoreRepository.Update(ctx, dailyScore); err != nil {
+		return err
+	}
+	r.readModel.Invalidate(dailyScore.PetID, dailyScore.GroupID, dailyScore.Date)
+	return nil
+}
+
+func (r *trackedDailyScoreRepository) RecalculateFromLogs(ctx context.Context, petID, groupID uuid.UUID, date time.Time) (*domain.DailyScore, error) {
+	dailyScore, err := r.DailyScoreRepository.RecalculateFromLogs(ctx, petID, groupID, date)
+	if err != nil {
+		return nil, err
+	}
+	r.readModel.Invalidate(petID, groupID, date)
+	return dailyScore, nil
+}
+
+func (r *trackedDailyScoreRepository) RecordAdjustment(ctx context.Context, adjustment *domain.PointAdjustment) (*domain.DailyScore, error) {
+	dailyScore, err := r.DailyScoreRepository.RecordAdjustment(ctx, adjustment)
+	if err != nil {
+		return nil, err
+	}
+	r.readModel.Invalidate(dailyScore.PetID, dailyScore.GroupID, dailyScore.Date)
+	return dailyScore, nil
+}
+
+func (r *trackedDailyScoreRepository) RecordBehaviorLogs(ctx context.Context, scoredLogs []domain.ScoredBehaviorLog) ([]*domain.DailyScore, error) {
+	dailyScores, err := r.DailyScoreRepository.RecordBehaviorLogs(ctx, scoredLogs)
+	if err != nil {
+		return nil, err
+	}
+	for _, dailyScore := range dailyScores {
+		r.readModel.Invalidate(dailyScore.PetID, dailyScore.GroupID, dailyScore.Date)
+	}
+	return dailyScores, nil
+}
+
+func (r *trackedDailyScoreRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.DailyScoreRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	r.readModel.invalidateScore(id)
+	return nil
+}
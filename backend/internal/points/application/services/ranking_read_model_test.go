@@ -0,0 +1,403 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+	"pet-of-the-day/internal/shared/events"
+)
+
+// countingRankingReadModelRepository counts the queries made to a rankings read model repository
+type countingRankingReadModelRepository struct {
+	domain.RankingReadModelRepository
+	groupDays int
+	petDays   int
+}
+
+func (r *countingRankingReadModelRepository) GetGroupDay(ctx context.Context, groupID uuid.UUID, date time.Time) ([]*domain.RankingEntry, error) {
+	r.groupDays++
+	return r.RankingReadModelRepository.GetGroupDay(ctx, groupID, date)
+}
+
+func (r *countingRankingReadModelRepository) GetPetDay(ctx context.Context, petID, groupID uuid.UUID, date time.Time) (*domain.RankingEntry, error) {
+	r.petDays++
+	return r.RankingReadModelRepository.GetPetDay(ctx, petID, groupID, date)
+}
+
+// countingAuthorizationRepository counts the pet and user lookups made to an authorization repository
+type countingAuthorizationRepository struct {
+	domain.AuthorizationRepository
+	lookups int
+}
+
+func (r *countingAuthorizationRepository) GetPetInfo(ctx context.Context, petID uuid.UUID) (*domain.PetInfo, error) {
+	r.lookups++
+	return r.AuthorizationRepository.GetPetInfo(ctx, petID)
+}
+
+func (r *countingAuthorizationRepository) GetUserInfo(ctx context.Context, userID uuid.UUID) (*domain.UserInfo, error) {
+	r.lookups++
+	return r.AuthorizationRepository.GetUserInfo(ctx, userID)
+}
+
+func TestRankingReadModel(t *testing.T) {
+	ctx := context.Background()
+	groupID, ownerID := uuid.New(), uuid.New()
+	date := time.Date(2026, time.March, 11, 0, 0, 0, 0, time.UTC)
+
+	// Each case ranks its own group day of pets owned by Alice, with every daily score change
+	// going through the tracked repository
+	type rankingDay struct {
+		readModel      *RankingReadModel
+		repo           *countingRankingReadModelRepository
+		dailyScoreRepo domain.DailyScoreRepository
+		untrackedRepo  *mock.MockDailyScoreRepository
+		authRepo       *mock.MockAuthorizationRepository
+	}
+	newRankingDay := func() *rankingDay {
+		d := &rankingDay{untrackedRepo: mock.NewMockDailyScoreRepository(), authRepo: mock.NewMockAuthorizationRepository()}
+		d.repo = &countingRankingReadModelRepository{
+			RankingReadModelRepository: mock.NewMockRankingReadModelRepository(d.untrackedRepo, d.authRepo),
+		}
+		d.readModel = NewRankingReadModel(d.repo)
+		d.dailyScoreRepo = d.readModel.TrackDailyScores(d.untrackedRepo)
+		d.authRepo.AddUser(ownerID, &domain.UserInfo{ID: ownerID, Name: "Alice"})
+		return d
+	}
+	addPet := func(d *rankingDay, name string) uuid.UUID {
+		petID := uuid.New()
+		d.authRepo.AddUserPet(ownerID, petID, &domain.PetInfo{ID: petID, Name: name, Species: domain.SpeciesDog, OwnerID: ownerID})
+		d.authRepo.AddPetToGroup(petID, groupID)
+		return petID
+	}
+	logPoints := func(t *testing.T, d *rankingDay, petID uuid.UUID, points int) {
+		t.Helper()
+
+		dailyScore, err := d.dailyScoreRepo.GetOrCreate(ctx, petID, groupID, date)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		dailyScore.AddBehaviorLog(&domain.BehaviorLog{PetID: petID, PointsAwarded: points, LoggedAt: date})
+		if err := d.dailyScoreRepo.Update(ctx, dailyScore); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	readDay := func(t *testing.T, d *rankingDay) []*domain.RankingEntry {
+		t.Helper()
+
+		entries, err := d.readModel.GetGroupDay(ctx, groupID, date)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		for _, entry := range entries {
+			if entry.OwnerName != "Alice" {
+				t.Errorf("Expected the owner name to be joined, got %q", entry.OwnerName)
+			}
+		}
+		return entries
+	}
+	pointsByName := func(t *testing.T, d *rankingDay) map[string]int {
+		t.Helper()
+
+		points := make(map[string]int)
+		for _, entry := range readDay(t, d) {
+			points[entry.PetName] = entry.DailyScore.TotalPoints
+		}
+		return points
+	}
+
+	t.Run("Reads a day once and changed pets again", func(t *testing.T) {
+		d := newRankingDay()
+		rex, milo := addPet(d, "Rex"), addPet(d, "Milo")
+		logPoints(t, d, rex, 5)
+		logPoints(t, d, milo, 3)
+
+		if points := pointsByName(t, d); points["Rex"] != 5 || points["Milo"] != 3 {
+			t.Errorf("Expected Rex with 5 points and Milo with 3, got %v", points)
+		}
+		if points := pointsByName(t, d); len(points) != 2 || d.repo.groupDays != 1 || d.repo.petDays != 0 {
+			t.Errorf("Expected the day to be read once, got %d day and %d pet queries", d.repo.groupDays, d.repo.petDays)
+		}
+
+		// A score change only reads the pet again
+		logPoints(t, d, rex, 2)
+		if points := pointsByName(t, d); points["Rex"] != 7 || points["Milo"] != 3 {
+			t.Errorf("Expected Rex to reach 7 points, got %v", points)
+		}
+		if d.repo.groupDays != 1 || d.repo.petDays != 1 {
+			t.Errorf("Expected a single pet query, got %d day and %d pet queries", d.repo.groupDays, d.repo.petDays)
+		}
+
+		// A pet scoring for the first time that day joins the cached day
+		logPoints(t, d, addPet(d, "Luna"), 4)
+		if points := pointsByName(t, d); len(points) != 3 || points["Luna"] != 4 {
+			t.Errorf("Expected Luna to join with 4 points, got %v", points)
+		}
+
+		// Pets that no longer exist are left out like with the join
+		logPoints(t, d, uuid.New(), 9)
+		if points := pointsByName(t, d); len(points) != 3 {
+			t.Errorf("Expected unknown pets to be left out, got %v", points)
+		}
+	})
+
+	t.Run("Keeps entries by points", func(t *testing.T) {
+		d := newRankingDay()
+		rex, milo, luna := addPet(d, "Rex"), addPet(d, "Milo"), addPet(d, "Luna")
+		logPoints(t, d, rex, 5)
+		logPoints(t, d, milo, 3)
+		logPoints(t, d, luna, 1)
+
+		names := func() string {
+			petNames := make([]string, 0)
+			for _, entry := range readDay(t, d) {
+				petNames = append(petNames, entry.PetName)
+			}
+			return fmt.Sprint(petNames)
+		}
+
+		if order := names(); order != "[Rex Milo Luna]" {
+			t.Errorf("Expected entries by points, got %s", order)
+		}
+
+		// Luna overtakes both, the others keep their order
+		logPoints(t, d, luna, 9)
+		if order := names(); order != "[Luna Rex Milo]" {
+			t.Errorf("Expected Luna to move first, got %s", order)
+		}
+	})
+
+	t.Run("Drops deleted scores", func(t *testing.T) {
+		d := newRankingDay()
+		rex := addPet(d, "Rex")
+		logPoints(t, d, rex, 5)
+		readDay(t, d)
+
+		dailyScore, _ := d.dailyScoreRepo.GetOrCreate(ctx, rex, groupID, date)
+		if err := d.dailyScoreRepo.Delete(ctx, dailyScore.ID); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if points := pointsByName(t, d); len(points) != 0 {
+			t.Errorf("Expected the deleted score to be dropped, got %v", points)
+		}
+	})
+
+	t.Run("Refreshes the pet of a behavior log event", func(t *testing.T) {
+		d := newRankingDay()
+		rex := addPet(d, "Rex")
+		logPoints(t, d, rex, 5)
+		readDay(t, d)
+
+		logPoints(t, d, rex, 2)
+		behaviorLog := &domain.BehaviorLog{ID: uuid.New(), PetID: rex, PointsAwarded: 2, LoggedAt: date}
+		behaviorLog.AddGroupShare(groupID)
+		if err := d.readModel.handleBehaviorLogCreated(ctx, domain.NewBehaviorLogCreatedEvent(behaviorLog)); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if d.repo.petDays != 1 {
+			t.Errorf("Expected the event to read the pet again, got %d pet queries", d.repo.petDays)
+		}
+
+		// The entry is fresh, reading the day makes no query
+		if points := pointsByName(t, d); points["Rex"] != 7 || d.repo.groupDays != 1 || d.repo.petDays != 1 {
+			t.Errorf("Expected Rex with 7 points without a query, got %v", points)
+		}
+	})
+
+	t.Run("Reads expired days again", func(t *testing.T) {
+		d := newRankingDay()
+		rex := addPet(d, "Rex")
+		logPoints(t, d, rex, 5)
+		readDay(t, d)
+
+		// A change that bypasses the tracked repository, like one made by another server
+		dailyScore, _ := d.untrackedRepo.GetOrCreate(ctx, rex, groupID, date)
+		dailyScore.AddBehaviorLog(&domain.BehaviorLog{PetID: rex, PointsAwarded: 3, LoggedAt: date})
+		d.untrackedRepo.Update(ctx, dailyScore)
+		if points := pointsByName(t, d); points["Rex"] != 5 {
+			t.Errorf("Expected the cached day to miss the change, got %v", points)
+		}
+
+		d.readModel.days[newRankingDayKey(groupID, date)].loadedAt = time.Now().Add(-rankingDayTTL)
+		if points := pointsByName(t, d); points["Rex"] != 8 || d.repo.groupDays != 2 {
+			t.Errorf("Expected the expired day to be read again, got %v after %d day queries", points, d.repo.groupDays)
+		}
+		if points := pointsByName(t, d); points["Rex"] != 8 || d.repo.groupDays != 2 {
+			t.Errorf("Expected the day read again to be cached, got %d day queries", d.repo.groupDays)
+		}
+	})
+
+	t.Run("Drops the day loaded first", func(t *testing.T) {
+		d := newRankingDay()
+		d.readModel.maxDays = 2
+
+		for day := 0; day < 3; day++ {
+			if _, err := d.readModel.GetGroupDay(ctx, groupID, date.AddDate(0, 0, day)); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		}
+		if len(d.readModel.days) != 2 {
+			t.Errorf("Expected 2 cached days, got %d", len(d.readModel.days))
+		}
+		if _, cached := d.readModel.days[newRankingDayKey(groupID, date)]; cached {
+			t.Errorf("Expected the day loaded first to be dropped")
+		}
+	})
+
+	t.Run("Ranks without pet or owner lookups", func(t *testing.T) {
+		d := newRankingDay()
+		rex, milo := addPet(d, "Rex"), addPet(d, "Milo")
+		logPoints(t, d, rex, 3)
+		logPoints(t, d, milo, 5)
+
+		authRepo := &countingAuthorizationRepository{AuthorizationRepository: d.authRepo}
+		service := NewRankingService(
+			d.dailyScoreRepo, d.readModel, mock.NewMockBehaviorLogRepository(), mock.NewMockPetOfTheDayRepository(), authRepo,
+			mock.NewMockUserSettingsRepository(), mock.NewMockDailyResetStateRepository(), mock.NewMockScoringRulesRepository(), events.NewInMemoryBus(),
+		)
+
+		winners, err := service.SelectPetOfTheDay(ctx, groupID, date)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(winners) != 1 || winners[0].PetName != "Milo" || winners[0].OwnerName != "Alice" {
+			t.Errorf("Expected Milo of Alice to win, got %+v", winners)
+		}
+		if authRepo.lookups != 0 {
+			t.Errorf("Expected no pet or user lookup, got %d", authRepo.lookups)
+		}
+	})
+}
+
+// rankWithLookups ranks a group day the way rankings were built before the read model, with a
+// pet and an owner lookup per daily score
+func rankWithLookups(ctx context.Context, dailyScoreRepo domain.DailyScoreRepository, authRepo domain.AuthorizationRepository, rules *domain.ScoringRules, groupID uuid.UUID, date time.Time) ([]*domain.PetRanking, error) {
+	topScorers, err := dailyScoreRepo.GetTopScorers(ctx, groupID, date)
+	if err != nil {
+		return nil, err
+	}
+
+	rankings := make([]*domain.PetRanking, 0, len(topScorers))
+	for _, dailyScore := range topScorers {
+		petInfo, err := authRepo.GetPetInfo(ctx, dailyScore.PetID)
+		if err != nil {
+			continue
+		}
+		ownerInfo, err := authRepo.GetUserInfo(ctx, petInfo.OwnerID)
+		if err != nil {
+			continue
+		}
+
+		ranking := domain.NewPetRanking(dailyScore.PetID, petInfo.Name, ownerInfo.Name)
+		ranking.UpdateFromDailyScore(dailyScore)
+		ranking.AddNormalizedPoints(rules.NormalizeDay(dailyScore, nil))
+		rankings = append(rankings, ranking)
+	}
+
+	return rules.AssignRanks(rankings), nil
+}
+
+// BenchmarkCalculateGroupRankings ranks a day of a 1000 pet group. Mock lookups are map reads, so
+// queries/op shows the round trips a database would have to make.
+func BenchmarkCalculateGroupRankings(b *testing.B) {
+	ctx := context.Background()
+	groupID, ownerID := uuid.New(), uuid.New()
+	date := time.Date(2026, time.March, 11, 0, 0, 0, 0, time.UTC)
+
+	// Setup: Alice owns the 1000 pets of the group, each scoring up to 49 points
+	dailyScoreRepo := mock.NewMockDailyScoreRepository()
+	authRepo := mock.NewMockAuthorizationRepository()
+	authRepo.AddUser(ownerID, &domain.UserInfo{ID: ownerID, Name: "Alice"})
+	repo := &countingRankingReadModelRepository{
+		RankingReadModelRepository: mock.NewMockRankingReadModelRepository(dailyScoreRepo, authRepo),
+	}
+	readModel := NewRankingReadModel(repo)
+	for i := 0; i < 1000; i++ {
+		petID := uuid.New()
+		authRepo.AddUserPet(ownerID, petID, &domain.PetInfo{ID: petID, Name: fmt.Sprintf("Pet %d", i), Species: domain.SpeciesDog, OwnerID: ownerID})
+		authRepo.AddPetToGroup(petID, groupID)
+		dailyScore, _ := dailyScoreRepo.GetOrCreate(ctx, petID, groupID, date)
+		dailyScore.AddBehaviorLog(&domain.BehaviorLog{PetID: petID, PointsAwarded: i % 50, LoggedAt: date})
+		dailyScoreRepo.Update(ctx, dailyScore)
+	}
+	scoringRulesRepo := mock.NewMockScoringRulesRepository()
+
+	newService := func(rankingReadModel domain.RankingReadModelRepository) *RankingService {
+		return NewRankingService(
+			readModel.TrackDailyScores(dailyScoreRepo), rankingReadModel, mock.NewMockBehaviorLogRepository(), mock.NewMockPetOfTheDayRepository(), authRepo,
+			mock.NewMockUserSettingsRepository(), mock.NewMockDailyResetStateRepository(), scoringRulesRepo, events.NewInMemoryBus(),
+		)
+	}
+
+	b.Run("PerPetLookups", func(b *testing.B) {
+		countingAuthRepo := &countingAuthorizationRepository{AuthorizationRepository: authRepo}
+		rules, _ := scoringRulesRepo.GetByGroup(ctx, groupID)
+		for i := 0; i < b.N; i++ {
+			if _, err := rankWithLookups(ctx, dailyScoreRepo, countingAuthRepo, rules, groupID, date); err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.ReportMetric(float64(countingAuthRepo.lookups+b.N)/float64(b.N), "queries/op")
+	})
+
+	b.Run("ReadModelQuery", func(b *testing.B) {
+		service := newService(repo)
+		before := repo.groupDays + repo.petDays
+		for i := 0; i < b.N; i++ {
+			if _, err := service.CalculateGroupRankings(ctx, groupID, date); err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.ReportMetric(float64(repo.groupDays+repo.petDays-before)/float64(b.N), "queries/op")
+	})
+
+	b.Run("CachedReadModel", func(b *testing.B) {
+		service := newService(readModel)
+		if _, err := service.CalculateGroupRankings(ctx, groupID, date); err != nil {
+			b.Fatal(err)
+		}
+		before := repo.groupDays + repo.petDays
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := service.CalculateGroupRankings(ctx, groupID, date); err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.ReportMetric(float64(repo.groupDays+repo.petDays-before)/float64(b.N), "queries/op")
+	})
+
+	// The broadcast that follows a logged behavior: one pet's score changes, then the day is
+	// read again
+	b.Run("GroupDayAfterLog", func(b *testing.B) {
+		trackedDailyScoreRepo := readModel.TrackDailyScores(dailyScoreRepo)
+		petID := uuid.New()
+		authRepo.AddUserPet(ownerID, petID, &domain.PetInfo{ID: petID, Name: "Rex", Species: domain.SpeciesDog, OwnerID: ownerID})
+		authRepo.AddPetToGroup(petID, groupID)
+		if _, err := readModel.GetGroupDay(ctx, groupID, date); err != nil {
+			b.Fatal(err)
+		}
+
+		before := repo.groupDays + repo.petDays
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			dailyScore, err := trackedDailyScoreRepo.GetOrCreate(ctx, petID, groupID, date)
+			if err != nil {
+				b.Fatal(err)
+			}
+			dailyScore.AddBehaviorLog(&domain.BehaviorLog{PetID: petID, PointsAwarded: 1, LoggedAt: date})
+			if err := trackedDailyScoreRepo.Update(ctx, dailyScore); err != nil {
+				b.Fatal(err)
+			}
+			if _, err := readModel.GetGroupDay(ctx, groupID, date); err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.ReportMetric(float64(repo.groupDays+repo.petDays-before)/float64(b.N), "queries/op")
+	})
+}
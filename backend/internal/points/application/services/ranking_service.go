@@ -2,75 +2,203 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
 	"sort"
 	"time"
 
 	"github.com/google/uuid"
 
 	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/events"
+	"pet-of-the-day/internal/shared/timezone"
 )
 
+const (
+	// maxResetCatchUpDays limits how many missed days are replayed for a group in one reset run
+	maxResetCatchUpDays = 7
+
+	// maxTrendingDays bounds the recent window of the trending analysis
+	maxTrendingDays = 30
+	// normalizationPageSize is the number of daily scores loaded at once to normalize rankings
+	normalizationPageSize = 500
+	// trendingBaselineMultiplier sets the baseline length as a multiple of the recent window
+	trendingBaselineMultiplier = 3
+	// minTrendingBaselineDays is the number of active baseline days needed for a confident trend
+	minTrendingBaselineDays = 3
+)
+
+// DayClosedHook is notified once Pet of the Day has been selected for a group's closed day
+type DayClosedHook interface {
+	OnDayClosed(ctx context.Context, groupID uuid.UUID, day time.Time) error
+}
+
+// VoteTallier counts the Pet of the Day votes of groups that vote
+type VoteTallier interface {
+	// TallyVotes returns the vote tallies of a group day, and false if the group does not vote
+	TallyVotes(ctx context.Context, groupID uuid.UUID, day time.Time) ([]*domain.VoteTally, bool, error)
+}
+
 // RankingService handles ranking calculations and Pet of the Day selection
 type RankingService struct {
-	dailyScoreRepo      domain.DailyScoreRepository
-	petOfTheDayRepo     domain.PetOfTheDayRepository
-	authRepo            domain.AuthorizationRepository
-	userSettingsRepo    domain.UserSettingsRepository
+	dailyScoreRepo   domain.DailyScoreRepository
+	rankingReadModel domain.RankingReadModelRepository
+	behaviorLogRepo  domain.BehaviorLogRepository
+	petOfTheDayRepo  domain.PetOfTheDayRepository
+	authRepo         domain.AuthorizationRepository
+	userSettingsRepo domain.UserSettingsRepository
+	resetStateRepo   domain.DailyResetStateRepository
+	scoringRulesRepo domain.ScoringRulesRepository
+	dayClosedHooks   []DayClosedHook
+	voteTallier      VoteTallier
+	eventBus         events.Bus
 }
 
 // NewRankingService creates a new ranking service
 func NewRankingService(
 	dailyScoreRepo domain.DailyScoreRepository,
+	rankingReadModel domain.RankingReadModelRepository,
+	behaviorLogRepo domain.BehaviorLogRepository,
 	petOfTheDayRepo domain.PetOfTheDayRepository,
 	authRepo domain.AuthorizationRepository,
 	userSettingsRepo domain.UserSettingsRepository,
+	resetStateRepo domain.DailyResetStateRepository,
+	scoringRulesRepo domain.ScoringRulesRepository,
+	eventBus events.Bus,
 ) *RankingService {
 	return &RankingService{
 		dailyScoreRepo:   dailyScoreRepo,
+		rankingReadModel: rankingReadModel,
+		behaviorLogRepo:  behaviorLogRepo,
 		petOfTheDayRepo:  petOfTheDayRepo,
 		authRepo:         authRepo,
 		userSettingsRepo: userSettingsRepo,
+		resetStateRepo:   resetStateRepo,
+		scoringRulesRepo: scoringRulesRepo,
+		eventBus:         eventBus,
 	}
 }
 
-// CalculateGroupRankings calculates and returns current rankings for a group
+// AddDayClosedHook registers a hook that runs during the daily reset after each closed day.
+// A failing hook stops the group's reset so the day is retried on the next run.
+func (s *RankingService) AddDayClosedHook(hook DayClosedHook) {
+	s.dayClosedHooks = append(s.dayClosedHooks, hook)
+}
+
+// SetVoteTallier registers the vote counter consulted when selecting Pet of the Day. Without
+// one, every group selects Pet of the Day by points.
+func (s *RankingService) SetVoteTallier(tallier VoteTallier) {
+	s.voteTallier = tallier
+}
+
+// CalculateGroupRankings calculates and returns current rankings for a group, ranked with the
+// group's scoring rules. Rankings keep their raw points next to the points of the scoring mode.
+// Scores and names come from the rankings read model, pets that no longer exist are left out.
 func (s *RankingService) CalculateGroupRankings(ctx context.Context, groupID uuid.UUID, date time.Time) ([]*domain.PetRanking, error) {
-	// Get all daily scores for the group on the specified date
-	topScorers, err := s.dailyScoreRepo.GetTopScorers(ctx, groupID, date)
+	rules, err := s.scoringRulesRepo.GetByGroup(ctx, groupID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get top scorers: %w", err)
+		return nil, fmt.Errorf("failed to get scoring rules: %w", err)
 	}
 
-	// Convert to PetRanking objects with additional information
-	rankings := make([]*domain.PetRanking, 0, len(topScorers))
+	// Get the daily scores of the group on the date with the pet and owner names
+	entries, err := s.rankingReadModel.GetGroupDay(ctx, groupID, date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ranking entries: %w", err)
+	}
 
-	for _, dailyScore := range topScorers {
-		// Get pet info
-		petInfo, err := s.authRepo.GetPetInfo(ctx, dailyScore.PetID)
+	rankings := make([]*domain.PetRanking, 0, len(entries))
+	breakdowns := make(groupDayBreakdowns)
+
+	for _, entry := range entries {
+		ranking := entry.ToPetRanking()
+
+		points, err := s.normalizeDay(ctx, rules, entry.DailyScore, breakdowns)
 		if err != nil {
-			continue // Skip pets we can't get info for
+			return nil, err
 		}
+		ranking.AddNormalizedPoints(points)
+
+		rankings = append(rankings, ranking)
+	}
+
+	// Sort rankings and assign ranks with the tie breakers of the group
+	return rules.AssignRanks(rankings), nil
+}
+
+// NormalizeRankings sets the normalized points of rankings computed over the days from and to,
+// summing the points of each day under the scoring mode of the rules. A zero from covers every
+// day up to to.
+func (s *RankingService) NormalizeRankings(ctx context.Context, groupID uuid.UUID, rules *domain.ScoringRules, rankings []*domain.PetRanking, from, to time.Time) error {
+	if rules.Mode == domain.ScoringModeRaw {
+		for _, ranking := range rankings {
+			ranking.NormalizedPoints = nil
+			ranking.AddNormalizedPoints(float64(ranking.TotalPoints))
+		}
+		return nil
+	}
 
-		// Get owner info
-		ownerInfo, err := s.authRepo.GetUserInfo(ctx, petInfo.OwnerID)
+	rankingsByPet := make(map[uuid.UUID]*domain.PetRanking, len(rankings))
+	for _, ranking := range rankings {
+		ranking.NormalizedPoints = nil
+		ranking.AddNormalizedPoints(0)
+		rankingsByPet[ranking.PetID] = ranking
+	}
+
+	breakdowns := make(groupDayBreakdowns)
+	for offset := 0; ; offset += normalizationPageSize {
+		filter := domain.NewDailyScoreFilter().
+			WithGroup(groupID).
+			WithDateRange(from, to).
+			WithPagination(normalizationPageSize, offset)
+
+		dailyScores, err := s.dailyScoreRepo.Find(ctx, filter)
 		if err != nil {
-			continue // Skip pets whose owner info we can't get
+			return fmt.Errorf("failed to get daily scores: %w", err)
 		}
 
-		ranking := domain.NewPetRanking(dailyScore.PetID, petInfo.Name, ownerInfo.Name)
-		ranking.UpdateFromDailyScore(dailyScore)
+		for _, dailyScore := range dailyScores {
+			ranking, exists := rankingsByPet[dailyScore.PetID]
+			if !exists {
+				continue
+			}
+
+			points, err := s.normalizeDay(ctx, rules, dailyScore, breakdowns)
+			if err != nil {
+				return err
+			}
+			ranking.AddNormalizedPoints(points)
+		}
 
-		rankings = append(rankings, ranking)
+		if len(dailyScores) < normalizationPageSize {
+			return nil
+		}
 	}
+}
 
-	// Sort rankings by total points (desc) then by negative behaviors (asc)
-	sort.Slice(rankings, func(i, j int) bool {
-		return rankings[i].CompareForRanking(rankings[j]) > 0
-	})
+// groupDayBreakdowns keeps the breakdowns by pet of the group days read while normalizing,
+// keyed by day
+type groupDayBreakdowns map[string]map[uuid.UUID][]*domain.DailyScoreBreakdown
+
+// normalizeDay returns the points of a daily score under the scoring mode of the rules. The
+// breakdowns of a group day are read once for all its pets.
+func (s *RankingService) normalizeDay(ctx context.Context, rules *domain.ScoringRules, dailyScore *domain.DailyScore, breakdowns groupDayBreakdowns) (float64, error) {
+	var breakdown []*domain.DailyScoreBreakdown
+	if rules.NeedsBreakdown() {
+		day := dailyScore.Date.Format("2006-01-02")
+		petBreakdowns, exists := breakdowns[day]
+		if !exists {
+			var err error
+			petBreakdowns, err = s.behaviorLogRepo.GetGroupBreakdowns(ctx, dailyScore.GroupID, dailyScore.Date)
+			if err != nil {
+				return 0, fmt.Errorf("failed to get behavior breakdown: %w", err)
+			}
+			breakdowns[day] = petBreakdowns
+		}
+		breakdown = petBreakdowns[dailyScore.PetID]
+	}
 
-	// Assign ranks with tie handling
-	return s.assignRanks(rankings), nil
+	return rules.NormalizeDay(dailyScore, breakdown), nil
 }
 
 // SelectPetOfTheDay selects the Pet of the Day winner(s) for a group on a specific date
@@ -81,51 +209,118 @@ func (s *RankingService) SelectPetOfTheDay(ctx context.Context, groupID uuid.UUI
 		return nil, fmt.Errorf("failed to calculate rankings: %w", err)
 	}
 
-	// Find winners (rank 1 with positive scores)
+	// Find the rankings of the winners and their votes in groups that vote
+	winningRankings, votes, err := s.findWinningRankings(ctx, groupID, date, rankings)
+	if err != nil {
+		return nil, err
+	}
+
 	winners := make([]*domain.PetOfTheDayWinner, 0)
 
+	for _, ranking := range winningRankings {
+		winner := domain.NewPetOfTheDayWinner(
+			groupID,
+			ranking.PetID,
+			ranking.PetName,
+			ranking.OwnerName,
+			date,
+			ranking.TotalPoints,
+			ranking.PositiveBehaviors,
+			ranking.NegativeBehaviors,
+		)
+		winner.Votes = votes[ranking.PetID]
+
+		winners = append(winners, winner)
+	}
+
+	// Clear existing winners for this date (in case of recalculation)
+	if err := s.petOfTheDayRepo.DeleteByGroupAndDate(ctx, groupID, date); err != nil {
+		return nil, fmt.Errorf("failed to clear existing winners: %w", err)
+	}
+
+	// Save new winners
+	for _, winner := range winners {
+		if err := s.petOfTheDayRepo.Create(ctx, winner); err != nil {
+			return nil, fmt.Errorf("failed to save winner: %w", err)
+		}
+	}
+
+	s.eventBus.Publish(ctx, domain.NewPetOfTheDaySelectedEvent(groupID, date, winners))
+
+	return winners, nil
+}
+
+// findWinningRankings returns the rankings of the winners of a group day with their weighted
+// votes. Groups that vote select their most voted pets, points breaking ties. Other groups, and
+// voting groups nobody voted in, select rank 1 with positive points. Rank 1 is shared only if
+// the group's scoring rules allow shared titles.
+func (s *RankingService) findWinningRankings(ctx context.Context, groupID uuid.UUID, date time.Time, rankings []*domain.PetRanking) ([]*domain.PetRanking, map[uuid.UUID]int, error) {
+	if s.voteTallier != nil {
+		tallies, voting, err := s.voteTallier.TallyVotes(ctx, groupID, date)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to tally votes: %w", err)
+		}
+		if voting && len(tallies) > 0 {
+			return s.findVoteWinners(ctx, groupID, rankings, tallies)
+		}
+	}
+
+	winningRankings := make([]*domain.PetRanking, 0)
+	for _, ranking := range rankings {
+		if ranking.Rank == 1 && ranking.RankingPoints() > 0 {
+			winningRankings = append(winningRankings, ranking)
+		}
+	}
+
+	return winningRankings, map[uuid.UUID]int{}, nil
+}
+
+// findVoteWinners ranks the most voted pets of a group day with the group's scoring rules
+func (s *RankingService) findVoteWinners(ctx context.Context, groupID uuid.UUID, rankings []*domain.PetRanking, tallies []*domain.VoteTally) ([]*domain.PetRanking, map[uuid.UUID]int, error) {
+	rankingsByPet := make(map[uuid.UUID]*domain.PetRanking, len(rankings))
 	for _, ranking := range rankings {
-		// Only pets with rank 1 and positive scores can win
-		if ranking.Rank == 1 && ranking.TotalPoints > 0 {
-			// Get owner info
-			petInfo, err := s.authRepo.GetPetInfo(ctx, ranking.PetID)
+		rankingsByPet[ranking.PetID] = ranking
+	}
+
+	// Tallies are sorted by weight, so the most voted pets come first
+	votes := make(map[uuid.UUID]int)
+	candidates := make([]*domain.PetRanking, 0)
+	for _, tally := range tallies {
+		if tally.Weight < tallies[0].Weight {
+			break
+		}
+
+		ranking, exists := rankingsByPet[tally.PetID]
+		if !exists {
+			// Pets can be voted for without scoring any points
+			petInfo, err := s.authRepo.GetPetInfo(ctx, tally.PetID)
 			if err != nil {
 				continue
 			}
-
 			ownerInfo, err := s.authRepo.GetUserInfo(ctx, petInfo.OwnerID)
 			if err != nil {
 				continue
 			}
-
-			winner := domain.NewPetOfTheDayWinner(
-				groupID,
-				ranking.PetID,
-				ranking.PetName,
-				ownerInfo.Name,
-				date,
-				ranking.TotalPoints,
-				ranking.PositiveBehaviors,
-				ranking.NegativeBehaviors,
-			)
-
-			winners = append(winners, winner)
+			ranking = domain.NewPetRanking(tally.PetID, petInfo.Name, ownerInfo.Name)
 		}
+
+		votes[tally.PetID] = tally.Weight
+		candidates = append(candidates, ranking)
 	}
 
-	// Clear existing winners for this date (in case of recalculation)
-	if err := s.petOfTheDayRepo.DeleteByGroupAndDate(ctx, groupID, date); err != nil {
-		return nil, fmt.Errorf("failed to clear existing winners: %w", err)
+	rules, err := s.scoringRulesRepo.GetByGroup(ctx, groupID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get scoring rules: %w", err)
 	}
 
-	// Save new winners
-	for _, winner := range winners {
-		if err := s.petOfTheDayRepo.Create(ctx, winner); err != nil {
-			return nil, fmt.Errorf("failed to save winner: %w", err)
+	winningRankings := make([]*domain.PetRanking, 0)
+	for _, candidate := range rules.AssignRanks(candidates) {
+		if candidate.Rank == 1 {
+			winningRankings = append(winningRankings, candidate)
 		}
 	}
 
-	return winners, nil
+	return winningRankings, votes, nil
 }
 
 // RecalculateDailyScores recalculates daily scores for a specific pet and group on a date
@@ -169,55 +364,152 @@ func (s *RankingService) GetGroupStats(ctx context.Context, groupID uuid.UUID) (
 	return stats, nil
 }
 
-// ScheduleDailyReset schedules Pet of the Day selection for all groups at their reset times
+// ScheduleDailyReset runs the Pet of the Day selection for every group whose day has closed.
+// It is safe to call repeatedly: each group's progress is recorded, so days are selected
+// once and days missed while the server was down are caught up on the next run.
 func (s *RankingService) ScheduleDailyReset(ctx context.Context) error {
-	// This would typically be called by a cron job or scheduler
-	// For now, it's a placeholder for the daily reset functionality
-	
-	// In a real implementation, this would:
-	// 1. Get all groups
-	// 2. For each group, get the owner's timezone and reset time
-	// 3. Check if reset time has passed for today
-	// 4. If so, calculate Pet of the Day for yesterday and save results
-	// 5. Reset daily scores for new day
-	
-	return fmt.Errorf("daily reset scheduling not yet implemented")
-}
-
-// assignRanks assigns rank positions to rankings with proper tie handling
-func (s *RankingService) assignRanks(rankings []*domain.PetRanking) []*domain.PetRanking {
-	if len(rankings) == 0 {
-		return rankings
+	return s.runDailyReset(ctx, time.Now())
+}
+
+// runDailyReset performs the daily reset for all groups as of the given time
+func (s *RankingService) runDailyReset(ctx context.Context, now time.Time) error {
+	groupIDs, err := s.authRepo.GetAllGroups(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list groups: %w", err)
 	}
 
-	currentRank := 1
-	for i, ranking := range rankings {
-		if i == 0 {
-			// First place
-			ranking.SetRank(currentRank, false)
-		} else {
-			// Check if tied with previous
-			prevRanking := rankings[i-1]
-			if ranking.CompareForRanking(prevRanking) == 0 {
-				// Tied - same rank
-				ranking.SetRank(currentRank, true)
-				// Mark previous as tied if not already marked
-				if !prevRanking.IsTied {
-					prevRanking.SetRank(currentRank, true)
-				}
-			} else {
-				// Not tied - increment rank by number of tied positions
-				currentRank = i + 1
-				ranking.SetRank(currentRank, false)
+	var resetErrors []error
+	for _, groupID := range groupIDs {
+		if err := s.resetGroup(ctx, groupID, now); err != nil {
+			resetErrors = append(resetErrors, fmt.Errorf("group %s: %w", groupID, err))
+		}
+	}
+
+	if len(resetErrors) > 0 {
+		return fmt.Errorf("daily reset failed for %d of %d groups: %w",
+			len(resetErrors), len(groupIDs), errors.Join(resetErrors...))
+	}
+
+	return nil
+}
+
+// resetGroup selects Pet of the Day for every closed day of a group that has not been reset yet
+func (s *RankingService) resetGroup(ctx context.Context, groupID uuid.UUID, now time.Time) error {
+	config, err := s.getGroupTimeConfig(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	lastClosedDay, err := lastClosedDayFor(now, config)
+	if err != nil {
+		return fmt.Errorf("failed to calculate daily boundary: %w", err)
+	}
+
+	state, err := s.resetStateRepo.GetByGroup(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("failed to get reset state: %w", err)
+	}
+
+	// First run for this group: only the day that just closed is selected
+	firstDay := lastClosedDay
+	if state != nil {
+		if state.HasResetFor(lastClosedDay) {
+			return nil
+		}
+
+		lastReset := state.LastResetDate.In(lastClosedDay.Location())
+		firstDay = time.Date(lastReset.Year(), lastReset.Month(), lastReset.Day(), 0, 0, 0, 0, lastClosedDay.Location()).AddDate(0, 0, 1)
+	}
+
+	// Cap catch-up so a long outage does not replay the whole history
+	earliestDay := lastClosedDay.AddDate(0, 0, -(maxResetCatchUpDays - 1))
+	if firstDay.Before(earliestDay) {
+		log.Printf("Daily reset for group %s skipping %s to %s (catch-up limited to %d days)",
+			groupID, firstDay.Format("2006-01-02"), earliestDay.AddDate(0, 0, -1).Format("2006-01-02"), maxResetCatchUpDays)
+		firstDay = earliestDay
+	}
+
+	for day := firstDay; !day.After(lastClosedDay); day = day.AddDate(0, 0, 1) {
+		if _, err := s.closeDay(ctx, groupID, day); err != nil {
+			return err
+		}
+
+		// Record progress after each day so a failure resumes from the right place
+		if state == nil {
+			state, err = domain.NewGroupResetState(groupID, day, now)
+			if err != nil {
+				return err
 			}
+		} else {
+			state.MarkReset(day, now)
+		}
+
+		if err := s.resetStateRepo.Save(ctx, state); err != nil {
+			return fmt.Errorf("failed to save reset state: %w", err)
 		}
 	}
 
-	return rankings
+	return nil
+}
+
+// ReselectPetOfTheDay selects Pet of the Day again for a closed day whose scores changed.
+// The day closed hooks run again so that streaks and seasons follow the new winners.
+func (s *RankingService) ReselectPetOfTheDay(ctx context.Context, groupID uuid.UUID, day time.Time) ([]*domain.PetOfTheDayWinner, error) {
+	return s.closeDay(ctx, groupID, day)
+}
+
+// closeDay selects Pet of the Day for a closed day of a group and runs the day closed hooks
+func (s *RankingService) closeDay(ctx context.Context, groupID uuid.UUID, day time.Time) ([]*domain.PetOfTheDayWinner, error) {
+	winners, err := s.SelectPetOfTheDay(ctx, groupID, day)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select Pet of the Day for %s: %w", day.Format("2006-01-02"), err)
+	}
+
+	for _, hook := range s.dayClosedHooks {
+		if err := hook.OnDayClosed(ctx, groupID, day); err != nil {
+			return nil, fmt.Errorf("failed to close %s: %w", day.Format("2006-01-02"), err)
+		}
+	}
+
+	return winners, nil
+}
+
+// getGroupTimeConfig resolves the timezone configuration used for a group's daily boundary.
+// Groups follow the timezone settings of their owner.
+func (s *RankingService) getGroupTimeConfig(ctx context.Context, groupID uuid.UUID) (timezone.UserTimeConfig, error) {
+	return NewGroupCalendar(s.authRepo, s.userSettingsRepo).Config(ctx, groupID)
+}
+
+// LastClosedDay returns the most recent day of a group whose reset time has passed
+func (s *RankingService) LastClosedDay(ctx context.Context, groupID uuid.UUID, now time.Time) (time.Time, error) {
+	config, err := s.getGroupTimeConfig(ctx, groupID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	lastClosedDay, err := lastClosedDayFor(now, config)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to calculate daily boundary: %w", err)
+	}
+
+	return lastClosedDay, nil
 }
 
-// ValidateRankingConsistency validates that rankings are mathematically consistent
-func (s *RankingService) ValidateRankingConsistency(rankings []*domain.PetRanking) error {
+// lastClosedDayFor returns the most recent day whose reset time has passed, normalized
+// to midnight in the configured timezone. It is the day before the one now belongs to, so a
+// log made at the reset instant counts for the day that is still open.
+func lastClosedDayFor(now time.Time, config timezone.UserTimeConfig) (time.Time, error) {
+	open, err := timezone.GetUserDate(now, config)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return open.AddDate(0, 0, -1), nil
+}
+
+// ValidateRankingConsistency validates that rankings are mathematically consistent with the
+// scoring rules they were ranked with
+func (s *RankingService) ValidateRankingConsistency(rankings []*domain.PetRanking, rules *domain.ScoringRules) error {
 	if len(rankings) == 0 {
 		return nil
 	}
@@ -228,7 +520,7 @@ func (s *RankingService) ValidateRankingConsistency(rankings []*domain.PetRankin
 		previous := rankings[i-1]
 
 		// Current should not rank higher than previous
-		if current.CompareForRanking(previous) > 0 {
+		if rules.Compare(current, previous) > 0 {
 			return fmt.Errorf("ranking inconsistency: pet %s should rank higher than pet %s",
 				current.PetID, previous.PetID)
 		}
@@ -243,9 +535,88 @@ func (s *RankingService) ValidateRankingConsistency(rankings []*domain.PetRankin
 	return nil
 }
 
-// GetTrendingPets identifies pets with significant point changes over time
-func (s *RankingService) GetTrendingPets(ctx context.Context, groupID uuid.UUID, days int) ([]*domain.PetRanking, error) {
-	// This is a placeholder for trending analysis
-	// Would compare recent performance to historical averages
-	return nil, fmt.Errorf("trending pets analysis not yet implemented")
+// GetTrendingPets ranks a group's pets by momentum: how their average daily points over the
+// last `days` closed days compare to their own average over the preceding baseline period
+func (s *RankingService) GetTrendingPets(ctx context.Context, groupID uuid.UUID, days int) ([]*domain.TrendingPet, error) {
+	return s.getTrendingPets(ctx, groupID, days, time.Now())
+}
+
+// getTrendingPets computes trending pets as of the given time
+func (s *RankingService) getTrendingPets(ctx context.Context, groupID uuid.UUID, days int, now time.Time) ([]*domain.TrendingPet, error) {
+	if days <= 0 || days > maxTrendingDays {
+		return nil, &domain.ValidationError{Message: fmt.Sprintf("days must be between 1 and %d", maxTrendingDays)}
+	}
+
+	config, err := s.getGroupTimeConfig(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	lastDay, err := lastClosedDayFor(now, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate daily boundary: %w", err)
+	}
+
+	baselineDays := days * trendingBaselineMultiplier
+	recentStart := lastDay.AddDate(0, 0, -(days - 1))
+	baselineStart := recentStart.AddDate(0, 0, -baselineDays)
+
+	// Any pet that scored in either window is a candidate
+	candidates, err := s.dailyScoreRepo.GetRankingsByDateRange(ctx, groupID, baselineStart, lastDay)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rankings: %w", err)
+	}
+
+	// The scores of every candidate over both windows come from a single range query
+	scores, err := s.dailyScoreRepo.Find(ctx, domain.NewDailyScoreFilter().
+		WithGroup(groupID).
+		WithDateRange(baselineStart, lastDay).
+		WithPagination(len(candidates)*(days+baselineDays), 0))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily scores: %w", err)
+	}
+
+	type trendTotals struct {
+		recentPoints, baselinePoints, activeBaselineDays int
+	}
+	totals := make(map[uuid.UUID]*trendTotals, len(candidates))
+	for _, score := range scores {
+		petTotals, exists := totals[score.PetID]
+		if !exists {
+			petTotals = &trendTotals{}
+			totals[score.PetID] = petTotals
+		}
+
+		scoreDay := time.Date(score.Date.Year(), score.Date.Month(), score.Date.Day(), 0, 0, 0, 0, lastDay.Location())
+		if scoreDay.Before(recentStart) {
+			petTotals.baselinePoints += score.TotalPoints
+			if score.PositiveBehaviors+score.NegativeBehaviors > 0 {
+				petTotals.activeBaselineDays++
+			}
+		} else {
+			petTotals.recentPoints += score.TotalPoints
+		}
+	}
+
+	trending := make([]*domain.TrendingPet, 0, len(candidates))
+	for _, candidate := range candidates {
+		petTotals, exists := totals[candidate.PetID]
+		if !exists {
+			petTotals = &trendTotals{}
+		}
+
+		trending = append(trending, domain.NewTrendingPet(
+			candidate, petTotals.recentPoints, days, petTotals.baselinePoints, baselineDays, petTotals.activeBaselineDays, minTrendingBaselineDays,
+		))
+	}
+
+	sort.SliceStable(trending, func(i, j int) bool {
+		return trending[i].CompareMomentum(trending[j]) > 0
+	})
+
+	for i, pet := range trending {
+		pet.Rank = i + 1
+	}
+
+	return trending, nil
 }
@@ -0,0 +1,479 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+	"pet-of-the-day/internal/shared/events"
+	"pet-of-the-day/internal/shared/timezone"
+)
+
+func TestRankingService_RunDailyReset(t *testing.T) {
+	ctx := context.Background()
+	day := func(d int) time.Time { return time.Date(2025, time.March, d, 0, 0, 0, 0, time.UTC) }
+
+	// Default reset time is 21:00 UTC
+	tests := []struct {
+		name          string
+		scoredDays    []int
+		lastResetDay  *time.Time // Reset state before the runs
+		runs          []time.Time
+		wantWinners   []int // Days with a winner
+		wantNoWinners []int // Days without a winner
+		wantLastReset time.Time
+	}{
+		{
+			name:          "Selects the day that just closed",
+			scoredDays:    []int{10},
+			runs:          []time.Time{time.Date(2025, time.March, 10, 21, 30, 0, 0, time.UTC)},
+			wantWinners:   []int{10},
+			wantLastReset: day(10),
+		},
+		{
+			name:          "Does not close the current day before the reset time",
+			scoredDays:    []int{10},
+			runs:          []time.Time{time.Date(2025, time.March, 10, 20, 59, 0, 0, time.UTC)},
+			wantNoWinners: []int{10},
+			wantLastReset: day(9),
+		},
+		{
+			name:          "Does not close the current day at the reset instant",
+			scoredDays:    []int{10},
+			runs:          []time.Time{time.Date(2025, time.March, 10, 21, 0, 0, 0, time.UTC)},
+			wantNoWinners: []int{10},
+			wantLastReset: day(9),
+		},
+		{
+			name:       "Is idempotent across runs",
+			scoredDays: []int{10},
+			runs: []time.Time{
+				time.Date(2025, time.March, 10, 22, 0, 0, 0, time.UTC),
+				time.Date(2025, time.March, 10, 22, 0, 0, 0, time.UTC),
+				time.Date(2025, time.March, 10, 22, 0, 0, 0, time.UTC),
+			},
+			wantWinners:   []int{10},
+			wantLastReset: day(10),
+		},
+		{
+			name:       "Catches up missed days",
+			scoredDays: []int{10, 11, 12},
+			runs: []time.Time{
+				time.Date(2025, time.March, 10, 21, 5, 0, 0, time.UTC),
+				// Server down for two days
+				time.Date(2025, time.March, 12, 23, 0, 0, 0, time.UTC),
+			},
+			wantWinners:   []int{10, 11, 12},
+			wantLastReset: day(12),
+		},
+		{
+			name:          "Limits catch-up to the most recent days",
+			scoredDays:    []int{1},
+			lastResetDay:  func() *time.Time { d := time.Date(2025, time.February, 20, 0, 0, 0, 0, time.UTC); return &d }(),
+			runs:          []time.Time{time.Date(2025, time.March, 20, 21, 30, 0, 0, time.UTC)},
+			wantNoWinners: []int{1},
+			wantLastReset: day(20),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			dailyScoreRepo := mock.NewMockDailyScoreRepository()
+			petOfTheDayRepo := mock.NewMockPetOfTheDayRepository()
+			authRepo := mock.NewMockAuthorizationRepository()
+			resetStateRepo := mock.NewMockDailyResetStateRepository()
+			service := NewRankingService(
+				dailyScoreRepo,
+				mock.NewMockRankingReadModelRepository(dailyScoreRepo, authRepo),
+				mock.NewMockBehaviorLogRepository(),
+				petOfTheDayRepo,
+				authRepo,
+				mock.NewMockUserSettingsRepository(),
+				resetStateRepo,
+				mock.NewMockScoringRulesRepository(),
+				events.NewInMemoryBus(),
+			)
+
+			// Test data
+			ownerID, groupID, petID := uuid.New(), uuid.New(), uuid.New()
+			authRepo.AddUser(ownerID, &domain.UserInfo{ID: ownerID, Name: "Alice"})
+			authRepo.AddUserGroup(ownerID, groupID, &domain.GroupInfo{ID: groupID, Name: "Park friends", OwnerID: ownerID})
+			authRepo.AddUserPet(ownerID, petID, &domain.PetInfo{ID: petID, Name: "Rex", Species: domain.SpeciesDog, OwnerID: ownerID})
+			authRepo.AddPetToGroup(petID, groupID)
+
+			for _, d := range tt.scoredDays {
+				dailyScore, err := dailyScoreRepo.GetOrCreate(ctx, petID, groupID, day(d))
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				dailyScore.AddBehaviorLog(&domain.BehaviorLog{PetID: petID, PointsAwarded: 5, LoggedAt: day(d)})
+			}
+
+			if tt.lastResetDay != nil {
+				state, _ := domain.NewGroupResetState(groupID, *tt.lastResetDay, time.Now())
+				resetStateRepo.Save(ctx, state)
+			}
+
+			for _, now := range tt.runs {
+				if err := service.runDailyReset(ctx, now); err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+			}
+
+			for _, d := range tt.wantWinners {
+				winners, _ := petOfTheDayRepo.GetByGroupAndDate(ctx, groupID, day(d))
+				if len(winners) != 1 {
+					t.Errorf("Expected 1 winner on March %d, got %d", d, len(winners))
+				}
+			}
+			for _, d := range tt.wantNoWinners {
+				winners, _ := petOfTheDayRepo.GetByGroupAndDate(ctx, groupID, day(d))
+				if len(winners) != 0 {
+					t.Errorf("Expected no winner on March %d, got %d", d, len(winners))
+				}
+			}
+
+			stats, _ := petOfTheDayRepo.GetGroupStats(ctx, groupID)
+			if stats.TotalWins != len(tt.wantWinners) {
+				t.Errorf("Expected %d wins recorded, got %d", len(tt.wantWinners), stats.TotalWins)
+			}
+
+			state, _ := resetStateRepo.GetByGroup(ctx, groupID)
+			if state == nil || !state.LastResetDate.Equal(tt.wantLastReset) {
+				t.Errorf("Expected last reset date %v, got %+v", tt.wantLastReset, state)
+			}
+		})
+	}
+}
+
+func TestRankingService_SelectPetOfTheDay(t *testing.T) {
+	ctx := context.Background()
+	day := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		tieBreakers []domain.TieBreaker
+		wantWinners []string
+	}{
+		{
+			name:        "Tied pets share the title by default",
+			wantWinners: []string{"Luna", "Rex"},
+		},
+		{
+			name:        "Uses the tie breakers of the group",
+			tieBreakers: []domain.TieBreaker{domain.TieBreakerEarliestToScore},
+			wantWinners: []string{"Luna"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			dailyScoreRepo := mock.NewMockDailyScoreRepository()
+			authRepo := mock.NewMockAuthorizationRepository()
+			scoringRulesRepo := mock.NewMockScoringRulesRepository()
+			service := NewRankingService(
+				dailyScoreRepo,
+				mock.NewMockRankingReadModelRepository(dailyScoreRepo, authRepo),
+				mock.NewMockBehaviorLogRepository(),
+				mock.NewMockPetOfTheDayRepository(),
+				authRepo,
+				mock.NewMockUserSettingsRepository(),
+				mock.NewMockDailyResetStateRepository(),
+				scoringRulesRepo,
+				events.NewInMemoryBus(),
+			)
+
+			// Test data: Rex and Luna both reach 5 points, Luna first
+			ownerID, groupID := uuid.New(), uuid.New()
+			authRepo.AddUser(ownerID, &domain.UserInfo{ID: ownerID, Name: "Alice"})
+			authRepo.AddUserGroup(ownerID, groupID, &domain.GroupInfo{ID: groupID, Name: "Park friends", OwnerID: ownerID})
+
+			names := make(map[uuid.UUID]string)
+			for name, loggedAt := range map[string]time.Time{"Rex": day.Add(18 * time.Hour), "Luna": day.Add(9 * time.Hour)} {
+				petID := uuid.New()
+				names[petID] = name
+				authRepo.AddUserPet(ownerID, petID, &domain.PetInfo{ID: petID, Name: name, Species: domain.SpeciesDog, OwnerID: ownerID})
+				authRepo.AddPetToGroup(petID, groupID)
+
+				dailyScore, err := dailyScoreRepo.GetOrCreate(ctx, petID, groupID, day)
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				dailyScore.AddBehaviorLog(&domain.BehaviorLog{PetID: petID, PointsAwarded: 5, LoggedAt: loggedAt})
+			}
+
+			if tt.tieBreakers != nil {
+				rules, err := domain.NewScoringRules(groupID, ownerID, domain.ScoringModeRaw, 0, 0, tt.tieBreakers, false)
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				scoringRulesRepo.Save(ctx, rules)
+			}
+
+			winners, err := service.SelectPetOfTheDay(ctx, groupID, day)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			won := make(map[string]bool)
+			for _, winner := range winners {
+				won[names[winner.PetID]] = true
+			}
+			if len(winners) != len(tt.wantWinners) {
+				t.Fatalf("Expected %d winners, got %d", len(tt.wantWinners), len(winners))
+			}
+			for _, name := range tt.wantWinners {
+				if !won[name] {
+					t.Errorf("Expected %s to win, got %+v", name, winners)
+				}
+			}
+		})
+	}
+}
+
+// countingBreakdownRepository counts the breakdown queries made to a behavior log repository
+type countingBreakdownRepository struct {
+	*mock.MockBehaviorLogRepository
+	petQueries   int
+	groupQueries int
+}
+
+func (r *countingBreakdownRepository) GetBreakdown(ctx context.Context, petID uuid.UUID, groupID uuid.UUID, date time.Time) ([]*domain.DailyScoreBreakdown, error) {
+	r.petQueries++
+	return r.MockBehaviorLogRepository.GetBreakdown(ctx, petID, groupID, date)
+}
+
+func (r *countingBreakdownRepository) GetGroupBreakdowns(ctx context.Context, groupID uuid.UUID, date time.Time) (map[uuid.UUID][]*domain.DailyScoreBreakdown, error) {
+	r.groupQueries++
+	return r.MockBehaviorLogRepository.GetGroupBreakdowns(ctx, groupID, date)
+}
+
+func TestRankingService_CalculateGroupRankings(t *testing.T) {
+	ctx := context.Background()
+	day := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	// Setup: the group caps each category at 5 points a day
+	dailyScoreRepo := mock.NewMockDailyScoreRepository()
+	behaviorLogRepo := &countingBreakdownRepository{MockBehaviorLogRepository: mock.NewMockBehaviorLogRepository()}
+	authRepo := mock.NewMockAuthorizationRepository()
+	scoringRulesRepo := mock.NewMockScoringRulesRepository()
+	service := NewRankingService(
+		dailyScoreRepo,
+		mock.NewMockRankingReadModelRepository(dailyScoreRepo, authRepo),
+		behaviorLogRepo,
+		mock.NewMockPetOfTheDayRepository(),
+		authRepo,
+		mock.NewMockUserSettingsRepository(),
+		mock.NewMockDailyResetStateRepository(),
+		scoringRulesRepo,
+		events.NewInMemoryBus(),
+	)
+
+	ownerID, groupID := uuid.New(), uuid.New()
+	authRepo.AddUser(ownerID, &domain.UserInfo{ID: ownerID, Name: "Alice"})
+	authRepo.AddUserGroup(ownerID, groupID, &domain.GroupInfo{ID: groupID, Name: "Park friends", OwnerID: ownerID})
+	rules, err := domain.NewScoringRules(groupID, ownerID, domain.ScoringModeCategoryCap, 5, 0, nil, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	scoringRulesRepo.Save(ctx, rules)
+
+	fetch := &domain.Behavior{ID: uuid.New(), Name: "Fetch session", Category: domain.BehaviorCategoryPlay, PointValue: 3}
+	sit := &domain.Behavior{ID: uuid.New(), Name: "Sit on command", Category: domain.BehaviorCategoryTraining, PointValue: 2}
+	behaviorLogRepo.AddBehavior(fetch)
+	behaviorLogRepo.AddBehavior(sit)
+
+	// Test data: Rex plays fetch 3 times, Luna twice and sits once
+	logged := map[string][]*domain.Behavior{
+		"Rex":  {fetch, fetch, fetch},
+		"Luna": {fetch, fetch, sit},
+	}
+	for name, behaviors := range logged {
+		petID := uuid.New()
+		authRepo.AddUserPet(ownerID, petID, &domain.PetInfo{ID: petID, Name: name, Species: domain.SpeciesDog, OwnerID: ownerID})
+		authRepo.AddPetToGroup(petID, groupID)
+
+		dailyScore, err := dailyScoreRepo.GetOrCreate(ctx, petID, groupID, day)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		for _, behavior := range behaviors {
+			behaviorLog := &domain.BehaviorLog{
+				ID:            uuid.New(),
+				PetID:         petID,
+				BehaviorID:    behavior.ID,
+				UserID:        ownerID,
+				PointsAwarded: behavior.PointValue,
+				LoggedAt:      day.Add(10 * time.Hour),
+			}
+			behaviorLog.AddGroupShare(groupID)
+			behaviorLogRepo.Create(ctx, behaviorLog)
+			dailyScore.AddBehaviorLog(behaviorLog)
+		}
+	}
+
+	rankings, err := service.CalculateGroupRankings(ctx, groupID, day)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(rankings) != 2 || rankings[0].PetName != "Luna" || rankings[1].PetName != "Rex" {
+		t.Fatalf("Expected Luna to rank first with the capped points, got %+v", rankings)
+	}
+	if rankings[0].TotalPoints != 8 || rankings[1].TotalPoints != 9 {
+		t.Errorf("Expected the raw points to be kept, got %d and %d", rankings[0].TotalPoints, rankings[1].TotalPoints)
+	}
+	if behaviorLogRepo.groupQueries != 1 || behaviorLogRepo.petQueries != 0 {
+		t.Errorf("Expected one breakdown query for the group day, got %d group and %d pet queries",
+			behaviorLogRepo.groupQueries, behaviorLogRepo.petQueries)
+	}
+}
+
+func TestRankingService_GetTrendingPets(t *testing.T) {
+	ctx := context.Background()
+	day := func(d int) time.Time { return time.Date(2025, time.March, d, 0, 0, 0, 0, time.UTC) }
+	now := time.Date(2025, time.March, 20, 22, 0, 0, 0, time.UTC)
+
+	// Setup
+	dailyScoreRepo := mock.NewMockDailyScoreRepository()
+	authRepo := mock.NewMockAuthorizationRepository()
+	service := NewRankingService(
+		dailyScoreRepo,
+		mock.NewMockRankingReadModelRepository(dailyScoreRepo, authRepo),
+		mock.NewMockBehaviorLogRepository(),
+		mock.NewMockPetOfTheDayRepository(),
+		authRepo,
+		mock.NewMockUserSettingsRepository(),
+		mock.NewMockDailyResetStateRepository(),
+		mock.NewMockScoringRulesRepository(),
+		events.NewInMemoryBus(),
+	)
+
+	// Test data
+	ownerID, groupID := uuid.New(), uuid.New()
+	risingID, newcomerID, decliningID := uuid.New(), uuid.New(), uuid.New()
+	authRepo.AddUserGroup(ownerID, groupID, &domain.GroupInfo{ID: groupID, Name: "Park friends", OwnerID: ownerID})
+	for _, petID := range []uuid.UUID{risingID, newcomerID, decliningID} {
+		authRepo.AddPetToGroup(petID, groupID)
+	}
+
+	addPoints := func(petID uuid.UUID, d, points int) {
+		dailyScore, err := dailyScoreRepo.GetOrCreate(ctx, petID, groupID, day(d))
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		dailyScore.AddBehaviorLog(&domain.BehaviorLog{PetID: petID, PointsAwarded: points, LoggedAt: day(d)})
+	}
+
+	// With a 2 day window the recent days are March 19-20 and the baseline is March 13-18
+	for d := 13; d <= 18; d++ {
+		addPoints(risingID, d, 1)
+	}
+	addPoints(risingID, 19, 5)
+	addPoints(risingID, 20, 5)
+
+	addPoints(newcomerID, 20, 2)
+	addPoints(newcomerID, 21, 50) // Day in progress is ignored
+
+	for d := 13; d <= 15; d++ {
+		addPoints(decliningID, d, 4)
+	}
+
+	t.Run("Ranks pets by momentum", func(t *testing.T) {
+		trending, err := service.getTrendingPets(ctx, groupID, 2, now)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(trending) != 3 {
+			t.Fatalf("Expected 3 trending pets, got %d", len(trending))
+		}
+
+		rising, newcomer, declining := trending[0], trending[1], trending[2]
+		if rising.PetID != risingID || newcomer.PetID != newcomerID || declining.PetID != decliningID {
+			t.Fatalf("Unexpected trending order: %v, %v, %v", rising.PetID, newcomer.PetID, declining.PetID)
+		}
+
+		if rising.Rank != 1 || rising.Delta != 4 || rising.PercentChange == nil || *rising.PercentChange != 400 {
+			t.Errorf("Expected rank 1 with delta 4 and +400%%, got %+v", rising)
+		}
+		if rising.LowConfidence {
+			t.Error("Expected pet with a full baseline to be confident")
+		}
+
+		if newcomer.RecentPoints != 2 || newcomer.PercentChange != nil || !newcomer.LowConfidence {
+			t.Errorf("Expected newcomer with 2 recent points, no percentage and low confidence, got %+v", newcomer)
+		}
+
+		if declining.Delta != -2 || declining.PercentChange == nil || *declining.PercentChange != -100 {
+			t.Errorf("Expected declining pet with delta -2 and -100%%, got %+v", declining)
+		}
+	})
+
+	for _, days := range []int{0, 31} {
+		t.Run("Rejects invalid days", func(t *testing.T) {
+			_, err := service.getTrendingPets(ctx, groupID, days, now)
+			if _, ok := err.(*domain.ValidationError); !ok {
+				t.Errorf("Expected ValidationError for %d days, got %T", days, err)
+			}
+		})
+	}
+}
+
+func TestLastClosedDayFor(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip("timezone data not available")
+	}
+
+	config := timezone.UserTimeConfig{DailyResetTime: "21:00", Timezone: "Europe/Paris"}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want time.Time
+	}{
+		{
+			// 20:30 UTC is 21:30 in Paris (winter time), after the 21:00 reset
+			name: "After the reset time",
+			now:  time.Date(2025, time.January, 15, 20, 30, 0, 0, time.UTC),
+			want: time.Date(2025, time.January, 15, 0, 0, 0, 0, paris),
+		},
+		{
+			name: "Before the reset time",
+			now:  time.Date(2025, time.January, 15, 19, 30, 0, 0, time.UTC),
+			want: time.Date(2025, time.January, 14, 0, 0, 0, 0, paris),
+		},
+		{
+			// A log made at the reset instant still counts for January 15
+			name: "At the reset instant",
+			now:  time.Date(2025, time.January, 15, 20, 0, 0, 0, time.UTC),
+			want: time.Date(2025, time.January, 14, 0, 0, 0, 0, paris),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			closed, err := lastClosedDayFor(tt.now, config)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !closed.Equal(tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, closed)
+			}
+
+			// The day the moment belongs to is the one after the last closed day
+			day, err := timezone.GetUserDate(tt.now, config)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !day.Equal(closed.AddDate(0, 0, 1)) {
+				t.Errorf("Expected %v to belong to the day after %v, got %v", tt.now, closed, day)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+)
+
+// scoreEventMigrationPageSize is the number of score events read and converted at once
+const scoreEventMigrationPageSize = 500
+
+// ScoreEventIssue is a score event that could not be migrated or does not match its behavior log
+type ScoreEventIssue struct {
+	EventID uuid.UUID `json:"event_id"`
+	PetID   uuid.UUID `json:"pet_id"`
+	GroupID uuid.UUID `json:"group_id"`
+	Reason  string    `json:"reason"`
+}
+
+// ScoreEventMigrationReport summarizes a migration of legacy score events. On a dry run the
+// counts are what a migration would do, nothing is written.
+type ScoreEventMigrationReport struct {
+	DryRun           bool               `json:"dry_run"`
+	Scanned          int                `json:"scanned"`
+	Migrated         int                `json:"migrated"`
+	AlreadyMigrated  int                `json:"already_migrated"`
+	Skipped          []*ScoreEventIssue `json:"skipped"`
+	RecalculatedDays int                `json:"recalculated_days"` // Daily scores recomputed from logs
+}
+
+// MigratedPointTotals compares the points of a pet in a group in both scoring systems
+type MigratedPointTotals struct {
+	PetID          uuid.UUID `json:"pet_id"`
+	GroupID        uuid.UUID `json:"group_id"`
+	Events         int       `json:"events"`
+	LegacyPoints   int       `json:"legacy_points"`
+	MigratedPoints int       `json:"migrated_points"` // Points of the behavior logs converted from the events
+}
+
+// ScoreEventVerificationReport lists the score events whose behavior log is missing or differs
+type ScoreEventVerificationReport struct {
+	Checked    int                    `json:"checked"`
+	Verified   int                    `json:"verified"`
+	Mismatches []*ScoreEventIssue     `json:"mismatches"`
+	Totals     []*MigratedPointTotals `json:"totals"`
+}
+
+// ScoreEventMigrationService converts the score events of the legacy scoring system into behavior
+// logs and recomputes the daily scores of the days they changed
+type ScoreEventMigrationService struct {
+	source           domain.ScoreEventSource
+	behaviorLogRepo  domain.BehaviorLogRepository
+	dailyScoreRepo   domain.DailyScoreRepository
+	authRepo         domain.AuthorizationRepository
+	userSettingsRepo domain.UserSettingsRepository
+}
+
+// NewScoreEventMigrationService creates a new score event migration service
+func NewScoreEventMigrationService(
+	source domain.ScoreEventSource,
+	behaviorLogRepo domain.BehaviorLogRepository,
+	dailyScoreRepo domain.DailyScoreRepository,
+	authRepo domain.AuthorizationRepository,
+	userSettingsRepo domain.UserSettingsRepository,
+) *ScoreEventMigrationService {
+	return &ScoreEventMigrationService{
+		source:           source,
+		behaviorLogRepo:  behaviorLogRepo,
+		dailyScoreRepo:   dailyScoreRepo,
+		authRepo:         authRepo,
+		userSettingsRepo: userSettingsRepo,
+	}
+}
+
+// scoreDay identifies the daily score of a pet in a group
+type scoreDay struct {
+	petID   uuid.UUID
+	groupID uuid.UUID
+	date    time.Time
+}
+
+// Migrate converts every score event that has no behavior log yet, then recomputes the daily
+// scores of the days that received logs. Migrations can be run again, converted events are
+// skipped.
+func (s *ScoreEventMigrationService) Migrate(ctx context.Context, dryRun bool) (*ScoreEventMigrationReport, error) {
+	report := &ScoreEventMigrationReport{DryRun: dryRun, Skipped: make([]*ScoreEventIssue, 0)}
+	days := make(map[scoreDay]bool)
+	dayOrder := make([]scoreDay, 0)
+	calendar := NewGroupCalendar(s.authRepo, s.userSettingsRepo)
+
+	for offset := 0; ; offset += scoreEventMigrationPageSize {
+		events, err := s.source.ListScoreEvents(ctx, offset, scoreEventMigrationPageSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list score events: %w", err)
+		}
+		if len(events) == 0 {
+			break
+		}
+
+		migrated, err := s.migratedEvents(ctx, events)
+		if err != nil {
+			return nil, err
+		}
+
+		batch := make([]*domain.BehaviorLog, 0, len(events))
+		for _, event := range events {
+			report.Scanned++
+
+			if migrated[event.ID] {
+				report.AlreadyMigrated++
+				continue
+			}
+
+			behaviorLog, err := domain.NewBehaviorLogFromScoreEvent(event)
+			if err != nil {
+				report.Skipped = append(report.Skipped, newScoreEventIssue(event, err.Error()))
+				continue
+			}
+
+			date, err := calendar.LogDay(ctx, event.GroupID, behaviorLog)
+			if err != nil {
+				report.Skipped = append(report.Skipped, newScoreEventIssue(event, err.Error()))
+				continue
+			}
+
+			day := scoreDay{petID: event.PetID, groupID: event.GroupID, date: date}
+			if !days[day] {
+				days[day] = true
+				dayOrder = append(dayOrder, day)
+			}
+			batch = append(batch, behaviorLog)
+		}
+
+		report.Migrated += len(batch)
+		if dryRun || len(batch) == 0 {
+			continue
+		}
+
+		if err := s.behaviorLogRepo.CreateBatch(ctx, batch); err != nil {
+			return nil, fmt.Errorf("failed to save behavior logs of score events %d to %d: %w", offset, offset+len(events), err)
+		}
+	}
+
+	report.RecalculatedDays = len(dayOrder)
+	if dryRun {
+		return report, nil
+	}
+
+	for _, day := range dayOrder {
+		if _, err := s.dailyScoreRepo.RecalculateFromLogs(ctx, day.petID, day.groupID, day.date); err != nil {
+			return nil, fmt.Errorf("failed to recalculate daily score of pet %s in group %s on %s: %w",
+				day.petID, day.groupID, day.date.Format("2006-01-02"), err)
+		}
+	}
+
+	return report, nil
+}
+
+// Verify checks that every score event has a behavior log counted in the event's group with the
+// same points, and compares the points of each pet and group in both systems
+func (s *ScoreEventMigrationService) Verify(ctx context.Context) (*ScoreEventVerificationReport, error) {
+	report := &ScoreEventVerificationReport{Mismatches: make([]*ScoreEventIssue, 0)}
+	totalsByKey := make(map[[2]uuid.UUID]*MigratedPointTotals)
+
+	for offset := 0; ; offset += scoreEventMigrationPageSize {
+		events, err := s.source.ListScoreEvents(ctx, offset, scoreEventMigrationPageSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list score events: %w", err)
+		}
+		if len(events) == 0 {
+			break
+		}
+
+		for _, event := range events {
+			report.Checked++
+
+			key := [2]uuid.UUID{event.PetID, event.GroupID}
+			totals, exists := totalsByKey[key]
+			if !exists {
+				totals = &MigratedPointTotals{PetID: event.PetID, GroupID: event.GroupID}
+				totalsByKey[key] = totals
+			}
+			totals.Events++
+			totals.LegacyPoints += event.Points
+
+			behaviorLog, err := s.behaviorLogRepo.GetByID(ctx, event.ID)
+			if errors.Is(err, domain.ErrBehaviorLogNotFound) {
+				report.Mismatches = append(report.Mismatches, newScoreEventIssue(event, "no behavior log"))
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to get behavior log of score event %s: %w", event.ID, err)
+			}
+
+			if behaviorLog.IsCountedInGroup(event.GroupID) {
+				totals.MigratedPoints += behaviorLog.PointsForGroup(event.GroupID)
+			}
+
+			switch {
+			case behaviorLog.PetID != event.PetID || behaviorLog.BehaviorID != event.BehaviorID:
+				report.Mismatches = append(report.Mismatches, newScoreEventIssue(event, "behavior log is for another pet or behavior"))
+			case !behaviorLog.IsCountedInGroup(event.GroupID):
+				report.Mismatches = append(report.Mismatches, newScoreEventIssue(event, "behavior log is not counted in the group"))
+			case behaviorLog.PointsForGroup(event.GroupID) != event.Points:
+				report.Mismatches = append(report.Mismatches, newScoreEventIssue(event, fmt.Sprintf(
+					"behavior log awards %d points instead of %d", behaviorLog.PointsForGroup(event.GroupID), event.Points)))
+			default:
+				report.Verified++
+			}
+		}
+	}
+
+	report.Totals = make([]*MigratedPointTotals, 0, len(totalsByKey))
+	for _, totals := range totalsByKey {
+		report.Totals = append(report.Totals, totals)
+	}
+	sort.Slice(report.Totals, func(i, j int) bool {
+		if report.Totals[i].GroupID != report.Totals[j].GroupID {
+			return report.Totals[i].GroupID.String() < report.Totals[j].GroupID.String()
+		}
+		return report.Totals[i].PetID.String() < report.Totals[j].PetID.String()
+	})
+
+	return report, nil
+}
+
+// migratedEvents returns which score events of a page already have their behavior log, with one
+// query for the page
+func (s *ScoreEventMigrationService) migratedEvents(ctx context.Context, events []domain.ScoreEvent) (map[uuid.UUID]bool, error) {
+	ids := make([]uuid.UUID, len(events))
+	for i, event := range events {
+		ids[i] = event.ID
+	}
+
+	existing, err := s.behaviorLogRepo.FindExistingIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find migrated score events: %w", err)
+	}
+
+	migrated := make(map[uuid.UUID]bool, len(existing))
+	for _, id := range existing {
+		migrated[id] = true
+	}
+	return migrated, nil
+}
+
+// newScoreEventIssue reports a problem with a score event
+func newScoreEventIssue(event domain.ScoreEvent, reason string) *ScoreEventIssue {
+	return &ScoreEventIssue{
+		EventID: event.ID,
+		PetID:   event.PetID,
+		GroupID: event.GroupID,
+		Reason:  reason,
+	}
+}
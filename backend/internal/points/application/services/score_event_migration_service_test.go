@@ -0,0 +1,254 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+)
+
+// unreachableBehaviorLogRepository fails to look up behavior logs
+type unreachableBehaviorLogRepository struct {
+	*mock.MockBehaviorLogRepository
+}
+
+func (r *unreachableBehaviorLogRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.BehaviorLog, error) {
+	return nil, errors.New("connection lost")
+}
+
+func (r *unreachableBehaviorLogRepository) FindExistingIDs(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error) {
+	return nil, errors.New("connection lost")
+}
+
+func TestScoreEventMigrationService_Migrate(t *testing.T) {
+	ctx := context.Background()
+	petID, groupID, userID, behaviorID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	// Events count on the days of their group, which follows the default settings of its owner
+	authRepo := mock.NewMockAuthorizationRepository()
+	authRepo.AddUserGroup(userID, groupID, &domain.GroupInfo{ID: groupID, Name: "Park friends", OwnerID: userID})
+	userSettingsRepo := mock.NewMockUserSettingsRepository()
+
+	// Each case migrates its own legacy events, recorded on days of March 2025
+	type migration struct {
+		service         *ScoreEventMigrationService
+		source          *infrastructure.MockScoreEventRepository
+		behaviorLogRepo *mock.MockBehaviorLogRepository
+		dailyScoreRepo  *mock.MockDailyScoreRepository
+	}
+	newMigration := func() *migration {
+		m := &migration{
+			source:          infrastructure.NewMockScoreEventRepository(),
+			behaviorLogRepo: mock.NewMockBehaviorLogRepository(),
+		}
+		m.dailyScoreRepo = mock.NewMockDailyScoreRepository().
+			WithRecalculationSources(m.behaviorLogRepo, mock.NewMockPointAdjustmentRepository())
+		m.service = NewScoreEventMigrationService(m.source, m.behaviorLogRepo, m.dailyScoreRepo, authRepo, userSettingsRepo)
+		return m
+	}
+	addEvent := func(t *testing.T, m *migration, day, points int) domain.ScoreEvent {
+		t.Helper()
+
+		actionDate := time.Date(2025, time.March, day, 10, 0, 0, 0, time.UTC)
+		event, err := m.source.Create(ctx, domain.ScoreEvent{
+			ID:           uuid.New(),
+			PetID:        petID,
+			BehaviorID:   behaviorID,
+			GroupID:      groupID,
+			RecordedByID: userID,
+			Points:       points,
+			Comment:      "from the old app",
+			ActionDate:   actionDate,
+			RecordedAt:   actionDate.Add(time.Minute),
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		return *event
+	}
+	logCount := func(m *migration) int {
+		behaviorLogs, _ := m.behaviorLogRepo.Find(ctx, domain.NewBehaviorLogFilter().WithPet(petID).WithPagination(100, 0))
+		return len(behaviorLogs)
+	}
+	totalPoints := func(m *migration) int {
+		dailyScores, _ := m.dailyScoreRepo.Find(ctx, domain.NewDailyScoreFilter().WithPet(petID).WithGroup(groupID))
+		total := 0
+		for _, dailyScore := range dailyScores {
+			total += dailyScore.TotalPoints
+		}
+		return total
+	}
+
+	t.Run("Dry run writes nothing", func(t *testing.T) {
+		m := newMigration()
+		addEvent(t, m, 10, 5)
+		addEvent(t, m, 11, -3)
+
+		report, err := m.service.Migrate(ctx, true)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !report.DryRun || report.Scanned != 2 || report.Migrated != 2 || report.RecalculatedDays != 2 {
+			t.Errorf("Expected 2 events to migrate over 2 days, got %+v", report)
+		}
+		if count := logCount(m); count != 0 {
+			t.Errorf("Expected no behavior logs on a dry run, got %d", count)
+		}
+	})
+
+	t.Run("Converts events and recomputes daily scores", func(t *testing.T) {
+		m := newMigration()
+		event := addEvent(t, m, 10, 5)
+		addEvent(t, m, 10, 2)
+		addEvent(t, m, 11, -3)
+
+		report, err := m.service.Migrate(ctx, false)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if report.Migrated != 3 || report.RecalculatedDays != 2 || len(report.Skipped) != 0 {
+			t.Errorf("Expected 3 events migrated over 2 days, got %+v", report)
+		}
+
+		behaviorLog, err := m.behaviorLogRepo.GetByID(ctx, event.ID)
+		if err != nil {
+			t.Fatalf("Expected the log to keep the event ID, got %v", err)
+		}
+		if behaviorLog.UserID != userID || !behaviorLog.LoggedAt.Equal(event.ActionDate) || behaviorLog.Notes != event.Comment {
+			t.Errorf("Expected the log to copy the event, got %+v", behaviorLog)
+		}
+		if !behaviorLog.IsCountedInGroup(groupID) || behaviorLog.PointsForGroup(groupID) != 5 {
+			t.Errorf("Expected the log to award 5 points in the group")
+		}
+		if total := totalPoints(m); total != 4 {
+			t.Errorf("Expected daily scores to total 4 points, got %d", total)
+		}
+	})
+
+	t.Run("Can be run again", func(t *testing.T) {
+		m := newMigration()
+		addEvent(t, m, 10, 5)
+		if _, err := m.service.Migrate(ctx, false); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		addEvent(t, m, 12, 4)
+
+		report, err := m.service.Migrate(ctx, false)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if report.Migrated != 1 || report.AlreadyMigrated != 1 {
+			t.Errorf("Expected only the new event to migrate, got %+v", report)
+		}
+		if count := logCount(m); count != 2 {
+			t.Errorf("Expected 2 behavior logs, got %d", count)
+		}
+		if total := totalPoints(m); total != 9 {
+			t.Errorf("Expected daily scores to total 9 points, got %d", total)
+		}
+	})
+
+	t.Run("Skips invalid events", func(t *testing.T) {
+		m := newMigration()
+		addEvent(t, m, 10, 5)
+		invalid, _ := m.source.Create(ctx, domain.ScoreEvent{
+			ID:         uuid.New(),
+			PetID:      petID,
+			BehaviorID: behaviorID,
+			GroupID:    groupID,
+			Points:     3,
+			ActionDate: time.Date(2025, time.March, 10, 10, 0, 0, 0, time.UTC),
+		})
+
+		report, err := m.service.Migrate(ctx, false)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if report.Migrated != 1 || len(report.Skipped) != 1 || report.Skipped[0].EventID != invalid.ID {
+			t.Errorf("Expected the event without a recorder to be skipped, got %+v", report)
+		}
+	})
+
+	t.Run("Stops when migrated events cannot be looked up", func(t *testing.T) {
+		m := newMigration()
+		addEvent(t, m, 10, 5)
+		service := NewScoreEventMigrationService(
+			m.source, &unreachableBehaviorLogRepository{m.behaviorLogRepo}, m.dailyScoreRepo, authRepo, userSettingsRepo,
+		)
+
+		if _, err := service.Migrate(ctx, false); err == nil {
+			t.Error("Expected an error instead of migrating the event again")
+		}
+		if _, err := service.Verify(ctx); err == nil {
+			t.Error("Expected an error instead of a missing behavior log")
+		}
+	})
+}
+
+func TestScoreEventMigrationService_Verify(t *testing.T) {
+	ctx := context.Background()
+	petID, groupID, userID, behaviorID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	source := infrastructure.NewMockScoreEventRepository()
+	behaviorLogRepo := mock.NewMockBehaviorLogRepository()
+	dailyScoreRepo := mock.NewMockDailyScoreRepository().
+		WithRecalculationSources(behaviorLogRepo, mock.NewMockPointAdjustmentRepository())
+	authRepo := mock.NewMockAuthorizationRepository()
+	authRepo.AddUserGroup(userID, groupID, &domain.GroupInfo{ID: groupID, Name: "Park friends", OwnerID: userID})
+	service := NewScoreEventMigrationService(source, behaviorLogRepo, dailyScoreRepo, authRepo, mock.NewMockUserSettingsRepository())
+
+	var events []*domain.ScoreEvent
+	for day, points := range map[int]int{10: 5, 11: -3} {
+		actionDate := time.Date(2025, time.March, day, 10, 0, 0, 0, time.UTC)
+		event, _ := source.Create(ctx, domain.ScoreEvent{
+			ID:           uuid.New(),
+			PetID:        petID,
+			BehaviorID:   behaviorID,
+			GroupID:      groupID,
+			RecordedByID: userID,
+			Points:       points,
+			ActionDate:   actionDate,
+			RecordedAt:   actionDate,
+		})
+		events = append(events, event)
+	}
+
+	report, err := service.Verify(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if report.Checked != 2 || len(report.Mismatches) != 2 || report.Mismatches[0].Reason != "no behavior log" {
+		t.Errorf("Expected both events to be unmigrated, got %+v", report)
+	}
+
+	if _, err := service.Migrate(ctx, false); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	report, err = service.Verify(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if report.Verified != 2 || len(report.Mismatches) != 0 {
+		t.Errorf("Expected both events to be verified, got %+v", report)
+	}
+	if len(report.Totals) != 1 || report.Totals[0].LegacyPoints != 2 || report.Totals[0].MigratedPoints != 2 {
+		t.Errorf("Expected 2 points in both systems, got %+v", report.Totals)
+	}
+
+	// A log edited after the migration no longer matches its event
+	behaviorLog, _ := behaviorLogRepo.GetByID(ctx, events[0].ID)
+	behaviorLog.GroupShares[0].PointsAwarded = events[0].Points + 2
+	report, err = service.Verify(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(report.Mismatches) != 1 || report.Mismatches[0].EventID != events[0].ID {
+		t.Errorf("Expected a points mismatch, got %+v", report.Mismatches)
+	}
+}
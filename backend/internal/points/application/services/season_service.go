@@ -0,0 +1,169 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+)
+
+// SeasonService computes season standings and closes seasons at the end of their last day
+type SeasonService struct {
+	seasonRepo     domain.SeasonRepository
+	dailyScoreRepo domain.DailyScoreRepository
+	authRepo       domain.AuthorizationRepository
+}
+
+// NewSeasonService creates a new season service
+func NewSeasonService(
+	seasonRepo domain.SeasonRepository,
+	dailyScoreRepo domain.DailyScoreRepository,
+	authRepo domain.AuthorizationRepository,
+) *SeasonService {
+	return &SeasonService{
+		seasonRepo:     seasonRepo,
+		dailyScoreRepo: dailyScoreRepo,
+		authRepo:       authRepo,
+	}
+}
+
+// GetStandings returns the standings of a season. Closed seasons return their archived
+// standings, running seasons are computed from the daily scores up to asOf.
+func (s *SeasonService) GetStandings(ctx context.Context, season *domain.Season, asOf time.Time) ([]*domain.SeasonStanding, error) {
+	if season.IsClosed() {
+		standings, err := s.seasonRepo.GetFinalStandings(ctx, season.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get final standings: %w", err)
+		}
+		return standings, nil
+	}
+
+	asOfDay := asOf.In(season.EndDate.Location())
+	to := time.Date(asOfDay.Year(), asOfDay.Month(), asOfDay.Day(), 0, 0, 0, 0, season.EndDate.Location())
+	if to.After(season.EndDate) {
+		to = season.EndDate
+	}
+
+	return s.computeStandings(ctx, season, to)
+}
+
+// CloseSeason archives the final standings of a season and moves pets to their new tiers.
+// The archive, the tiers and the season status are saved together, so a failed close can
+// simply run again.
+func (s *SeasonService) CloseSeason(ctx context.Context, season *domain.Season) ([]*domain.SeasonStanding, error) {
+	standings, err := s.computeStandings(ctx, season, season.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	closed := *season
+	if err := closed.Close(time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.seasonRepo.CloseSeason(ctx, &closed, standings); err != nil {
+		return nil, fmt.Errorf("failed to close season: %w", err)
+	}
+	*season = closed
+
+	return standings, nil
+}
+
+// OnDayClosed closes the group's seasons whose last day has closed.
+// It is registered as a daily reset hook so seasons close in the group's timezone.
+func (s *SeasonService) OnDayClosed(ctx context.Context, groupID uuid.UUID, day time.Time) error {
+	seasons, err := s.seasonRepo.GetByGroup(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("failed to get group seasons: %w", err)
+	}
+
+	for _, season := range seasons {
+		if !season.IsDueForClose(day) {
+			continue
+		}
+
+		standings, err := s.CloseSeason(ctx, season)
+		if err != nil {
+			return fmt.Errorf("failed to close season %s: %w", season.ID, err)
+		}
+
+		log.Printf("Season %q of group %s closed with %d ranked pets", season.Name, groupID, len(standings))
+	}
+
+	return nil
+}
+
+// computeStandings ranks the pets of a season from its first day up to the given day.
+// Only pets still in the group are ranked.
+func (s *SeasonService) computeStandings(ctx context.Context, season *domain.Season, to time.Time) ([]*domain.SeasonStanding, error) {
+	rankings := make([]*domain.PetRanking, 0)
+	if !to.Before(season.StartDate) {
+		var err error
+		rankings, err = s.dailyScoreRepo.GetRankingsByDateRange(ctx, season.GroupID, season.StartDate, to)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get season rankings: %w", err)
+		}
+	}
+
+	tiers, err := s.seasonRepo.GetLeagueTiers(ctx, season.GroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get league tiers: %w", err)
+	}
+
+	members := make(map[uuid.UUID]bool, len(tiers))
+	isMember := func(petID uuid.UUID) (bool, error) {
+		if member, checked := members[petID]; checked {
+			return member, nil
+		}
+		member, err := s.authRepo.IsPetInGroup(ctx, petID, season.GroupID)
+		if err != nil {
+			return false, fmt.Errorf("failed to check group membership: %w", err)
+		}
+		members[petID] = member
+		return member, nil
+	}
+
+	memberRankings := make([]*domain.PetRanking, 0, len(rankings))
+	for _, ranking := range rankings {
+		member, err := isMember(ranking.PetID)
+		if err != nil {
+			return nil, err
+		}
+		if member {
+			memberRankings = append(memberRankings, ranking)
+		}
+	}
+	for petID := range tiers {
+		member, err := isMember(petID)
+		if err != nil {
+			return nil, err
+		}
+		if !member {
+			delete(tiers, petID)
+		}
+	}
+
+	standings := domain.ComputeSeasonStandings(season, memberRankings, tiers)
+
+	// Pets without points this season are not part of the rankings and have no names yet
+	for _, standing := range standings {
+		if standing.PetName != "" {
+			continue
+		}
+
+		petInfo, err := s.authRepo.GetPetInfo(ctx, standing.PetID)
+		if err != nil {
+			continue
+		}
+		standing.PetName = petInfo.Name
+
+		if ownerInfo, err := s.authRepo.GetUserInfo(ctx, petInfo.OwnerID); err == nil {
+			standing.OwnerName = ownerInfo.Name
+		}
+	}
+
+	return standings, nil
+}
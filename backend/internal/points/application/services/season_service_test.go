@@ -0,0 +1,161 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+	"pet-of-the-day/internal/shared/events"
+)
+
+func TestSeasonService_CloseOnDailyReset(t *testing.T) {
+	ctx := context.Background()
+	day := func(d int) time.Time { return time.Date(2025, time.March, d, 0, 0, 0, 0, time.UTC) }
+
+	// Setup
+	dailyScoreRepo := mock.NewMockDailyScoreRepository()
+	petOfTheDayRepo := mock.NewMockPetOfTheDayRepository()
+	authRepo := mock.NewMockAuthorizationRepository()
+	rankingService := NewRankingService(
+		dailyScoreRepo,
+		mock.NewMockRankingReadModelRepository(dailyScoreRepo, authRepo),
+		mock.NewMockBehaviorLogRepository(),
+		petOfTheDayRepo,
+		authRepo,
+		mock.NewMockUserSettingsRepository(),
+		mock.NewMockDailyResetStateRepository(),
+		mock.NewMockScoringRulesRepository(),
+		events.NewInMemoryBus(),
+	)
+	seasonRepo := mock.NewMockSeasonRepository()
+	seasonService := NewSeasonService(seasonRepo, dailyScoreRepo, authRepo)
+	rankingService.AddDayClosedHook(seasonService)
+
+	// Test data
+	ownerID, groupID, petID := uuid.New(), uuid.New(), uuid.New()
+	authRepo.AddUser(ownerID, &domain.UserInfo{ID: ownerID, Name: "Alice"})
+	authRepo.AddUserGroup(ownerID, groupID, &domain.GroupInfo{ID: groupID, Name: "Park friends", OwnerID: ownerID})
+	authRepo.AddUserPet(ownerID, petID, &domain.PetInfo{ID: petID, Name: "Rex", Species: domain.SpeciesDog, OwnerID: ownerID})
+	authRepo.AddPetToGroup(petID, groupID)
+
+	addPoints := func(petID uuid.UUID, date time.Time, points int) {
+		dailyScore, err := dailyScoreRepo.GetOrCreate(ctx, petID, groupID, date)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		dailyScore.AddBehaviorLog(&domain.BehaviorLog{PetID: petID, PointsAwarded: points, LoggedAt: date})
+	}
+
+	milo, bella := uuid.New(), uuid.New()
+	authRepo.AddUserPet(ownerID, milo, &domain.PetInfo{ID: milo, Name: "Milo", Species: domain.SpeciesCat, OwnerID: ownerID})
+	authRepo.AddUserPet(ownerID, bella, &domain.PetInfo{ID: bella, Name: "Bella", Species: domain.SpeciesDog, OwnerID: ownerID})
+	authRepo.AddPetToGroup(milo, groupID)
+	authRepo.AddPetToGroup(bella, groupID)
+
+	// Luna scored and held a tier, then left the group before the season ended
+	luna := uuid.New()
+	authRepo.AddUserPet(ownerID, luna, &domain.PetInfo{ID: luna, Name: "Luna", Species: domain.SpeciesCat, OwnerID: ownerID})
+
+	season, err := domain.NewSeason(groupID, ownerID, "Spring", day(1), day(10), 1, 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	seasonRepo.Create(ctx, season)
+	seasonRepo.SaveLeagueTiers(ctx, groupID, map[uuid.UUID]domain.LeagueTier{
+		petID: domain.LeagueTierSilver,
+		milo:  domain.LeagueTierSilver,
+		luna:  domain.LeagueTierGold,
+	})
+
+	addPoints(petID, day(2), 12)
+	addPoints(milo, day(9), 4)
+	addPoints(bella, day(5), 2)
+	addPoints(luna, day(3), 30)
+	// Outside the season
+	addPoints(milo, day(11), 50)
+
+	t.Run("Live standings before the season ends", func(t *testing.T) {
+		if err := rankingService.runDailyReset(ctx, time.Date(2025, time.March, 9, 21, 30, 0, 0, time.UTC)); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		current, _ := seasonRepo.GetByID(ctx, season.ID)
+		if current.IsClosed() {
+			t.Fatal("Expected season to stay open before its last day closes")
+		}
+
+		standings, err := seasonService.GetStandings(ctx, current, day(9))
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(standings) != 3 || standings[0].PetID != petID || standings[0].Tier != domain.LeagueTierSilver {
+			t.Fatalf("Expected Rex leading silver, got %+v", standings)
+		}
+	})
+
+	t.Run("Closes, archives and moves pets between tiers", func(t *testing.T) {
+		if err := rankingService.runDailyReset(ctx, time.Date(2025, time.March, 11, 22, 0, 0, 0, time.UTC)); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		closed, _ := seasonRepo.GetByID(ctx, season.ID)
+		if !closed.IsClosed() || closed.ClosedAt == nil {
+			t.Fatalf("Expected season to be closed, got %+v", closed)
+		}
+
+		standings, err := seasonRepo.GetFinalStandings(ctx, season.ID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		expected := map[uuid.UUID]struct {
+			points   int
+			movement domain.LeagueMovement
+			next     domain.LeagueTier
+		}{
+			petID: {12, domain.LeagueMovementPromoted, domain.LeagueTierGold},
+			milo:  {4, domain.LeagueMovementRelegated, domain.LeagueTierBronze},
+			bella: {2, domain.LeagueMovementPromoted, domain.LeagueTierSilver},
+		}
+		if len(standings) != len(expected) {
+			t.Fatalf("Expected %d archived standings, got %d", len(expected), len(standings))
+		}
+		for _, standing := range standings {
+			want := expected[standing.PetID]
+			if standing.Points != want.points || standing.Movement != want.movement || standing.NextTier != want.next {
+				t.Errorf("Unexpected standing for %s: %+v", standing.PetName, standing)
+			}
+		}
+		if standings[0].Tier != domain.LeagueTierSilver || standings[2].Tier != domain.LeagueTierBronze {
+			t.Errorf("Expected standings ordered by tier, highest first")
+		}
+
+		tiers, _ := seasonRepo.GetLeagueTiers(ctx, groupID)
+		if tiers[petID] != domain.LeagueTierGold || tiers[milo] != domain.LeagueTierBronze || tiers[bella] != domain.LeagueTierSilver {
+			t.Errorf("Expected league tiers to be updated, got %v", tiers)
+		}
+		if _, exists := tiers[luna]; exists || len(tiers) != 3 {
+			t.Errorf("Expected pets that left the group to leave the league, got %v", tiers)
+		}
+	})
+
+	t.Run("Closed season standings come from the archive", func(t *testing.T) {
+		closed, _ := seasonRepo.GetByID(ctx, season.ID)
+		// Score logged after the close must not change the archived results
+		addPoints(bella, day(10), 100)
+
+		standings, err := seasonService.GetStandings(ctx, closed, day(20))
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		for _, standing := range standings {
+			if standing.PetID == bella && standing.Points != 2 {
+				t.Errorf("Expected archived points, got %d", standing.Points)
+			}
+		}
+	})
+}
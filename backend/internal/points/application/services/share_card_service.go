@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"io"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/upload"
+)
+
+// localUploadsPrefix is the URL path under which uploaded files are served
+const localUploadsPrefix = "/uploads/"
+
+// CardRenderer draws the PNG image of a share card
+type CardRenderer interface {
+	Render(w io.Writer, card *domain.ShareCard, photo image.Image) error
+}
+
+// ShareCardService renders Pet of the Day share cards and caches them on disk. A cached card
+// is served until anything it shows changes, e.g. after a late correction or a new photo.
+type ShareCardService struct {
+	settingsRepo    domain.ShareCardSettingsRepository
+	petOfTheDayRepo domain.PetOfTheDayRepository
+	behaviorLogRepo domain.BehaviorLogRepository
+	authRepo        domain.AuthorizationRepository
+	uploadService   *upload.FileUploadService
+	renderer        CardRenderer
+	cacheDir        string
+}
+
+// NewShareCardService creates a new share card service. Pet photos are read from the
+// uploads of the upload service and cards are cached under the cache directory.
+func NewShareCardService(
+	settingsRepo domain.ShareCardSettingsRepository,
+	petOfTheDayRepo domain.PetOfTheDayRepository,
+	behaviorLogRepo domain.BehaviorLogRepository,
+	authRepo domain.AuthorizationRepository,
+	uploadService *upload.FileUploadService,
+	renderer CardRenderer,
+	cacheDir string,
+) *ShareCardService {
+	return &ShareCardService{
+		settingsRepo:    settingsRepo,
+		petOfTheDayRepo: petOfTheDayRepo,
+		behaviorLogRepo: behaviorLogRepo,
+		authRepo:        authRepo,
+		uploadService:   uploadService,
+		renderer:        renderer,
+		cacheDir:        cacheDir,
+	}
+}
+
+// GetCard returns the PNG card of the Pet of the Day of a group day. Among tied winners the
+// card shows the given pet, or the first winner without one. The caller closes the card.
+func (s *ShareCardService) GetCard(ctx context.Context, groupID uuid.UUID, date time.Time, petID *uuid.UUID) (io.ReadCloser, error) {
+	card, photoURL, err := s.buildCard(ctx, groupID, date, petID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tied winners each have their card, named after the day and the pet
+	dir := filepath.Join(s.cacheDir, groupID.String())
+	prefix := card.Date.Format("2006-01-02") + "-" + card.PetID.String()
+	cachedPath := filepath.Join(dir, prefix+"-"+card.CacheKey()+".png")
+
+	if cached, err := os.Open(cachedPath); err == nil {
+		return cached, nil
+	}
+
+	if err := s.render(card, s.loadPhoto(photoURL), dir, cachedPath); err != nil {
+		return nil, err
+	}
+
+	// Renderings of the pet's card that show something else are stale
+	if stale, err := filepath.Glob(filepath.Join(dir, prefix+"-*.png")); err == nil {
+		for _, path := range stale {
+			if path != cachedPath {
+				os.Remove(path)
+			}
+		}
+	}
+
+	cached, err := os.Open(cachedPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open share card: %w", err)
+	}
+	return cached, nil
+}
+
+// buildCard gathers what the card of a group day shows, and the photo URL of its pet
+func (s *ShareCardService) buildCard(ctx context.Context, groupID uuid.UUID, date time.Time, petID *uuid.UUID) (*domain.ShareCard, string, error) {
+	winners, err := s.petOfTheDayRepo.GetByGroupAndDate(ctx, groupID, date)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get Pet of the Day winners: %w", err)
+	}
+
+	var winner *domain.PetOfTheDayWinner
+	for _, candidate := range winners {
+		if petID == nil || candidate.PetID == *petID {
+			winner = candidate
+			break
+		}
+	}
+	if winner == nil {
+		return nil, "", fmt.Errorf("no Pet of the Day on %s", date.Format("2006-01-02"))
+	}
+
+	settings, err := s.settingsRepo.GetByGroup(ctx, groupID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get share card settings: %w", err)
+	}
+
+	groupInfo, err := s.authRepo.GetGroupInfo(ctx, groupID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get group info: %w", err)
+	}
+
+	// Pets removed since their win keep their card, without a photo
+	photoURL := ""
+	if petInfo, err := s.authRepo.GetPetInfo(ctx, winner.PetID); err == nil {
+		photoURL = petInfo.PhotoURL
+	}
+
+	breakdown, err := s.behaviorLogRepo.GetBreakdown(ctx, winner.PetID, groupID, winner.Date)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get behavior breakdown: %w", err)
+	}
+
+	return domain.NewShareCard(settings, winner, groupInfo.Name, photoURL, breakdown), photoURL, nil
+}
+
+// render writes a card to the cache. The card is written to a temporary file first so that
+// concurrent requests never read a partial card.
+func (s *ShareCardService) render(card *domain.ShareCard, photo image.Image, dir, path string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create share card cache: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, "render-*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create share card: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := s.renderer.Render(tmp, card, photo); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write share card: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to cache share card: %w", err)
+	}
+	return nil
+}
+
+// loadPhoto decodes a pet photo stored in the local uploads. Photos hosted elsewhere, too large
+// to decode or that cannot be read are left out of the card.
+func (s *ShareCardService) loadPhoto(photoURL string) image.Image {
+	if photoURL == "" {
+		return nil
+	}
+
+	parsed, err := url.Parse(photoURL)
+	if err != nil || !strings.HasPrefix(parsed.Path, localUploadsPrefix) {
+		return nil
+	}
+
+	photo, err := s.uploadService.DecodeImage(strings.TrimPrefix(parsed.Path, localUploadsPrefix))
+	if err != nil {
+		log.Printf("Failed to decode pet photo %s: %v", photoURL, err)
+		return nil
+	}
+	return photo
+}
@@ -0,0 +1,441 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/events"
+	"pet-of-the-day/internal/shared/timezone"
+)
+
+// logPageSize is the number of behavior logs loaded per query when scanning a full history
+const logPageSize = 500
+
+// StreakService maintains the streaks of pets. A log event updates the streaks from the logs of
+// the day it changed; changes that cannot be applied to the stored streaks, like backdated logs
+// before the latest run, rebuild them from the pet's full history. Only counted logs are used.
+type StreakService struct {
+	streakRepo        domain.StreakRepository
+	behaviorLogRepo   domain.BehaviorLogRepository
+	behaviorRepo      domain.BehaviorRepository
+	groupBehaviorRepo domain.GroupBehaviorRepository
+	petOfTheDayRepo   domain.PetOfTheDayRepository
+	authRepo          domain.AuthorizationRepository
+	userSettingsRepo  domain.UserSettingsRepository
+	eventBus          events.Bus
+	petLocks          sync.Map // Serializes the streak updates of each pet
+}
+
+// NewStreakService creates a new streak service
+func NewStreakService(
+	streakRepo domain.StreakRepository,
+	behaviorLogRepo domain.BehaviorLogRepository,
+	behaviorRepo domain.BehaviorRepository,
+	groupBehaviorRepo domain.GroupBehaviorRepository,
+	petOfTheDayRepo domain.PetOfTheDayRepository,
+	authRepo domain.AuthorizationRepository,
+	userSettingsRepo domain.UserSettingsRepository,
+	eventBus events.Bus,
+) *StreakService {
+	return &StreakService{
+		streakRepo:        streakRepo,
+		behaviorLogRepo:   behaviorLogRepo,
+		behaviorRepo:      behaviorRepo,
+		groupBehaviorRepo: groupBehaviorRepo,
+		petOfTheDayRepo:   petOfTheDayRepo,
+		authRepo:          authRepo,
+		userSettingsRepo:  userSettingsRepo,
+		eventBus:          eventBus,
+	}
+}
+
+// Subscribe registers the service for behavior log events
+func (s *StreakService) Subscribe(bus events.Bus) {
+	bus.Subscribe(domain.BehaviorLogCreatedEventType, events.HandlerFunc(s.handleBehaviorLogEvent))
+	bus.Subscribe(domain.BehaviorLogBatchCreatedEventType, eachCreatedLog(s.handleBehaviorLogEvent))
+	bus.Subscribe(domain.BehaviorLogDeletedEventType, events.HandlerFunc(s.handleBehaviorLogEvent))
+	bus.Subscribe(domain.BehaviorLogReviewedEventType, events.HandlerFunc(s.handleBehaviorLogEvent))
+	bus.Subscribe(domain.LateCorrectionEventType, events.HandlerFunc(s.handleLateCorrectionEvent))
+}
+
+// OnDayClosed updates the Pet of the Day streaks of the day's winners.
+// It is registered as a daily reset hook, after the winners have been selected.
+func (s *StreakService) OnDayClosed(ctx context.Context, groupID uuid.UUID, day time.Time) error {
+	winners, err := s.petOfTheDayRepo.GetByGroupAndDate(ctx, groupID, day)
+	if err != nil {
+		return fmt.Errorf("failed to get Pet of the Day winners: %w", err)
+	}
+
+	for _, winner := range winners {
+		if _, err := s.RecomputePet(ctx, winner.PetID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetPetStreaks evaluates a pet's streaks on the pet owner's current day
+func (s *StreakService) GetPetStreaks(ctx context.Context, petID uuid.UUID, now time.Time) (time.Time, []*domain.StreakStatus, error) {
+	config, err := s.getPetTimeConfig(ctx, petID)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	today, err := timezone.GetUserDate(now, config)
+	if err != nil {
+		return time.Time{}, nil, fmt.Errorf("failed to calculate pet's day: %w", err)
+	}
+
+	streaks, err := s.streakRepo.GetByPet(ctx, petID)
+	if err != nil {
+		return time.Time{}, nil, fmt.Errorf("failed to get pet streaks: %w", err)
+	}
+
+	// Pets with history from before streak tracking are computed on first access
+	if len(streaks) == 0 {
+		if streaks, err = s.RecomputePet(ctx, petID); err != nil {
+			return time.Time{}, nil, err
+		}
+	}
+
+	sort.SliceStable(streaks, func(i, j int) bool {
+		if streaks[i].Type != streaks[j].Type {
+			return streaks[i].Type < streaks[j].Type
+		}
+		return streaks[i].Category < streaks[j].Category
+	})
+
+	statuses := make([]*domain.StreakStatus, len(streaks))
+	for i, streak := range streaks {
+		statuses[i] = streak.Status(today)
+	}
+
+	return today, statuses, nil
+}
+
+// RecomputePet rebuilds all streaks of a pet from its behavior logs and Pet of the Day wins
+func (s *StreakService) RecomputePet(ctx context.Context, petID uuid.UUID) ([]*domain.PetStreak, error) {
+	unlock := s.lockPet(petID)
+	defer unlock()
+
+	return s.recomputePet(ctx, petID)
+}
+
+func (s *StreakService) recomputePet(ctx context.Context, petID uuid.UUID) ([]*domain.PetStreak, error) {
+	config, err := s.getPetTimeConfig(ctx, petID)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := findAllLogs(ctx, s.behaviorLogRepo, domain.NewBehaviorLogFilter().WithPet(petID))
+	if err != nil {
+		return nil, err
+	}
+
+	days, err := s.collectStreakDays(ctx, logs, config)
+	if err != nil {
+		return nil, err
+	}
+
+	var positiveDays []time.Time
+	negativeDays := make(map[domain.BehaviorCategory][]time.Time)
+	var trackingStart *time.Time
+	for _, day := range days {
+		if day.points > 0 {
+			positiveDays = append(positiveDays, day.date)
+		}
+		for category := range day.negativeCategories {
+			negativeDays[category] = append(negativeDays[category], day.date)
+		}
+		if trackingStart == nil || day.date.Before(*trackingStart) {
+			trackingStart = &day.date
+		}
+	}
+
+	wins, err := s.petOfTheDayRepo.GetPetWins(ctx, petID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Pet of the Day wins: %w", err)
+	}
+	winDays := make([]time.Time, len(wins))
+	for i, win := range wins {
+		winDays[i] = win.Date
+	}
+
+	streaks := []*domain.PetStreak{
+		domain.NewConsecutiveDaysStreak(petID, domain.StreakTypePositiveScore, positiveDays),
+		domain.NewConsecutiveDaysStreak(petID, domain.StreakTypePetOfTheDay, winDays),
+	}
+	if trackingStart != nil {
+		for _, category := range domain.GetValidCategories() {
+			streaks = append(streaks, domain.NewNoNegativeStreak(petID, category, *trackingStart, negativeDays[category]))
+		}
+	}
+
+	return streaks, s.saveStreaks(ctx, petID, streaks)
+}
+
+// UpdateDay updates the streaks of a pet after its logs of the day of loggedAt changed
+func (s *StreakService) UpdateDay(ctx context.Context, petID uuid.UUID, loggedAt time.Time) error {
+	unlock := s.lockPet(petID)
+	defer unlock()
+
+	streaks, err := s.streakRepo.GetByPet(ctx, petID)
+	if err != nil {
+		return fmt.Errorf("failed to get pet streaks: %w", err)
+	}
+
+	config, err := s.getPetTimeConfig(ctx, petID)
+	if err != nil {
+		return err
+	}
+
+	date, err := timezone.GetUserDate(loggedAt, config)
+	if err != nil {
+		return fmt.Errorf("failed to calculate log day: %w", err)
+	}
+	boundary, err := timezone.GetDailyBoundaryForDate(date, config)
+	if err != nil {
+		return fmt.Errorf("failed to calculate day boundaries: %w", err)
+	}
+
+	logs, err := findAllLogs(ctx, s.behaviorLogRepo, domain.NewBehaviorLogFilter().
+		WithPet(petID).
+		WithDateRange(boundary.Start, boundary.End))
+	if err != nil {
+		return err
+	}
+
+	days, err := s.collectStreakDays(ctx, logs, config)
+	if err != nil {
+		return err
+	}
+
+	// A day left without counted logs may have been the first tracked day
+	day, exists := days[date.Format("2006-01-02")]
+	if !exists || !s.applyDay(streaks, day) {
+		_, err := s.recomputePet(ctx, petID)
+		return err
+	}
+
+	return s.saveStreaks(ctx, petID, streaks)
+}
+
+// applyDay applies a changed day to the stored streaks of a pet. It returns false when they
+// have to be rebuilt from the pet's history.
+func (s *StreakService) applyDay(streaks []*domain.PetStreak, day *streakDay) bool {
+	noNegativeStreaks := 0
+	for _, streak := range streaks {
+		var qualifies bool
+		switch streak.Type {
+		case domain.StreakTypePositiveScore:
+			qualifies = day.points > 0
+		case domain.StreakTypeNoNegative:
+			qualifies = !day.negativeCategories[streak.Category]
+			noNegativeStreaks++
+		default:
+			continue
+		}
+
+		if !streak.ApplyDay(day.date, qualifies) {
+			return false
+		}
+	}
+
+	// Pets that had no logs yet have no no-negative streaks to update
+	return noNegativeStreaks == len(domain.GetValidCategories())
+}
+
+// saveStreaks stores the streaks of a pet and notifies their subscribers
+func (s *StreakService) saveStreaks(ctx context.Context, petID uuid.UUID, streaks []*domain.PetStreak) error {
+	if err := s.streakRepo.ReplaceForPet(ctx, petID, streaks); err != nil {
+		return fmt.Errorf("failed to save pet streaks: %w", err)
+	}
+
+	s.eventBus.Publish(ctx, domain.NewPetStreaksUpdatedEvent(petID))
+	return nil
+}
+
+// streakDay holds what the streaks need to know about the counted logs of one day
+type streakDay struct {
+	date               time.Time
+	points             int
+	negativeCategories map[domain.BehaviorCategory]bool
+}
+
+// collectStreakDays groups the counted logs of a pet by day, keyed by date as locations loaded
+// per log are not comparable. Pending and rejected logs are left out, and negative logs of
+// behaviors that no longer exist count for their points but for no category.
+func (s *StreakService) collectStreakDays(ctx context.Context, logs []*domain.BehaviorLog, config timezone.UserTimeConfig) (map[string]*streakDay, error) {
+	days := make(map[string]*streakDay)
+	categories := make(map[uuid.UUID]domain.BehaviorCategory)
+
+	for _, behaviorLog := range logs {
+		if !behaviorLog.IsCounted() {
+			continue
+		}
+
+		date, err := timezone.GetUserDate(behaviorLog.LoggedAt, config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to calculate log day: %w", err)
+		}
+
+		dayKey := date.Format("2006-01-02")
+		day, exists := days[dayKey]
+		if !exists {
+			day = &streakDay{date: date, negativeCategories: make(map[domain.BehaviorCategory]bool)}
+			days[dayKey] = day
+		}
+		day.points += behaviorLog.PointsAwarded
+
+		if !behaviorLog.IsNegative() {
+			continue
+		}
+
+		category, cached := categories[behaviorLog.BehaviorID]
+		if !cached {
+			category, err = lookupBehaviorCategory(ctx, s.groupBehaviorRepo, s.behaviorRepo, behaviorLog.BehaviorID)
+			if err != nil && !errors.Is(err, domain.ErrBehaviorNotFound) {
+				return nil, err
+			}
+			categories[behaviorLog.BehaviorID] = category
+		}
+		if category != "" {
+			day.negativeCategories[category] = true
+		}
+	}
+
+	return days, nil
+}
+
+// handleBehaviorLogEvent updates the streaks of the pet a log was created, deleted or reviewed for
+func (s *StreakService) handleBehaviorLogEvent(ctx context.Context, event events.Event) error {
+	var petID uuid.UUID
+	var loggedAt time.Time
+	switch e := event.(type) {
+	case *domain.BehaviorLogCreatedEvent:
+		petID, loggedAt = e.PetID, e.LoggedAt
+	case *domain.BehaviorLogDeletedEvent:
+		petID, loggedAt = e.PetID, e.LoggedAt
+	case *domain.BehaviorLogReviewedEvent:
+		petID, loggedAt = e.PetID, e.LoggedAt
+	default:
+		return nil
+	}
+
+	// The bus cancels handler contexts once it stops waiting for them
+	return s.UpdateDay(context.WithoutCancel(ctx), petID, loggedAt)
+}
+
+// handleLateCorrectionEvent recomputes the streaks of the pets that lost a Pet of the Day win
+// after a closed day was corrected. New winners are handled by OnDayClosed.
+func (s *StreakService) handleLateCorrectionEvent(ctx context.Context, event events.Event) error {
+	correctionEvent, ok := event.(*domain.LateCorrectionEvent)
+	if !ok {
+		return nil
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	for _, petID := range correctionEvent.DethronedPetIDs {
+		if _, err := s.RecomputePet(ctx, petID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findAllLogs loads every behavior log matching a filter, page by page
+func findAllLogs(ctx context.Context, behaviorLogRepo domain.BehaviorLogRepository, filter *domain.BehaviorLogFilter) ([]*domain.BehaviorLog, error) {
+	var logs []*domain.BehaviorLog
+	for offset := 0; ; offset += logPageSize {
+		page, err := behaviorLogRepo.Find(ctx, filter.WithPagination(logPageSize, offset))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get behavior logs: %w", err)
+		}
+
+		logs = append(logs, page...)
+		if len(page) < logPageSize {
+			return logs, nil
+		}
+	}
+}
+
+// eachCreatedLog adapts a handler of BehaviorLogCreatedEvent to BehaviorLogBatchCreatedEvent,
+// which replaces the creation events of the logs of a batch
+func eachCreatedLog(handler events.HandlerFunc) events.HandlerFunc {
+	return func(ctx context.Context, event events.Event) error {
+		batchEvent, ok := event.(*domain.BehaviorLogBatchCreatedEvent)
+		if !ok {
+			return nil
+		}
+
+		var handlerErrors []error
+		for _, createdEvent := range batchEvent.BehaviorLogs {
+			if err := handler(ctx, createdEvent); err != nil {
+				handlerErrors = append(handlerErrors, err)
+			}
+		}
+		return errors.Join(handlerErrors...)
+	}
+}
+
+// lookupBehaviorCategory returns the category of a custom group behavior or catalog behavior
+func lookupBehaviorCategory(
+	ctx context.Context,
+	groupBehaviorRepo domain.GroupBehaviorRepository,
+	behaviorRepo domain.BehaviorRepository,
+	behaviorID uuid.UUID,
+) (domain.BehaviorCategory, error) {
+	groupBehavior, err := groupBehaviorRepo.GetByID(ctx, behaviorID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get group behavior: %w", err)
+	}
+	if groupBehavior != nil && !groupBehavior.IsOverride() {
+		return groupBehavior.Category, nil
+	}
+
+	behavior, err := behaviorRepo.GetByID(ctx, behaviorID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get behavior: %w", err)
+	}
+	if behavior == nil {
+		return "", fmt.Errorf("behavior %s: %w", behaviorID, domain.ErrBehaviorNotFound)
+	}
+
+	return behavior.Category, nil
+}
+
+// lockPet serializes the streak updates of a pet, which read the stored streaks or logs before
+// replacing the streaks. It returns the function releasing the lock.
+func (s *StreakService) lockPet(petID uuid.UUID) func() {
+	lock, _ := s.petLocks.LoadOrStore(petID, &sync.Mutex{})
+	mutex := lock.(*sync.Mutex)
+	mutex.Lock()
+	return mutex.Unlock
+}
+
+// getPetTimeConfig returns the day boundaries of a pet, those of its owner
+func (s *StreakService) getPetTimeConfig(ctx context.Context, petID uuid.UUID) (timezone.UserTimeConfig, error) {
+	petInfo, err := s.authRepo.GetPetInfo(ctx, petID)
+	if err != nil {
+		return timezone.UserTimeConfig{}, fmt.Errorf("failed to get pet info: %w", err)
+	}
+
+	settings, err := s.userSettingsRepo.GetUserTimezone(ctx, petInfo.OwnerID)
+	if err != nil || settings == nil {
+		settings = domain.NewUserTimezoneSettings(petInfo.OwnerID)
+	}
+
+	return timezone.UserTimeConfig{
+		DailyResetTime: settings.DailyResetTime,
+		Timezone:       settings.Timezone,
+	}, nil
+}
@@ -0,0 +1,296 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+	"pet-of-the-day/internal/shared/events"
+)
+
+func TestStreakService_BehaviorLogEvents(t *testing.T) {
+	ctx := context.Background()
+
+	behaviorRepo := mock.NewMockBehaviorRepository()
+	behaviorLogRepo := mock.NewMockBehaviorLogRepository()
+	authRepo := mock.NewMockAuthorizationRepository()
+	userSettingsRepo := mock.NewMockUserSettingsRepository()
+	bus := events.NewInMemoryBus()
+
+	service := NewStreakService(
+		mock.NewMockStreakRepository(),
+		behaviorLogRepo,
+		behaviorRepo,
+		mock.NewMockGroupBehaviorRepository(),
+		mock.NewMockPetOfTheDayRepository(),
+		authRepo,
+		userSettingsRepo,
+		bus,
+	)
+	service.Subscribe(bus)
+
+	ownerID, petID := uuid.New(), uuid.New()
+	authRepo.AddUserPet(ownerID, petID, &domain.PetInfo{ID: petID, Name: "Rex", Species: domain.SpeciesDog, OwnerID: ownerID})
+	userSettingsRepo.UpdateUserTimezone(ctx, ownerID, &domain.UserTimezoneSettings{
+		UserID:         ownerID,
+		Timezone:       "America/New_York",
+		DailyResetTime: "21:00",
+	})
+
+	sit, _ := domain.NewBehavior("Sit", "Pet sits on command", domain.BehaviorCategoryTraining, 5, 5, domain.SpeciesDog, "sit")
+	accident, _ := domain.NewBehavior("Accident", "Pee inside", domain.BehaviorCategoryPottyTraining, -5, 5, domain.SpeciesDog, "accident")
+	behaviorRepo.Create(ctx, sit)
+	behaviorRepo.Create(ctx, accident)
+
+	newYork, _ := time.LoadLocation("America/New_York")
+	at := func(day, hour int) time.Time { return time.Date(2025, time.March, day, hour, 0, 0, 0, newYork) }
+
+	logBehavior := func(behavior *domain.Behavior, loggedAt time.Time) *domain.BehaviorLog {
+		behaviorLog := &domain.BehaviorLog{
+			ID:            uuid.New(),
+			PetID:         petID,
+			BehaviorID:    behavior.ID,
+			UserID:        ownerID,
+			PointsAwarded: behavior.PointValue,
+			LoggedAt:      loggedAt,
+		}
+		behaviorLogRepo.Create(ctx, behaviorLog)
+		bus.Publish(ctx, domain.NewBehaviorLogCreatedEvent(behaviorLog))
+		return behaviorLog
+	}
+
+	streak := func(streakType domain.StreakType, category domain.BehaviorCategory, now time.Time) *domain.StreakStatus {
+		t.Helper()
+		_, statuses, err := service.GetPetStreaks(ctx, petID, now)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		for _, status := range statuses {
+			if status.Type == streakType && status.Category == category {
+				return status
+			}
+		}
+		t.Fatalf("Streak %s %s not found", streakType, category)
+		return nil
+	}
+
+	// March 10 at 22:00 is after the owner's reset time and counts for March 11
+	logBehavior(sit, at(8, 10))
+	logBehavior(sit, at(9, 10))
+	logBehavior(sit, at(10, 22))
+	accidentLog := logBehavior(accident, at(9, 12))
+
+	now := at(11, 12)
+
+	t.Run("Positive score days in the owner's timezone", func(t *testing.T) {
+		// March 9 nets zero points, March 10 has no log and March 11 has the late log
+		if got := streak(domain.StreakTypePositiveScore, "", now); got.Current != 1 || got.Best != 1 {
+			t.Errorf("Expected current and best streak of 1, got %+v", got)
+		}
+	})
+
+	t.Run("Days without accidents", func(t *testing.T) {
+		got := streak(domain.StreakTypeNoNegative, domain.BehaviorCategoryPottyTraining, now)
+		if got.Current != 2 || got.Best != 2 {
+			t.Errorf("Expected 2 days without accidents, got %+v", got)
+		}
+		if got.CurrentSince == nil || got.CurrentSince.Day() != 10 {
+			t.Errorf("Expected streak since March 10, got %v", got.CurrentSince)
+		}
+	})
+
+	t.Run("Deleting a log recomputes the streaks", func(t *testing.T) {
+		behaviorLogRepo.Delete(ctx, accidentLog.ID)
+		bus.Publish(ctx, domain.NewBehaviorLogDeletedEvent(accidentLog, ownerID))
+
+		if got := streak(domain.StreakTypeNoNegative, domain.BehaviorCategoryPottyTraining, now); got.Current != 4 {
+			t.Errorf("Expected 4 days without accidents since tracking started, got %+v", got)
+		}
+		if got := streak(domain.StreakTypePositiveScore, "", now); got.Best != 2 {
+			t.Errorf("Expected best positive streak of 2, got %+v", got)
+		}
+	})
+
+	t.Run("Backdated logs fill the gap", func(t *testing.T) {
+		logBehavior(sit, at(10, 9))
+
+		if got := streak(domain.StreakTypePositiveScore, "", now); got.Current != 4 || got.Best != 4 {
+			t.Errorf("Expected a 4 day streak, got %+v", got)
+		}
+	})
+
+	t.Run("Streak breaks after a missed day", func(t *testing.T) {
+		if got := streak(domain.StreakTypePositiveScore, "", at(13, 12)); got.Current != 0 || got.Best != 4 {
+			t.Errorf("Expected broken streak with best of 4, got %+v", got)
+		}
+	})
+}
+
+func TestStreakService_CountedLogs(t *testing.T) {
+	ctx := context.Background()
+	at := func(d int) time.Time { return time.Date(2025, time.March, d, 12, 0, 0, 0, time.UTC) }
+
+	behaviorRepo := mock.NewMockBehaviorRepository()
+	behaviorLogRepo := mock.NewMockBehaviorLogRepository()
+	authRepo := mock.NewMockAuthorizationRepository()
+	userSettingsRepo := mock.NewMockUserSettingsRepository()
+	service := NewStreakService(
+		mock.NewMockStreakRepository(),
+		behaviorLogRepo,
+		behaviorRepo,
+		mock.NewMockGroupBehaviorRepository(),
+		mock.NewMockPetOfTheDayRepository(),
+		authRepo,
+		userSettingsRepo,
+		events.NewInMemoryBus(),
+	)
+
+	ownerID, reviewerID, groupID, petID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	authRepo.AddUserPet(ownerID, petID, &domain.PetInfo{ID: petID, Name: "Rex", Species: domain.SpeciesDog, OwnerID: ownerID})
+	userSettingsRepo.UpdateUserTimezone(ctx, ownerID, &domain.UserTimezoneSettings{UserID: ownerID, Timezone: "UTC", DailyResetTime: "21:00"})
+
+	sit, _ := domain.NewBehavior("Sit", "Pet sits on command", domain.BehaviorCategoryTraining, 5, 5, domain.SpeciesDog, "sit")
+	behaviorRepo.Create(ctx, sit)
+	// The accident behavior was hard deleted after being logged
+	accident, _ := domain.NewBehavior("Accident", "Pee inside", domain.BehaviorCategoryPottyTraining, -5, 5, domain.SpeciesDog, "accident")
+
+	logBehavior := func(behavior *domain.Behavior, loggedAt time.Time, pending bool) *domain.BehaviorLog {
+		t.Helper()
+		behaviorLog := &domain.BehaviorLog{
+			ID:            uuid.New(),
+			PetID:         petID,
+			BehaviorID:    behavior.ID,
+			UserID:        ownerID,
+			PointsAwarded: behavior.PointValue,
+			LoggedAt:      loggedAt,
+		}
+		behaviorLog.AddGroupShare(groupID)
+		if pending {
+			behaviorLog.RequireVerification(groupID)
+		}
+		behaviorLogRepo.Create(ctx, behaviorLog)
+		if err := service.UpdateDay(ctx, petID, loggedAt); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		return behaviorLog
+	}
+
+	streak := func(streakType domain.StreakType, category domain.BehaviorCategory, now time.Time) *domain.StreakStatus {
+		t.Helper()
+		_, statuses, err := service.GetPetStreaks(ctx, petID, now)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		for _, status := range statuses {
+			if status.Type == streakType && status.Category == category {
+				return status
+			}
+		}
+		t.Fatalf("Streak %s %s not found", streakType, category)
+		return nil
+	}
+
+	logBehavior(sit, at(1), false)
+	pendingLog := logBehavior(sit, at(2), true)
+
+	t.Run("Pending logs are not counted", func(t *testing.T) {
+		if got := streak(domain.StreakTypePositiveScore, "", at(2)); got.Current != 1 {
+			t.Errorf("Expected a 1 day streak, got %+v", got)
+		}
+	})
+
+	t.Run("Confirmed logs are counted", func(t *testing.T) {
+		pendingLog.ConfirmInGroup(groupID, reviewerID, at(2))
+		behaviorLogRepo.Update(ctx, pendingLog)
+		if err := service.UpdateDay(ctx, petID, pendingLog.LoggedAt); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if got := streak(domain.StreakTypePositiveScore, "", at(2)); got.Current != 2 {
+			t.Errorf("Expected a 2 day streak, got %+v", got)
+		}
+	})
+
+	t.Run("Logs of deleted behaviors count for no category", func(t *testing.T) {
+		logBehavior(accident, at(2), false)
+		if got := streak(domain.StreakTypeNoNegative, domain.BehaviorCategoryPottyTraining, at(3)); got.Current != 3 {
+			t.Errorf("Expected 3 days without accidents, got %+v", got)
+		}
+
+		if _, err := service.RecomputePet(ctx, petID); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if got := streak(domain.StreakTypePositiveScore, "", at(2)); got.Current != 1 {
+			t.Errorf("Expected the accident points to break the positive streak, got %+v", got)
+		}
+	})
+}
+
+func TestStreakService_PetOfTheDayWins(t *testing.T) {
+	ctx := context.Background()
+	day := func(d int) time.Time { return time.Date(2025, time.March, d, 0, 0, 0, 0, time.UTC) }
+
+	// Setup
+	dailyScoreRepo := mock.NewMockDailyScoreRepository()
+	petOfTheDayRepo := mock.NewMockPetOfTheDayRepository()
+	authRepo := mock.NewMockAuthorizationRepository()
+	rankingService := NewRankingService(
+		dailyScoreRepo,
+		mock.NewMockRankingReadModelRepository(dailyScoreRepo, authRepo),
+		mock.NewMockBehaviorLogRepository(),
+		petOfTheDayRepo,
+		authRepo,
+		mock.NewMockUserSettingsRepository(),
+		mock.NewMockDailyResetStateRepository(),
+		mock.NewMockScoringRulesRepository(),
+		events.NewInMemoryBus(),
+	)
+
+	// Test data
+	ownerID, groupID, petID := uuid.New(), uuid.New(), uuid.New()
+	authRepo.AddUser(ownerID, &domain.UserInfo{ID: ownerID, Name: "Alice"})
+	authRepo.AddUserGroup(ownerID, groupID, &domain.GroupInfo{ID: groupID, Name: "Park friends", OwnerID: ownerID})
+	authRepo.AddUserPet(ownerID, petID, &domain.PetInfo{ID: petID, Name: "Rex", Species: domain.SpeciesDog, OwnerID: ownerID})
+	authRepo.AddPetToGroup(petID, groupID)
+
+	addPoints := func(petID uuid.UUID, date time.Time, points int) {
+		dailyScore, err := dailyScoreRepo.GetOrCreate(ctx, petID, groupID, date)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		dailyScore.AddBehaviorLog(&domain.BehaviorLog{PetID: petID, PointsAwarded: points, LoggedAt: date})
+	}
+
+	service := NewStreakService(
+		mock.NewMockStreakRepository(),
+		mock.NewMockBehaviorLogRepository(),
+		mock.NewMockBehaviorRepository(),
+		mock.NewMockGroupBehaviorRepository(),
+		petOfTheDayRepo,
+		authRepo,
+		mock.NewMockUserSettingsRepository(),
+		events.NewInMemoryBus(),
+	)
+	rankingService.AddDayClosedHook(service)
+
+	for d := 10; d <= 12; d++ {
+		addPoints(petID, day(d), 5)
+		if err := rankingService.runDailyReset(ctx, time.Date(2025, time.March, d, 21, 30, 0, 0, time.UTC)); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+
+	_, statuses, err := service.GetPetStreaks(ctx, petID, time.Date(2025, time.March, 12, 22, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	for _, status := range statuses {
+		if status.Type == domain.StreakTypePetOfTheDay && status.Current != 3 {
+			t.Errorf("Expected 3 consecutive wins, got %+v", status)
+		}
+	}
+}
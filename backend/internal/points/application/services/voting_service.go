@@ -0,0 +1,220 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/events"
+	"pet-of-the-day/internal/shared/timezone"
+)
+
+// voteStandingsTTL is how long the rankings votes are weighted by are reused. Votes come in
+// bursts before the daily reset, and the standings barely move within a minute.
+const voteStandingsTTL = time.Minute
+
+// VotingService runs the Pet of the Day votes of groups that select Pet of the Day by vote.
+// Members vote during a window before the daily reset, and RankingService tallies the votes
+// when the day closes.
+type VotingService struct {
+	policyRepo       domain.VotingPolicyRepository
+	voteRepo         domain.PetOfTheDayVoteRepository
+	authRepo         domain.AuthorizationRepository
+	userSettingsRepo domain.UserSettingsRepository
+	rankingService   *RankingService
+	eventBus         events.Bus
+
+	mu        sync.Mutex
+	standings map[string]*voteStandings // key: groupID_date
+}
+
+// voteStandings are the rankings of a group day votes were last weighted by
+type voteStandings struct {
+	rankings   []*domain.PetRanking
+	computedAt time.Time
+}
+
+// NewVotingService creates a new voting service
+func NewVotingService(
+	policyRepo domain.VotingPolicyRepository,
+	voteRepo domain.PetOfTheDayVoteRepository,
+	authRepo domain.AuthorizationRepository,
+	userSettingsRepo domain.UserSettingsRepository,
+	rankingService *RankingService,
+	eventBus events.Bus,
+) *VotingService {
+	return &VotingService{
+		policyRepo:       policyRepo,
+		voteRepo:         voteRepo,
+		authRepo:         authRepo,
+		userSettingsRepo: userSettingsRepo,
+		rankingService:   rankingService,
+		eventBus:         eventBus,
+		standings:        make(map[string]*voteStandings),
+	}
+}
+
+// CurrentWindow returns the voting window of the group day in progress at the given time
+func (s *VotingService) CurrentWindow(ctx context.Context, groupID uuid.UUID, now time.Time) (*domain.VotingWindow, error) {
+	policy, err := s.policyRepo.GetByGroup(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get voting policy: %w", err)
+	}
+
+	return s.currentWindow(ctx, policy, now)
+}
+
+func (s *VotingService) currentWindow(ctx context.Context, policy *domain.VotingPolicy, now time.Time) (*domain.VotingWindow, error) {
+	config, err := NewGroupCalendar(s.authRepo, s.userSettingsRepo).Config(ctx, policy.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	day, err := timezone.GetUserDate(now, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate group day: %w", err)
+	}
+
+	boundary, err := timezone.GetDailyBoundaryForDate(now, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate daily boundary: %w", err)
+	}
+
+	// Past today's reset time the group day in progress closes tomorrow
+	resetAt := boundary.End
+	if now.After(resetAt) {
+		resetAt = resetAt.AddDate(0, 0, 1)
+	}
+
+	return policy.WindowFor(day, resetAt), nil
+}
+
+// CastVote records the vote of a member for the group day in progress, replacing an earlier
+// vote of the member for the same day. The caller checks that the member can vote for the pet.
+func (s *VotingService) CastVote(ctx context.Context, groupID, voterID, petID uuid.UUID, now time.Time) (*domain.PetOfTheDayVote, []*domain.VoteTally, error) {
+	policy, err := s.policyRepo.GetByGroup(ctx, groupID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get voting policy: %w", err)
+	}
+	if !policy.Enabled {
+		return nil, nil, &domain.ValidationError{Message: "group does not vote for Pet of the Day"}
+	}
+
+	window, err := s.currentWindow(ctx, policy, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !window.IsOpen(now) {
+		return nil, nil, &domain.ValidationError{Message: fmt.Sprintf("voting is open from %s to %s", window.OpensAt.Format(time.RFC3339), window.ClosesAt.Format(time.RFC3339))}
+	}
+
+	weight, err := s.voteWeight(ctx, policy, voterID, window.Date, now)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vote, err := domain.NewPetOfTheDayVote(groupID, window.Date, voterID, petID, weight)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.voteRepo.Save(ctx, vote); err != nil {
+		return nil, nil, fmt.Errorf("failed to save vote: %w", err)
+	}
+
+	tallies, _, err := s.TallyVotes(ctx, groupID, window.Date)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.eventBus.Publish(ctx, domain.NewPetOfTheDayVoteCastEvent(vote, tallies))
+
+	return vote, tallies, nil
+}
+
+// TallyVotes returns the vote tallies of a group day, and false if the group does not vote
+func (s *VotingService) TallyVotes(ctx context.Context, groupID uuid.UUID, day time.Time) ([]*domain.VoteTally, bool, error) {
+	policy, err := s.policyRepo.GetByGroup(ctx, groupID)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get voting policy: %w", err)
+	}
+	if !policy.Enabled {
+		return nil, false, nil
+	}
+
+	votes, err := s.voteRepo.GetByGroupAndDate(ctx, groupID, day)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get votes: %w", err)
+	}
+
+	return domain.TallyVotes(votes), true, nil
+}
+
+// voteWeight returns the weight of a member's vote from the standing of the member's best pet
+// in the points ranking of the day, as of at most voteStandingsTTL ago
+func (s *VotingService) voteWeight(ctx context.Context, policy *domain.VotingPolicy, voterID uuid.UUID, day, now time.Time) (int, error) {
+	if !policy.WeightByPoints {
+		return 1, nil
+	}
+
+	petIDs, err := s.authRepo.GetUserPets(ctx, voterID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get voter pets: %w", err)
+	}
+	if len(petIDs) == 0 {
+		return 1, nil
+	}
+
+	rankings, err := s.rankings(ctx, policy.GroupID, day, now)
+	if err != nil {
+		return 0, err
+	}
+
+	ownPets := make(map[uuid.UUID]bool, len(petIDs))
+	for _, petID := range petIDs {
+		ownPets[petID] = true
+	}
+
+	bestRank := 0
+	for _, ranking := range rankings {
+		if ownPets[ranking.PetID] && (bestRank == 0 || ranking.Rank < bestRank) {
+			bestRank = ranking.Rank
+		}
+	}
+
+	return policy.VoteWeight(bestRank, len(rankings)), nil
+}
+
+// rankings returns the points ranking of a group day, calculated again once the standings
+// kept for it are older than voteStandingsTTL
+func (s *VotingService) rankings(ctx context.Context, groupID uuid.UUID, day, now time.Time) ([]*domain.PetRanking, error) {
+	key := fmt.Sprintf("%s_%s", groupID, day.Format("2006-01-02"))
+
+	s.mu.Lock()
+	standings, exists := s.standings[key]
+	s.mu.Unlock()
+	if exists && now.Sub(standings.computedAt) < voteStandingsTTL {
+		return standings.rankings, nil
+	}
+
+	rankings, err := s.rankingService.CalculateGroupRankings(ctx, groupID, day)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate rankings: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// Drop the standings of other days once they expire
+	for otherKey, other := range s.standings {
+		if now.Sub(other.computedAt) >= voteStandingsTTL {
+			delete(s.standings, otherKey)
+		}
+	}
+	s.standings[key] = &voteStandings{rankings: rankings, computedAt: now}
+
+	return rankings, nil
+}
@@ -0,0 +1,250 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+	"pet-of-the-day/internal/shared/events"
+)
+
+func TestVotingService_CastVote(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup: Rex leads Luna on points on March 10. Alice owns Rex, Bob owns Luna and Carol has no pets.
+	dailyScoreRepo := mock.NewMockDailyScoreRepository()
+	authRepo := mock.NewMockAuthorizationRepository()
+	policyRepo := mock.NewMockVotingPolicyRepository()
+
+	aliceID, bobID, carolID := uuid.New(), uuid.New(), uuid.New()
+	groupID, rexID, lunaID := uuid.New(), uuid.New(), uuid.New()
+	day := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	group := &domain.GroupInfo{ID: groupID, Name: "Park friends", OwnerID: aliceID}
+	for userID, name := range map[uuid.UUID]string{aliceID: "Alice", bobID: "Bob", carolID: "Carol"} {
+		authRepo.AddUser(userID, &domain.UserInfo{ID: userID, Name: name})
+		authRepo.AddUserGroup(userID, groupID, group)
+	}
+	authRepo.AddUserPet(aliceID, rexID, &domain.PetInfo{ID: rexID, Name: "Rex", Species: domain.SpeciesDog, OwnerID: aliceID})
+	authRepo.AddUserPet(bobID, lunaID, &domain.PetInfo{ID: lunaID, Name: "Luna", Species: domain.SpeciesCat, OwnerID: bobID})
+	for petID, points := range map[uuid.UUID]int{rexID: 5, lunaID: 3} {
+		authRepo.AddPetToGroup(petID, groupID)
+		dailyScore, _ := dailyScoreRepo.GetOrCreate(ctx, petID, groupID, day)
+		dailyScore.AddBehaviorLog(&domain.BehaviorLog{PetID: petID, PointsAwarded: points, LoggedAt: day})
+	}
+
+	// The default window opens at 19:00 UTC, two hours before the 21:00 UTC reset
+	inWindow := day.Add(20 * time.Hour)
+
+	newService := func(policy *domain.VotingPolicy) *VotingService {
+		policyRepo.Save(ctx, policy)
+
+		rankingService := NewRankingService(
+			dailyScoreRepo,
+			mock.NewMockRankingReadModelRepository(dailyScoreRepo, authRepo),
+			mock.NewMockBehaviorLogRepository(),
+			mock.NewMockPetOfTheDayRepository(),
+			authRepo,
+			mock.NewMockUserSettingsRepository(),
+			mock.NewMockDailyResetStateRepository(),
+			mock.NewMockScoringRulesRepository(),
+			events.NewInMemoryBus(),
+		)
+		return NewVotingService(
+			policyRepo,
+			mock.NewMockPetOfTheDayVoteRepository(),
+			authRepo,
+			mock.NewMockUserSettingsRepository(),
+			rankingService,
+			events.NewInMemoryBus(),
+		)
+	}
+
+	enabled := &domain.VotingPolicy{GroupID: groupID, Enabled: true, WindowHours: domain.DefaultVotingWindowHours}
+	weighted := &domain.VotingPolicy{GroupID: groupID, Enabled: true, WindowHours: domain.DefaultVotingWindowHours, WeightByPoints: true}
+
+	rejections := []struct {
+		name   string
+		policy *domain.VotingPolicy
+		at     time.Time
+	}{
+		{name: "Rejects votes before the window opens", policy: enabled, at: day.Add(15 * time.Hour)},
+		{name: "Rejects votes after the reset", policy: enabled, at: day.Add(22 * time.Hour)},
+		{name: "Rejects votes in groups that do not vote", policy: domain.DefaultVotingPolicy(groupID), at: inWindow},
+	}
+
+	for _, tt := range rejections {
+		t.Run(tt.name, func(t *testing.T) {
+			service := newService(tt.policy)
+
+			_, _, err := service.CastVote(ctx, groupID, carolID, rexID, tt.at)
+			if _, ok := err.(*domain.ValidationError); !ok {
+				t.Errorf("Expected ValidationError, got %v", err)
+			}
+		})
+	}
+
+	t.Run("Voting again replaces the vote", func(t *testing.T) {
+		service := newService(enabled)
+
+		for _, petID := range []uuid.UUID{rexID, lunaID} {
+			if _, _, err := service.CastVote(ctx, groupID, carolID, petID, inWindow); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		}
+
+		tallies, voting, err := service.TallyVotes(ctx, groupID, day)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !voting || len(tallies) != 1 || tallies[0].PetID != lunaID || tallies[0].Votes != 1 {
+			t.Errorf("Expected a single vote for Luna, got %+v", tallies)
+		}
+	})
+
+	weights := []struct {
+		name       string
+		voterID    uuid.UUID
+		wantWeight int
+	}{
+		{name: "The owner of the leader weighs 2", voterID: aliceID, wantWeight: 2},
+		{name: "The owner of the runner-up weighs 1", voterID: bobID, wantWeight: 1},
+		{name: "A member without pets weighs 1", voterID: carolID, wantWeight: 1},
+	}
+
+	for _, tt := range weights {
+		t.Run(tt.name, func(t *testing.T) {
+			service := newService(weighted)
+
+			vote, _, err := service.CastVote(ctx, groupID, tt.voterID, lunaID, inWindow)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if vote.Weight != tt.wantWeight {
+				t.Errorf("Expected weight %d, got %d", tt.wantWeight, vote.Weight)
+			}
+		})
+	}
+
+	t.Run("Weights follow the standings a minute late", func(t *testing.T) {
+		service := newService(weighted)
+		if _, _, err := service.CastVote(ctx, groupID, bobID, rexID, inWindow); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		// Luna takes the lead with 4 more points
+		dailyScore, _ := dailyScoreRepo.GetOrCreate(ctx, lunaID, groupID, day)
+		dailyScore.AddBehaviorLog(&domain.BehaviorLog{PetID: lunaID, PointsAwarded: 4, LoggedAt: day})
+
+		for _, tt := range []struct {
+			at         time.Time
+			wantWeight int
+		}{
+			{at: inWindow.Add(30 * time.Second), wantWeight: 1},
+			{at: inWindow.Add(voteStandingsTTL), wantWeight: 2},
+		} {
+			vote, _, err := service.CastVote(ctx, groupID, bobID, rexID, tt.at)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if vote.Weight != tt.wantWeight {
+				t.Errorf("At %s: expected weight %d, got %d", tt.at.Format(time.TimeOnly), tt.wantWeight, vote.Weight)
+			}
+		}
+	})
+}
+
+func TestVotingService_SelectPetOfTheDay(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup: Rex leads Luna on points on March 10. Alice owns Rex, Bob owns Luna and Carol has no pets.
+	dailyScoreRepo := mock.NewMockDailyScoreRepository()
+	authRepo := mock.NewMockAuthorizationRepository()
+	policyRepo := mock.NewMockVotingPolicyRepository()
+
+	aliceID, bobID, carolID := uuid.New(), uuid.New(), uuid.New()
+	groupID, rexID, lunaID := uuid.New(), uuid.New(), uuid.New()
+	day := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	group := &domain.GroupInfo{ID: groupID, Name: "Park friends", OwnerID: aliceID}
+	for userID, name := range map[uuid.UUID]string{aliceID: "Alice", bobID: "Bob", carolID: "Carol"} {
+		authRepo.AddUser(userID, &domain.UserInfo{ID: userID, Name: name})
+		authRepo.AddUserGroup(userID, groupID, group)
+	}
+	authRepo.AddUserPet(aliceID, rexID, &domain.PetInfo{ID: rexID, Name: "Rex", Species: domain.SpeciesDog, OwnerID: aliceID})
+	authRepo.AddUserPet(bobID, lunaID, &domain.PetInfo{ID: lunaID, Name: "Luna", Species: domain.SpeciesCat, OwnerID: bobID})
+	for petID, points := range map[uuid.UUID]int{rexID: 5, lunaID: 3} {
+		authRepo.AddPetToGroup(petID, groupID)
+		dailyScore, _ := dailyScoreRepo.GetOrCreate(ctx, petID, groupID, day)
+		dailyScore.AddBehaviorLog(&domain.BehaviorLog{PetID: petID, PointsAwarded: points, LoggedAt: day})
+	}
+
+	policyRepo.Save(ctx, &domain.VotingPolicy{GroupID: groupID, Enabled: true, WindowHours: domain.DefaultVotingWindowHours})
+
+	tests := []struct {
+		name       string
+		votes      map[uuid.UUID]uuid.UUID // Voter to pet
+		wantWinner uuid.UUID
+		wantVotes  int
+	}{
+		{
+			name:       "Votes decide the winner",
+			votes:      map[uuid.UUID]uuid.UUID{carolID: lunaID},
+			wantWinner: lunaID,
+			wantVotes:  1,
+		},
+		{
+			name:       "Points break a tie of votes",
+			votes:      map[uuid.UUID]uuid.UUID{carolID: lunaID, bobID: rexID},
+			wantWinner: rexID,
+			wantVotes:  1,
+		},
+		{
+			name:       "Points decide when nobody votes",
+			wantWinner: rexID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rankingService := NewRankingService(
+				dailyScoreRepo,
+				mock.NewMockRankingReadModelRepository(dailyScoreRepo, authRepo),
+				mock.NewMockBehaviorLogRepository(),
+				mock.NewMockPetOfTheDayRepository(),
+				authRepo,
+				mock.NewMockUserSettingsRepository(),
+				mock.NewMockDailyResetStateRepository(),
+				mock.NewMockScoringRulesRepository(),
+				events.NewInMemoryBus(),
+			)
+			service := NewVotingService(
+				policyRepo,
+				mock.NewMockPetOfTheDayVoteRepository(),
+				authRepo,
+				mock.NewMockUserSettingsRepository(),
+				rankingService,
+				events.NewInMemoryBus(),
+			)
+			rankingService.SetVoteTallier(service)
+
+			for voterID, petID := range tt.votes {
+				if _, _, err := service.CastVote(ctx, groupID, voterID, petID, day.Add(20*time.Hour)); err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+			}
+
+			winners, err := rankingService.SelectPetOfTheDay(ctx, groupID, day)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if len(winners) != 1 || winners[0].PetID != tt.wantWinner || winners[0].Votes != tt.wantVotes {
+				t.Errorf("Expected %s to win with %d votes, got %+v", tt.wantWinner, tt.wantVotes, winners)
+			}
+		})
+	}
+}
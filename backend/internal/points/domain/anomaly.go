@@ -0,0 +1,274 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AnomalyRule names a pattern of behavior logs that is unlikely to be genuine
+type AnomalyRule string
+
+const (
+	// AnomalyRuleLogRate flags a pet logged too many times within an hour
+	AnomalyRuleLogRate AnomalyRule = "log_rate"
+	// AnomalyRuleScoreSpike flags a day far above the pet's usual score in a group
+	AnomalyRuleScoreSpike AnomalyRule = "score_spike"
+	// AnomalyRuleBackdatedBurst flags a user entering many logs for past times at once
+	AnomalyRuleBackdatedBurst AnomalyRule = "backdated_burst"
+	// AnomalyRuleSynchronizedLogs flags a user logging many pets at the exact same time
+	AnomalyRuleSynchronizedLogs AnomalyRule = "synchronized_logs"
+)
+
+// AnomalyFlagStatus represents the moderation state of a flag
+type AnomalyFlagStatus string
+
+const (
+	// AnomalyFlagStatusOpen waits for the group admin
+	AnomalyFlagStatusOpen AnomalyFlagStatus = "open"
+	// AnomalyFlagStatusDismissed was a false positive, the log counts
+	AnomalyFlagStatusDismissed AnomalyFlagStatus = "dismissed"
+	// AnomalyFlagStatusConfirmed was score farming, the log is rejected in the group
+	AnomalyFlagStatusConfirmed AnomalyFlagStatus = "confirmed"
+)
+
+// AnomalyThresholds tunes the anomaly rules
+type AnomalyThresholds struct {
+	MaxLogsPerHour     int           // Logs of a pet within an hour before it is flagged
+	SpikeFactor        int           // Times the usual daily points a day must reach to be a spike
+	SpikeMinPoints     int           // Points below which a day is never a spike
+	SpikeHistoryDays   int           // Past days the usual daily points are computed from
+	SpikeMinHistory    int           // Past days with a score needed before spikes are detected
+	BackdateAge        time.Duration // Age from which a log counts as backdated
+	BackdatedBurstSize int           // Backdated logs entered by a user within an hour before they are flagged
+	SynchronizedPets   int           // Pets logged by a user at the exact same time before they are flagged
+}
+
+// DefaultAnomalyThresholds returns the thresholds used by the anomaly detector
+func DefaultAnomalyThresholds() AnomalyThresholds {
+	return AnomalyThresholds{
+		MaxLogsPerHour:     12,
+		SpikeFactor:        3,
+		SpikeMinPoints:     30,
+		SpikeHistoryDays:   14,
+		SpikeMinHistory:    3,
+		BackdateAge:        6 * time.Hour,
+		BackdatedBurstSize: 5,
+		SynchronizedPets:   3,
+	}
+}
+
+// AnomalyReason explains why a rule flagged a behavior log
+type AnomalyReason struct {
+	Rule   AnomalyRule `json:"rule"`
+	Detail string      `json:"detail"`
+}
+
+// CheckLogRate flags a log when its pet was logged too many times in the hour up to it.
+// The pet's logs are expected to include the checked log.
+func (t AnomalyThresholds) CheckLogRate(behaviorLog *BehaviorLog, petLogs []*BehaviorLog) *AnomalyReason {
+	windowStart := behaviorLog.LoggedAt.Add(-time.Hour)
+
+	count := 0
+	for _, petLog := range petLogs {
+		if petLog.LoggedAt.After(windowStart) && !petLog.LoggedAt.After(behaviorLog.LoggedAt) {
+			count++
+		}
+	}
+
+	if count <= t.MaxLogsPerHour {
+		return nil
+	}
+	return &AnomalyReason{
+		Rule:   AnomalyRuleLogRate,
+		Detail: fmt.Sprintf("%d logs for the pet within an hour", count),
+	}
+}
+
+// CheckBackdatedBurst flags a backdated log when its user entered many backdated logs within
+// an hour of it. The user's logs are expected to include the checked log.
+func (t AnomalyThresholds) CheckBackdatedBurst(behaviorLog *BehaviorLog, userLogs []*BehaviorLog) *AnomalyReason {
+	if !t.isBackdated(behaviorLog) {
+		return nil
+	}
+
+	count := 0
+	for _, userLog := range userLogs {
+		gap := userLog.CreatedAt.Sub(behaviorLog.CreatedAt)
+		if t.isBackdated(userLog) && gap <= time.Hour && gap >= -time.Hour {
+			count++
+		}
+	}
+
+	if count < t.BackdatedBurstSize {
+		return nil
+	}
+	return &AnomalyReason{
+		Rule:   AnomalyRuleBackdatedBurst,
+		Detail: fmt.Sprintf("%d backdated logs entered within an hour", count),
+	}
+}
+
+// CheckSynchronizedLogs flags a log when its user logged many pets at the exact same second
+func (t AnomalyThresholds) CheckSynchronizedLogs(behaviorLog *BehaviorLog, userLogs []*BehaviorLog) *AnomalyReason {
+	loggedAt := behaviorLog.LoggedAt.Truncate(time.Second)
+
+	pets := map[uuid.UUID]bool{behaviorLog.PetID: true}
+	for _, userLog := range userLogs {
+		if userLog.LoggedAt.Truncate(time.Second).Equal(loggedAt) {
+			pets[userLog.PetID] = true
+		}
+	}
+
+	if len(pets) < t.SynchronizedPets {
+		return nil
+	}
+	return &AnomalyReason{
+		Rule:   AnomalyRuleSynchronizedLogs,
+		Detail: fmt.Sprintf("%d pets logged at the same time", len(pets)),
+	}
+}
+
+// CheckScoreSpike flags a positive log when the day's behavior points of its pet in the group
+// are far above the pet's usual days. History holds the pet's earlier daily scores in the group.
+func (t AnomalyThresholds) CheckScoreSpike(behaviorLog *BehaviorLog, day *DailyScore, history []*DailyScore) *AnomalyReason {
+	if day == nil || behaviorLog.PointsForGroup(day.GroupID) <= 0 {
+		return nil
+	}
+
+	activeDays, total := 0, 0
+	for _, score := range history {
+		if score.Date.Before(day.Date) && score.HasActivity() {
+			activeDays++
+			total += score.BehaviorPointTotal
+		}
+	}
+	if activeDays < t.SpikeMinHistory {
+		return nil
+	}
+
+	usual := total / activeDays
+	if usual < 1 {
+		usual = 1
+	}
+	if day.BehaviorPointTotal < t.SpikeMinPoints || day.BehaviorPointTotal <= usual*t.SpikeFactor {
+		return nil
+	}
+	return &AnomalyReason{
+		Rule:   AnomalyRuleScoreSpike,
+		Detail: fmt.Sprintf("%d points in a day against %d usually", day.BehaviorPointTotal, usual),
+	}
+}
+
+func (t AnomalyThresholds) isBackdated(behaviorLog *BehaviorLog) bool {
+	return behaviorLog.CreatedAt.Sub(behaviorLog.LoggedAt) >= t.BackdateAge
+}
+
+// AnomalyFlag puts a behavior log shared with a group in the moderation queue of the group admin
+type AnomalyFlag struct {
+	ID            uuid.UUID
+	BehaviorLogID uuid.UUID
+	PetID         uuid.UUID
+	UserID        uuid.UUID // Member who logged the behavior
+	GroupID       uuid.UUID
+	Reasons       []AnomalyReason
+	Held          bool // The log was held from the group's rankings until the flag is resolved
+	Status        AnomalyFlagStatus
+	ResolvedBy    *uuid.UUID
+	ResolvedAt    *time.Time
+	CreatedAt     time.Time
+}
+
+// NewAnomalyFlag creates an open flag for a behavior log in a group
+func NewAnomalyFlag(behaviorLog *BehaviorLog, groupID uuid.UUID, reasons []AnomalyReason) (*AnomalyFlag, error) {
+	if len(reasons) == 0 {
+		return nil, fmt.Errorf("an anomaly flag needs at least one reason")
+	}
+	if !behaviorLog.IsSharedWithGroup(groupID) {
+		return nil, fmt.Errorf("behavior log is not shared with group %s", groupID)
+	}
+
+	return &AnomalyFlag{
+		ID:            uuid.New(),
+		BehaviorLogID: behaviorLog.ID,
+		PetID:         behaviorLog.PetID,
+		UserID:        behaviorLog.UserID,
+		GroupID:       groupID,
+		Reasons:       reasons,
+		Status:        AnomalyFlagStatusOpen,
+		CreatedAt:     time.Now(),
+	}, nil
+}
+
+// Summary joins the reasons of the flag in a single line
+func (f *AnomalyFlag) Summary() string {
+	details := make([]string, len(f.Reasons))
+	for i, reason := range f.Reasons {
+		details[i] = reason.Detail
+	}
+	return strings.Join(details, "; ")
+}
+
+// IsOpen returns true if the flag waits for the group admin
+func (f *AnomalyFlag) IsOpen() bool {
+	return f.Status == AnomalyFlagStatusOpen
+}
+
+// Resolve records the decision of the group admin
+func (f *AnomalyFlag) Resolve(adminID uuid.UUID, confirmed bool, now time.Time) error {
+	if !f.IsOpen() {
+		return fmt.Errorf("anomaly flag is already %s", f.Status)
+	}
+
+	f.Status = AnomalyFlagStatusDismissed
+	if confirmed {
+		f.Status = AnomalyFlagStatusConfirmed
+	}
+	f.ResolvedBy = &adminID
+	f.ResolvedAt = &now
+	return nil
+}
+
+// AnomalyFlagFilter represents criteria for filtering the moderation queue
+type AnomalyFlagFilter struct {
+	GroupID       *uuid.UUID
+	BehaviorLogID *uuid.UUID
+	Status        *AnomalyFlagStatus
+	Limit         int
+	Offset        int
+}
+
+// NewAnomalyFlagFilter creates a new filter with sensible defaults
+func NewAnomalyFlagFilter() *AnomalyFlagFilter {
+	return &AnomalyFlagFilter{
+		Limit:  50,
+		Offset: 0,
+	}
+}
+
+// WithGroup adds a group ID filter
+func (f *AnomalyFlagFilter) WithGroup(groupID uuid.UUID) *AnomalyFlagFilter {
+	f.GroupID = &groupID
+	return f
+}
+
+// WithBehaviorLog adds a behavior log ID filter
+func (f *AnomalyFlagFilter) WithBehaviorLog(behaviorLogID uuid.UUID) *AnomalyFlagFilter {
+	f.BehaviorLogID = &behaviorLogID
+	return f
+}
+
+// WithStatus adds a status filter
+func (f *AnomalyFlagFilter) WithStatus(status AnomalyFlagStatus) *AnomalyFlagFilter {
+	f.Status = &status
+	return f
+}
+
+// WithPagination sets limit and offset
+func (f *AnomalyFlagFilter) WithPagination(limit, offset int) *AnomalyFlagFilter {
+	f.Limit = limit
+	f.Offset = offset
+	return f
+}
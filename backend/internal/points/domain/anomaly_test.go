@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestAnomalyThresholds_Rules(t *testing.T) {
+	thresholds := DefaultAnomalyThresholds()
+	petID, userID, groupID := uuid.New(), uuid.New(), uuid.New()
+	now := time.Date(2026, 3, 10, 18, 0, 0, 0, time.UTC)
+
+	newLog := func(loggedAt, createdAt time.Time) *BehaviorLog {
+		log := &BehaviorLog{ID: uuid.New(), PetID: petID, UserID: userID, PointsAwarded: 5, LoggedAt: loggedAt, CreatedAt: createdAt}
+		log.AddGroupShare(groupID)
+		return log
+	}
+
+	t.Run("Log rate", func(t *testing.T) {
+		var logs []*BehaviorLog
+		for i := 0; i < thresholds.MaxLogsPerHour; i++ {
+			logs = append(logs, newLog(now.Add(-time.Duration(i)*time.Minute), now))
+		}
+		if reason := thresholds.CheckLogRate(logs[0], logs); reason != nil {
+			t.Errorf("Expected no flag at the limit, got %+v", reason)
+		}
+
+		logs = append(logs, newLog(now.Add(-50*time.Minute), now))
+		if reason := thresholds.CheckLogRate(logs[0], logs); reason == nil || reason.Rule != AnomalyRuleLogRate {
+			t.Errorf("Expected log rate flag, got %+v", reason)
+		}
+
+		// Logs from more than an hour before are not counted
+		if reason := thresholds.CheckLogRate(newLog(now.Add(-2*time.Hour), now), logs); reason != nil {
+			t.Errorf("Expected no flag for an older log, got %+v", reason)
+		}
+	})
+
+	t.Run("Backdated burst", func(t *testing.T) {
+		var logs []*BehaviorLog
+		for i := 0; i < thresholds.BackdatedBurstSize; i++ {
+			logs = append(logs, newLog(now.Add(-20*time.Hour), now.Add(time.Duration(i)*time.Minute)))
+		}
+		if reason := thresholds.CheckBackdatedBurst(logs[0], logs); reason == nil || reason.Rule != AnomalyRuleBackdatedBurst {
+			t.Errorf("Expected backdated burst flag, got %+v", reason)
+		}
+
+		recent := newLog(now.Add(-time.Hour), now)
+		if reason := thresholds.CheckBackdatedBurst(recent, append(logs, recent)); reason != nil {
+			t.Errorf("Expected no flag for a log that is not backdated, got %+v", reason)
+		}
+	})
+
+	t.Run("Score spike", func(t *testing.T) {
+		day, _ := NewDailyScore(petID, groupID, now)
+		log := newLog(now, now)
+
+		var history []*DailyScore
+		for i := 1; i <= thresholds.SpikeMinHistory; i++ {
+			past, _ := NewDailyScore(petID, groupID, now.AddDate(0, 0, -i))
+			past.AddBehaviorLog(log)
+			history = append(history, past)
+		}
+
+		day.BehaviorPointTotal = thresholds.SpikeMinPoints
+		if reason := thresholds.CheckScoreSpike(log, day, history); reason == nil || reason.Rule != AnomalyRuleScoreSpike {
+			t.Errorf("Expected score spike flag, got %+v", reason)
+		}
+
+		if reason := thresholds.CheckScoreSpike(log, day, history[:1]); reason != nil {
+			t.Errorf("Expected no flag without enough history, got %+v", reason)
+		}
+
+		day.BehaviorPointTotal = 15
+		if reason := thresholds.CheckScoreSpike(log, day, history); reason != nil {
+			t.Errorf("Expected no flag below the minimum points, got %+v", reason)
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxBehaviorLogAttachments bounds the photos attached to a single behavior log
+const MaxBehaviorLogAttachments = 5
+
+// BehaviorLogAttachment is a photo attached to a behavior log as evidence. It is visible to
+// the people who can see the log: its logger, the pet's owners and the groups it is shared with.
+type BehaviorLogAttachment struct {
+	ID            uuid.UUID `json:"id"`
+	BehaviorLogID uuid.UUID `json:"behavior_log_id"`
+	UploadedBy    uuid.UUID `json:"uploaded_by"`
+	OriginalName  string    `json:"original_name"`
+	MimeType      string    `json:"mime_type"`
+	Size          int64     `json:"size"`
+	Path          string    `json:"-"` // Relative to the upload directory
+	ThumbnailPath string    `json:"-"` // Empty for images thumbnails cannot be made of
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// NewBehaviorLogAttachment creates a new attachment for a stored file
+func NewBehaviorLogAttachment(behaviorLogID, uploadedBy uuid.UUID, originalName, mimeType string, size int64, path string) (*BehaviorLogAttachment, error) {
+	if behaviorLogID == uuid.Nil {
+		return nil, fmt.Errorf("behavior log ID is required")
+	}
+	if path == "" {
+		return nil, fmt.Errorf("attachment path is required")
+	}
+
+	return &BehaviorLogAttachment{
+		ID:            uuid.New(),
+		BehaviorLogID: behaviorLogID,
+		UploadedBy:    uploadedBy,
+		OriginalName:  originalName,
+		MimeType:      mimeType,
+		Size:          size,
+		Path:          path,
+		CreatedAt:     time.Now(),
+	}, nil
+}
+
+// HasThumbnail returns true if a thumbnail was generated for the attachment
+func (a *BehaviorLogAttachment) HasThumbnail() bool {
+	return a.ThumbnailPath != ""
+}
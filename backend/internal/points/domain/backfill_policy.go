@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultMaxBackfillHours is how far in the past behaviors can be logged in groups that
+	// kept the default policy, and for logs that are not shared with any group
+	DefaultMaxBackfillHours = 24
+	// MaxBackfillWindowHours bounds the backfill window a group can allow
+	MaxBackfillWindowHours = 7 * 24
+)
+
+// BackfillPolicy is the retroactive logging setting of a group. It bounds how late a behavior
+// can be logged and decides what happens when a log lands on a day whose Pet of the Day is
+// already selected.
+type BackfillPolicy struct {
+	GroupID          uuid.UUID
+	MaxBackfillHours int  // How far in the past a behavior shared with the group can be logged
+	AllowClosedDays  bool // Accept logs on days whose Pet of the Day is already selected
+	RerunPetOfTheDay bool // Select Pet of the Day again when the scores of a closed day change
+	UpdatedBy        *uuid.UUID
+	UpdatedAt        time.Time
+}
+
+// DefaultBackfillPolicy returns the policy of groups that never changed it: logs up to a day
+// old are accepted, and Pet of the Day is selected again when they change a closed day
+func DefaultBackfillPolicy(groupID uuid.UUID) *BackfillPolicy {
+	return &BackfillPolicy{
+		GroupID:          groupID,
+		MaxBackfillHours: DefaultMaxBackfillHours,
+		AllowClosedDays:  true,
+		RerunPetOfTheDay: true,
+	}
+}
+
+// NewBackfillPolicy creates a backfill policy with validation
+func NewBackfillPolicy(groupID, updatedBy uuid.UUID, maxBackfillHours int, allowClosedDays, rerunPetOfTheDay bool) (*BackfillPolicy, error) {
+	if maxBackfillHours < 0 || maxBackfillHours > MaxBackfillWindowHours {
+		return nil, fmt.Errorf("backfill window must be between 0 and %d hours", MaxBackfillWindowHours)
+	}
+
+	return &BackfillPolicy{
+		GroupID:          groupID,
+		MaxBackfillHours: maxBackfillHours,
+		AllowClosedDays:  allowClosedDays,
+		RerunPetOfTheDay: rerunPetOfTheDay,
+		UpdatedBy:        &updatedBy,
+		UpdatedAt:        time.Now(),
+	}, nil
+}
+
+// CheckLoggedAt checks that a behavior logged at the given time is within the backfill window
+func (p *BackfillPolicy) CheckLoggedAt(loggedAt, now time.Time) error {
+	if loggedAt.Before(now.Add(-time.Duration(p.MaxBackfillHours) * time.Hour)) {
+		return fmt.Errorf("logged time cannot be more than %d hours in the past", p.MaxBackfillHours)
+	}
+	return nil
+}
+
+// LateCorrectionCause tells what changed a closed day
+type LateCorrectionCause string
+
+const (
+	LateCorrectionCauseLogCreated  LateCorrectionCause = "log_created"
+	LateCorrectionCauseLogDeleted  LateCorrectionCause = "log_deleted"
+	LateCorrectionCauseLogReviewed LateCorrectionCause = "log_reviewed"
+)
+
+// LateCorrection records a behavior log change on a group day whose Pet of the Day was already
+// selected, along with the winners before and after the change
+type LateCorrection struct {
+	ID                uuid.UUID
+	GroupID           uuid.UUID
+	Date              time.Time // Closed group day that changed
+	Cause             LateCorrectionCause
+	BehaviorLogID     uuid.UUID
+	PetID             uuid.UUID
+	PreviousWinnerIDs []uuid.UUID
+	WinnerIDs         []uuid.UUID // Same as the previous winners unless Pet of the Day was selected again
+	Reselected        bool
+	CreatedAt         time.Time
+}
+
+// NewLateCorrection creates a late correction for a closed day, keeping its current winners
+func NewLateCorrection(groupID uuid.UUID, date time.Time, cause LateCorrectionCause, behaviorLogID, petID uuid.UUID, winners []*PetOfTheDayWinner) *LateCorrection {
+	winnerIDs := winnerPetIDs(winners)
+
+	return &LateCorrection{
+		ID:                uuid.New(),
+		GroupID:           groupID,
+		Date:              normalizeDate(date),
+		Cause:             cause,
+		BehaviorLogID:     behaviorLogID,
+		PetID:             petID,
+		PreviousWinnerIDs: winnerIDs,
+		WinnerIDs:         winnerIDs,
+		CreatedAt:         time.Now(),
+	}
+}
+
+// Reselect records the winners selected again after the change
+func (c *LateCorrection) Reselect(winners []*PetOfTheDayWinner) {
+	c.WinnerIDs = winnerPetIDs(winners)
+	c.Reselected = true
+}
+
+// WinnersChanged returns true if the day has different winners after the correction
+func (c *LateCorrection) WinnersChanged() bool {
+	if len(c.PreviousWinnerIDs) != len(c.WinnerIDs) {
+		return true
+	}
+
+	previous := make(map[uuid.UUID]bool, len(c.PreviousWinnerIDs))
+	for _, petID := range c.PreviousWinnerIDs {
+		previous[petID] = true
+	}
+	for _, petID := range c.WinnerIDs {
+		if !previous[petID] {
+			return true
+		}
+	}
+	return false
+}
+
+// DethronedPetIDs returns the pets that won the day before the correction but no longer do
+func (c *LateCorrection) DethronedPetIDs() []uuid.UUID {
+	current := make(map[uuid.UUID]bool, len(c.WinnerIDs))
+	for _, petID := range c.WinnerIDs {
+		current[petID] = true
+	}
+
+	dethroned := make([]uuid.UUID, 0)
+	for _, petID := range c.PreviousWinnerIDs {
+		if !current[petID] {
+			dethroned = append(dethroned, petID)
+		}
+	}
+	return dethroned
+}
+
+func winnerPetIDs(winners []*PetOfTheDayWinner) []uuid.UUID {
+	petIDs := make([]uuid.UUID, len(winners))
+	for i, winner := range winners {
+		petIDs[i] = winner.PetID
+	}
+	return petIDs
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestBackfillPolicy_CheckLoggedAt(t *testing.T) {
+	groupID, adminID := uuid.New(), uuid.New()
+	now := time.Date(2025, time.March, 11, 10, 0, 0, 0, time.UTC)
+
+	policy := DefaultBackfillPolicy(groupID)
+	if err := policy.CheckLoggedAt(now.Add(-23*time.Hour), now); err != nil {
+		t.Errorf("Expected no error within the default window, got %v", err)
+	}
+	if err := policy.CheckLoggedAt(now.Add(-25*time.Hour), now); err == nil {
+		t.Error("Expected error outside the default window")
+	}
+
+	week, err := NewBackfillPolicy(groupID, adminID, MaxBackfillWindowHours, true, true)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := week.CheckLoggedAt(now.Add(-6*24*time.Hour), now); err != nil {
+		t.Errorf("Expected no error within a week, got %v", err)
+	}
+
+	if _, err := NewBackfillPolicy(groupID, adminID, MaxBackfillWindowHours+1, true, false); err == nil {
+		t.Error("Expected error for a window longer than a week")
+	}
+	if _, err := NewBackfillPolicy(groupID, adminID, -1, true, false); err == nil {
+		t.Error("Expected error for a negative window")
+	}
+}
+
+func TestLateCorrection_Winners(t *testing.T) {
+	rex, luna := uuid.New(), uuid.New()
+	day := time.Date(2025, time.March, 10, 15, 0, 0, 0, time.UTC)
+	winner := func(petID uuid.UUID) *PetOfTheDayWinner { return &PetOfTheDayWinner{PetID: petID} }
+
+	correction := NewLateCorrection(uuid.New(), day, LateCorrectionCauseLogCreated, uuid.New(), luna, []*PetOfTheDayWinner{winner(rex)})
+	if !correction.Date.Equal(time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected the date to be normalized, got %v", correction.Date)
+	}
+	if correction.WinnersChanged() {
+		t.Error("Expected winners to be unchanged before reselection")
+	}
+
+	// A tie keeps Rex and adds Luna
+	correction.Reselect([]*PetOfTheDayWinner{winner(rex), winner(luna)})
+	if !correction.WinnersChanged() || len(correction.DethronedPetIDs()) != 0 {
+		t.Errorf("Expected a change without dethroned pets, got %v", correction.DethronedPetIDs())
+	}
+
+	correction.Reselect([]*PetOfTheDayWinner{winner(luna)})
+	if dethroned := correction.DethronedPetIDs(); len(dethroned) != 1 || dethroned[0] != rex {
+		t.Errorf("Expected Rex to be dethroned, got %v", dethroned)
+	}
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BadgeScope tells whether a badge is earned by a pet or by a user
+type BadgeScope string
+
+const (
+	BadgeScopePet  BadgeScope = "pet"
+	BadgeScopeUser BadgeScope = "user"
+)
+
+// BadgeMetric is a counter badge rules are evaluated against
+type BadgeMetric string
+
+const (
+	// Pet metrics
+	BadgeMetricPetOfTheDayWins    BadgeMetric = "pet_of_the_day_wins"
+	BadgeMetricWinningGroups      BadgeMetric = "winning_groups"
+	BadgeMetricPositiveBehaviors  BadgeMetric = "positive_behaviors"
+	BadgeMetricBestPositiveStreak BadgeMetric = "best_positive_streak"
+	BadgeMetricBestPottyStreak    BadgeMetric = "best_potty_streak"
+
+	// User metrics
+	BadgeMetricBehaviorsLogged BadgeMetric = "behaviors_logged"
+	BadgeMetricGroupsJoined    BadgeMetric = "groups_joined"
+	BadgeMetricGroupsCreated   BadgeMetric = "groups_created"
+)
+
+// BadgeRule declares a badge and the threshold a metric must reach to unlock it
+type BadgeRule struct {
+	Code        string      `json:"code"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Icon        string      `json:"icon"`
+	Scope       BadgeScope  `json:"scope"`
+	Metric      BadgeMetric `json:"metric"`
+	Threshold   int         `json:"threshold"`
+}
+
+// badgeRules is the badge catalog. Codes are persisted with awards and must never change.
+var badgeRules = []BadgeRule{
+	{"first_pet_of_the_day", "Rising Star", "Won Pet of the Day for the first time", "star", BadgeScopePet, BadgeMetricPetOfTheDayWins, 1},
+	{"pet_of_the_day_10", "Crowd Favorite", "Won Pet of the Day 10 times", "trophy", BadgeScopePet, BadgeMetricPetOfTheDayWins, 10},
+	{"winner_in_3_groups", "Globetrotter", "Won Pet of the Day in 3 different groups", "globe", BadgeScopePet, BadgeMetricWinningGroups, 3},
+	{"positive_behaviors_100", "Good Boy", "Logged 100 positive behaviors", "paw", BadgeScopePet, BadgeMetricPositiveBehaviors, 100},
+	{"positive_streak_7", "On a Roll", "Scored positive points 7 days in a row", "fire", BadgeScopePet, BadgeMetricBestPositiveStreak, 7},
+	{"potty_streak_30", "Potty Pro", "Went 30 days in a row without a potty accident", "droplet", BadgeScopePet, BadgeMetricBestPottyStreak, 30},
+	{"first_log", "First Steps", "Logged a first behavior", "pencil", BadgeScopeUser, BadgeMetricBehaviorsLogged, 1},
+	{"behaviors_logged_500", "Dedicated Keeper", "Logged 500 behaviors", "notebook", BadgeScopeUser, BadgeMetricBehaviorsLogged, 500},
+	{"groups_joined_3", "Social Butterfly", "Member of 3 groups", "users", BadgeScopeUser, BadgeMetricGroupsJoined, 3},
+	{"group_founder", "Founder", "Created a group", "flag", BadgeScopeUser, BadgeMetricGroupsCreated, 1},
+}
+
+// GetBadgeRules returns the badge catalog
+func GetBadgeRules() []BadgeRule {
+	rules := make([]BadgeRule, len(badgeRules))
+	copy(rules, badgeRules)
+	return rules
+}
+
+// GetBadgeRule returns the rule of a badge code
+func GetBadgeRule(code string) (BadgeRule, bool) {
+	for _, rule := range badgeRules {
+		if rule.Code == code {
+			return rule, true
+		}
+	}
+	return BadgeRule{}, false
+}
+
+// BadgeProgress holds the metric values of a pet or user
+type BadgeProgress map[BadgeMetric]int
+
+// UnlockedBadgeRules returns the rules of a scope satisfied by the progress
+func UnlockedBadgeRules(scope BadgeScope, progress BadgeProgress) []BadgeRule {
+	unlocked := make([]BadgeRule, 0)
+	for _, rule := range badgeRules {
+		if rule.Scope == scope && progress[rule.Metric] >= rule.Threshold {
+			unlocked = append(unlocked, rule)
+		}
+	}
+	return unlocked
+}
+
+// Badge is a badge awarded to a pet or a user
+type Badge struct {
+	ID        uuid.UUID
+	Code      string
+	Scope     BadgeScope
+	PetID     *uuid.UUID // Set for pet badges
+	UserID    *uuid.UUID // Set for user badges
+	AwardedAt time.Time
+}
+
+// NewPetBadge creates a badge awarded to a pet
+func NewPetBadge(petID uuid.UUID, rule BadgeRule) *Badge {
+	return &Badge{
+		ID:        uuid.New(),
+		Code:      rule.Code,
+		Scope:     BadgeScopePet,
+		PetID:     &petID,
+		AwardedAt: time.Now(),
+	}
+}
+
+// NewUserBadge creates a badge awarded to a user
+func NewUserBadge(userID uuid.UUID, rule BadgeRule) *Badge {
+	return &Badge{
+		ID:        uuid.New(),
+		Code:      rule.Code,
+		Scope:     BadgeScopeUser,
+		UserID:    &userID,
+		AwardedAt: time.Now(),
+	}
+}
@@ -0,0 +1,54 @@
+package domain
+
+import "testing"
+
+func TestUnlockedBadgeRules(t *testing.T) {
+	progress := BadgeProgress{
+		BadgeMetricPetOfTheDayWins:   3,
+		BadgeMetricWinningGroups:     3,
+		BadgeMetricPositiveBehaviors: 99,
+		BadgeMetricBehaviorsLogged:   1,
+	}
+
+	codes := func(rules []BadgeRule) map[string]bool {
+		set := make(map[string]bool, len(rules))
+		for _, rule := range rules {
+			set[rule.Code] = true
+		}
+		return set
+	}
+
+	petBadges := codes(UnlockedBadgeRules(BadgeScopePet, progress))
+	for _, code := range []string{"first_pet_of_the_day", "winner_in_3_groups"} {
+		if !petBadges[code] {
+			t.Errorf("expected pet badge %s to be unlocked", code)
+		}
+	}
+	for _, code := range []string{"pet_of_the_day_10", "positive_behaviors_100", "first_log"} {
+		if petBadges[code] {
+			t.Errorf("expected pet badge %s to stay locked", code)
+		}
+	}
+
+	userBadges := codes(UnlockedBadgeRules(BadgeScopeUser, progress))
+	if len(userBadges) != 1 || !userBadges["first_log"] {
+		t.Errorf("expected only first_log user badge, got %v", userBadges)
+	}
+}
+
+func TestBadgeRules_UniqueCodes(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, rule := range GetBadgeRules() {
+		if seen[rule.Code] {
+			t.Errorf("duplicate badge code %s", rule.Code)
+		}
+		seen[rule.Code] = true
+
+		if found, exists := GetBadgeRule(rule.Code); !exists || found != rule {
+			t.Errorf("GetBadgeRule(%s) = %v, %v", rule.Code, found, exists)
+		}
+		if rule.Threshold <= 0 {
+			t.Errorf("badge %s must have a positive threshold", rule.Code)
+		}
+	}
+}
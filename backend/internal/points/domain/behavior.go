@@ -38,6 +38,7 @@ type Behavior struct {
 	Species            Species
 	Icon               string
 	IsActive           bool
+	GroupID            *uuid.UUID // Set for custom behaviors that only exist within a group
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
 }
@@ -25,9 +25,42 @@ type BehaviorLogGroupShare struct {
 	ID            uuid.UUID
 	BehaviorLogID uuid.UUID
 	GroupID       uuid.UUID
+	PointsAwarded int // Points counted in this group, which may differ from the log's default
+	Status        ShareStatus
+	ReviewedBy    *uuid.UUID // Last member who confirmed, disputed or resolved the share
+	ReviewedAt    *time.Time
+	DisputeReason string
 	CreatedAt     time.Time
 }
 
+// ShareStatus is the verification state of a behavior log in a group
+type ShareStatus string
+
+const (
+	// ShareStatusVerified counts toward the group's daily score. Shares recorded before
+	// verification existed have no status and are verified.
+	ShareStatusVerified ShareStatus = "verified"
+	// ShareStatusPending waits for another member of the group to confirm it
+	ShareStatusPending ShareStatus = "pending"
+	// ShareStatusDisputed was challenged by a member and waits for the group admin
+	ShareStatusDisputed ShareStatus = "disputed"
+	// ShareStatusRejected was turned down by the group admin
+	ShareStatusRejected ShareStatus = "rejected"
+)
+
+// CurrentStatus returns the verification status of the share, verified for shares without one
+func (s BehaviorLogGroupShare) CurrentStatus() ShareStatus {
+	if s.Status == "" {
+		return ShareStatusVerified
+	}
+	return s.Status
+}
+
+// IsCounted returns true if the share counts toward the group's daily score
+func (s BehaviorLogGroupShare) IsCounted() bool {
+	return s.CurrentStatus() == ShareStatusVerified
+}
+
 // NewBehaviorLog creates a new behavior log entry with validation
 func NewBehaviorLog(petID, behaviorID, userID uuid.UUID, pointsAwarded int, loggedAt time.Time, notes string) (*BehaviorLog, error) {
 	if petID == uuid.Nil {
@@ -65,8 +98,13 @@ func NewBehaviorLog(petID, behaviorID, userID uuid.UUID, pointsAwarded int, logg
 	}, nil
 }
 
-// AddGroupShare adds a group share to this behavior log
+// AddGroupShare adds a group share to this behavior log using the log's point value
 func (bl *BehaviorLog) AddGroupShare(groupID uuid.UUID) error {
+	return bl.AddGroupShareWithPoints(groupID, bl.PointsAwarded)
+}
+
+// AddGroupShareWithPoints adds a group share that awards a group-specific point value
+func (bl *BehaviorLog) AddGroupShareWithPoints(groupID uuid.UUID, points int) error {
 	if groupID == uuid.Nil {
 		return fmt.Errorf("group ID is required")
 	}
@@ -82,6 +120,8 @@ func (bl *BehaviorLog) AddGroupShare(groupID uuid.UUID) error {
 		ID:            uuid.New(),
 		BehaviorLogID: bl.ID,
 		GroupID:       groupID,
+		PointsAwarded: points,
+		Status:        ShareStatusVerified,
 		CreatedAt:     time.Now(),
 	}
 
@@ -119,6 +159,142 @@ func (bl *BehaviorLog) GetSharedGroupIDs() []uuid.UUID {
 	return groupIDs
 }
 
+// PointsForGroup returns the points this log awards in a group. Shares recorded before
+// group point values existed have no value of their own and use the log's points.
+func (bl *BehaviorLog) PointsForGroup(groupID uuid.UUID) int {
+	for _, share := range bl.GroupShares {
+		if share.GroupID == groupID && share.PointsAwarded != 0 {
+			return share.PointsAwarded
+		}
+	}
+	return bl.PointsAwarded
+}
+
+// GetGroupShare returns the share of this behavior log with a group, nil if it is not shared
+func (bl *BehaviorLog) GetGroupShare(groupID uuid.UUID) *BehaviorLogGroupShare {
+	for i := range bl.GroupShares {
+		if bl.GroupShares[i].GroupID == groupID {
+			return &bl.GroupShares[i]
+		}
+	}
+	return nil
+}
+
+// IsCountedInGroup returns true if this behavior log counts toward the group's daily score
+func (bl *BehaviorLog) IsCountedInGroup(groupID uuid.UUID) bool {
+	share := bl.GetGroupShare(groupID)
+	return share != nil && share.IsCounted()
+}
+
+// IsCounted returns true if this behavior log counts toward the pet's own records. A log shared
+// with groups counts once one of its shares counts; a log shared with no group always counts.
+func (bl *BehaviorLog) IsCounted() bool {
+	if len(bl.GroupShares) == 0 {
+		return true
+	}
+	for _, share := range bl.GroupShares {
+		if share.IsCounted() {
+			return true
+		}
+	}
+	return false
+}
+
+// RequireVerification holds the share with a group until another member confirms it
+func (bl *BehaviorLog) RequireVerification(groupID uuid.UUID) error {
+	share := bl.GetGroupShare(groupID)
+	if share == nil {
+		return fmt.Errorf("group share not found for group %s", groupID)
+	}
+
+	share.Status = ShareStatusPending
+	return nil
+}
+
+// ConfirmInGroup verifies a pending share. The member who logged the behavior cannot confirm it.
+func (bl *BehaviorLog) ConfirmInGroup(groupID, reviewerID uuid.UUID, now time.Time) error {
+	share, err := bl.reviewableShare(groupID, reviewerID)
+	if err != nil {
+		return err
+	}
+	if share.Status != ShareStatusPending {
+		return fmt.Errorf("only pending behavior logs can be confirmed")
+	}
+
+	share.review(ShareStatusVerified, reviewerID, now)
+	return nil
+}
+
+// DisputeInGroup sends a pending or verified share to the group admin
+func (bl *BehaviorLog) DisputeInGroup(groupID, reviewerID uuid.UUID, reason string, now time.Time) error {
+	share, err := bl.reviewableShare(groupID, reviewerID)
+	if err != nil {
+		return err
+	}
+	if status := share.CurrentStatus(); status == ShareStatusDisputed || status == ShareStatusRejected {
+		return fmt.Errorf("behavior log is already %s", status)
+	}
+	if len(reason) == 0 || len(reason) > 200 {
+		return fmt.Errorf("dispute reason must be between 1 and 200 characters")
+	}
+
+	share.review(ShareStatusDisputed, reviewerID, now)
+	share.DisputeReason = reason
+	return nil
+}
+
+// HoldInGroup sends a share to the group admin on behalf of the application, e.g. when the
+// log looks like score farming
+func (bl *BehaviorLog) HoldInGroup(groupID uuid.UUID, reason string) error {
+	share := bl.GetGroupShare(groupID)
+	if share == nil {
+		return fmt.Errorf("group share not found for group %s", groupID)
+	}
+	if status := share.CurrentStatus(); status == ShareStatusDisputed || status == ShareStatusRejected {
+		return fmt.Errorf("behavior log is already %s", status)
+	}
+
+	share.Status = ShareStatusDisputed
+	share.DisputeReason = reason
+	return nil
+}
+
+// ResolveDisputeInGroup settles a disputed share: an upheld log counts again, otherwise it is rejected
+func (bl *BehaviorLog) ResolveDisputeInGroup(groupID, adminID uuid.UUID, uphold bool, now time.Time) error {
+	share := bl.GetGroupShare(groupID)
+	if share == nil {
+		return fmt.Errorf("group share not found for group %s", groupID)
+	}
+	if share.Status != ShareStatusDisputed {
+		return fmt.Errorf("only disputed behavior logs can be resolved")
+	}
+
+	status := ShareStatusRejected
+	if uphold {
+		status = ShareStatusVerified
+	}
+	share.review(status, adminID, now)
+	return nil
+}
+
+// reviewableShare returns the share a member other than the logger can confirm or dispute
+func (bl *BehaviorLog) reviewableShare(groupID, reviewerID uuid.UUID) (*BehaviorLogGroupShare, error) {
+	share := bl.GetGroupShare(groupID)
+	if share == nil {
+		return nil, fmt.Errorf("group share not found for group %s", groupID)
+	}
+	if reviewerID == bl.UserID {
+		return nil, fmt.Errorf("behavior logs must be reviewed by another member")
+	}
+	return share, nil
+}
+
+func (s *BehaviorLogGroupShare) review(status ShareStatus, reviewerID uuid.UUID, now time.Time) {
+	s.Status = status
+	s.ReviewedBy = &reviewerID
+	s.ReviewedAt = &now
+}
+
 // IsPositive returns true if this behavior log awards positive points
 func (bl *BehaviorLog) IsPositive() bool {
 	return bl.PointsAwarded > 0
@@ -151,9 +327,9 @@ func validateLoggedAtTime(loggedAt time.Time) error {
 		return fmt.Errorf("logged time cannot be in the future")
 	}
 
-	// Cannot be more than 24 hours in the past
-	if loggedAt.Before(now.Add(-24 * time.Hour)) {
-		return fmt.Errorf("logged time cannot be more than 24 hours in the past")
+	// Groups choose their own window within this bound, see BackfillPolicy
+	if loggedAt.Before(now.Add(-MaxBackfillWindowHours * time.Hour)) {
+		return fmt.Errorf("logged time cannot be more than %d hours in the past", MaxBackfillWindowHours)
 	}
 
 	return nil
@@ -168,14 +344,15 @@ func validateNotes(notes string) error {
 
 // BehaviorLogFilter represents criteria for filtering behavior logs
 type BehaviorLogFilter struct {
-	PetID      *uuid.UUID
-	BehaviorID *uuid.UUID
-	GroupID    *uuid.UUID
-	UserID     *uuid.UUID
-	DateFrom   *time.Time
-	DateTo     *time.Time
-	Limit      int
-	Offset     int
+	PetID       *uuid.UUID
+	BehaviorID  *uuid.UUID
+	GroupID     *uuid.UUID
+	UserID      *uuid.UUID
+	DateFrom    *time.Time
+	DateTo      *time.Time
+	ShareStatus *ShareStatus // Status of the share with GroupID
+	Limit       int
+	Offset      int
 }
 
 // NewBehaviorLogFilter creates a new filter with sensible defaults
@@ -217,6 +394,12 @@ func (f *BehaviorLogFilter) WithDateRange(from, to time.Time) *BehaviorLogFilter
 	return f
 }
 
+// WithShareStatus adds a verification status filter on the share with the filtered group
+func (f *BehaviorLogFilter) WithShareStatus(status ShareStatus) *BehaviorLogFilter {
+	f.ShareStatus = &status
+	return f
+}
+
 // WithPagination sets limit and offset
 func (f *BehaviorLogFilter) WithPagination(limit, offset int) *BehaviorLogFilter {
 	f.Limit = limit
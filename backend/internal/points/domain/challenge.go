@@ -0,0 +1,274 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ChallengeMetric is what a challenge measures over the matching behavior logs
+type ChallengeMetric string
+
+const (
+	ChallengeMetricCount  ChallengeMetric = "count"
+	ChallengeMetricPoints ChallengeMetric = "points"
+)
+
+// ChallengeGoal tells whether the measure has to reach the target or stay within it
+type ChallengeGoal string
+
+const (
+	// ChallengeGoalAtLeast is met when the measure reaches the target, e.g. "log 20 training behaviors"
+	ChallengeGoalAtLeast ChallengeGoal = "at_least"
+	// ChallengeGoalAtMost is met when the measure never exceeds the target, e.g. "no accidents"
+	ChallengeGoalAtMost ChallengeGoal = "at_most"
+)
+
+// ChallengePolarity restricts the matching behavior logs to positive or negative ones
+type ChallengePolarity string
+
+const (
+	ChallengePolarityAny      ChallengePolarity = "any"
+	ChallengePolarityPositive ChallengePolarity = "positive"
+	ChallengePolarityNegative ChallengePolarity = "negative"
+)
+
+// ChallengeStatus represents the lifecycle of a challenge
+type ChallengeStatus string
+
+const (
+	ChallengeStatusActive ChallengeStatus = "active"
+	ChallengeStatusClosed ChallengeStatus = "closed"
+)
+
+// ChallengeOutcome is the result of a pet in a challenge
+type ChallengeOutcome string
+
+const (
+	ChallengeOutcomeInProgress ChallengeOutcome = "in_progress"
+	ChallengeOutcomeCompleted  ChallengeOutcome = "completed"
+	ChallengeOutcomeFailed     ChallengeOutcome = "failed"
+)
+
+const (
+	// MaxChallengeDuration bounds the window of a challenge
+	MaxChallengeDuration = 90 * 24 * time.Hour
+	// MaxChallengeBonusPoints bounds the bonus a challenge can award
+	MaxChallengeBonusPoints = 100
+)
+
+// ChallengeTarget defines the behavior logs a challenge looks at and the goal over them
+type ChallengeTarget struct {
+	BehaviorID *uuid.UUID        `json:"behavior_id,omitempty"` // Only logs of this behavior
+	Category   *BehaviorCategory `json:"category,omitempty"`    // Only logs of behaviors in this category
+	Polarity   ChallengePolarity `json:"polarity"`
+	Metric     ChallengeMetric   `json:"metric"`
+	Goal       ChallengeGoal     `json:"goal"`
+	Value      int               `json:"value"`
+}
+
+// Validate checks the target, defaulting an empty polarity to any
+func (t *ChallengeTarget) Validate() error {
+	if t.Polarity == "" {
+		t.Polarity = ChallengePolarityAny
+	}
+
+	switch t.Polarity {
+	case ChallengePolarityAny, ChallengePolarityPositive, ChallengePolarityNegative:
+	default:
+		return fmt.Errorf("invalid challenge polarity: %s", t.Polarity)
+	}
+
+	switch t.Metric {
+	case ChallengeMetricCount, ChallengeMetricPoints:
+	default:
+		return fmt.Errorf("invalid challenge metric: %s", t.Metric)
+	}
+
+	switch t.Goal {
+	case ChallengeGoalAtLeast:
+		if t.Value <= 0 {
+			return fmt.Errorf("challenge target must be positive")
+		}
+	case ChallengeGoalAtMost:
+		if t.Value < 0 {
+			return fmt.Errorf("challenge target cannot be negative")
+		}
+	default:
+		return fmt.Errorf("invalid challenge goal: %s", t.Goal)
+	}
+
+	if t.Category != nil {
+		if err := validateCategory(*t.Category); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Matches checks if a behavior log counts toward the target. The category is the one of
+// the log's behavior and points are the ones awarded in the challenge's group.
+func (t ChallengeTarget) Matches(behaviorLog *BehaviorLog, category BehaviorCategory, points int) bool {
+	if t.BehaviorID != nil && behaviorLog.BehaviorID != *t.BehaviorID {
+		return false
+	}
+	if t.Category != nil && category != *t.Category {
+		return false
+	}
+
+	switch t.Polarity {
+	case ChallengePolarityPositive:
+		return points > 0
+	case ChallengePolarityNegative:
+		return points < 0
+	default:
+		return true
+	}
+}
+
+// Challenge is a goal set by a group admin that pets of the group try to meet before a deadline
+type Challenge struct {
+	ID          uuid.UUID
+	GroupID     uuid.UUID
+	Name        string
+	Description string
+	Target      ChallengeTarget
+	StartsAt    time.Time
+	EndsAt      time.Time // Deadline, excluded from the window
+	BonusPoints int       // Added to the score of the pets that complete the challenge
+	Status      ChallengeStatus
+	CreatedBy   uuid.UUID
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	ClosedAt    *time.Time
+}
+
+// NewChallenge creates a new challenge with validation
+func NewChallenge(groupID, createdBy uuid.UUID, name, description string, target ChallengeTarget, startsAt, endsAt time.Time, bonusPoints int) (*Challenge, error) {
+	challenge := &Challenge{
+		ID:        uuid.New(),
+		GroupID:   groupID,
+		Status:    ChallengeStatusActive,
+		CreatedBy: createdBy,
+		CreatedAt: time.Now(),
+	}
+
+	if err := challenge.Update(name, description, target, startsAt, endsAt, bonusPoints); err != nil {
+		return nil, err
+	}
+
+	return challenge, nil
+}
+
+// Update changes the definition of an active challenge
+func (c *Challenge) Update(name, description string, target ChallengeTarget, startsAt, endsAt time.Time, bonusPoints int) error {
+	if c.IsClosed() {
+		return fmt.Errorf("a closed challenge cannot be changed")
+	}
+
+	if len(name) == 0 || len(name) > 100 {
+		return fmt.Errorf("challenge name must be between 1 and 100 characters")
+	}
+	if len(description) > 500 {
+		return fmt.Errorf("challenge description cannot exceed 500 characters")
+	}
+
+	if err := target.Validate(); err != nil {
+		return err
+	}
+
+	if !endsAt.After(startsAt) {
+		return fmt.Errorf("challenge deadline must be after its start")
+	}
+	if endsAt.Sub(startsAt) > MaxChallengeDuration {
+		return fmt.Errorf("challenge cannot last longer than %d days", int(MaxChallengeDuration.Hours()/24))
+	}
+
+	if bonusPoints < 0 || bonusPoints > MaxChallengeBonusPoints {
+		return fmt.Errorf("challenge bonus must be between 0 and %d points", MaxChallengeBonusPoints)
+	}
+
+	c.Name = name
+	c.Description = description
+	c.Target = target
+	c.StartsAt = startsAt
+	c.EndsAt = endsAt
+	c.BonusPoints = bonusPoints
+	c.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// Contains checks if a moment is within the challenge window
+func (c *Challenge) Contains(t time.Time) bool {
+	return !t.Before(c.StartsAt) && t.Before(c.EndsAt)
+}
+
+// IsClosed returns true if the challenge outcomes are final
+func (c *Challenge) IsClosed() bool {
+	return c.Status == ChallengeStatusClosed
+}
+
+// IsDue returns true if the deadline of an active challenge has passed
+func (c *Challenge) IsDue(now time.Time) bool {
+	return !c.IsClosed() && !now.Before(c.EndsAt)
+}
+
+// Close marks the challenge as closed
+func (c *Challenge) Close(now time.Time) error {
+	if c.IsClosed() {
+		return fmt.Errorf("challenge is already closed")
+	}
+
+	c.Status = ChallengeStatusClosed
+	c.ClosedAt = &now
+	c.UpdatedAt = now
+	return nil
+}
+
+// Outcome returns the result of a measure at the deadline
+func (c *Challenge) Outcome(measure int) ChallengeOutcome {
+	met := measure >= c.Target.Value
+	if c.Target.Goal == ChallengeGoalAtMost {
+		met = measure <= c.Target.Value
+	}
+
+	if met {
+		return ChallengeOutcomeCompleted
+	}
+	return ChallengeOutcomeFailed
+}
+
+// ChallengeProgress tracks a pet's progress in a challenge. Pets take part as soon as
+// they log a behavior shared with the challenge's group during its window.
+type ChallengeProgress struct {
+	ID           uuid.UUID
+	ChallengeID  uuid.UUID
+	PetID        uuid.UUID
+	Count        int // Matching behavior logs
+	Points       int // Points of the matching behavior logs in the group
+	Outcome      ChallengeOutcome
+	BonusAwarded int
+	UpdatedAt    time.Time
+}
+
+// NewChallengeProgress creates an empty progress entry for a pet
+func NewChallengeProgress(challengeID, petID uuid.UUID) *ChallengeProgress {
+	return &ChallengeProgress{
+		ID:          uuid.New(),
+		ChallengeID: challengeID,
+		PetID:       petID,
+		Outcome:     ChallengeOutcomeInProgress,
+		UpdatedAt:   time.Now(),
+	}
+}
+
+// Measure returns the value compared against the target
+func (p *ChallengeProgress) Measure(metric ChallengeMetric) int {
+	if metric == ChallengeMetricPoints {
+		return p.Points
+	}
+	return p.Count
+}
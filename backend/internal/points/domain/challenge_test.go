@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewChallenge(t *testing.T) {
+	groupID, userID := uuid.New(), uuid.New()
+	start := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	target := ChallengeTarget{Metric: ChallengeMetricCount, Goal: ChallengeGoalAtLeast, Value: 20}
+
+	challenge, err := NewChallenge(groupID, userID, "Training week", "", target, start, start.AddDate(0, 0, 7), 10)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if challenge.Status != ChallengeStatusActive || challenge.Target.Polarity != ChallengePolarityAny {
+		t.Errorf("Expected active challenge with any polarity, got %+v", challenge)
+	}
+
+	invalidCategory := BehaviorCategory("napping")
+	invalid := []struct {
+		name   string
+		target ChallengeTarget
+		end    time.Time
+		bonus  int
+	}{
+		{"Deadline before start", target, start, 10},
+		{"Too long", target, start.Add(MaxChallengeDuration + time.Hour), 10},
+		{"Bonus too high", target, start.AddDate(0, 0, 7), MaxChallengeBonusPoints + 1},
+		{"Unknown metric", ChallengeTarget{Metric: "streak", Goal: ChallengeGoalAtLeast, Value: 1}, start.AddDate(0, 0, 7), 0},
+		{"Zero at least target", ChallengeTarget{Metric: ChallengeMetricCount, Goal: ChallengeGoalAtLeast}, start.AddDate(0, 0, 7), 0},
+		{"Unknown category", ChallengeTarget{Category: &invalidCategory, Metric: ChallengeMetricCount, Goal: ChallengeGoalAtMost}, start.AddDate(0, 0, 7), 0},
+	}
+	for _, test := range invalid {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := NewChallenge(groupID, userID, "Challenge", "", test.target, start, test.end, test.bonus); err == nil {
+				t.Error("Expected validation error")
+			}
+		})
+	}
+
+	t.Run("Closed challenges cannot change", func(t *testing.T) {
+		if err := challenge.Close(start.AddDate(0, 0, 7)); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if err := challenge.Update("Renamed", "", target, start, start.AddDate(0, 0, 7), 10); err == nil {
+			t.Error("Expected error when updating a closed challenge")
+		}
+		if err := challenge.Close(start.AddDate(0, 0, 8)); err == nil {
+			t.Error("Expected error when closing twice")
+		}
+	})
+}
+
+func TestChallengeWindowAndOutcome(t *testing.T) {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 5)
+	noAccidents := ChallengeTarget{Polarity: ChallengePolarityNegative, Metric: ChallengeMetricCount, Goal: ChallengeGoalAtMost}
+	challenge, _ := NewChallenge(uuid.New(), uuid.New(), "No accidents", "", noAccidents, start, end, 5)
+
+	if !challenge.Contains(start) || challenge.Contains(end) {
+		t.Error("Expected the window to include its start and exclude its deadline")
+	}
+	if challenge.IsDue(end.Add(-time.Second)) || !challenge.IsDue(end) {
+		t.Error("Expected the challenge to be due at its deadline")
+	}
+
+	if got := challenge.Outcome(0); got != ChallengeOutcomeCompleted {
+		t.Errorf("Expected completed without accidents, got %s", got)
+	}
+	if got := challenge.Outcome(1); got != ChallengeOutcomeFailed {
+		t.Errorf("Expected failed with an accident, got %s", got)
+	}
+}
+
+func TestChallengeTargetMatches(t *testing.T) {
+	behaviorID, otherBehaviorID := uuid.New(), uuid.New()
+	training := BehaviorCategoryTraining
+	behaviorLog := &BehaviorLog{BehaviorID: behaviorID}
+
+	tests := []struct {
+		name     string
+		target   ChallengeTarget
+		category BehaviorCategory
+		points   int
+		want     bool
+	}{
+		{"Any log", ChallengeTarget{Polarity: ChallengePolarityAny}, BehaviorCategorySocial, 5, true},
+		{"Same behavior", ChallengeTarget{BehaviorID: &behaviorID}, BehaviorCategorySocial, 5, true},
+		{"Other behavior", ChallengeTarget{BehaviorID: &otherBehaviorID}, BehaviorCategorySocial, 5, false},
+		{"Same category", ChallengeTarget{Category: &training}, BehaviorCategoryTraining, 5, true},
+		{"Other category", ChallengeTarget{Category: &training}, BehaviorCategorySocial, 5, false},
+		{"Positive only", ChallengeTarget{Polarity: ChallengePolarityPositive}, BehaviorCategorySocial, -5, false},
+		{"Negative only", ChallengeTarget{Polarity: ChallengePolarityNegative}, BehaviorCategorySocial, -5, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.target.Matches(behaviorLog, test.category, test.points); got != test.want {
+				t.Errorf("Expected %v, got %v", test.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// MaxCommentLength bounds the body of a comment, in characters
+const MaxCommentLength = 1000
+
+// ReactionEmojis is the set of emojis members can react with
+var ReactionEmojis = []string{"👍", "❤️", "😂", "🎉", "🐾", "😮"}
+
+// IsValidReaction checks if an emoji belongs to the reaction set
+func IsValidReaction(emoji string) bool {
+	for _, allowed := range ReactionEmojis {
+		if emoji == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// Comment is a message about a behavior log in one of the groups it is shared with. Comments
+// belong to the group share: members of other groups the log is shared with do not see them.
+type Comment struct {
+	ID            uuid.UUID  `json:"id"`
+	BehaviorLogID uuid.UUID  `json:"behavior_log_id"`
+	GroupID       uuid.UUID  `json:"group_id"`
+	UserID        uuid.UUID  `json:"user_id"`
+	ParentID      *uuid.UUID `json:"parent_id,omitempty"` // Set for replies
+	Body          string     `json:"body"`
+	CreatedAt     time.Time  `json:"created_at"`
+	EditedAt      *time.Time `json:"edited_at,omitempty"`
+	DeletedAt     *time.Time `json:"deleted_at,omitempty"`
+	DeletedBy     *uuid.UUID `json:"deleted_by,omitempty"` // The author, or the group admin moderating
+}
+
+// NewComment creates a new comment with validation. Replies are made to a comment of the same
+// behavior log in the same group.
+func NewComment(behaviorLogID, groupID, userID uuid.UUID, parent *Comment, body string) (*Comment, error) {
+	if behaviorLogID == uuid.Nil {
+		return nil, fmt.Errorf("behavior log ID is required")
+	}
+	if groupID == uuid.Nil {
+		return nil, fmt.Errorf("group ID is required")
+	}
+
+	comment := &Comment{
+		ID:            uuid.New(),
+		BehaviorLogID: behaviorLogID,
+		GroupID:       groupID,
+		UserID:        userID,
+		CreatedAt:     time.Now(),
+	}
+
+	if parent != nil {
+		if parent.BehaviorLogID != behaviorLogID || parent.GroupID != groupID {
+			return nil, fmt.Errorf("a reply must be made in the thread of the same behavior log and group")
+		}
+		if parent.IsDeleted() {
+			return nil, fmt.Errorf("cannot reply to a deleted comment")
+		}
+		parentID := parent.ID
+		comment.ParentID = &parentID
+	}
+
+	if err := comment.setBody(body); err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+// Edit changes the body of a comment. Only its author can edit it.
+func (c *Comment) Edit(userID uuid.UUID, body string, now time.Time) error {
+	if c.IsDeleted() {
+		return fmt.Errorf("a deleted comment cannot be edited")
+	}
+	if c.UserID != userID {
+		return fmt.Errorf("only the author can edit a comment")
+	}
+
+	if err := c.setBody(body); err != nil {
+		return err
+	}
+	c.EditedAt = &now
+	return nil
+}
+
+// Delete removes the body of a comment. The comment is kept so that the replies to it stay in
+// their thread.
+func (c *Comment) Delete(deletedBy uuid.UUID, now time.Time) error {
+	if c.IsDeleted() {
+		return fmt.Errorf("comment is already deleted")
+	}
+
+	c.Body = ""
+	c.DeletedAt = &now
+	c.DeletedBy = &deletedBy
+	return nil
+}
+
+// IsDeleted returns true if the comment was deleted by its author or a moderator
+func (c *Comment) IsDeleted() bool {
+	return c.DeletedAt != nil
+}
+
+func (c *Comment) setBody(body string) error {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return fmt.Errorf("comment cannot be empty")
+	}
+	if utf8.RuneCountInString(body) > MaxCommentLength {
+		return fmt.Errorf("comment cannot exceed %d characters", MaxCommentLength)
+	}
+
+	c.Body = body
+	return nil
+}
+
+// CommentFilter represents criteria for filtering the comments of a behavior log
+type CommentFilter struct {
+	BehaviorLogID *uuid.UUID
+	GroupID       *uuid.UUID
+	Limit         int
+	Offset        int
+}
+
+// NewCommentFilter creates a new filter with sensible defaults
+func NewCommentFilter() *CommentFilter {
+	return &CommentFilter{
+		Limit:  50,
+		Offset: 0,
+	}
+}
+
+// WithBehaviorLog adds a behavior log ID filter
+func (f *CommentFilter) WithBehaviorLog(behaviorLogID uuid.UUID) *CommentFilter {
+	f.BehaviorLogID = &behaviorLogID
+	return f
+}
+
+// WithGroup adds a group ID filter
+func (f *CommentFilter) WithGroup(groupID uuid.UUID) *CommentFilter {
+	f.GroupID = &groupID
+	return f
+}
+
+// WithPagination sets limit and offset
+func (f *CommentFilter) WithPagination(limit, offset int) *CommentFilter {
+	f.Limit = limit
+	f.Offset = offset
+	return f
+}
+
+// Reaction is an emoji a member put on a behavior log in one of the groups it is shared with.
+// A member reacts at most once with each emoji.
+type Reaction struct {
+	ID            uuid.UUID `json:"id"`
+	BehaviorLogID uuid.UUID `json:"behavior_log_id"`
+	GroupID       uuid.UUID `json:"group_id"`
+	UserID        uuid.UUID `json:"user_id"`
+	Emoji         string    `json:"emoji"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// NewReaction creates a new reaction with validation
+func NewReaction(behaviorLogID, groupID, userID uuid.UUID, emoji string) (*Reaction, error) {
+	if !IsValidReaction(emoji) {
+		return nil, fmt.Errorf("invalid reaction: %s", emoji)
+	}
+
+	return &Reaction{
+		ID:            uuid.New(),
+		BehaviorLogID: behaviorLogID,
+		GroupID:       groupID,
+		UserID:        userID,
+		Emoji:         emoji,
+		CreatedAt:     time.Now(),
+	}, nil
+}
+
+// ReactionSummary counts the reactions with one emoji on a behavior log in a group
+type ReactionSummary struct {
+	Emoji   string `json:"emoji"`
+	Count   int    `json:"count"`
+	Reacted bool   `json:"reacted"` // The user looking at the log reacted with this emoji
+}
+
+// SummarizeReactions counts reactions per emoji, in the order of the reaction set
+func SummarizeReactions(reactions []*Reaction, viewerID uuid.UUID) []ReactionSummary {
+	summaries := make([]ReactionSummary, 0)
+	for _, emoji := range ReactionEmojis {
+		summary := ReactionSummary{Emoji: emoji}
+		for _, reaction := range reactions {
+			if reaction.Emoji != emoji {
+				continue
+			}
+			summary.Count++
+			if reaction.UserID == viewerID {
+				summary.Reacted = true
+			}
+		}
+		if summary.Count > 0 {
+			summaries = append(summaries, summary)
+		}
+	}
+	return summaries
+}
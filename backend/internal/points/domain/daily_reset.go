@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GroupResetState records the last day for which the daily reset ran for a group
+type GroupResetState struct {
+	GroupID       uuid.UUID
+	LastResetDate time.Time
+	LastRunAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewGroupResetState creates a reset state for a group that has just been reset for a date
+func NewGroupResetState(groupID uuid.UUID, resetDate, runAt time.Time) (*GroupResetState, error) {
+	if groupID == uuid.Nil {
+		return nil, fmt.Errorf("group ID is required")
+	}
+
+	return &GroupResetState{
+		GroupID:       groupID,
+		LastResetDate: normalizeDate(resetDate),
+		LastRunAt:     runAt,
+		UpdatedAt:     time.Now(),
+	}, nil
+}
+
+// MarkReset records that the daily reset completed for the given date
+func (s *GroupResetState) MarkReset(resetDate, runAt time.Time) {
+	s.LastResetDate = normalizeDate(resetDate)
+	s.LastRunAt = runAt
+	s.UpdatedAt = time.Now()
+}
+
+// HasResetFor returns true if the reset already ran for the given date (or a later one)
+func (s *GroupResetState) HasResetFor(date time.Time) bool {
+	lastReset := s.LastResetDate.In(date.Location())
+	lastDay := time.Date(lastReset.Year(), lastReset.Month(), lastReset.Day(), 0, 0, 0, 0, date.Location())
+	return !lastDay.Before(normalizeDate(date))
+}
+
+// normalizeDate strips the time component of a date, keeping its location
+func normalizeDate(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+}
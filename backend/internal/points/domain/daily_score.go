@@ -2,6 +2,8 @@ package domain
 
 import (
 	"fmt"
+	"math"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,6 +20,7 @@ type DailyScore struct {
 	NegativeBehaviors   int
 	BehaviorPointTotal  int
 	LastActivityAt      *time.Time
+	ArchivedAt          *time.Time // Set once the logs of the day are purged, the score is then final
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
 }
@@ -52,47 +55,62 @@ func NewDailyScore(petID, groupID uuid.UUID, date time.Time) (*DailyScore, error
 	}, nil
 }
 
-// AddBehaviorLog updates the daily score with a new behavior log
+// AddBehaviorLog updates the daily score with a new behavior log. Archived scores are final
+// and cannot change.
 func (ds *DailyScore) AddBehaviorLog(behaviorLog *BehaviorLog) error {
 	if behaviorLog == nil {
 		return fmt.Errorf("behavior log is required")
 	}
+	if ds.IsArchived() {
+		return ErrDailyScoreArchived
+	}
 
-	// Update point totals
-	ds.BehaviorPointTotal += behaviorLog.PointsAwarded
-	ds.TotalPoints += behaviorLog.PointsAwarded
+	// Update point totals with the value awarded in this group
+	points := behaviorLog.PointsForGroup(ds.GroupID)
+	ds.BehaviorPointTotal += points
+	ds.TotalPoints += points
 
 	// Update behavior counts
-	if behaviorLog.IsPositive() {
+	if points > 0 {
 		ds.PositiveBehaviors++
-	} else if behaviorLog.IsNegative() {
+	} else if points < 0 {
 		ds.NegativeBehaviors++
 	}
 
-	// Update last activity time
-	ds.LastActivityAt = &behaviorLog.LoggedAt
+	// Keep the latest activity, backdated logs do not move it back
+	if ds.LastActivityAt == nil || behaviorLog.LoggedAt.After(*ds.LastActivityAt) {
+		loggedAt := behaviorLog.LoggedAt
+		ds.LastActivityAt = &loggedAt
+	}
 	ds.UpdatedAt = time.Now()
 
 	return nil
 }
 
-// RemoveBehaviorLog updates the daily score by removing a behavior log's contribution
+// RemoveBehaviorLog updates the daily score by removing a behavior log's contribution.
+// The last activity cannot be restored from the score alone, so scores ranked with the
+// earliest to score tie breaker must be rebuilt with Recalculate instead. Archived scores
+// are final and cannot change.
 func (ds *DailyScore) RemoveBehaviorLog(behaviorLog *BehaviorLog) error {
 	if behaviorLog == nil {
 		return fmt.Errorf("behavior log is required")
 	}
+	if ds.IsArchived() {
+		return ErrDailyScoreArchived
+	}
 
-	// Update point totals
-	ds.BehaviorPointTotal -= behaviorLog.PointsAwarded
-	ds.TotalPoints -= behaviorLog.PointsAwarded
+	// Update point totals with the value awarded in this group
+	points := behaviorLog.PointsForGroup(ds.GroupID)
+	ds.BehaviorPointTotal -= points
+	ds.TotalPoints -= points
 
 	// Update behavior counts
-	if behaviorLog.IsPositive() {
+	if points > 0 {
 		ds.PositiveBehaviors--
 		if ds.PositiveBehaviors < 0 {
 			ds.PositiveBehaviors = 0
 		}
-	} else if behaviorLog.IsNegative() {
+	} else if points < 0 {
 		ds.NegativeBehaviors--
 		if ds.NegativeBehaviors < 0 {
 			ds.NegativeBehaviors = 0
@@ -104,24 +122,85 @@ func (ds *DailyScore) RemoveBehaviorLog(behaviorLog *BehaviorLog) error {
 	return nil
 }
 
-// IsWinningScore returns true if this score would win against another score
-// Uses tie-breaking rules: higher points win, if tied then fewer negative behaviors win
-func (ds *DailyScore) IsWinningScore(other *DailyScore) bool {
-	if ds.TotalPoints > other.TotalPoints {
-		return true
+// AddAdjustment updates the daily score with a point ledger entry. Adjustments count
+// toward the total but not toward the behavior point total or the behavior counts. Archived
+// scores are final and cannot change.
+func (ds *DailyScore) AddAdjustment(adjustment *PointAdjustment) error {
+	if adjustment == nil {
+		return fmt.Errorf("point adjustment is required")
+	}
+	if adjustment.PetID != ds.PetID || adjustment.GroupID != ds.GroupID {
+		return fmt.Errorf("point adjustment belongs to another daily score")
+	}
+	if ds.IsArchived() {
+		return ErrDailyScoreArchived
+	}
+
+	ds.TotalPoints += adjustment.Points
+	ds.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// AdjustmentPoints returns the points of the day that do not come from behavior logs
+func (ds *DailyScore) AdjustmentPoints() int {
+	return ds.TotalPoints - ds.BehaviorPointTotal
+}
+
+// Recalculate rebuilds the daily score from the behavior logs and point adjustments of its day.
+// Archived scores cannot be rebuilt since their logs were purged.
+func (ds *DailyScore) Recalculate(behaviorLogs []*BehaviorLog, adjustments []*PointAdjustment) error {
+	if ds.IsArchived() {
+		return ErrDailyScoreArchived
 	}
 
-	if ds.TotalPoints == other.TotalPoints {
-		// Tie-breaking: fewer negative behaviors wins
-		return ds.NegativeBehaviors < other.NegativeBehaviors
+	ds.TotalPoints = 0
+	ds.PositiveBehaviors = 0
+	ds.NegativeBehaviors = 0
+	ds.BehaviorPointTotal = 0
+	ds.LastActivityAt = nil
+
+	for _, behaviorLog := range behaviorLogs {
+		// Logs waiting for verification or rejected in the group do not count
+		if share := behaviorLog.GetGroupShare(ds.GroupID); share != nil && !share.IsCounted() {
+			continue
+		}
+
+		if err := ds.AddBehaviorLog(behaviorLog); err != nil {
+			return err
+		}
 	}
 
-	return false
+	for _, adjustment := range adjustments {
+		if err := ds.AddAdjustment(adjustment); err != nil {
+			return err
+		}
+	}
+
+	ds.UpdatedAt = time.Now()
+	return nil
+}
+
+// Archive freezes the daily score before the logs of its day are purged
+func (ds *DailyScore) Archive(now time.Time) {
+	ds.ArchivedAt = &now
+	ds.UpdatedAt = now
+}
+
+// IsArchived returns true if the logs of the day were purged
+func (ds *DailyScore) IsArchived() bool {
+	return ds.ArchivedAt != nil
+}
+
+// IsWinningScore returns true if this score would win against another score
+// Uses the default scoring rules, see ScoringRules.CompareScores for the rules of a group
+func (ds *DailyScore) IsWinningScore(other *DailyScore) bool {
+	return DefaultScoringRules(ds.GroupID).CompareScores(ds, other) > 0
 }
 
-// IsTiedWith returns true if this score is tied with another score
+// IsTiedWith returns true if this score is tied with another score under the default scoring rules
 func (ds *DailyScore) IsTiedWith(other *DailyScore) bool {
-	return ds.TotalPoints == other.TotalPoints && ds.NegativeBehaviors == other.NegativeBehaviors
+	return DefaultScoringRules(ds.GroupID).CompareScores(ds, other) == 0
 }
 
 // IsPositive returns true if the total score is positive
@@ -139,6 +218,13 @@ func (ds *DailyScore) GetNetBehaviorCount() int {
 	return ds.PositiveBehaviors - ds.NegativeBehaviors
 }
 
+// ScoredBehaviorLog is a new behavior log with the group day it scores on in each group it
+// counts in, see DailyScoreRepository.RecordBehaviorLogs
+type ScoredBehaviorLog struct {
+	BehaviorLog *BehaviorLog
+	GroupDays   map[uuid.UUID]time.Time // Group day of the log, by group
+}
+
 // DailyScoreBreakdown represents a detailed breakdown of how daily points were earned
 type DailyScoreBreakdown struct {
 	BehaviorID        uuid.UUID
@@ -149,18 +235,58 @@ type DailyScoreBreakdown struct {
 	TotalPoints       int
 }
 
+// NewDailyScoreBreakdown aggregates per behavior the logs counted in a group, with the points
+// awarded in the group, highest totals first. Behaviors are looked up by ID, missing ones are
+// named Unknown.
+func NewDailyScoreBreakdown(groupID uuid.UUID, behaviorLogs []*BehaviorLog, behaviors map[uuid.UUID]*Behavior) []*DailyScoreBreakdown {
+	breakdown := make([]*DailyScoreBreakdown, 0)
+	breakdownByBehavior := make(map[uuid.UUID]*DailyScoreBreakdown)
+	for _, behaviorLog := range behaviorLogs {
+		if !behaviorLog.IsCountedInGroup(groupID) {
+			continue
+		}
+
+		points := behaviorLog.PointsForGroup(groupID)
+		item, exists := breakdownByBehavior[behaviorLog.BehaviorID]
+		if !exists {
+			item = &DailyScoreBreakdown{
+				BehaviorID:        behaviorLog.BehaviorID,
+				BehaviorName:      "Unknown",
+				PointsPerInstance: points,
+			}
+			if behavior := behaviors[behaviorLog.BehaviorID]; behavior != nil {
+				item.BehaviorName = behavior.Name
+				item.BehaviorCategory = behavior.Category
+			}
+			breakdownByBehavior[behaviorLog.BehaviorID] = item
+			breakdown = append(breakdown, item)
+		}
+		item.Count++
+		item.TotalPoints += points
+	}
+
+	sort.SliceStable(breakdown, func(i, j int) bool {
+		return breakdown[i].TotalPoints > breakdown[j].TotalPoints
+	})
+
+	return breakdown
+}
+
 // PetRanking represents a pet's ranking within a group for a specific time period
 type PetRanking struct {
 	PetID             uuid.UUID
 	PetName           string
 	OwnerName         string
 	TotalPoints       int
+	BehaviorPoints    int // Part of the total earned by behavior logs
+	AdjustmentPoints  int // Part of the total from the point ledger (bonuses, penalties, corrections)
 	TodaysPoints      int
 	Rank              int
 	PositiveBehaviors int
 	NegativeBehaviors int
 	LastActivityAt    *time.Time
 	IsTied            bool
+	NormalizedPoints  *float64 // Points under the scoring mode of the group, nil if not normalized
 }
 
 // NewPetRanking creates a new pet ranking entry
@@ -183,6 +309,8 @@ func NewPetRanking(petID uuid.UUID, petName, ownerName string) *PetRanking {
 func (pr *PetRanking) UpdateFromDailyScore(dailyScore *DailyScore) {
 	pr.TodaysPoints = dailyScore.TotalPoints
 	pr.TotalPoints += dailyScore.TotalPoints
+	pr.BehaviorPoints += dailyScore.BehaviorPointTotal
+	pr.AdjustmentPoints += dailyScore.AdjustmentPoints()
 	pr.PositiveBehaviors += dailyScore.PositiveBehaviors
 	pr.NegativeBehaviors += dailyScore.NegativeBehaviors
 
@@ -193,33 +321,106 @@ func (pr *PetRanking) UpdateFromDailyScore(dailyScore *DailyScore) {
 	}
 }
 
+// AddNormalizedPoints adds the points of a day normalized with the scoring mode of the group
+func (pr *PetRanking) AddNormalizedPoints(points float64) {
+	if pr.NormalizedPoints == nil {
+		pr.NormalizedPoints = new(float64)
+	}
+	*pr.NormalizedPoints += points
+}
+
+// RankingPoints returns the points the pet is ranked by: the normalized points if set, the
+// total points otherwise
+func (pr *PetRanking) RankingPoints() float64 {
+	if pr.NormalizedPoints != nil {
+		return *pr.NormalizedPoints
+	}
+	return float64(pr.TotalPoints)
+}
+
 // SetRank sets the ranking position for this pet
 func (pr *PetRanking) SetRank(rank int, isTied bool) {
 	pr.Rank = rank
 	pr.IsTied = isTied
 }
 
-// CompareForRanking compares two pet rankings using the standard rules
+// CompareForRanking compares two pet rankings using the standard rules: points, then fewer
+// negative behaviors. Groups can configure other tie breakers, see ScoringRules.Compare.
 // Returns positive if pr should rank higher, negative if other should rank higher, 0 if tied
 func (pr *PetRanking) CompareForRanking(other *PetRanking) int {
-	// First compare total points
-	if pr.TotalPoints > other.TotalPoints {
-		return 1
+	return compareWith(pr, other, standardTieBreakers)
+}
+
+// TrendingPet describes how a pet's recent scoring compares to its own earlier baseline
+type TrendingPet struct {
+	PetID              uuid.UUID
+	PetName            string
+	OwnerName          string
+	Rank               int
+	RecentPoints       int
+	BaselinePoints     int
+	RecentDailyAverage float64
+	BaselineAverage    float64
+	// Delta is the change in average points per day between the baseline and the recent window
+	Delta float64
+	// PercentChange is nil when the baseline average is zero
+	PercentChange  *float64
+	BaselineDays   int
+	ActiveDays     int
+	LowConfidence  bool
+	LastActivityAt *time.Time
+}
+
+// NewTrendingPet computes a pet's momentum from its points in the recent window and in the
+// baseline window, both expressed as per-day averages so windows of different lengths compare
+func NewTrendingPet(ranking *PetRanking, recentPoints, recentDays, baselinePoints, baselineDays, activeBaselineDays, minActiveDays int) *TrendingPet {
+	trend := &TrendingPet{
+		PetID:          ranking.PetID,
+		PetName:        ranking.PetName,
+		OwnerName:      ranking.OwnerName,
+		RecentPoints:   recentPoints,
+		BaselinePoints: baselinePoints,
+		BaselineDays:   baselineDays,
+		ActiveDays:     activeBaselineDays,
+		LowConfidence:  activeBaselineDays < minActiveDays,
+		LastActivityAt: ranking.LastActivityAt,
 	}
-	if pr.TotalPoints < other.TotalPoints {
-		return -1
+
+	if recentDays > 0 {
+		trend.RecentDailyAverage = float64(recentPoints) / float64(recentDays)
+	}
+	if baselineDays > 0 {
+		trend.BaselineAverage = float64(baselinePoints) / float64(baselineDays)
 	}
 
-	// If points are tied, compare negative behavior count (fewer is better)
-	if pr.NegativeBehaviors < other.NegativeBehaviors {
-		return 1
+	trend.Delta = trend.RecentDailyAverage - trend.BaselineAverage
+	if trend.BaselineAverage != 0 {
+		percent := trend.Delta / math.Abs(trend.BaselineAverage) * 100
+		trend.PercentChange = &percent
 	}
-	if pr.NegativeBehaviors > other.NegativeBehaviors {
+
+	return trend
+}
+
+// CompareMomentum compares two trending pets
+// Returns positive if t is trending more strongly, negative if other is, 0 if equal
+func (t *TrendingPet) CompareMomentum(other *TrendingPet) int {
+	if t.Delta != other.Delta {
+		if t.Delta > other.Delta {
+			return 1
+		}
+		return -1
+	}
+
+	// Prefer pets we have enough history to be confident about
+	if t.LowConfidence != other.LowConfidence {
+		if !t.LowConfidence {
+			return 1
+		}
 		return -1
 	}
 
-	// Perfect tie
-	return 0
+	return t.RecentPoints - other.RecentPoints
 }
 
 // PetOfTheDayWinner represents a pet who won "Pet of the Day" for a specific date
@@ -233,6 +434,7 @@ type PetOfTheDayWinner struct {
 	FinalScore       int
 	PositiveBehaviors int
 	NegativeBehaviors int
+	Votes            int // Weighted votes the pet won with, 0 in groups that do not vote
 	CreatedAt        time.Time
 }
 
@@ -0,0 +1,24 @@
+package domain
+
+import "errors"
+
+// ErrBehaviorNotFound is returned by behavior repositories when no behavior has the requested ID
+var ErrBehaviorNotFound = errors.New("behavior not found")
+
+// ErrBehaviorLogNotFound is returned by behavior log repositories when no behavior log has the
+// requested ID
+var ErrBehaviorLogNotFound = errors.New("behavior log not found")
+
+// ErrShareStatusChanged is returned by behavior log repositories when a group share was reviewed
+// by someone else since it was read
+var ErrShareStatusChanged = errors.New("behavior log share status changed")
+
+// ValidationError is returned when a request breaks a rule of the points system, such as a value
+// out of its allowed range. Interfaces report it as a bad request.
+type ValidationError struct {
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
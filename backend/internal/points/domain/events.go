@@ -0,0 +1,379 @@
+package domain
+
+import (
+	"time"
+
+	"pet-of-the-day/internal/shared/events"
+
+	"github.com/google/uuid"
+)
+
+const (
+	BehaviorCreatedEventType = "points.behavior.created"
+	BehaviorUpdatedEventType = "points.behavior.updated"
+	BehaviorDeletedEventType = "points.behavior.deleted"
+
+	BehaviorLogCreatedEventType      = "points.behavior_log.created"
+	BehaviorLogDeletedEventType      = "points.behavior_log.deleted"
+	BehaviorLogReviewedEventType     = "points.behavior_log.reviewed"
+	BehaviorLogBatchCreatedEventType = "points.behavior_log.batch_created"
+
+	PetOfTheDaySelectedEventType = "points.pet_of_the_day.selected"
+	PetOfTheDayVoteCastEventType = "points.pet_of_the_day.vote_cast"
+	PetStreaksUpdatedEventType   = "points.pet_streaks.updated"
+	BadgeAwardedEventType        = "points.badge.awarded"
+	PointsAdjustedEventType      = "points.points.adjusted"
+	AnomalyFlaggedEventType      = "points.anomaly.flagged"
+	CommentPostedEventType       = "points.comment.posted"
+	LateCorrectionEventType      = "points.late_correction.recorded"
+)
+
+// BehaviorCatalogEventTypes lists the events that change the global behavior catalog
+var BehaviorCatalogEventTypes = []string{
+	BehaviorCreatedEventType,
+	BehaviorUpdatedEventType,
+	BehaviorDeletedEventType,
+}
+
+type BehaviorCreatedEvent struct {
+	events.BaseEvent
+	BehaviorID uuid.UUID `json:"behavior_id"`
+	Name       string    `json:"name"`
+	CreatedBy  uuid.UUID `json:"created_by"`
+}
+
+func NewBehaviorCreatedEvent(behavior *Behavior, createdBy uuid.UUID) *BehaviorCreatedEvent {
+	return &BehaviorCreatedEvent{
+		BaseEvent:  events.NewBaseEvent(BehaviorCreatedEventType, behavior.ID),
+		BehaviorID: behavior.ID,
+		Name:       behavior.Name,
+		CreatedBy:  createdBy,
+	}
+}
+
+type BehaviorUpdatedEvent struct {
+	events.BaseEvent
+	BehaviorID uuid.UUID `json:"behavior_id"`
+	Name       string    `json:"name"`
+	IsActive   bool      `json:"is_active"`
+	UpdatedBy  uuid.UUID `json:"updated_by"`
+}
+
+func NewBehaviorUpdatedEvent(behavior *Behavior, updatedBy uuid.UUID) *BehaviorUpdatedEvent {
+	return &BehaviorUpdatedEvent{
+		BaseEvent:  events.NewBaseEvent(BehaviorUpdatedEventType, behavior.ID),
+		BehaviorID: behavior.ID,
+		Name:       behavior.Name,
+		IsActive:   behavior.IsActive,
+		UpdatedBy:  updatedBy,
+	}
+}
+
+// BehaviorDeletedEvent is published when a behavior leaves the catalog.
+// Deactivated is true when the behavior was kept because it still has logs.
+type BehaviorDeletedEvent struct {
+	events.BaseEvent
+	BehaviorID  uuid.UUID `json:"behavior_id"`
+	Name        string    `json:"name"`
+	Deactivated bool      `json:"deactivated"`
+	DeletedBy   uuid.UUID `json:"deleted_by"`
+}
+
+func NewBehaviorDeletedEvent(behavior *Behavior, deactivated bool, deletedBy uuid.UUID) *BehaviorDeletedEvent {
+	return &BehaviorDeletedEvent{
+		BaseEvent:   events.NewBaseEvent(BehaviorDeletedEventType, behavior.ID),
+		BehaviorID:  behavior.ID,
+		Name:        behavior.Name,
+		Deactivated: deactivated,
+		DeletedBy:   deletedBy,
+	}
+}
+
+// BehaviorLogCreatedEvent is published when a behavior is logged for a pet.
+// LoggedAt may be in the past when the log is backdated.
+type BehaviorLogCreatedEvent struct {
+	events.BaseEvent
+	BehaviorLogID uuid.UUID   `json:"behavior_log_id"`
+	PetID         uuid.UUID   `json:"pet_id"`
+	BehaviorID    uuid.UUID   `json:"behavior_id"`
+	UserID        uuid.UUID   `json:"user_id"`
+	PointsAwarded int         `json:"points_awarded"`
+	LoggedAt      time.Time   `json:"logged_at"`
+	GroupIDs      []uuid.UUID `json:"group_ids"`
+}
+
+func NewBehaviorLogCreatedEvent(behaviorLog *BehaviorLog) *BehaviorLogCreatedEvent {
+	return &BehaviorLogCreatedEvent{
+		BaseEvent:     events.NewBaseEvent(BehaviorLogCreatedEventType, behaviorLog.ID),
+		BehaviorLogID: behaviorLog.ID,
+		PetID:         behaviorLog.PetID,
+		BehaviorID:    behaviorLog.BehaviorID,
+		UserID:        behaviorLog.UserID,
+		PointsAwarded: behaviorLog.PointsAwarded,
+		LoggedAt:      behaviorLog.LoggedAt,
+		GroupIDs:      behaviorLog.GetSharedGroupIDs(),
+	}
+}
+
+type BehaviorLogDeletedEvent struct {
+	events.BaseEvent
+	BehaviorLogID uuid.UUID   `json:"behavior_log_id"`
+	PetID         uuid.UUID   `json:"pet_id"`
+	BehaviorID    uuid.UUID   `json:"behavior_id"`
+	LoggedAt      time.Time   `json:"logged_at"`
+	GroupIDs      []uuid.UUID `json:"group_ids"`
+	DeletedBy     uuid.UUID   `json:"deleted_by"`
+}
+
+func NewBehaviorLogDeletedEvent(behaviorLog *BehaviorLog, deletedBy uuid.UUID) *BehaviorLogDeletedEvent {
+	return &BehaviorLogDeletedEvent{
+		BaseEvent:     events.NewBaseEvent(BehaviorLogDeletedEventType, behaviorLog.ID),
+		BehaviorLogID: behaviorLog.ID,
+		PetID:         behaviorLog.PetID,
+		BehaviorID:    behaviorLog.BehaviorID,
+		LoggedAt:      behaviorLog.LoggedAt,
+		GroupIDs:      behaviorLog.GetSharedGroupIDs(),
+		DeletedBy:     deletedBy,
+	}
+}
+
+// PetOfTheDaySelectedEvent is published once the winners of a group's day have been saved
+type PetOfTheDaySelectedEvent struct {
+	events.BaseEvent
+	GroupID      uuid.UUID   `json:"group_id"`
+	Date         time.Time   `json:"date"`
+	WinnerPetIDs []uuid.UUID `json:"winner_pet_ids"`
+}
+
+func NewPetOfTheDaySelectedEvent(groupID uuid.UUID, date time.Time, winners []*PetOfTheDayWinner) *PetOfTheDaySelectedEvent {
+	return &PetOfTheDaySelectedEvent{
+		BaseEvent:    events.NewBaseEvent(PetOfTheDaySelectedEventType, groupID),
+		GroupID:      groupID,
+		Date:         date,
+		WinnerPetIDs: winnerPetIDs(winners),
+	}
+}
+
+// PetOfTheDayVoteCastEvent is published when a member votes for Pet of the Day, with the live
+// tallies of the day
+type PetOfTheDayVoteCastEvent struct {
+	events.BaseEvent
+	GroupID uuid.UUID    `json:"group_id"`
+	Date    time.Time    `json:"date"`
+	PetID   uuid.UUID    `json:"pet_id"`
+	Tallies []*VoteTally `json:"tallies"`
+}
+
+func NewPetOfTheDayVoteCastEvent(vote *PetOfTheDayVote, tallies []*VoteTally) *PetOfTheDayVoteCastEvent {
+	return &PetOfTheDayVoteCastEvent{
+		BaseEvent: events.NewBaseEvent(PetOfTheDayVoteCastEventType, vote.ID),
+		GroupID:   vote.GroupID,
+		Date:      vote.Date,
+		PetID:     vote.PetID,
+		Tallies:   tallies,
+	}
+}
+
+// PetStreaksUpdatedEvent is published after a pet's streaks have been recomputed
+type PetStreaksUpdatedEvent struct {
+	events.BaseEvent
+	PetID uuid.UUID `json:"pet_id"`
+}
+
+func NewPetStreaksUpdatedEvent(petID uuid.UUID) *PetStreaksUpdatedEvent {
+	return &PetStreaksUpdatedEvent{
+		BaseEvent: events.NewBaseEvent(PetStreaksUpdatedEventType, petID),
+		PetID:     petID,
+	}
+}
+
+// BadgeAwardedEvent is published when a pet or user unlocks a badge.
+// UserID is the badge holder for user badges and the pet owner for pet badges.
+type BadgeAwardedEvent struct {
+	events.BaseEvent
+	BadgeID uuid.UUID  `json:"badge_id"`
+	Rule    BadgeRule  `json:"badge"`
+	PetID   *uuid.UUID `json:"pet_id,omitempty"`
+	UserID  uuid.UUID  `json:"user_id"`
+}
+
+func NewBadgeAwardedEvent(badge *Badge, rule BadgeRule, userID uuid.UUID) *BadgeAwardedEvent {
+	return &BadgeAwardedEvent{
+		BaseEvent: events.NewBaseEvent(BadgeAwardedEventType, badge.ID),
+		BadgeID:   badge.ID,
+		Rule:      rule,
+		PetID:     badge.PetID,
+		UserID:    userID,
+	}
+}
+
+type PointsAdjustedEvent struct {
+	events.BaseEvent
+	AdjustmentID uuid.UUID           `json:"adjustment_id"`
+	PetID        uuid.UUID           `json:"pet_id"`
+	GroupID      uuid.UUID           `json:"group_id"`
+	Date         time.Time           `json:"date"`
+	Points       int                 `json:"points"`
+	Kind         PointAdjustmentKind `json:"kind"`
+	AuthorID     uuid.UUID           `json:"author_id"`
+}
+
+func NewPointsAdjustedEvent(adjustment *PointAdjustment) *PointsAdjustedEvent {
+	return &PointsAdjustedEvent{
+		BaseEvent:    events.NewBaseEvent(PointsAdjustedEventType, adjustment.ID),
+		AdjustmentID: adjustment.ID,
+		PetID:        adjustment.PetID,
+		GroupID:      adjustment.GroupID,
+		Date:         adjustment.Date,
+		Points:       adjustment.Points,
+		Kind:         adjustment.Kind,
+		AuthorID:     adjustment.AuthorID,
+	}
+}
+
+// BehaviorLogReviewedEvent is published when a member confirms or disputes a behavior log in a
+// group, or the group admin resolves a dispute
+type BehaviorLogReviewedEvent struct {
+	events.BaseEvent
+	BehaviorLogID uuid.UUID   `json:"behavior_log_id"`
+	PetID         uuid.UUID   `json:"pet_id"`
+	GroupID       uuid.UUID   `json:"group_id"`
+	LoggedAt      time.Time   `json:"logged_at"`
+	Status        ShareStatus `json:"status"`
+	ReviewedBy    uuid.UUID   `json:"reviewed_by"`
+}
+
+func NewBehaviorLogReviewedEvent(behaviorLog *BehaviorLog, share *BehaviorLogGroupShare, reviewedBy uuid.UUID) *BehaviorLogReviewedEvent {
+	return &BehaviorLogReviewedEvent{
+		BaseEvent:     events.NewBaseEvent(BehaviorLogReviewedEventType, behaviorLog.ID),
+		BehaviorLogID: behaviorLog.ID,
+		PetID:         behaviorLog.PetID,
+		GroupID:       share.GroupID,
+		LoggedAt:      behaviorLog.LoggedAt,
+		Status:        share.CurrentStatus(),
+		ReviewedBy:    reviewedBy,
+	}
+}
+
+// AnomalyFlaggedEvent is published when a behavior log enters the moderation queue of a group
+type AnomalyFlaggedEvent struct {
+	events.BaseEvent
+	FlagID        uuid.UUID     `json:"flag_id"`
+	BehaviorLogID uuid.UUID     `json:"behavior_log_id"`
+	PetID         uuid.UUID     `json:"pet_id"`
+	GroupID       uuid.UUID     `json:"group_id"`
+	Rules         []AnomalyRule `json:"rules"`
+	Held          bool          `json:"held"`
+}
+
+func NewAnomalyFlaggedEvent(flag *AnomalyFlag) *AnomalyFlaggedEvent {
+	rules := make([]AnomalyRule, len(flag.Reasons))
+	for i, reason := range flag.Reasons {
+		rules[i] = reason.Rule
+	}
+
+	return &AnomalyFlaggedEvent{
+		BaseEvent:     events.NewBaseEvent(AnomalyFlaggedEventType, flag.ID),
+		FlagID:        flag.ID,
+		BehaviorLogID: flag.BehaviorLogID,
+		PetID:         flag.PetID,
+		GroupID:       flag.GroupID,
+		Rules:         rules,
+		Held:          flag.Held,
+	}
+}
+
+// CommentPostedEvent is published when a member comments on a behavior log shared with a group
+type CommentPostedEvent struct {
+	events.BaseEvent
+	CommentID     uuid.UUID  `json:"comment_id"`
+	BehaviorLogID uuid.UUID  `json:"behavior_log_id"`
+	GroupID       uuid.UUID  `json:"group_id"`
+	UserID        uuid.UUID  `json:"user_id"`
+	ParentID      *uuid.UUID `json:"parent_id,omitempty"`
+	Body          string     `json:"body"`
+}
+
+func NewCommentPostedEvent(comment *Comment) *CommentPostedEvent {
+	return &CommentPostedEvent{
+		BaseEvent:     events.NewBaseEvent(CommentPostedEventType, comment.ID),
+		CommentID:     comment.ID,
+		BehaviorLogID: comment.BehaviorLogID,
+		GroupID:       comment.GroupID,
+		UserID:        comment.UserID,
+		ParentID:      comment.ParentID,
+		Body:          comment.Body,
+	}
+}
+
+// BehaviorLogBatchCreatedEvent is published once after a batch of behavior logs was saved, in
+// place of a BehaviorLogCreatedEvent per log, so that subscribers can refresh what the batch
+// changed at once, e.g. the rankings of each group
+type BehaviorLogBatchCreatedEvent struct {
+	events.BaseEvent
+	BatchID      uuid.UUID                  `json:"batch_id"`
+	UserID       uuid.UUID                  `json:"user_id"`
+	GroupIDs     []uuid.UUID                `json:"group_ids"`
+	PetIDs       []uuid.UUID                `json:"pet_ids"`
+	BehaviorLogs []*BehaviorLogCreatedEvent `json:"behavior_logs"` // The creation of each log of the batch
+}
+
+func NewBehaviorLogBatchCreatedEvent(batchID, userID uuid.UUID, behaviorLogs []*BehaviorLog) *BehaviorLogBatchCreatedEvent {
+	event := &BehaviorLogBatchCreatedEvent{
+		BaseEvent:    events.NewBaseEvent(BehaviorLogBatchCreatedEventType, batchID),
+		BatchID:      batchID,
+		UserID:       userID,
+		GroupIDs:     make([]uuid.UUID, 0),
+		PetIDs:       make([]uuid.UUID, 0, len(behaviorLogs)),
+		BehaviorLogs: make([]*BehaviorLogCreatedEvent, 0, len(behaviorLogs)),
+	}
+
+	seen := make(map[uuid.UUID]bool)
+	for _, behaviorLog := range behaviorLogs {
+		event.BehaviorLogs = append(event.BehaviorLogs, NewBehaviorLogCreatedEvent(behaviorLog))
+		if !seen[behaviorLog.PetID] {
+			seen[behaviorLog.PetID] = true
+			event.PetIDs = append(event.PetIDs, behaviorLog.PetID)
+		}
+		for _, groupID := range behaviorLog.GetSharedGroupIDs() {
+			if !seen[groupID] {
+				seen[groupID] = true
+				event.GroupIDs = append(event.GroupIDs, groupID)
+			}
+		}
+	}
+
+	return event
+}
+
+// LateCorrectionEvent is published when a behavior log changed a closed day of a group, so its
+// members learn that the day's scores, and possibly its Pet of the Day, were corrected
+type LateCorrectionEvent struct {
+	events.BaseEvent
+	CorrectionID      uuid.UUID           `json:"correction_id"`
+	GroupID           uuid.UUID           `json:"group_id"`
+	Date              time.Time           `json:"date"`
+	Cause             LateCorrectionCause `json:"cause"`
+	BehaviorLogID     uuid.UUID           `json:"behavior_log_id"`
+	PetID             uuid.UUID           `json:"pet_id"`
+	PreviousWinnerIDs []uuid.UUID         `json:"previous_winner_ids"`
+	WinnerIDs         []uuid.UUID         `json:"winner_ids"`
+	DethronedPetIDs   []uuid.UUID         `json:"dethroned_pet_ids"`
+	WinnersChanged    bool                `json:"winners_changed"`
+}
+
+func NewLateCorrectionEvent(correction *LateCorrection) *LateCorrectionEvent {
+	return &LateCorrectionEvent{
+		BaseEvent:         events.NewBaseEvent(LateCorrectionEventType, correction.ID),
+		CorrectionID:      correction.ID,
+		GroupID:           correction.GroupID,
+		Date:              correction.Date,
+		Cause:             correction.Cause,
+		BehaviorLogID:     correction.BehaviorLogID,
+		PetID:             correction.PetID,
+		PreviousWinnerIDs: correction.PreviousWinnerIDs,
+		WinnerIDs:         correction.WinnerIDs,
+		DethronedPetIDs:   correction.DethronedPetIDs(),
+		WinnersChanged:    correction.WinnersChanged(),
+	}
+}
@@ -0,0 +1,203 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GroupBehavior is a group-scoped entry layered over the global behavior catalog.
+// It either overrides a global behavior (BaseBehaviorID is set) or defines a custom
+// behavior that only exists within the group.
+type GroupBehavior struct {
+	ID                 uuid.UUID
+	GroupID            uuid.UUID
+	BaseBehaviorID     *uuid.UUID
+	Name               string
+	Description        string
+	Category           BehaviorCategory
+	PointValue         int
+	MinIntervalMinutes int
+	Species            Species
+	Icon               string
+	IsActive           bool
+	CreatedBy          uuid.UUID
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// NewCustomGroupBehavior creates a behavior that only exists within a group
+func NewCustomGroupBehavior(groupID, createdBy uuid.UUID, name, description string, category BehaviorCategory, pointValue, minIntervalMinutes int, species Species, icon string) (*GroupBehavior, error) {
+	if groupID == uuid.Nil {
+		return nil, fmt.Errorf("group ID is required")
+	}
+
+	// Custom behaviors follow the same rules as the global catalog
+	behavior, err := NewBehavior(name, description, category, pointValue, minIntervalMinutes, species, icon)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GroupBehavior{
+		ID:                 behavior.ID,
+		GroupID:            groupID,
+		Name:               behavior.Name,
+		Description:        behavior.Description,
+		Category:           behavior.Category,
+		PointValue:         behavior.PointValue,
+		MinIntervalMinutes: behavior.MinIntervalMinutes,
+		Species:            behavior.Species,
+		Icon:               behavior.Icon,
+		IsActive:           true,
+		CreatedBy:          createdBy,
+		CreatedAt:          behavior.CreatedAt,
+		UpdatedAt:          behavior.UpdatedAt,
+	}, nil
+}
+
+// NewGroupBehaviorOverride creates a group override of a global behavior's point value and status
+func NewGroupBehaviorOverride(groupID, createdBy uuid.UUID, base *Behavior, pointValue int, isActive bool) (*GroupBehavior, error) {
+	if groupID == uuid.Nil {
+		return nil, fmt.Errorf("group ID is required")
+	}
+
+	if base == nil {
+		return nil, fmt.Errorf("base behavior is required")
+	}
+
+	if err := validatePointValue(pointValue); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	baseID := base.ID
+
+	return &GroupBehavior{
+		ID:                 uuid.New(),
+		GroupID:            groupID,
+		BaseBehaviorID:     &baseID,
+		Name:               base.Name,
+		Description:        base.Description,
+		Category:           base.Category,
+		PointValue:         pointValue,
+		MinIntervalMinutes: base.MinIntervalMinutes,
+		Species:            base.Species,
+		Icon:               base.Icon,
+		IsActive:           isActive,
+		CreatedBy:          createdBy,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}, nil
+}
+
+// IsOverride returns true if this entry overrides a global behavior
+func (gb *GroupBehavior) IsOverride() bool {
+	return gb.BaseBehaviorID != nil
+}
+
+// Update updates the mutable fields of a custom group behavior
+func (gb *GroupBehavior) Update(name, description string, pointValue, minIntervalMinutes int, icon string, isActive bool) error {
+	if gb.IsOverride() {
+		return fmt.Errorf("only the point value and status of a catalog behavior can be changed")
+	}
+
+	if err := validateBehaviorName(name); err != nil {
+		return err
+	}
+
+	if err := validatePointValue(pointValue); err != nil {
+		return err
+	}
+
+	if err := validateMinInterval(minIntervalMinutes); err != nil {
+		return err
+	}
+
+	gb.Name = name
+	gb.Description = description
+	gb.PointValue = pointValue
+	gb.MinIntervalMinutes = minIntervalMinutes
+	gb.Icon = icon
+	gb.IsActive = isActive
+	gb.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// SetPointValue changes the number of points the behavior awards in the group
+func (gb *GroupBehavior) SetPointValue(pointValue int) error {
+	if err := validatePointValue(pointValue); err != nil {
+		return err
+	}
+
+	gb.PointValue = pointValue
+	gb.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetActive enables or disables the behavior in the group
+func (gb *GroupBehavior) SetActive(isActive bool) {
+	gb.IsActive = isActive
+	gb.UpdatedAt = time.Now()
+}
+
+// Resolve returns the behavior as seen by the group. For overrides the global behavior
+// is used as the base so catalog changes to names or icons are picked up.
+func (gb *GroupBehavior) Resolve(base *Behavior) *Behavior {
+	if gb.IsOverride() && base != nil {
+		resolved := *base
+		resolved.PointValue = gb.PointValue
+		resolved.IsActive = base.IsActive && gb.IsActive
+		return &resolved
+	}
+
+	groupID := gb.GroupID
+	return &Behavior{
+		ID:                 gb.ID,
+		GroupID:            &groupID,
+		Name:               gb.Name,
+		Description:        gb.Description,
+		Category:           gb.Category,
+		PointValue:         gb.PointValue,
+		MinIntervalMinutes: gb.MinIntervalMinutes,
+		Species:            gb.Species,
+		Icon:               gb.Icon,
+		IsActive:           gb.IsActive,
+		CreatedAt:          gb.CreatedAt,
+		UpdatedAt:          gb.UpdatedAt,
+	}
+}
+
+// MergeBehaviorCatalog layers a group's behaviors over the global catalog.
+// Overridden behaviors take the group's point value, disabled ones are dropped
+// and active custom behaviors are appended.
+func MergeBehaviorCatalog(global []*Behavior, groupBehaviors []*GroupBehavior) []*Behavior {
+	overrides := make(map[uuid.UUID]*GroupBehavior)
+	custom := make([]*GroupBehavior, 0)
+	for _, gb := range groupBehaviors {
+		if gb.IsOverride() {
+			overrides[*gb.BaseBehaviorID] = gb
+		} else {
+			custom = append(custom, gb)
+		}
+	}
+
+	merged := make([]*Behavior, 0, len(global)+len(custom))
+	for _, behavior := range global {
+		if override, exists := overrides[behavior.ID]; exists {
+			behavior = override.Resolve(behavior)
+		}
+		if behavior.IsActive {
+			merged = append(merged, behavior)
+		}
+	}
+
+	for _, gb := range custom {
+		if gb.IsActive {
+			merged = append(merged, gb.Resolve(nil))
+		}
+	}
+
+	return merged
+}
@@ -0,0 +1,253 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HistoryPeriod is the length of the periods Pet of the Day wins are aggregated over
+type HistoryPeriod string
+
+const (
+	// HistoryPeriodMonth aggregates wins per calendar month
+	HistoryPeriodMonth HistoryPeriod = "month"
+	// HistoryPeriodYear aggregates wins per calendar year
+	HistoryPeriodYear HistoryPeriod = "year"
+)
+
+// IsValidHistoryPeriod checks if a history period is known
+func IsValidHistoryPeriod(period HistoryPeriod) bool {
+	return period == HistoryPeriodMonth || period == HistoryPeriodYear
+}
+
+// WinnerCalendarDay holds the Pet of the Day winners of a group day
+type WinnerCalendarDay struct {
+	Date    time.Time            `json:"date"`
+	Winners []*PetOfTheDayWinner `json:"winners"`
+}
+
+// BuildWinnerCalendar groups winners by day, the most recent day first
+func BuildWinnerCalendar(winners []*PetOfTheDayWinner) []*WinnerCalendarDay {
+	daysByDate := make(map[time.Time]*WinnerCalendarDay)
+	for _, winner := range winners {
+		date := normalizeDate(winner.Date)
+		day, exists := daysByDate[date]
+		if !exists {
+			day = &WinnerCalendarDay{Date: date}
+			daysByDate[date] = day
+		}
+		day.Winners = append(day.Winners, winner)
+	}
+
+	days := make([]*WinnerCalendarDay, 0, len(daysByDate))
+	for _, day := range daysByDate {
+		days = append(days, day)
+	}
+
+	sort.Slice(days, func(i, j int) bool {
+		return days[i].Date.After(days[j].Date)
+	})
+
+	return days
+}
+
+// DecoratedPet is the Pet of the Day record of a pet. Pets with as many wins share a rank.
+type DecoratedPet struct {
+	Rank         int       `json:"rank"`
+	PetID        uuid.UUID `json:"pet_id"`
+	PetName      string    `json:"pet_name"`
+	OwnerName    string    `json:"owner_name"`
+	Wins         int       `json:"wins"`
+	BestScore    int       `json:"best_score"`
+	FirstWinDate time.Time `json:"first_win_date"`
+	LastWinDate  time.Time `json:"last_win_date"`
+}
+
+// RankMostDecorated ranks the pets of a set of winners by their number of wins. Pets with as
+// many wins share a rank and are listed by their most recent win.
+func RankMostDecorated(winners []*PetOfTheDayWinner) []*DecoratedPet {
+	petsByID := make(map[uuid.UUID]*DecoratedPet)
+	for _, winner := range winners {
+		pet, exists := petsByID[winner.PetID]
+		if !exists {
+			pet = &DecoratedPet{
+				PetID:        winner.PetID,
+				PetName:      winner.PetName,
+				OwnerName:    winner.OwnerName,
+				BestScore:    winner.FinalScore,
+				FirstWinDate: winner.Date,
+				LastWinDate:  winner.Date,
+			}
+			petsByID[winner.PetID] = pet
+		}
+
+		pet.Wins++
+		if winner.FinalScore > pet.BestScore {
+			pet.BestScore = winner.FinalScore
+		}
+		if winner.Date.Before(pet.FirstWinDate) {
+			pet.FirstWinDate = winner.Date
+		}
+		if winner.Date.After(pet.LastWinDate) {
+			// Names can change, the most recent win has the current one
+			pet.LastWinDate = winner.Date
+			pet.PetName = winner.PetName
+			pet.OwnerName = winner.OwnerName
+		}
+	}
+
+	pets := make([]*DecoratedPet, 0, len(petsByID))
+	for _, pet := range petsByID {
+		pets = append(pets, pet)
+	}
+
+	RankDecoratedPets(pets)
+	return pets
+}
+
+// RankDecoratedPets sorts pets by their number of wins and sets their rank, like
+// RankMostDecorated does for the pets it aggregates
+func RankDecoratedPets(pets []*DecoratedPet) {
+	sort.Slice(pets, func(i, j int) bool {
+		if pets[i].Wins != pets[j].Wins {
+			return pets[i].Wins > pets[j].Wins
+		}
+		if !pets[i].LastWinDate.Equal(pets[j].LastWinDate) {
+			return pets[i].LastWinDate.After(pets[j].LastWinDate)
+		}
+		return pets[i].PetName < pets[j].PetName
+	})
+
+	for i, pet := range pets {
+		if i > 0 && pet.Wins == pets[i-1].Wins {
+			pet.Rank = pets[i-1].Rank
+		} else {
+			pet.Rank = i + 1
+		}
+	}
+}
+
+// HallOfFamePeriod summarizes the Pet of the Day wins of a month or a year
+type HallOfFamePeriod struct {
+	Period        string          `json:"period"` // YYYY-MM for months, YYYY for years
+	From          time.Time       `json:"from"`
+	To            time.Time       `json:"to"`
+	TotalWins     int             `json:"total_wins"`
+	UniquePets    int             `json:"unique_pets"`
+	AverageScore  float64         `json:"average_score"`
+	MostDecorated []*DecoratedPet `json:"most_decorated"` // Pets with the most wins of the period
+}
+
+// AggregateWinners summarizes winners per month or year, the most recent period first
+func AggregateWinners(winners []*PetOfTheDayWinner, period HistoryPeriod) []*HallOfFamePeriod {
+	winnersByPeriod := make(map[time.Time][]*PetOfTheDayWinner)
+	for _, winner := range winners {
+		from := periodStart(winner.Date, period)
+		winnersByPeriod[from] = append(winnersByPeriod[from], winner)
+	}
+
+	periods := make([]*HallOfFamePeriod, 0, len(winnersByPeriod))
+	for from, periodWinners := range winnersByPeriod {
+		totalScore := 0
+		for _, winner := range periodWinners {
+			totalScore += winner.FinalScore
+		}
+		periods = append(periods, NewHallOfFamePeriod(from, period, RankMostDecorated(periodWinners), totalScore))
+	}
+
+	sort.Slice(periods, func(i, j int) bool {
+		return periods[i].From.After(periods[j].From)
+	})
+
+	return periods
+}
+
+// NewHallOfFamePeriod summarizes a period starting on a given day from the pets that won in it,
+// and the sum of their winning scores
+func NewHallOfFamePeriod(from time.Time, period HistoryPeriod, pets []*DecoratedPet, totalScore int) *HallOfFamePeriod {
+	summary := &HallOfFamePeriod{
+		Period:     from.Format("2006"),
+		From:       from,
+		To:         from.AddDate(1, 0, -1),
+		UniquePets: len(pets),
+	}
+	if period == HistoryPeriodMonth {
+		summary.Period = from.Format("2006-01")
+		summary.To = from.AddDate(0, 1, -1)
+	}
+
+	RankDecoratedPets(pets)
+	for _, pet := range pets {
+		summary.TotalWins += pet.Wins
+		if pet.Rank == 1 {
+			summary.MostDecorated = append(summary.MostDecorated, pet)
+		}
+	}
+	if summary.TotalWins > 0 {
+		summary.AverageScore = float64(totalScore) / float64(summary.TotalWins)
+	}
+
+	return summary
+}
+
+// GroupTrophies is the Pet of the Day record of a pet in one group
+type GroupTrophies struct {
+	GroupID      uuid.UUID `json:"group_id"`
+	GroupName    string    `json:"group_name"`
+	Wins         int       `json:"wins"`
+	BestScore    int       `json:"best_score"`
+	FirstWinDate time.Time `json:"first_win_date"`
+	LastWinDate  time.Time `json:"last_win_date"`
+}
+
+// CountTrophiesByGroup summarizes the wins of a pet per group, the group with the most wins first
+func CountTrophiesByGroup(wins []*PetOfTheDayWinner) []*GroupTrophies {
+	trophiesByGroup := make(map[uuid.UUID]*GroupTrophies)
+	for _, win := range wins {
+		trophies, exists := trophiesByGroup[win.GroupID]
+		if !exists {
+			trophies = &GroupTrophies{
+				GroupID:      win.GroupID,
+				BestScore:    win.FinalScore,
+				FirstWinDate: win.Date,
+				LastWinDate:  win.Date,
+			}
+			trophiesByGroup[win.GroupID] = trophies
+		}
+
+		trophies.Wins++
+		if win.FinalScore > trophies.BestScore {
+			trophies.BestScore = win.FinalScore
+		}
+		if win.Date.Before(trophies.FirstWinDate) {
+			trophies.FirstWinDate = win.Date
+		}
+		if win.Date.After(trophies.LastWinDate) {
+			trophies.LastWinDate = win.Date
+		}
+	}
+
+	groups := make([]*GroupTrophies, 0, len(trophiesByGroup))
+	for _, trophies := range trophiesByGroup {
+		groups = append(groups, trophies)
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Wins != groups[j].Wins {
+			return groups[i].Wins > groups[j].Wins
+		}
+		return groups[i].LastWinDate.After(groups[j].LastWinDate)
+	})
+
+	return groups
+}
+
+// periodStart returns the first day of the month or year of a date
+func periodStart(date time.Time, period HistoryPeriod) time.Time {
+	if period == HistoryPeriodYear {
+		return time.Date(date.Year(), time.January, 1, 0, 0, 0, 0, date.Location())
+	}
+	return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, date.Location())
+}
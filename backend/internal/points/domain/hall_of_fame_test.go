@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestHallOfFame(t *testing.T) {
+	groupID, otherGroupID := uuid.New(), uuid.New()
+	rexID, lunaID, miloID := uuid.New(), uuid.New(), uuid.New()
+	date := func(month time.Month, day int) time.Time { return time.Date(2025, month, day, 0, 0, 0, 0, time.UTC) }
+
+	// Rex and Luna share March 10
+	winners := []*PetOfTheDayWinner{
+		NewPetOfTheDayWinner(groupID, rexID, "Rex", "Alice", date(time.March, 10), 8, 4, 0),
+		NewPetOfTheDayWinner(groupID, lunaID, "Luna", "Bob", date(time.March, 10), 8, 3, 0),
+		NewPetOfTheDayWinner(groupID, rexID, "Rex", "Alice", date(time.March, 11), 12, 6, 1),
+		NewPetOfTheDayWinner(groupID, lunaID, "Luna", "Bob", date(time.April, 2), 5, 2, 0),
+		NewPetOfTheDayWinner(otherGroupID, miloID, "Milo", "Carol", date(time.April, 3), 7, 3, 0),
+	}
+
+	t.Run("Calendar groups winners by day", func(t *testing.T) {
+		days := BuildWinnerCalendar(winners)
+
+		if len(days) != 4 {
+			t.Fatalf("Expected 4 days, got %d", len(days))
+		}
+		if !days[0].Date.Equal(date(time.April, 3)) {
+			t.Errorf("Expected the most recent day first, got %v", days[0].Date)
+		}
+		if !days[3].Date.Equal(date(time.March, 10)) || len(days[3].Winners) != 2 {
+			t.Errorf("Expected 2 winners on March 10, got %+v", days[3])
+		}
+	})
+
+	t.Run("Pets with as many wins share a rank", func(t *testing.T) {
+		pets := RankMostDecorated(winners)
+
+		if len(pets) != 3 {
+			t.Fatalf("Expected 3 pets, got %d", len(pets))
+		}
+		// Luna won most recently, so she is listed before Rex
+		if pets[0].PetID != lunaID || pets[0].Rank != 1 || pets[1].PetID != rexID || pets[1].Rank != 1 {
+			t.Errorf("Expected Luna and Rex to share rank 1, got %+v %+v", pets[0], pets[1])
+		}
+		if pets[1].BestScore != 12 || !pets[1].FirstWinDate.Equal(date(time.March, 10)) {
+			t.Errorf("Expected Rex's best score 12 since March 10, got %+v", pets[1])
+		}
+		if pets[2].PetID != miloID || pets[2].Rank != 3 {
+			t.Errorf("Expected Milo at rank 3, got %+v", pets[2])
+		}
+	})
+
+	t.Run("Aggregates wins per month and year", func(t *testing.T) {
+		months := AggregateWinners(winners, HistoryPeriodMonth)
+
+		if len(months) != 2 {
+			t.Fatalf("Expected 2 months, got %d", len(months))
+		}
+		march := months[1]
+		if march.Period != "2025-03" || march.TotalWins != 3 || march.UniquePets != 2 || !march.To.Equal(date(time.March, 31)) {
+			t.Errorf("Expected 3 wins of 2 pets in March, got %+v", march)
+		}
+		if len(march.MostDecorated) != 1 || march.MostDecorated[0].PetID != rexID {
+			t.Errorf("Expected Rex to be the most decorated of March, got %+v", march.MostDecorated)
+		}
+
+		years := AggregateWinners(winners, HistoryPeriodYear)
+		if len(years) != 1 || years[0].Period != "2025" || years[0].TotalWins != 5 || years[0].AverageScore != 8 {
+			t.Errorf("Expected 5 wins averaging 8 points in 2025, got %+v", years)
+		}
+	})
+
+	t.Run("Counts trophies per group", func(t *testing.T) {
+		groups := CountTrophiesByGroup(winners)
+
+		if len(groups) != 2 || groups[0].GroupID != groupID || groups[0].Wins != 4 || groups[1].Wins != 1 {
+			t.Errorf("Expected 4 wins in the first group and 1 in the other, got %+v", groups)
+		}
+	})
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultLogRetentionDays is how long behavior logs are kept when a deployment does not
+	// configure it, about six months
+	DefaultLogRetentionDays = 183
+	// MinLogRetentionDays keeps logs well past the backfill window and dispute reviews, while
+	// the scores of their days can still change
+	MinLogRetentionDays = 30
+)
+
+// ErrDailyScoreArchived is returned when recalculating a daily score whose logs were purged
+var ErrDailyScoreArchived = fmt.Errorf("daily score is archived, its behavior logs were purged")
+
+// LogRetentionPolicy is the deployment setting of how long behavior logs are kept before their
+// days are archived and the logs purged
+type LogRetentionPolicy struct {
+	RetentionDays int
+	ArchiveDir    string // Purged logs are exported under this directory, not exported when empty
+}
+
+// NewLogRetentionPolicy creates a log retention policy with validation
+func NewLogRetentionPolicy(retentionDays int, archiveDir string) (*LogRetentionPolicy, error) {
+	if retentionDays < MinLogRetentionDays {
+		return nil, fmt.Errorf("log retention must be at least %d days", MinLogRetentionDays)
+	}
+
+	return &LogRetentionPolicy{
+		RetentionDays: retentionDays,
+		ArchiveDir:    archiveDir,
+	}, nil
+}
+
+// Cutoff returns the moment logs logged before are purged: midnight UTC, retention days ago
+func (p *LogRetentionPolicy) Cutoff(now time.Time) time.Time {
+	day := now.UTC().AddDate(0, 0, -p.RetentionDays)
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// ExportsLogs returns true if purged logs are exported to archive files first
+func (p *LogRetentionPolicy) ExportsLogs() bool {
+	return p.ArchiveDir != ""
+}
+
+// ArchivedDailyScore is the immutable summary of a pet's day in a group, kept once the behavior
+// logs of the day are purged. It has the breakdown the logs used to provide.
+type ArchivedDailyScore struct {
+	ID                 uuid.UUID
+	PetID              uuid.UUID
+	GroupID            uuid.UUID
+	Date               time.Time
+	TotalPoints        int
+	PositiveBehaviors  int
+	NegativeBehaviors  int
+	BehaviorPointTotal int
+	LogCount           int // Logs counted in the group on this day
+	Breakdown          []*DailyScoreBreakdown
+	ArchiveFile        string // Export of the purged logs, empty when logs are not exported
+	ArchivedAt         time.Time
+}
+
+// NewArchivedDailyScore summarizes a daily score and the behavior logs of its day, see
+// NewDailyScoreBreakdown for how behaviors are looked up.
+func NewArchivedDailyScore(dailyScore *DailyScore, behaviorLogs []*BehaviorLog, behaviors map[uuid.UUID]*Behavior, archiveFile string, now time.Time) *ArchivedDailyScore {
+	archived := &ArchivedDailyScore{
+		ID:                 uuid.New(),
+		PetID:              dailyScore.PetID,
+		GroupID:            dailyScore.GroupID,
+		Date:               dailyScore.Date,
+		TotalPoints:        dailyScore.TotalPoints,
+		PositiveBehaviors:  dailyScore.PositiveBehaviors,
+		NegativeBehaviors:  dailyScore.NegativeBehaviors,
+		BehaviorPointTotal: dailyScore.BehaviorPointTotal,
+		Breakdown:          NewDailyScoreBreakdown(dailyScore.GroupID, behaviorLogs, behaviors),
+		ArchiveFile:        archiveFile,
+		ArchivedAt:         now,
+	}
+
+	for _, item := range archived.Breakdown {
+		archived.LogCount += item.Count
+	}
+
+	return archived
+}
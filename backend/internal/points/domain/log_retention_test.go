@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewLogRetentionPolicy(t *testing.T) {
+	if _, err := NewLogRetentionPolicy(MinLogRetentionDays-1, ""); err == nil {
+		t.Errorf("Expected error for a retention shorter than %d days", MinLogRetentionDays)
+	}
+
+	policy, err := NewLogRetentionPolicy(DefaultLogRetentionDays, "")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if policy.ExportsLogs() {
+		t.Errorf("Expected no export without an archive directory")
+	}
+
+	// Late evening in New York is already the next day in UTC
+	now := time.Date(2025, time.September, 30, 22, 0, 0, 0, time.FixedZone("EDT", -4*60*60))
+	want := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+	if cutoff := policy.Cutoff(now); !cutoff.Equal(want) {
+		t.Errorf("Expected cutoff %v, got %v", want, cutoff)
+	}
+}
+
+func TestNewArchivedDailyScore(t *testing.T) {
+	petID, groupID, userID := uuid.New(), uuid.New(), uuid.New()
+	fetch := &Behavior{ID: uuid.New(), Name: "Fetch session", Category: BehaviorCategoryPlay}
+	deletedBehaviorID := uuid.New()
+	day := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	newLog := func(behaviorID uuid.UUID, points int) *BehaviorLog {
+		behaviorLog := &BehaviorLog{
+			ID:            uuid.New(),
+			PetID:         petID,
+			BehaviorID:    behaviorID,
+			UserID:        userID,
+			PointsAwarded: points,
+			LoggedAt:      day.Add(10 * time.Hour),
+		}
+		behaviorLog.AddGroupShare(groupID)
+		return behaviorLog
+	}
+
+	pending := newLog(fetch.ID, 3)
+	pending.RequireVerification(groupID)
+	behaviorLogs := []*BehaviorLog{newLog(fetch.ID, 3), newLog(fetch.ID, 3), newLog(deletedBehaviorID, -2), pending}
+
+	dailyScore, _ := NewDailyScore(petID, groupID, day)
+	dailyScore.Recalculate(behaviorLogs, nil)
+
+	now := day.AddDate(0, 6, 0)
+	archived := NewArchivedDailyScore(dailyScore, behaviorLogs, map[uuid.UUID]*Behavior{fetch.ID: fetch}, "logs.jsonl.gz", now)
+
+	if archived.TotalPoints != 4 || archived.PositiveBehaviors != 2 || archived.NegativeBehaviors != 1 || archived.LogCount != 3 {
+		t.Errorf("Expected 4 points from 3 counted logs, got %+v", archived)
+	}
+	if len(archived.Breakdown) != 2 {
+		t.Fatalf("Expected 2 behaviors in the breakdown, got %d", len(archived.Breakdown))
+	}
+	if item := archived.Breakdown[0]; item.BehaviorName != "Fetch session" || item.Count != 2 || item.TotalPoints != 6 {
+		t.Errorf("Expected fetch sessions first, got %+v", item)
+	}
+	if item := archived.Breakdown[1]; item.BehaviorName != "Unknown" || item.TotalPoints != -2 {
+		t.Errorf("Expected the deleted behavior to be named Unknown, got %+v", item)
+	}
+	if archived.ArchiveFile != "logs.jsonl.gz" || !archived.ArchivedAt.Equal(now) {
+		t.Errorf("Expected the archive file and time to be kept")
+	}
+}
+
+func TestDailyScore_Archived(t *testing.T) {
+	petID, groupID := uuid.New(), uuid.New()
+	day := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	behaviorLog := &BehaviorLog{ID: uuid.New(), PetID: petID, PointsAwarded: 3, LoggedAt: day.Add(10 * time.Hour)}
+	behaviorLog.AddGroupShare(groupID)
+	adjustment := &PointAdjustment{ID: uuid.New(), PetID: petID, GroupID: groupID, Points: 5}
+
+	tests := []struct {
+		name   string
+		change func(dailyScore *DailyScore) error
+	}{
+		{name: "Recalculate", change: func(dailyScore *DailyScore) error { return dailyScore.Recalculate(nil, nil) }},
+		{name: "AddBehaviorLog", change: func(dailyScore *DailyScore) error { return dailyScore.AddBehaviorLog(behaviorLog) }},
+		{name: "RemoveBehaviorLog", change: func(dailyScore *DailyScore) error { return dailyScore.RemoveBehaviorLog(behaviorLog) }},
+		{name: "AddAdjustment", change: func(dailyScore *DailyScore) error { return dailyScore.AddAdjustment(adjustment) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dailyScore, _ := NewDailyScore(petID, groupID, day)
+			dailyScore.TotalPoints = 7
+			dailyScore.Archive(time.Now())
+
+			if err := tt.change(dailyScore); !errors.Is(err, ErrDailyScoreArchived) {
+				t.Errorf("Expected ErrDailyScoreArchived, got %v", err)
+			}
+			if dailyScore.TotalPoints != 7 {
+				t.Errorf("Expected archived points to be kept, got %d", dailyScore.TotalPoints)
+			}
+		})
+	}
+}
@@ -0,0 +1,331 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxAnalyticsDays is the longest range pet analytics cover, the retention window of behavior logs
+const MaxAnalyticsDays = 184
+
+// AnalyticsInterval is the length of the periods the category mix of a pet is split into
+type AnalyticsInterval string
+
+const (
+	// AnalyticsIntervalDay splits the category mix per day
+	AnalyticsIntervalDay AnalyticsInterval = "day"
+	// AnalyticsIntervalWeek splits the category mix per week, starting on Monday
+	AnalyticsIntervalWeek AnalyticsInterval = "week"
+)
+
+// IsValidAnalyticsInterval checks if an analytics interval is known
+func IsValidAnalyticsInterval(interval AnalyticsInterval) bool {
+	return interval == AnalyticsIntervalDay || interval == AnalyticsIntervalWeek
+}
+
+// PetAnalyticsFilter selects the behavior logs and daily scores pet analytics aggregate
+type PetAnalyticsFilter struct {
+	PetID    uuid.UUID
+	GroupID  *uuid.UUID     // Only logs shared with and scores in this group
+	From     time.Time      // First day, as a date
+	To       time.Time      // Last day, as a date, included
+	Location *time.Location // Timezone of the pet's owner, logs are bucketed by its wall clock
+}
+
+// NewPetAnalyticsFilter creates a pet analytics filter with validation
+func NewPetAnalyticsFilter(petID uuid.UUID, groupID *uuid.UUID, from, to time.Time, location *time.Location) (*PetAnalyticsFilter, error) {
+	from = dateOnly(from)
+	to = dateOnly(to)
+
+	if to.Before(from) {
+		return nil, fmt.Errorf("analytics range ends before it starts")
+	}
+	if days := analyticsDays(from, to); days > MaxAnalyticsDays {
+		return nil, fmt.Errorf("analytics range cannot exceed %d days, got %d", MaxAnalyticsDays, days)
+	}
+	if location == nil {
+		location = time.UTC
+	}
+
+	return &PetAnalyticsFilter{
+		PetID:    petID,
+		GroupID:  groupID,
+		From:     from,
+		To:       to,
+		Location: location,
+	}, nil
+}
+
+// Bounds returns the moments the range starts and ends at in the owner's timezone, the end excluded
+func (f *PetAnalyticsFilter) Bounds() (time.Time, time.Time) {
+	start := time.Date(f.From.Year(), f.From.Month(), f.From.Day(), 0, 0, 0, 0, f.Location)
+	end := time.Date(f.To.Year(), f.To.Month(), f.To.Day()+1, 0, 0, 0, 0, f.Location)
+	return start, end
+}
+
+// Includes checks if a behavior log is aggregated by the filter. With a group, only logs whose
+// share counts in the group are; without one, logs that count toward the pet's own records.
+func (f *PetAnalyticsFilter) Includes(behaviorLog *BehaviorLog) bool {
+	start, end := f.Bounds()
+	if behaviorLog.PetID != f.PetID || behaviorLog.LoggedAt.Before(start) || !behaviorLog.LoggedAt.Before(end) {
+		return false
+	}
+
+	if f.GroupID != nil {
+		return behaviorLog.IsCountedInGroup(*f.GroupID)
+	}
+	return behaviorLog.IsCounted()
+}
+
+// LogPoints returns the points a behavior log counts for, those awarded in the filter's group
+// when it has one
+func (f *PetAnalyticsFilter) LogPoints(behaviorLog *BehaviorLog) int {
+	if f.GroupID != nil {
+		return behaviorLog.PointsForGroup(*f.GroupID)
+	}
+	return behaviorLog.PointsAwarded
+}
+
+// HeatmapCell counts the logs of a pet in one hour of one day of the week
+type HeatmapCell struct {
+	Weekday time.Weekday `json:"weekday"` // Sunday is 0
+	Hour    int          `json:"hour"`
+	Logs    int          `json:"logs"`
+	Points  int          `json:"points"`
+}
+
+// ActivityHeatmap spreads the logs of a pet over the hours of the week, indexed by weekday
+// (Sunday first) then hour, in the owner's timezone
+type ActivityHeatmap struct {
+	Logs        [7][24]int   `json:"logs"`
+	Points      [7][24]int   `json:"points"`
+	PeakWeekday time.Weekday `json:"peak_weekday"`
+	PeakHour    int          `json:"peak_hour"`
+}
+
+// BuildHeatmap lays heatmap cells out on a week. The busiest hour is the peak, the earliest
+// one of the week on a tie.
+func BuildHeatmap(cells []*HeatmapCell) *ActivityHeatmap {
+	heatmap := &ActivityHeatmap{}
+	for _, cell := range cells {
+		if cell.Weekday < time.Sunday || cell.Weekday > time.Saturday || cell.Hour < 0 || cell.Hour > 23 {
+			continue
+		}
+		heatmap.Logs[cell.Weekday][cell.Hour] += cell.Logs
+		heatmap.Points[cell.Weekday][cell.Hour] += cell.Points
+	}
+
+	peakLogs := 0
+	for weekday := range heatmap.Logs {
+		for hour, logs := range heatmap.Logs[weekday] {
+			if logs > peakLogs {
+				peakLogs = logs
+				heatmap.PeakWeekday = time.Weekday(weekday)
+				heatmap.PeakHour = hour
+			}
+		}
+	}
+
+	return heatmap
+}
+
+// CategoryMixBucket counts the logs of a pet in one behavior category over one period
+type CategoryMixBucket struct {
+	PeriodStart time.Time
+	Category    BehaviorCategory
+	Logs        int
+	Points      int
+}
+
+// CategoryShare is the part of one behavior category in the logs of a period
+type CategoryShare struct {
+	Category BehaviorCategory `json:"category"`
+	Logs     int              `json:"logs"`
+	Points   int              `json:"points"`
+	Share    float64          `json:"share"` // Fraction of the logs of the period
+}
+
+// CategoryMixPeriod is the category distribution of the logs of a pet over one period
+type CategoryMixPeriod struct {
+	PeriodStart time.Time        `json:"period_start"`
+	TotalLogs   int              `json:"total_logs"`
+	Categories  []*CategoryShare `json:"categories"`
+}
+
+// BuildCategoryMix lays category buckets out on every period of a range, listing every category
+// in each period, oldest period first
+func BuildCategoryMix(buckets []*CategoryMixBucket, from, to time.Time, interval AnalyticsInterval) []*CategoryMixPeriod {
+	periodsByStart := make(map[time.Time]*CategoryMixPeriod)
+	var periods []*CategoryMixPeriod
+	for start := intervalStart(from, interval); !start.After(dateOnly(to)); start = nextInterval(start, interval) {
+		period := &CategoryMixPeriod{PeriodStart: start}
+		for _, category := range GetValidCategories() {
+			period.Categories = append(period.Categories, &CategoryShare{Category: category})
+		}
+		periodsByStart[start] = period
+		periods = append(periods, period)
+	}
+
+	for _, bucket := range buckets {
+		period, exists := periodsByStart[intervalStart(bucket.PeriodStart, interval)]
+		if !exists {
+			continue
+		}
+		for _, share := range period.Categories {
+			if share.Category == bucket.Category {
+				share.Logs += bucket.Logs
+				share.Points += bucket.Points
+				period.TotalLogs += bucket.Logs
+				break
+			}
+		}
+	}
+
+	for _, period := range periods {
+		if period.TotalLogs == 0 {
+			continue
+		}
+		for _, share := range period.Categories {
+			share.Share = float64(share.Logs) / float64(period.TotalLogs)
+		}
+	}
+
+	return periods
+}
+
+// DailyBehaviorTotals counts the good and bad logs of a pet on one day
+type DailyBehaviorTotals struct {
+	Date         time.Time
+	PositiveLogs int
+	NegativeLogs int
+	Points       int
+}
+
+// RatioTrendPoint is the share of good behaviors of a pet on one day. Ratios are nil on days
+// without good or bad behaviors.
+type RatioTrendPoint struct {
+	Date               time.Time `json:"date"`
+	PositiveLogs       int       `json:"positive_logs"`
+	NegativeLogs       int       `json:"negative_logs"`
+	PositiveRatio      *float64  `json:"positive_ratio"`
+	RollingRatio       *float64  `json:"rolling_ratio"` // Over the window ending on the day
+	RollingAverageLogs float64   `json:"rolling_average_logs"`
+}
+
+// BuildRatioTrend lays daily totals out on every day of a range, with ratios rolling over the
+// given number of days. Days before the range are not counted, so the first windows are shorter.
+func BuildRatioTrend(totals []*DailyBehaviorTotals, from, to time.Time, window int) []*RatioTrendPoint {
+	totalsByDate := make(map[time.Time]*DailyBehaviorTotals, len(totals))
+	for _, total := range totals {
+		totalsByDate[dateOnly(total.Date)] = total
+	}
+
+	var points []*RatioTrendPoint
+	for date := dateOnly(from); !date.After(dateOnly(to)); date = date.AddDate(0, 0, 1) {
+		point := &RatioTrendPoint{Date: date}
+		if total, exists := totalsByDate[date]; exists {
+			point.PositiveLogs = total.PositiveLogs
+			point.NegativeLogs = total.NegativeLogs
+		}
+		point.PositiveRatio = positiveRatio(point.PositiveLogs, point.NegativeLogs)
+		points = append(points, point)
+	}
+
+	for i, point := range points {
+		positive, negative := 0, 0
+		days := rollingWindow(points, i, window)
+		for _, day := range days {
+			positive += day.PositiveLogs
+			negative += day.NegativeLogs
+		}
+		point.RollingRatio = positiveRatio(positive, negative)
+		point.RollingAverageLogs = float64(positive+negative) / float64(len(days))
+	}
+
+	return points
+}
+
+// DailyScoreTotal is the daily score of a pet, averaged over its groups unless filtered to one
+type DailyScoreTotal struct {
+	Date  time.Time
+	Score float64
+}
+
+// ScoreTrendPoint is the score of a pet on one day with its rolling average
+type ScoreTrendPoint struct {
+	Date           time.Time `json:"date"`
+	Score          float64   `json:"score"`
+	RollingAverage float64   `json:"rolling_average"`
+}
+
+// BuildScoreTrend lays daily scores out on every day of a range, days without a score counting
+// as zero, with averages rolling over the given number of days
+func BuildScoreTrend(scores []*DailyScoreTotal, from, to time.Time, window int) []*ScoreTrendPoint {
+	scoresByDate := make(map[time.Time]float64, len(scores))
+	for _, score := range scores {
+		scoresByDate[dateOnly(score.Date)] += score.Score
+	}
+
+	var points []*ScoreTrendPoint
+	for date := dateOnly(from); !date.After(dateOnly(to)); date = date.AddDate(0, 0, 1) {
+		points = append(points, &ScoreTrendPoint{Date: date, Score: scoresByDate[date]})
+	}
+
+	for i, point := range points {
+		total := 0.0
+		days := rollingWindow(points, i, window)
+		for _, day := range days {
+			total += day.Score
+		}
+		point.RollingAverage = total / float64(len(days))
+	}
+
+	return points
+}
+
+// rollingWindow returns the points of the window of days ending on a point
+func rollingWindow[T any](points []T, end, window int) []T {
+	start := end - window + 1
+	if window < 1 || start < 0 {
+		start = 0
+	}
+	return points[start : end+1]
+}
+
+// positiveRatio returns the share of good behaviors, or nil without any behavior
+func positiveRatio(positive, negative int) *float64 {
+	if positive+negative == 0 {
+		return nil
+	}
+	ratio := float64(positive) / float64(positive+negative)
+	return &ratio
+}
+
+// intervalStart returns the day or the Monday an analytics period of a date starts on
+func intervalStart(date time.Time, interval AnalyticsInterval) time.Time {
+	date = dateOnly(date)
+	if interval == AnalyticsIntervalWeek {
+		return date.AddDate(0, 0, -((int(date.Weekday()) + 6) % 7))
+	}
+	return date
+}
+
+// nextInterval returns the start of the analytics period following the one starting on a date
+func nextInterval(start time.Time, interval AnalyticsInterval) time.Time {
+	if interval == AnalyticsIntervalWeek {
+		return start.AddDate(0, 0, 7)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+// analyticsDays returns the number of days of a range, both ends included
+func analyticsDays(from, to time.Time) int {
+	return int(to.Sub(from).Hours()/24) + 1
+}
+
+// dateOnly returns the calendar date of a time as a UTC date
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewPetAnalyticsFilter(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("timezone database not available: %v", err)
+	}
+
+	from := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC)
+
+	filter, err := NewPetAnalyticsFilter(uuid.New(), nil, from, to, newYork)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	start, end := filter.Bounds()
+	if !start.Equal(time.Date(2025, time.March, 1, 5, 0, 0, 0, time.UTC)) || !end.Equal(time.Date(2025, time.April, 1, 4, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected the range to follow New York days, got %v to %v", start, end)
+	}
+
+	if _, err := NewPetAnalyticsFilter(uuid.New(), nil, to, from, newYork); err == nil {
+		t.Error("Expected error for a range ending before it starts")
+	}
+	if _, err := NewPetAnalyticsFilter(uuid.New(), nil, from, from.AddDate(0, 0, MaxAnalyticsDays), newYork); err == nil {
+		t.Error("Expected error for a range longer than the retention window")
+	}
+}
+
+func TestPetAnalyticsFilter_Includes(t *testing.T) {
+	petID, groupID := uuid.New(), uuid.New()
+	from := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	loggedAt := time.Date(2025, time.March, 2, 10, 0, 0, 0, time.UTC)
+
+	groupFilter, _ := NewPetAnalyticsFilter(petID, &groupID, from, from.AddDate(0, 0, 6), time.UTC)
+	petFilter, _ := NewPetAnalyticsFilter(petID, nil, from, from.AddDate(0, 0, 6), time.UTC)
+
+	tests := []struct {
+		name        string
+		shares      []BehaviorLogGroupShare
+		wantInGroup bool
+		wantForPet  bool
+		wantPoints  int
+	}{
+		{name: "Not shared", wantForPet: true},
+		{
+			name:        "Shared before verification",
+			shares:      []BehaviorLogGroupShare{{GroupID: groupID, PointsAwarded: 8}},
+			wantInGroup: true, wantForPet: true, wantPoints: 8,
+		},
+		{
+			name:        "Group points fall back to the log's",
+			shares:      []BehaviorLogGroupShare{{GroupID: groupID, Status: ShareStatusVerified}},
+			wantInGroup: true, wantForPet: true, wantPoints: 5,
+		},
+		{
+			name:   "Pending in the group",
+			shares: []BehaviorLogGroupShare{{GroupID: groupID, Status: ShareStatusPending}},
+		},
+		{
+			name:       "Counted in another group",
+			shares:     []BehaviorLogGroupShare{{GroupID: uuid.New(), Status: ShareStatusVerified}},
+			wantForPet: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			behaviorLog := &BehaviorLog{PetID: petID, PointsAwarded: 5, LoggedAt: loggedAt, GroupShares: tt.shares}
+			if got := groupFilter.Includes(behaviorLog); got != tt.wantInGroup {
+				t.Errorf("Expected the group filter to include the log: %v, got %v", tt.wantInGroup, got)
+			}
+			if got := petFilter.Includes(behaviorLog); got != tt.wantForPet {
+				t.Errorf("Expected the pet filter to include the log: %v, got %v", tt.wantForPet, got)
+			}
+			if tt.wantInGroup {
+				if got := groupFilter.LogPoints(behaviorLog); got != tt.wantPoints {
+					t.Errorf("Expected %d points in the group, got %d", tt.wantPoints, got)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildCategoryMix(t *testing.T) {
+	// Wednesday March 12 to Monday March 17 spans two weeks
+	from := time.Date(2025, time.March, 12, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, time.March, 17, 0, 0, 0, 0, time.UTC)
+	buckets := []*CategoryMixBucket{
+		{PeriodStart: time.Date(2025, time.March, 12, 0, 0, 0, 0, time.UTC), Category: BehaviorCategoryPlay, Logs: 3, Points: 9},
+		{PeriodStart: time.Date(2025, time.March, 14, 0, 0, 0, 0, time.UTC), Category: BehaviorCategoryFeeding, Logs: 1, Points: 1},
+		{PeriodStart: time.Date(2025, time.March, 17, 0, 0, 0, 0, time.UTC), Category: BehaviorCategoryPlay, Logs: 2, Points: 6},
+	}
+
+	periods := BuildCategoryMix(buckets, from, to, AnalyticsIntervalWeek)
+	if len(periods) != 2 {
+		t.Fatalf("Expected 2 weeks, got %d", len(periods))
+	}
+	if !periods[0].PeriodStart.Equal(time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected weeks to start on Monday, got %v", periods[0].PeriodStart)
+	}
+	if periods[0].TotalLogs != 4 || len(periods[0].Categories) != len(GetValidCategories()) {
+		t.Errorf("Expected 4 logs over every category, got %+v", periods[0])
+	}
+	for _, share := range periods[0].Categories {
+		if share.Category == BehaviorCategoryPlay && share.Share != 0.75 {
+			t.Errorf("Expected play to be 75%% of the first week, got %v", share.Share)
+		}
+	}
+}
+
+func TestBuildScoreTrend(t *testing.T) {
+	from := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, time.March, 13, 0, 0, 0, 0, time.UTC)
+	scores := []*DailyScoreTotal{
+		{Date: from, Score: 6},
+		{Date: from.AddDate(0, 0, 2), Score: 9},
+	}
+
+	trend := BuildScoreTrend(scores, from, to, 2)
+	if len(trend) != 4 {
+		t.Fatalf("Expected 4 days, got %d", len(trend))
+	}
+
+	expected := []float64{6, 3, 4.5, 4.5}
+	for i, point := range trend {
+		if point.RollingAverage != expected[i] {
+			t.Errorf("Expected a rolling average of %v on day %d, got %v", expected[i], i, point.RollingAverage)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PointAdjustmentKind describes where points that do not come from a behavior log come from
+type PointAdjustmentKind string
+
+const (
+	// PointAdjustmentKindManual is a correction made by a group admin, either way
+	PointAdjustmentKindManual PointAdjustmentKind = "manual"
+	// PointAdjustmentKindChallengeBonus is awarded to pets that complete a group challenge
+	PointAdjustmentKindChallengeBonus PointAdjustmentKind = "challenge_bonus"
+	// PointAdjustmentKindPenalty is a deduction made by a group admin
+	PointAdjustmentKindPenalty PointAdjustmentKind = "penalty"
+	// PointAdjustmentKindReversal cancels an earlier adjustment
+	PointAdjustmentKindReversal PointAdjustmentKind = "reversal"
+)
+
+// MaxPointAdjustment bounds the points a single adjustment can add or remove
+const MaxPointAdjustment = 100
+
+// PointAdjustment is an entry of the point ledger of a pet in a group. Entries are never
+// changed or deleted: a mistake is corrected by reversing the entry, which keeps the audit trail.
+type PointAdjustment struct {
+	ID          uuid.UUID
+	PetID       uuid.UUID
+	GroupID     uuid.UUID
+	Date        time.Time // Group day the points count for
+	Points      int
+	Kind        PointAdjustmentKind
+	Reason      string
+	AuthorID    uuid.UUID
+	ChallengeID *uuid.UUID // Set for challenge bonuses
+	ReversesID  *uuid.UUID // Set for reversals
+	CreatedAt   time.Time
+}
+
+// NewPointAdjustment creates a new ledger entry with validation
+func NewPointAdjustment(petID, groupID, authorID uuid.UUID, date time.Time, points int, kind PointAdjustmentKind, reason string) (*PointAdjustment, error) {
+	if petID == uuid.Nil {
+		return nil, fmt.Errorf("pet ID is required")
+	}
+	if groupID == uuid.Nil {
+		return nil, fmt.Errorf("group ID is required")
+	}
+	if authorID == uuid.Nil {
+		return nil, fmt.Errorf("author ID is required")
+	}
+
+	if points == 0 {
+		return nil, fmt.Errorf("adjustment cannot be zero")
+	}
+	if points > MaxPointAdjustment || points < -MaxPointAdjustment {
+		return nil, fmt.Errorf("adjustment must be between -%d and %d points", MaxPointAdjustment, MaxPointAdjustment)
+	}
+
+	switch kind {
+	case PointAdjustmentKindManual, PointAdjustmentKindReversal:
+	case PointAdjustmentKindChallengeBonus:
+		if points < 0 {
+			return nil, fmt.Errorf("challenge bonus must be positive")
+		}
+	case PointAdjustmentKindPenalty:
+		if points > 0 {
+			return nil, fmt.Errorf("penalty must be negative")
+		}
+	default:
+		return nil, fmt.Errorf("invalid adjustment kind: %s", kind)
+	}
+
+	if len(reason) == 0 || len(reason) > 200 {
+		return nil, fmt.Errorf("adjustment reason must be between 1 and 200 characters")
+	}
+
+	// Normalize date to start of day, like daily scores
+	normalizedDate := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+
+	return &PointAdjustment{
+		ID:        uuid.New(),
+		PetID:     petID,
+		GroupID:   groupID,
+		Date:      normalizedDate,
+		Points:    points,
+		Kind:      kind,
+		Reason:    reason,
+		AuthorID:  authorID,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// NewChallengeBonus creates the ledger entry of a challenge bonus
+func NewChallengeBonus(challenge *Challenge, petID uuid.UUID, date time.Time) (*PointAdjustment, error) {
+	adjustment, err := NewPointAdjustment(petID, challenge.GroupID, challenge.CreatedBy, date,
+		challenge.BonusPoints, PointAdjustmentKindChallengeBonus, fmt.Sprintf("Completed challenge %q", challenge.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	challengeID := challenge.ID
+	adjustment.ChallengeID = &challengeID
+	return adjustment, nil
+}
+
+// Reverse creates the entry that cancels this one, on the same day
+func (a *PointAdjustment) Reverse(authorID uuid.UUID, reason string) (*PointAdjustment, error) {
+	if a.Kind == PointAdjustmentKindReversal {
+		return nil, fmt.Errorf("a reversal cannot be reversed")
+	}
+
+	reversal, err := NewPointAdjustment(a.PetID, a.GroupID, authorID, a.Date, -a.Points, PointAdjustmentKindReversal, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	reversesID := a.ID
+	reversal.ReversesID = &reversesID
+	return reversal, nil
+}
+
+// PointAdjustmentFilter represents criteria for filtering the point ledger
+type PointAdjustmentFilter struct {
+	GroupID     *uuid.UUID
+	PetID       *uuid.UUID
+	ChallengeID *uuid.UUID
+	DateFrom    *time.Time
+	DateTo      *time.Time
+	Limit       int
+	Offset      int
+}
+
+// NewPointAdjustmentFilter creates a new filter with sensible defaults
+func NewPointAdjustmentFilter() *PointAdjustmentFilter {
+	return &PointAdjustmentFilter{
+		Limit:  50,
+		Offset: 0,
+	}
+}
+
+// WithGroup adds a group ID filter
+func (f *PointAdjustmentFilter) WithGroup(groupID uuid.UUID) *PointAdjustmentFilter {
+	f.GroupID = &groupID
+	return f
+}
+
+// WithPet adds a pet ID filter
+func (f *PointAdjustmentFilter) WithPet(petID uuid.UUID) *PointAdjustmentFilter {
+	f.PetID = &petID
+	return f
+}
+
+// WithChallenge adds a challenge ID filter
+func (f *PointAdjustmentFilter) WithChallenge(challengeID uuid.UUID) *PointAdjustmentFilter {
+	f.ChallengeID = &challengeID
+	return f
+}
+
+// WithDateRange adds a day range filter, both days included
+func (f *PointAdjustmentFilter) WithDateRange(from, to time.Time) *PointAdjustmentFilter {
+	f.DateFrom = &from
+	f.DateTo = &to
+	return f
+}
+
+// WithPagination sets limit and offset
+func (f *PointAdjustmentFilter) WithPagination(limit, offset int) *PointAdjustmentFilter {
+	f.Limit = limit
+	f.Offset = offset
+	return f
+}
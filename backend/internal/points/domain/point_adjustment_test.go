@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewPointAdjustment(t *testing.T) {
+	petID, groupID, authorID := uuid.New(), uuid.New(), uuid.New()
+	date := time.Date(2026, 3, 1, 15, 30, 0, 0, time.UTC)
+
+	adjustment, err := NewPointAdjustment(petID, groupID, authorID, date, 5, PointAdjustmentKindManual, "Helped a new member")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !adjustment.Date.Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected date normalized to midnight, got %v", adjustment.Date)
+	}
+
+	invalid := []struct {
+		name   string
+		points int
+		kind   PointAdjustmentKind
+		reason string
+	}{
+		{"Zero points", 0, PointAdjustmentKindManual, "Reason"},
+		{"Too many points", MaxPointAdjustment + 1, PointAdjustmentKindManual, "Reason"},
+		{"Positive penalty", 5, PointAdjustmentKindPenalty, "Reason"},
+		{"Negative challenge bonus", -5, PointAdjustmentKindChallengeBonus, "Reason"},
+		{"Unknown kind", 5, "gift", "Reason"},
+		{"Missing reason", 5, PointAdjustmentKindManual, ""},
+	}
+	for _, test := range invalid {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := NewPointAdjustment(petID, groupID, authorID, date, test.points, test.kind, test.reason); err == nil {
+				t.Error("Expected validation error")
+			}
+		})
+	}
+}
+
+func TestPointAdjustment_Reverse(t *testing.T) {
+	penalty, _ := NewPointAdjustment(uuid.New(), uuid.New(), uuid.New(), time.Now(), -10, PointAdjustmentKindPenalty, "Cheating")
+	reviewerID := uuid.New()
+
+	reversal, err := penalty.Reverse(reviewerID, "Logged by mistake")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if reversal.Points != 10 || reversal.Kind != PointAdjustmentKindReversal || reversal.AuthorID != reviewerID {
+		t.Errorf("Expected a 10 point reversal by the reviewer, got %+v", reversal)
+	}
+	if reversal.ReversesID == nil || *reversal.ReversesID != penalty.ID || !reversal.Date.Equal(penalty.Date) {
+		t.Errorf("Expected the reversal to point to the penalty on the same day, got %+v", reversal)
+	}
+
+	if _, err := reversal.Reverse(reviewerID, "Undo"); err == nil {
+		t.Error("Expected error when reversing a reversal")
+	}
+}
+
+func TestDailyScore_AdjustmentsAndRecalculation(t *testing.T) {
+	petID, groupID := uuid.New(), uuid.New()
+	date := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	score, _ := NewDailyScore(petID, groupID, date)
+
+	sit := &BehaviorLog{ID: uuid.New(), PetID: petID, PointsAwarded: 5, LoggedAt: date.Add(10 * time.Hour)}
+	accident := &BehaviorLog{ID: uuid.New(), PetID: petID, PointsAwarded: -3, LoggedAt: date.Add(8 * time.Hour)}
+	bonus, _ := NewPointAdjustment(petID, groupID, uuid.New(), date, 10, PointAdjustmentKindManual, "Great week")
+
+	score.AddBehaviorLog(sit)
+	if err := score.AddAdjustment(bonus); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if score.TotalPoints != 15 || score.BehaviorPointTotal != 5 || score.AdjustmentPoints() != 10 {
+		t.Errorf("Expected 5 behavior points and 10 adjustment points, got %+v", score)
+	}
+
+	otherGroup, _ := NewPointAdjustment(petID, uuid.New(), uuid.New(), date, 10, PointAdjustmentKindManual, "Other group")
+	if err := score.AddAdjustment(otherGroup); err == nil {
+		t.Error("Expected error when applying an adjustment of another group")
+	}
+
+	if err := score.Recalculate([]*BehaviorLog{sit, accident}, []*PointAdjustment{bonus}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if score.TotalPoints != 12 || score.BehaviorPointTotal != 2 || score.PositiveBehaviors != 1 || score.NegativeBehaviors != 1 {
+		t.Errorf("Expected recalculation to keep the adjustment, got %+v", score)
+	}
+	if score.LastActivityAt == nil || !score.LastActivityAt.Equal(sit.LoggedAt) {
+		t.Errorf("Expected last activity at the latest log, got %v", score.LastActivityAt)
+	}
+
+	ranking := NewPetRanking(petID, "Rex", "Owner")
+	ranking.UpdateFromDailyScore(score)
+	if ranking.TotalPoints != 12 || ranking.BehaviorPoints != 2 || ranking.AdjustmentPoints != 10 {
+		t.Errorf("Expected the ranking to show the split, got %+v", ranking)
+	}
+}
+
+func TestDailyScore_LastActivity(t *testing.T) {
+	petID, groupID := uuid.New(), uuid.New()
+	date := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	score, _ := NewDailyScore(petID, groupID, date)
+
+	walk := &BehaviorLog{ID: uuid.New(), PetID: petID, PointsAwarded: 5, LoggedAt: date.Add(9 * time.Hour)}
+	fetch := &BehaviorLog{ID: uuid.New(), PetID: petID, PointsAwarded: 3, LoggedAt: date.Add(18 * time.Hour)}
+	backdated := &BehaviorLog{ID: uuid.New(), PetID: petID, PointsAwarded: 2, LoggedAt: date.Add(7 * time.Hour)}
+
+	score.AddBehaviorLog(walk)
+	score.AddBehaviorLog(fetch)
+	score.AddBehaviorLog(backdated)
+	if score.LastActivityAt == nil || !score.LastActivityAt.Equal(fetch.LoggedAt) {
+		t.Errorf("Expected a backdated log not to move the last activity back, got %v", score.LastActivityAt)
+	}
+
+	// Removing the latest log needs the remaining logs to find when the score was reached
+	if err := score.Recalculate([]*BehaviorLog{walk, backdated}, nil); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if score.LastActivityAt == nil || !score.LastActivityAt.Equal(walk.LoggedAt) {
+		t.Errorf("Expected last activity at the latest remaining log, got %v", score.LastActivityAt)
+	}
+}
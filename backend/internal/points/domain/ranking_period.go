@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RankingPeriod represents the time span a ranking is computed over
+type RankingPeriod string
+
+const (
+	RankingPeriodDay     RankingPeriod = "day"
+	RankingPeriodWeek    RankingPeriod = "week"  // ISO week, Monday to Sunday
+	RankingPeriodMonth   RankingPeriod = "month" // Calendar month
+	RankingPeriodCustom  RankingPeriod = "custom"
+	RankingPeriodAllTime RankingPeriod = "all_time"
+)
+
+// MaxCustomRankingDays bounds custom ranking ranges
+const MaxCustomRankingDays = 366
+
+// ParseRankingPeriod parses a ranking period, defaulting to a single day
+func ParseRankingPeriod(value string) (RankingPeriod, error) {
+	switch RankingPeriod(value) {
+	case "":
+		return RankingPeriodDay, nil
+	case RankingPeriodDay, RankingPeriodWeek, RankingPeriodMonth, RankingPeriodCustom, RankingPeriodAllTime:
+		return RankingPeriod(value), nil
+	default:
+		return "", fmt.Errorf("invalid ranking period: %s", value)
+	}
+}
+
+// RankingWindow is the inclusive range of days a ranking covers.
+// From and To are midnights in the location of the reference date; From is zero for all-time rankings.
+type RankingWindow struct {
+	Period RankingPeriod
+	From   time.Time
+	To     time.Time
+}
+
+// NewRankingWindow resolves the days covered by a period around a reference date.
+// from and to are only used (and required) for custom periods, as calendar dates in the reference's location.
+func NewRankingWindow(period RankingPeriod, reference time.Time, from, to *time.Time) (*RankingWindow, error) {
+	day := normalizeDate(reference)
+
+	switch period {
+	case RankingPeriodDay:
+		return &RankingWindow{Period: period, From: day, To: day}, nil
+
+	case RankingPeriodWeek:
+		// ISO weeks start on Monday
+		offset := (int(day.Weekday()) + 6) % 7
+		monday := day.AddDate(0, 0, -offset)
+		return &RankingWindow{Period: period, From: monday, To: monday.AddDate(0, 0, 6)}, nil
+
+	case RankingPeriodMonth:
+		first := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location())
+		return &RankingWindow{Period: period, From: first, To: first.AddDate(0, 1, -1)}, nil
+
+	case RankingPeriodCustom:
+		if from == nil || to == nil {
+			return nil, fmt.Errorf("custom rankings require a start and end date")
+		}
+		start := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, reference.Location())
+		end := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, reference.Location())
+		if end.Before(start) {
+			return nil, fmt.Errorf("ranking end date must not be before its start date")
+		}
+		if end.After(start.AddDate(0, 0, MaxCustomRankingDays-1)) {
+			return nil, fmt.Errorf("custom rankings cannot span more than %d days", MaxCustomRankingDays)
+		}
+		return &RankingWindow{Period: period, From: start, To: end}, nil
+
+	case RankingPeriodAllTime:
+		return &RankingWindow{Period: period, To: day}, nil
+
+	default:
+		return nil, fmt.Errorf("invalid ranking period: %s", period)
+	}
+}
+
+// IsSingleDay returns true if the window covers exactly one day
+func (w *RankingWindow) IsSingleDay() bool {
+	return !w.IsAllTime() && w.From.Equal(w.To)
+}
+
+// IsAllTime returns true if the window has no start date
+func (w *RankingWindow) IsAllTime() bool {
+	return w.From.IsZero()
+}
+
+// Contains checks if a moment falls on one of the window's days
+func (w *RankingWindow) Contains(t time.Time) bool {
+	day := normalizeDate(t.In(w.To.Location()))
+	return !day.Before(w.From) && !day.After(w.To)
+}
+
+// AssignRanks sorts rankings with the default scoring rules and assigns rank positions.
+// Tied pets share a rank and the next rank skips the tied positions.
+func AssignRanks(rankings []*PetRanking) []*PetRanking {
+	return DefaultScoringRules(uuid.Nil).AssignRanks(rankings)
+}
@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewRankingWindow(t *testing.T) {
+	paris, _ := time.LoadLocation("Europe/Paris")
+	// Thursday 2026-01-01, ISO week 1 of 2026 starts on Monday 2025-12-29
+	reference := time.Date(2026, 1, 1, 15, 30, 0, 0, paris)
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		period   RankingPeriod
+		from, to *time.Time
+		wantFrom string
+		wantTo   string
+	}{
+		{"Day", RankingPeriodDay, nil, nil, "2026-01-01", "2026-01-01"},
+		{"ISO week across years", RankingPeriodWeek, nil, nil, "2025-12-29", "2026-01-04"},
+		{"Month", RankingPeriodMonth, nil, nil, "2026-01-01", "2026-01-31"},
+		{"Custom", RankingPeriodCustom, &from, &to, "2026-03-01", "2026-03-10"},
+		{"All time", RankingPeriodAllTime, nil, nil, "", "2026-01-01"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			window, err := NewRankingWindow(test.period, reference, test.from, test.to)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			gotFrom := ""
+			if !window.IsAllTime() {
+				gotFrom = window.From.Format("2006-01-02")
+			}
+			if gotFrom != test.wantFrom || window.To.Format("2006-01-02") != test.wantTo {
+				t.Errorf("Expected %s..%s, got %s..%s", test.wantFrom, test.wantTo, gotFrom, window.To.Format("2006-01-02"))
+			}
+			if window.To.Location() != paris {
+				t.Errorf("Expected window in the reference location, got %s", window.To.Location())
+			}
+		})
+	}
+
+	t.Run("Custom requires both dates", func(t *testing.T) {
+		if _, err := NewRankingWindow(RankingPeriodCustom, reference, &from, nil); err == nil {
+			t.Error("Expected error for missing end date")
+		}
+	})
+
+	t.Run("Custom rejects inverted and oversized ranges", func(t *testing.T) {
+		if _, err := NewRankingWindow(RankingPeriodCustom, reference, &to, &from); err == nil {
+			t.Error("Expected error for end before start")
+		}
+
+		farEnd := from.AddDate(0, 0, MaxCustomRankingDays)
+		if _, err := NewRankingWindow(RankingPeriodCustom, reference, &from, &farEnd); err == nil {
+			t.Error("Expected error for range over the limit")
+		}
+	})
+
+	t.Run("Contains", func(t *testing.T) {
+		window, _ := NewRankingWindow(RankingPeriodWeek, reference, nil, nil)
+		if !window.Contains(time.Date(2026, 1, 4, 23, 59, 0, 0, paris)) {
+			t.Error("Expected Sunday evening to be part of the week")
+		}
+		if window.Contains(time.Date(2026, 1, 4, 23, 30, 0, 0, time.UTC)) {
+			t.Error("Expected Monday in Paris to be outside the week")
+		}
+	})
+}
+
+func TestParseRankingPeriod(t *testing.T) {
+	if period, err := ParseRankingPeriod(""); err != nil || period != RankingPeriodDay {
+		t.Errorf("Expected empty period to default to day, got %s (%v)", period, err)
+	}
+	if period, err := ParseRankingPeriod("all_time"); err != nil || period != RankingPeriodAllTime {
+		t.Errorf("Expected all_time, got %s (%v)", period, err)
+	}
+	if _, err := ParseRankingPeriod("weekly"); err == nil {
+		t.Error("Expected error for unknown period")
+	}
+}
+
+func TestAssignRanks(t *testing.T) {
+	newRanking := func(points, negatives int) *PetRanking {
+		ranking := NewPetRanking(uuid.New(), "Pet", "Owner")
+		ranking.TotalPoints = points
+		ranking.NegativeBehaviors = negatives
+		return ranking
+	}
+
+	low := newRanking(5, 0)
+	tiedA := newRanking(10, 1)
+	tiedB := newRanking(10, 1)
+	best := newRanking(10, 0)
+
+	rankings := AssignRanks([]*PetRanking{low, tiedA, best, tiedB})
+
+	expected := []struct {
+		ranking *PetRanking
+		rank    int
+		tied    bool
+	}{
+		{best, 1, false},
+		{tiedA, 2, true},
+		{tiedB, 2, true},
+		{low, 4, false},
+	}
+
+	for i, want := range expected {
+		if rankings[i] != want.ranking || rankings[i].Rank != want.rank || rankings[i].IsTied != want.tied {
+			t.Errorf("Position %d: expected rank %d (tied %v), got rank %d (tied %v)", i, want.rank, want.tied, rankings[i].Rank, rankings[i].IsTied)
+		}
+	}
+}
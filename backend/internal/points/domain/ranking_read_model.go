@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// RankingEntry is a row of the rankings read model: the daily score of a pet in a group with the
+// names of the pet and its owner next to it, so the rankings of a group day are built without a
+// lookup per pet
+type RankingEntry struct {
+	DailyScore *DailyScore
+	PetName    string
+	OwnerID    uuid.UUID
+	OwnerName  string
+}
+
+// NewRankingEntry creates a rankings read model entry for a daily score
+func NewRankingEntry(dailyScore *DailyScore, petName string, ownerID uuid.UUID, ownerName string) *RankingEntry {
+	return &RankingEntry{
+		DailyScore: dailyScore,
+		PetName:    petName,
+		OwnerID:    ownerID,
+		OwnerName:  ownerName,
+	}
+}
+
+// WithDailyScore returns a copy of the entry with another score of the same pet, keeping the names
+func (e *RankingEntry) WithDailyScore(dailyScore *DailyScore) *RankingEntry {
+	return NewRankingEntry(dailyScore, e.PetName, e.OwnerID, e.OwnerName)
+}
+
+// ToPetRanking returns the ranking of the entry's day, without normalized points nor rank
+func (e *RankingEntry) ToPetRanking() *PetRanking {
+	ranking := NewPetRanking(e.DailyScore.PetID, e.PetName, e.OwnerName)
+	ranking.UpdateFromDailyScore(e.DailyScore)
+	return ranking
+}
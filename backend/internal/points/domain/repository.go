@@ -21,7 +21,33 @@ type BehaviorRepository interface {
 	GetByCategory(ctx context.Context, category BehaviorCategory, species *Species) ([]*Behavior, error)
 	Update(ctx context.Context, behavior *Behavior) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	// GetByName matches names case-insensitively and returns nil when no behavior has the name
 	GetByName(ctx context.Context, name string) (*Behavior, error)
+
+	// Catalog administration
+	GetAllIncludingInactive(ctx context.Context) ([]*Behavior, error)
+	HardDelete(ctx context.Context, id uuid.UUID) error
+}
+
+// GroupBehaviorRepository defines the interface for group-scoped behavior data access
+type GroupBehaviorRepository interface {
+	// Create creates a new custom group behavior or override
+	Create(ctx context.Context, groupBehavior *GroupBehavior) error
+
+	// GetByID retrieves a group behavior by ID (returns nil if not found)
+	GetByID(ctx context.Context, id uuid.UUID) (*GroupBehavior, error)
+
+	// GetByGroup retrieves all custom behaviors and overrides of a group, including inactive ones
+	GetByGroup(ctx context.Context, groupID uuid.UUID) ([]*GroupBehavior, error)
+
+	// GetOverride retrieves a group's override of a global behavior (returns nil if not overridden)
+	GetOverride(ctx context.Context, groupID, behaviorID uuid.UUID) (*GroupBehavior, error)
+
+	// Update updates an existing group behavior
+	Update(ctx context.Context, groupBehavior *GroupBehavior) error
+
+	// Delete deletes a group behavior
+	Delete(ctx context.Context, id uuid.UUID) error
 }
 
 // ScoreEventRepository defines the interface for score event data access
@@ -36,20 +62,39 @@ type ScoreEventRepository interface {
 	GetRecentActivitiesForUser(ctx context.Context, userID uuid.UUID, limit int) ([]ActivityItem, error)
 }
 
+// ScoreEventSource lists the events of the legacy scoring system, for migrating them to behavior logs
+type ScoreEventSource interface {
+	// ListScoreEvents retrieves a page of every score event, the oldest recorded first
+	ListScoreEvents(ctx context.Context, offset, limit int) ([]ScoreEvent, error)
+}
+
 // BehaviorLogRepository defines the interface for behavior log data access
 type BehaviorLogRepository interface {
 	// Create creates a new behavior log with group shares
 	Create(ctx context.Context, behaviorLog *BehaviorLog) error
 
-	// GetByID retrieves a behavior log by ID with group shares
+	// CreateBatch creates behavior logs with their group shares, all of them or none
+	CreateBatch(ctx context.Context, behaviorLogs []*BehaviorLog) error
+
+	// GetByID retrieves a behavior log by ID with group shares, ErrBehaviorLogNotFound if there is none
 	GetByID(ctx context.Context, id uuid.UUID) (*BehaviorLog, error)
 
+	// FindExistingIDs retrieves which of the given IDs belong to a behavior log
+	FindExistingIDs(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error)
+
 	// Find retrieves behavior logs based on filter criteria
 	Find(ctx context.Context, filter *BehaviorLogFilter) ([]*BehaviorLog, error)
 
+	// Count counts the behavior logs matching the filter criteria, ignoring pagination
+	Count(ctx context.Context, filter *BehaviorLogFilter) (int, error)
+
 	// Update updates an existing behavior log
 	Update(ctx context.Context, behaviorLog *BehaviorLog) error
 
+	// UpdateGroupShare updates the review state of a group share if its status is still the
+	// expected one, and returns ErrShareStatusChanged otherwise
+	UpdateGroupShare(ctx context.Context, share *BehaviorLogGroupShare, expectedStatus ShareStatus) error
+
 	// Delete deletes a behavior log (hard delete for data integrity)
 	Delete(ctx context.Context, id uuid.UUID) error
 
@@ -59,13 +104,17 @@ type BehaviorLogRepository interface {
 	// GetBreakdown retrieves behavior breakdown for daily score calculation
 	GetBreakdown(ctx context.Context, petID uuid.UUID, groupID uuid.UUID, date time.Time) ([]*DailyScoreBreakdown, error)
 
+	// GetGroupBreakdowns retrieves the behavior breakdown of every pet of a group on a day, by pet
+	GetGroupBreakdowns(ctx context.Context, groupID uuid.UUID, date time.Time) (map[uuid.UUID][]*DailyScoreBreakdown, error)
+
 	// CountByDateRange counts behavior logs within a date range
 	CountByDateRange(ctx context.Context, petID uuid.UUID, from, to time.Time) (int, error)
 
 	// GetByGroup retrieves all behavior logs shared with a specific group
 	GetByGroup(ctx context.Context, groupID uuid.UUID, filter *BehaviorLogFilter) ([]*BehaviorLog, error)
 
-	// CleanupOldLogs removes behavior logs older than the retention period (6 months)
+	// CleanupOldLogs removes behavior logs logged before the cutoff date. Their days must be
+	// archived first, see ArchivedDailyScoreRepository.
 	CleanupOldLogs(ctx context.Context, cutoffDate time.Time) (int, error)
 }
 
@@ -95,9 +144,18 @@ type DailyScoreRepository interface {
 	// GetTopScorers retrieves the highest scoring pets for a group on a specific date
 	GetTopScorers(ctx context.Context, groupID uuid.UUID, date time.Time) ([]*DailyScore, error)
 
-	// RecalculateFromLogs recalculates daily scores based on behavior logs
+	// RecalculateFromLogs recalculates daily scores based on behavior logs, replaying the
+	// point adjustments of the day so that non-behavior points are kept
 	RecalculateFromLogs(ctx context.Context, petID, groupID uuid.UUID, date time.Time) (*DailyScore, error)
 
+	// RecordAdjustment saves a point ledger entry and adds it to the daily score of its day,
+	// both or neither
+	RecordAdjustment(ctx context.Context, adjustment *PointAdjustment) (*DailyScore, error)
+
+	// RecordBehaviorLogs saves new behavior logs and adds each of them to the daily scores of
+	// its group days, all of them or none. It returns the changed daily scores.
+	RecordBehaviorLogs(ctx context.Context, scoredLogs []ScoredBehaviorLog) ([]*DailyScore, error)
+
 	// Delete deletes a daily score entry
 	Delete(ctx context.Context, id uuid.UUID) error
 
@@ -119,14 +177,293 @@ type PetOfTheDayRepository interface {
 	// GetPetWinCount retrieves the number of times a pet has won Pet of the Day
 	GetPetWinCount(ctx context.Context, petID uuid.UUID) (int, error)
 
+	// GetPetWins retrieves all Pet of the Day wins of a pet across groups, oldest first
+	GetPetWins(ctx context.Context, petID uuid.UUID) ([]*PetOfTheDayWinner, error)
+
 	// GetGroupStats retrieves statistics for a group (total winners, unique winners, etc.)
 	GetGroupStats(ctx context.Context, groupID uuid.UUID) (*GroupPetOfTheDayStats, error)
 
+	// GetMostDecorated retrieves the pets of a group with the most wins, ranked like
+	// RankMostDecorated, at most limit pets
+	GetMostDecorated(ctx context.Context, groupID uuid.UUID, limit int) ([]*DecoratedPet, error)
+
+	// GetHallOfFamePeriods retrieves the wins of a group summarized per month or year like
+	// AggregateWinners, the most recent period first
+	GetHallOfFamePeriods(ctx context.Context, groupID uuid.UUID, period HistoryPeriod) ([]*HallOfFamePeriod, error)
+
 	// DeleteByGroupAndDate deletes winners for a specific group and date (for recalculation)
 	DeleteByGroupAndDate(ctx context.Context, groupID uuid.UUID, date time.Time) error
 
 	// GetLatestWinners retrieves the most recent winners across all groups
 	GetLatestWinners(ctx context.Context, limit int) ([]*PetOfTheDayWinner, error)
+
+	// GetLatestGroupWinners retrieves the most recent winners of the given groups
+	GetLatestGroupWinners(ctx context.Context, groupIDs []uuid.UUID, limit int) ([]*PetOfTheDayWinner, error)
+}
+
+// DailyResetStateRepository defines the interface for tracking per-group daily reset progress
+type DailyResetStateRepository interface {
+	// GetByGroup retrieves the reset state for a group (nil if the group was never reset)
+	GetByGroup(ctx context.Context, groupID uuid.UUID) (*GroupResetState, error)
+
+	// Save creates or updates the reset state for a group
+	Save(ctx context.Context, state *GroupResetState) error
+}
+
+// SeasonRepository defines the interface for season and league data access
+type SeasonRepository interface {
+	// Create creates a new season
+	Create(ctx context.Context, season *Season) error
+
+	// GetByID retrieves a season by ID (returns nil if not found)
+	GetByID(ctx context.Context, id uuid.UUID) (*Season, error)
+
+	// GetByGroup retrieves all seasons of a group, most recent first
+	GetByGroup(ctx context.Context, groupID uuid.UUID) ([]*Season, error)
+
+	// Update updates an existing season
+	Update(ctx context.Context, season *Season) error
+
+	// CloseSeason saves a closed season with its final standings in one transaction. The group's
+	// league moves to the next tiers of the standings; pets without a standing leave the league.
+	CloseSeason(ctx context.Context, season *Season, standings []*SeasonStanding) error
+
+	// GetFinalStandings retrieves the archived standings of a closed season
+	GetFinalStandings(ctx context.Context, seasonID uuid.UUID) ([]*SeasonStanding, error)
+
+	// GetLeagueTiers retrieves the current tier of every pet placed in a group's league
+	GetLeagueTiers(ctx context.Context, groupID uuid.UUID) (map[uuid.UUID]LeagueTier, error)
+
+	// SaveLeagueTiers creates or updates the tiers of pets in a group's league
+	SaveLeagueTiers(ctx context.Context, groupID uuid.UUID, tiers map[uuid.UUID]LeagueTier) error
+}
+
+// StreakRepository defines the interface for pet streak data access
+type StreakRepository interface {
+	// GetByPet retrieves all streaks of a pet
+	GetByPet(ctx context.Context, petID uuid.UUID) ([]*PetStreak, error)
+
+	// ReplaceForPet replaces all streaks of a pet with freshly computed ones
+	ReplaceForPet(ctx context.Context, petID uuid.UUID, streaks []*PetStreak) error
+}
+
+// BadgeRepository defines the interface for awarded badge data access
+type BadgeRepository interface {
+	// Award saves a badge unless its holder already has a badge with the same code.
+	// Returns false when the badge had already been awarded.
+	Award(ctx context.Context, badge *Badge) (bool, error)
+
+	// GetByPet retrieves the badges awarded to a pet, oldest first
+	GetByPet(ctx context.Context, petID uuid.UUID) ([]*Badge, error)
+
+	// GetByUser retrieves the badges awarded to a user, oldest first
+	GetByUser(ctx context.Context, userID uuid.UUID) ([]*Badge, error)
+}
+
+// ChallengeRepository defines the interface for group challenge data access
+type ChallengeRepository interface {
+	// Create creates a new challenge
+	Create(ctx context.Context, challenge *Challenge) error
+
+	// GetByID retrieves a challenge by ID, nil if it does not exist
+	GetByID(ctx context.Context, id uuid.UUID) (*Challenge, error)
+
+	// GetByGroup retrieves the challenges of a group, latest deadline first
+	GetByGroup(ctx context.Context, groupID uuid.UUID) ([]*Challenge, error)
+
+	// GetDue retrieves the active challenges whose deadline is not after the given time
+	GetDue(ctx context.Context, now time.Time) ([]*Challenge, error)
+
+	// Update updates an existing challenge
+	Update(ctx context.Context, challenge *Challenge) error
+
+	// Delete deletes a challenge and the progress of its pets
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// GetProgress retrieves the progress of every pet taking part in a challenge
+	GetProgress(ctx context.Context, challengeID uuid.UUID) ([]*ChallengeProgress, error)
+
+	// SaveProgress creates or replaces the progress of a pet in a challenge
+	SaveProgress(ctx context.Context, progress *ChallengeProgress) error
+}
+
+// PointAdjustmentRepository defines the interface for point ledger data access.
+// The ledger is append-only: entries are never updated or deleted.
+type PointAdjustmentRepository interface {
+	// Create records a new ledger entry
+	Create(ctx context.Context, adjustment *PointAdjustment) error
+
+	// GetByID retrieves a ledger entry by ID, nil if it does not exist
+	GetByID(ctx context.Context, id uuid.UUID) (*PointAdjustment, error)
+
+	// Find retrieves ledger entries based on filter criteria, most recent first
+	Find(ctx context.Context, filter *PointAdjustmentFilter) ([]*PointAdjustment, error)
+
+	// GetReversal retrieves the entry reversing an adjustment, nil if it was not reversed
+	GetReversal(ctx context.Context, adjustmentID uuid.UUID) (*PointAdjustment, error)
+}
+
+// VerificationPolicyRepository defines the interface for group verification policy data access
+type VerificationPolicyRepository interface {
+	// GetByGroup retrieves the policy of a group, the default policy if none was saved
+	GetByGroup(ctx context.Context, groupID uuid.UUID) (*VerificationPolicy, error)
+
+	// Save creates or replaces the policy of a group
+	Save(ctx context.Context, policy *VerificationPolicy) error
+}
+
+// BackfillPolicyRepository defines the interface for group backfill policy data access
+type BackfillPolicyRepository interface {
+	// GetByGroup retrieves the policy of a group, the default policy if none was saved
+	GetByGroup(ctx context.Context, groupID uuid.UUID) (*BackfillPolicy, error)
+
+	// Save creates or replaces the policy of a group
+	Save(ctx context.Context, policy *BackfillPolicy) error
+}
+
+// ScoringRulesRepository defines the interface for group scoring rules data access
+type ScoringRulesRepository interface {
+	// GetByGroup retrieves the rules of a group, the default rules if none were saved
+	GetByGroup(ctx context.Context, groupID uuid.UUID) (*ScoringRules, error)
+
+	// Save creates or replaces the rules of a group
+	Save(ctx context.Context, rules *ScoringRules) error
+}
+
+// ShareCardSettingsRepository defines the interface for group share card settings data access
+type ShareCardSettingsRepository interface {
+	// GetByGroup retrieves the settings of a group, the default settings if none were saved
+	GetByGroup(ctx context.Context, groupID uuid.UUID) (*ShareCardSettings, error)
+
+	// Save creates or replaces the settings of a group
+	Save(ctx context.Context, settings *ShareCardSettings) error
+}
+
+// PetAnalyticsRepository defines the interface for pet analytics. Aggregates are computed by the
+// database so that they stay cheap over the whole retention window.
+type PetAnalyticsRepository interface {
+	// GetHourlyHeatmap counts the logs of a pet per weekday and hour in the owner's timezone
+	GetHourlyHeatmap(ctx context.Context, filter *PetAnalyticsFilter) ([]*HeatmapCell, error)
+
+	// GetCategoryMix counts the logs of a pet per behavior category and period
+	GetCategoryMix(ctx context.Context, filter *PetAnalyticsFilter, interval AnalyticsInterval) ([]*CategoryMixBucket, error)
+
+	// GetDailyBehaviorTotals counts the good and bad logs of a pet per day in the owner's timezone
+	GetDailyBehaviorTotals(ctx context.Context, filter *PetAnalyticsFilter) ([]*DailyBehaviorTotals, error)
+
+	// GetDailyScoreTotals retrieves the daily scores of a pet, averaged over its groups unless
+	// the filter selects one
+	GetDailyScoreTotals(ctx context.Context, filter *PetAnalyticsFilter) ([]*DailyScoreTotal, error)
+}
+
+// ArchivedDailyScoreRepository defines the interface for the summaries of days whose logs were purged
+type ArchivedDailyScoreRepository interface {
+	// CreateBatch saves archived daily scores, all of them or none
+	CreateBatch(ctx context.Context, archived []*ArchivedDailyScore) error
+
+	// GetByPetGroupAndDate retrieves the summary of a pet's day in a group, nil if the day is not archived
+	GetByPetGroupAndDate(ctx context.Context, petID, groupID uuid.UUID, date time.Time) (*ArchivedDailyScore, error)
+}
+
+// RankingReadModelRepository defines the interface for the rankings read model: daily scores
+// joined with the names of their pets and owners
+type RankingReadModelRepository interface {
+	// GetGroupDay retrieves the entries of a group day in one query, highest points first. Pets
+	// that no longer exist are left out.
+	GetGroupDay(ctx context.Context, groupID uuid.UUID, date time.Time) ([]*RankingEntry, error)
+
+	// GetPetDay retrieves the entry of a pet on a group day, nil if the pet has no score that day
+	GetPetDay(ctx context.Context, petID, groupID uuid.UUID, date time.Time) (*RankingEntry, error)
+}
+
+// VotingPolicyRepository defines the interface for group voting policy data access
+type VotingPolicyRepository interface {
+	// GetByGroup retrieves the policy of a group, the default policy if none was saved
+	GetByGroup(ctx context.Context, groupID uuid.UUID) (*VotingPolicy, error)
+
+	// Save creates or replaces the policy of a group
+	Save(ctx context.Context, policy *VotingPolicy) error
+}
+
+// PetOfTheDayVoteRepository defines the interface for Pet of the Day votes data access
+type PetOfTheDayVoteRepository interface {
+	// Save records a vote, replacing the vote of the same member for the same group day
+	Save(ctx context.Context, vote *PetOfTheDayVote) error
+
+	// GetByGroupAndDate retrieves the votes of a group day
+	GetByGroupAndDate(ctx context.Context, groupID uuid.UUID, date time.Time) ([]*PetOfTheDayVote, error)
+
+	// GetByVoter retrieves the vote of a member for a group day, nil if the member did not vote
+	GetByVoter(ctx context.Context, groupID uuid.UUID, date time.Time, voterID uuid.UUID) (*PetOfTheDayVote, error)
+}
+
+// LateCorrectionRepository defines the interface for the late corrections of closed days
+type LateCorrectionRepository interface {
+	// Create records a new late correction
+	Create(ctx context.Context, correction *LateCorrection) error
+
+	// GetByGroup retrieves the late corrections of a group, most recent first
+	GetByGroup(ctx context.Context, groupID uuid.UUID, limit, offset int) ([]*LateCorrection, error)
+}
+
+// AnomalyFlagRepository defines the interface for the moderation queue of anomaly flags
+type AnomalyFlagRepository interface {
+	// Create records a new flag
+	Create(ctx context.Context, flag *AnomalyFlag) error
+
+	// GetByID retrieves a flag by ID, nil if it does not exist
+	GetByID(ctx context.Context, id uuid.UUID) (*AnomalyFlag, error)
+
+	// Find retrieves flags based on filter criteria, most recent first
+	Find(ctx context.Context, filter *AnomalyFlagFilter) ([]*AnomalyFlag, error)
+
+	// Update updates the moderation state of a flag
+	Update(ctx context.Context, flag *AnomalyFlag) error
+}
+
+// BehaviorLogAttachmentRepository defines the interface for behavior log attachment data access
+type BehaviorLogAttachmentRepository interface {
+	// Create records a new attachment
+	Create(ctx context.Context, attachment *BehaviorLogAttachment) error
+
+	// GetByID retrieves an attachment by ID, nil if it does not exist
+	GetByID(ctx context.Context, id uuid.UUID) (*BehaviorLogAttachment, error)
+
+	// GetByBehaviorLog retrieves the attachments of a behavior log, oldest first
+	GetByBehaviorLog(ctx context.Context, behaviorLogID uuid.UUID) ([]*BehaviorLogAttachment, error)
+
+	// Delete deletes an attachment
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// CommentRepository defines the interface for behavior log comment data access
+type CommentRepository interface {
+	// Create creates a new comment
+	Create(ctx context.Context, comment *Comment) error
+
+	// GetByID retrieves a comment by ID, nil if it does not exist
+	GetByID(ctx context.Context, id uuid.UUID) (*Comment, error)
+
+	// Find retrieves comments based on filter criteria, oldest first
+	Find(ctx context.Context, filter *CommentFilter) ([]*Comment, error)
+
+	// Count returns the number of comments matching the filter, ignoring pagination
+	Count(ctx context.Context, filter *CommentFilter) (int, error)
+
+	// Update updates an existing comment
+	Update(ctx context.Context, comment *Comment) error
+}
+
+// ReactionRepository defines the interface for behavior log reaction data access
+type ReactionRepository interface {
+	// Add records a reaction, doing nothing if the user already reacted with the same emoji
+	Add(ctx context.Context, reaction *Reaction) error
+
+	// Remove deletes the reaction of a user with an emoji, doing nothing if there is none
+	Remove(ctx context.Context, behaviorLogID, groupID, userID uuid.UUID, emoji string) error
+
+	// GetByBehaviorLog retrieves the reactions on a behavior log in a group
+	GetByBehaviorLog(ctx context.Context, behaviorLogID, groupID uuid.UUID) ([]*Reaction, error)
 }
 
 // UserSettingsRepository defines the interface for user settings data access
@@ -147,6 +484,11 @@ type GroupMembershipChecker interface {
 	IsGroupMember(ctx context.Context, userID, groupID uuid.UUID) (bool, error)
 }
 
+// AdminChecker determines whether a user may administer the global behavior catalog
+type AdminChecker interface {
+	IsAdmin(ctx context.Context, userID uuid.UUID) (bool, error)
+}
+
 type ScoreEventOwnerChecker interface {
 	IsScoreEventOwner(ctx context.Context, userID, eventID uuid.UUID) (bool, error)
 }
@@ -168,6 +510,15 @@ type AuthorizationRepository interface {
 	// GetUserGroups retrieves all groups a user is a member of
 	GetUserGroups(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
 
+	// GetPetGroups retrieves all groups a pet is a member of
+	GetPetGroups(ctx context.Context, petID uuid.UUID) ([]uuid.UUID, error)
+
+	// GetAllGroups retrieves the IDs of every group (used by scheduled jobs)
+	GetAllGroups(ctx context.Context) ([]uuid.UUID, error)
+
+	// GetAllUsers retrieves the IDs of every user (used by backfill jobs)
+	GetAllUsers(ctx context.Context) ([]uuid.UUID, error)
+
 	// GetPetInfo retrieves basic pet information (name, species)
 	GetPetInfo(ctx context.Context, petID uuid.UUID) (*PetInfo, error)
 
@@ -191,10 +542,11 @@ type GroupPetOfTheDayStats struct {
 
 // PetInfo represents basic pet information from the pet bounded context
 type PetInfo struct {
-	ID      uuid.UUID
-	Name    string
-	Species Species
-	OwnerID uuid.UUID
+	ID       uuid.UUID
+	Name     string
+	Species  Species
+	OwnerID  uuid.UUID
+	PhotoURL string
 }
 
 // GroupInfo represents basic group information from the community bounded context
@@ -229,6 +581,26 @@ func NewUserTimezoneSettings(userID uuid.UUID) *UserTimezoneSettings {
 	}
 }
 
+// GetGroupTimezoneSettings returns the timezone settings a group follows, those of its owner.
+// Owners without settings use the defaults.
+func GetGroupTimezoneSettings(
+	ctx context.Context,
+	authRepo AuthorizationRepository,
+	userSettingsRepo UserSettingsRepository,
+	groupID uuid.UUID,
+) (*UserTimezoneSettings, error) {
+	groupInfo, err := authRepo.GetGroupInfo(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group info: %w", err)
+	}
+
+	settings, err := userSettingsRepo.GetUserTimezone(ctx, groupInfo.OwnerID)
+	if err != nil || settings == nil {
+		settings = NewUserTimezoneSettings(groupInfo.OwnerID)
+	}
+	return settings, nil
+}
+
 // Validate validates the timezone settings
 func (uts *UserTimezoneSettings) Validate() error {
 	// Validate timezone
@@ -248,6 +620,7 @@ func (uts *UserTimezoneSettings) Validate() error {
 type RepositoryFactory interface {
 	// Existing repositories
 	NewBehaviorRepository() BehaviorRepository
+	NewGroupBehaviorRepository() GroupBehaviorRepository
 	NewScoreEventRepository() ScoreEventRepository
 
 	// New behavior logging repositories
@@ -256,4 +629,24 @@ type RepositoryFactory interface {
 	NewPetOfTheDayRepository() PetOfTheDayRepository
 	NewAuthorizationRepository() AuthorizationRepository
 	NewUserSettingsRepository() UserSettingsRepository
+	NewDailyResetStateRepository() DailyResetStateRepository
+	NewSeasonRepository() SeasonRepository
+	NewStreakRepository() StreakRepository
+	NewBadgeRepository() BadgeRepository
+	NewChallengeRepository() ChallengeRepository
+	NewPointAdjustmentRepository() PointAdjustmentRepository
+	NewVerificationPolicyRepository() VerificationPolicyRepository
+	NewAnomalyFlagRepository() AnomalyFlagRepository
+	NewBehaviorLogAttachmentRepository() BehaviorLogAttachmentRepository
+	NewCommentRepository() CommentRepository
+	NewReactionRepository() ReactionRepository
+	NewBackfillPolicyRepository() BackfillPolicyRepository
+	NewLateCorrectionRepository() LateCorrectionRepository
+	NewScoringRulesRepository() ScoringRulesRepository
+	NewVotingPolicyRepository() VotingPolicyRepository
+	NewPetOfTheDayVoteRepository() PetOfTheDayVoteRepository
+	NewShareCardSettingsRepository() ShareCardSettingsRepository
+	NewPetAnalyticsRepository() PetAnalyticsRepository
+	NewArchivedDailyScoreRepository() ArchivedDailyScoreRepository
+	NewRankingReadModelRepository() RankingReadModelRepository
 }
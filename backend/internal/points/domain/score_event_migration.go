@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// NewBehaviorLogFromScoreEvent converts a legacy score event into a behavior log counted in the
+// event's group. The log keeps the ID of the event, so an event is never converted twice and
+// legacy clients can keep using the IDs they know. Unlike NewBehaviorLog, events of any age are
+// accepted since they were already scored.
+func NewBehaviorLogFromScoreEvent(event ScoreEvent) (*BehaviorLog, error) {
+	if event.ID == uuid.Nil {
+		return nil, fmt.Errorf("score event ID is required")
+	}
+	if event.PetID == uuid.Nil {
+		return nil, fmt.Errorf("pet ID is required")
+	}
+	if event.BehaviorID == uuid.Nil {
+		return nil, fmt.Errorf("behavior ID is required")
+	}
+	if event.RecordedByID == uuid.Nil {
+		return nil, fmt.Errorf("user ID is required")
+	}
+	if event.ActionDate.IsZero() {
+		return nil, fmt.Errorf("action date is required")
+	}
+	if err := validateNotes(event.Comment); err != nil {
+		return nil, err
+	}
+
+	createdAt := event.RecordedAt
+	if createdAt.IsZero() {
+		createdAt = event.ActionDate
+	}
+
+	behaviorLog := &BehaviorLog{
+		ID:            event.ID,
+		PetID:         event.PetID,
+		BehaviorID:    event.BehaviorID,
+		UserID:        event.RecordedByID,
+		PointsAwarded: event.Points,
+		LoggedAt:      event.ActionDate,
+		CreatedAt:     createdAt,
+		Notes:         event.Comment,
+		GroupShares:   make([]BehaviorLogGroupShare, 0, 1),
+	}
+	if err := behaviorLog.AddGroupShareWithPoints(event.GroupID, event.Points); err != nil {
+		return nil, err
+	}
+	behaviorLog.GroupShares[0].CreatedAt = createdAt
+
+	return behaviorLog, nil
+}
+
+// NewScoreEventFromBehaviorLog presents a behavior log as the legacy score event of one of its groups
+func NewScoreEventFromBehaviorLog(behaviorLog *BehaviorLog, groupID uuid.UUID) ScoreEvent {
+	return ScoreEvent{
+		ID:           behaviorLog.ID,
+		PetID:        behaviorLog.PetID,
+		BehaviorID:   behaviorLog.BehaviorID,
+		GroupID:      groupID,
+		RecordedByID: behaviorLog.UserID,
+		Points:       behaviorLog.PointsForGroup(groupID),
+		Comment:      behaviorLog.Notes,
+		ActionDate:   behaviorLog.LoggedAt,
+		RecordedAt:   behaviorLog.CreatedAt,
+	}
+}
@@ -0,0 +1,293 @@
+package domain
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TieBreaker names a rule that decides between pets with the same points
+type TieBreaker string
+
+const (
+	// TieBreakerFewestNegatives ranks the pet with fewer negative behaviors first
+	TieBreakerFewestNegatives TieBreaker = "fewest_negatives"
+	// TieBreakerMostPositives ranks the pet with more positive behaviors first
+	TieBreakerMostPositives TieBreaker = "most_positives"
+	// TieBreakerEarliestToScore ranks first the pet that reached its final score first, that is
+	// whose last counted behavior was logged earliest
+	TieBreakerEarliestToScore TieBreaker = "earliest_to_score"
+)
+
+// ScoringMode decides which points pets are ranked by, so that pets whose owners log a lot do
+// not dominate the rankings of a group
+type ScoringMode string
+
+const (
+	// ScoringModeRaw ranks pets by the points they earned
+	ScoringModeRaw ScoringMode = "raw"
+	// ScoringModePerBehavior ranks pets by the points earned per logged behavior each day
+	ScoringModePerBehavior ScoringMode = "per_behavior"
+	// ScoringModeCategoryCap caps the points a pet earns in each behavior category each day
+	ScoringModeCategoryCap ScoringMode = "category_cap"
+	// ScoringModeDiminishingReturns halves the value of each log of the same behavior past a
+	// number of logs per day
+	ScoringModeDiminishingReturns ScoringMode = "diminishing_returns"
+)
+
+// IsValidScoringMode checks if a scoring mode is known
+func IsValidScoringMode(mode ScoringMode) bool {
+	switch mode {
+	case ScoringModeRaw, ScoringModePerBehavior, ScoringModeCategoryCap, ScoringModeDiminishingReturns:
+		return true
+	default:
+		return false
+	}
+}
+
+// RankingComparator compares two rankings on one criterion.
+// Returns positive if a should rank higher, negative if b should rank higher, 0 if equal.
+type RankingComparator func(a, b *PetRanking) int
+
+// rankingComparators holds the strategy behind each tie breaker
+var rankingComparators = map[TieBreaker]RankingComparator{
+	TieBreakerFewestNegatives: func(a, b *PetRanking) int {
+		return b.NegativeBehaviors - a.NegativeBehaviors
+	},
+	TieBreakerMostPositives: func(a, b *PetRanking) int {
+		return a.PositiveBehaviors - b.PositiveBehaviors
+	},
+	TieBreakerEarliestToScore: compareEarliestActivity,
+}
+
+// standardTieBreakers are the tie breakers of groups that never changed their scoring rules
+var standardTieBreakers = []TieBreaker{TieBreakerFewestNegatives}
+
+// IsValidTieBreaker checks if a tie breaker is known
+func IsValidTieBreaker(tieBreaker TieBreaker) bool {
+	_, exists := rankingComparators[tieBreaker]
+	return exists
+}
+
+// ScoringRules is the ranking configuration of a group. Pets are ranked by the points of the
+// scoring mode, then by each tie breaker in order. Pets still tied share their rank, and the
+// Pet of the Day title, unless the group turned shared titles off.
+type ScoringRules struct {
+	GroupID          uuid.UUID    `json:"group_id"`
+	Mode             ScoringMode  `json:"mode"`
+	CategoryCap      int          `json:"category_cap,omitempty"`      // Daily points per category in the category cap mode
+	DiminishingAfter int          `json:"diminishing_after,omitempty"` // Daily logs of a behavior at full value in the diminishing returns mode
+	TieBreakers      []TieBreaker `json:"tie_breakers"`
+	SharedTitles     bool         `json:"shared_titles"`
+	UpdatedBy        *uuid.UUID   `json:"updated_by,omitempty"`
+	UpdatedAt        time.Time    `json:"updated_at"`
+}
+
+// DefaultScoringRules returns the rules of groups that never changed them
+func DefaultScoringRules(groupID uuid.UUID) *ScoringRules {
+	return &ScoringRules{
+		GroupID:      groupID,
+		Mode:         ScoringModeRaw,
+		TieBreakers:  standardTieBreakers,
+		SharedTitles: true,
+	}
+}
+
+// NewScoringRules creates scoring rules with validation
+func NewScoringRules(
+	groupID, updatedBy uuid.UUID,
+	mode ScoringMode,
+	categoryCap, diminishingAfter int,
+	tieBreakers []TieBreaker,
+	sharedTitles bool,
+) (*ScoringRules, error) {
+	if !IsValidScoringMode(mode) {
+		return nil, fmt.Errorf("invalid scoring mode: %s", mode)
+	}
+	if categoryCap < 0 || diminishingAfter < 0 {
+		return nil, fmt.Errorf("category cap and diminishing returns threshold cannot be negative")
+	}
+	if mode == ScoringModeCategoryCap && categoryCap == 0 {
+		return nil, fmt.Errorf("category cap mode requires a category cap")
+	}
+	if mode == ScoringModeDiminishingReturns && diminishingAfter == 0 {
+		return nil, fmt.Errorf("diminishing returns mode requires the number of logs at full value")
+	}
+
+	seen := make(map[TieBreaker]bool, len(tieBreakers))
+	for _, tieBreaker := range tieBreakers {
+		if !IsValidTieBreaker(tieBreaker) {
+			return nil, fmt.Errorf("invalid tie breaker: %s", tieBreaker)
+		}
+		if seen[tieBreaker] {
+			return nil, fmt.Errorf("tie breaker %s is listed twice", tieBreaker)
+		}
+		seen[tieBreaker] = true
+	}
+
+	return &ScoringRules{
+		GroupID:          groupID,
+		Mode:             mode,
+		CategoryCap:      categoryCap,
+		DiminishingAfter: diminishingAfter,
+		TieBreakers:      append([]TieBreaker{}, tieBreakers...),
+		SharedTitles:     sharedTitles,
+		UpdatedBy:        &updatedBy,
+		UpdatedAt:        time.Now(),
+	}, nil
+}
+
+// NeedsBreakdown returns true if the scoring mode needs the behaviors logged each day
+func (r *ScoringRules) NeedsBreakdown() bool {
+	return r.Mode == ScoringModeCategoryCap || r.Mode == ScoringModeDiminishingReturns
+}
+
+// NormalizeDay returns the points a daily score is worth under the scoring mode. The breakdown
+// of the day is only used by modes that need it. Point adjustments are never normalized.
+func (r *ScoringRules) NormalizeDay(dailyScore *DailyScore, breakdown []*DailyScoreBreakdown) float64 {
+	adjustments := float64(dailyScore.AdjustmentPoints())
+
+	switch r.Mode {
+	case ScoringModePerBehavior:
+		logs := dailyScore.PositiveBehaviors + dailyScore.NegativeBehaviors
+		if logs == 0 {
+			return adjustments
+		}
+		return float64(dailyScore.BehaviorPointTotal)/float64(logs) + adjustments
+
+	case ScoringModeCategoryCap:
+		categoryPoints := make(map[BehaviorCategory]int)
+		for _, entry := range breakdown {
+			categoryPoints[entry.BehaviorCategory] += entry.TotalPoints
+		}
+
+		points := 0
+		for _, categoryTotal := range categoryPoints {
+			if categoryTotal > r.CategoryCap {
+				categoryTotal = r.CategoryCap
+			}
+			points += categoryTotal
+		}
+		return float64(points) + adjustments
+
+	case ScoringModeDiminishingReturns:
+		points := 0.0
+		for _, entry := range breakdown {
+			if entry.Count == 0 {
+				continue
+			}
+
+			pointsPerLog := float64(entry.TotalPoints) / float64(entry.Count)
+			weight := 1.0
+			for i := 0; i < entry.Count; i++ {
+				if i >= r.DiminishingAfter {
+					weight /= 2
+				}
+				points += pointsPerLog * weight
+			}
+		}
+		return points + adjustments
+
+	default:
+		return float64(dailyScore.TotalPoints)
+	}
+}
+
+// Compare compares two rankings with the rules.
+// Returns positive if a should rank higher, negative if b should rank higher, 0 if tied.
+func (r *ScoringRules) Compare(a, b *PetRanking) int {
+	if result := compareWith(a, b, r.TieBreakers); result != 0 || r.SharedTitles {
+		return result
+	}
+
+	// Without shared titles every pet gets its own rank: the earliest to score goes first,
+	// and the pet ID keeps the order stable when a day is ranked again
+	if result := compareEarliestActivity(a, b); result != 0 {
+		return result
+	}
+	return bytes.Compare(b.PetID[:], a.PetID[:])
+}
+
+// CompareScores compares two daily scores with the rules
+func (r *ScoringRules) CompareScores(a, b *DailyScore) int {
+	return r.Compare(rankingFromScore(a), rankingFromScore(b))
+}
+
+// AssignRanks sorts rankings with the rules and assigns rank positions.
+// Tied pets share a rank and the next rank skips the tied positions.
+func (r *ScoringRules) AssignRanks(rankings []*PetRanking) []*PetRanking {
+	sort.SliceStable(rankings, func(i, j int) bool {
+		return r.Compare(rankings[i], rankings[j]) > 0
+	})
+
+	currentRank := 1
+	for i, ranking := range rankings {
+		if i == 0 {
+			ranking.SetRank(currentRank, false)
+			continue
+		}
+
+		prevRanking := rankings[i-1]
+		if r.Compare(ranking, prevRanking) == 0 {
+			ranking.SetRank(currentRank, true)
+			prevRanking.SetRank(currentRank, true)
+		} else {
+			currentRank = i + 1
+			ranking.SetRank(currentRank, false)
+		}
+	}
+
+	return rankings
+}
+
+// compareWith compares two rankings by points, then by the given tie breakers in order
+func compareWith(a, b *PetRanking, tieBreakers []TieBreaker) int {
+	aPoints, bPoints := a.RankingPoints(), b.RankingPoints()
+	if aPoints != bPoints {
+		if aPoints > bPoints {
+			return 1
+		}
+		return -1
+	}
+
+	for _, tieBreaker := range tieBreakers {
+		compare, exists := rankingComparators[tieBreaker]
+		if !exists {
+			continue
+		}
+		if result := compare(a, b); result != 0 {
+			return result
+		}
+	}
+
+	return 0
+}
+
+// compareEarliestActivity ranks the pet whose last activity came first higher.
+// Pets without activity, scoring only through adjustments, come last.
+func compareEarliestActivity(a, b *PetRanking) int {
+	switch {
+	case a.LastActivityAt == nil && b.LastActivityAt == nil:
+		return 0
+	case a.LastActivityAt == nil:
+		return -1
+	case b.LastActivityAt == nil:
+		return 1
+	case a.LastActivityAt.Before(*b.LastActivityAt):
+		return 1
+	case b.LastActivityAt.Before(*a.LastActivityAt):
+		return -1
+	default:
+		return 0
+	}
+}
+
+// rankingFromScore creates the ranking of a single daily score
+func rankingFromScore(dailyScore *DailyScore) *PetRanking {
+	ranking := NewPetRanking(dailyScore.PetID, "", "")
+	ranking.UpdateFromDailyScore(dailyScore)
+	return ranking
+}
@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewScoringRules(t *testing.T) {
+	groupID, userID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name             string
+		mode             ScoringMode
+		categoryCap      int
+		diminishingAfter int
+		tieBreakers      []TieBreaker
+		wantErr          bool
+	}{
+		{"No tie breakers", ScoringModeRaw, 0, 0, nil, false},
+		{"Several tie breakers", ScoringModeRaw, 0, 0, []TieBreaker{TieBreakerMostPositives, TieBreakerEarliestToScore}, false},
+		{"Unknown tie breaker", ScoringModeRaw, 0, 0, []TieBreaker{"coin_flip"}, true},
+		{"Duplicate tie breaker", ScoringModeRaw, 0, 0, []TieBreaker{TieBreakerMostPositives, TieBreakerMostPositives}, true},
+		{"Unknown mode", "loudest", 0, 0, nil, true},
+		{"Category cap", ScoringModeCategoryCap, 15, 0, nil, false},
+		{"Category cap without cap", ScoringModeCategoryCap, 0, 0, nil, true},
+		{"Diminishing returns without threshold", ScoringModeDiminishingReturns, 0, 0, nil, true},
+		{"Negative cap", ScoringModePerBehavior, -1, 0, nil, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewScoringRules(groupID, userID, test.mode, test.categoryCap, test.diminishingAfter, test.tieBreakers, true)
+			if (err != nil) != test.wantErr {
+				t.Errorf("Expected error %v, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestScoringRules_AssignRanks(t *testing.T) {
+	base := time.Date(2025, time.March, 10, 8, 0, 0, 0, time.UTC)
+	newRanking := func(points, positives, negatives int, lastActivityHour int) *PetRanking {
+		ranking := NewPetRanking(uuid.New(), "Pet", "Owner")
+		ranking.TotalPoints = points
+		ranking.PositiveBehaviors = positives
+		ranking.NegativeBehaviors = negatives
+		lastActivityAt := base.Add(time.Duration(lastActivityHour) * time.Hour)
+		ranking.LastActivityAt = &lastActivityAt
+		return ranking
+	}
+
+	// Same points: early has more negatives, busy has more positives and finished last
+	early := newRanking(10, 3, 1, 1)
+	busy := newRanking(10, 4, 0, 5)
+	steady := newRanking(10, 3, 0, 3)
+
+	tests := []struct {
+		name     string
+		rules    *ScoringRules
+		expected []*PetRanking
+		ranks    []int
+	}{
+		{"Default rules", DefaultScoringRules(uuid.Nil), []*PetRanking{busy, steady, early}, []int{1, 1, 3}},
+		{"Most positives", &ScoringRules{TieBreakers: []TieBreaker{TieBreakerMostPositives}, SharedTitles: true}, []*PetRanking{busy, early, steady}, []int{1, 2, 2}},
+		{"Earliest to score", &ScoringRules{TieBreakers: []TieBreaker{TieBreakerEarliestToScore}, SharedTitles: true}, []*PetRanking{early, steady, busy}, []int{1, 2, 3}},
+		{"No shared titles", &ScoringRules{TieBreakers: []TieBreaker{TieBreakerFewestNegatives}}, []*PetRanking{steady, busy, early}, []int{1, 2, 3}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rankings := test.rules.AssignRanks([]*PetRanking{early, busy, steady})
+
+			for i, want := range test.expected {
+				if rankings[i] != want || rankings[i].Rank != test.ranks[i] {
+					t.Errorf("Position %d: expected rank %d, got rank %d", i, test.ranks[i], rankings[i].Rank)
+				}
+			}
+		})
+	}
+}
+
+func TestScoringRules_NormalizeDay(t *testing.T) {
+	// 30 behavior points over 5 logs and a 5 point bonus
+	dailyScore := &DailyScore{TotalPoints: 35, BehaviorPointTotal: 30, PositiveBehaviors: 5}
+	breakdown := []*DailyScoreBreakdown{
+		{BehaviorCategory: BehaviorCategoryPottyTraining, Count: 4, TotalPoints: 24},
+		{BehaviorCategory: BehaviorCategoryPlay, Count: 1, TotalPoints: 6},
+	}
+
+	tests := []struct {
+		name     string
+		rules    *ScoringRules
+		expected float64
+	}{
+		{"Raw", &ScoringRules{Mode: ScoringModeRaw}, 35},
+		{"Per behavior", &ScoringRules{Mode: ScoringModePerBehavior}, 6 + 5},
+		{"Category cap", &ScoringRules{Mode: ScoringModeCategoryCap, CategoryCap: 10}, 10 + 6 + 5},
+		// The third and fourth potty logs are worth 3 and 1.5 points
+		{"Diminishing returns", &ScoringRules{Mode: ScoringModeDiminishingReturns, DiminishingAfter: 2}, 6 + 6 + 3 + 1.5 + 6 + 5},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if points := test.rules.NormalizeDay(dailyScore, breakdown); points != test.expected {
+				t.Errorf("Expected %v points, got %v", test.expected, points)
+			}
+		})
+	}
+}
+
+func TestDailyScore_IsWinningScore(t *testing.T) {
+	newScore := func(points, negatives int) *DailyScore {
+		return &DailyScore{PetID: uuid.New(), TotalPoints: points, NegativeBehaviors: negatives}
+	}
+
+	if !newScore(10, 0).IsWinningScore(newScore(10, 1)) {
+		t.Error("Expected fewer negative behaviors to win a tie")
+	}
+	if newScore(5, 0).IsWinningScore(newScore(10, 2)) {
+		t.Error("Expected more points to win")
+	}
+	if !newScore(10, 1).IsTiedWith(newScore(10, 1)) {
+		t.Error("Expected equal scores to be tied")
+	}
+}
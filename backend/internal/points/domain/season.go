@@ -0,0 +1,294 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LeagueTier represents a division pets compete in during a season
+type LeagueTier string
+
+const (
+	LeagueTierBronze LeagueTier = "bronze"
+	LeagueTierSilver LeagueTier = "silver"
+	LeagueTierGold   LeagueTier = "gold"
+)
+
+// GetLeagueTiers returns all league tiers, lowest first
+func GetLeagueTiers() []LeagueTier {
+	return []LeagueTier{
+		LeagueTierBronze,
+		LeagueTierSilver,
+		LeagueTierGold,
+	}
+}
+
+// Level returns the position of the tier, 0 being the lowest (-1 if invalid)
+func (t LeagueTier) Level() int {
+	for i, tier := range GetLeagueTiers() {
+		if tier == t {
+			return i
+		}
+	}
+	return -1
+}
+
+// Above returns the next tier up, or the tier itself if it is the highest
+func (t LeagueTier) Above() LeagueTier {
+	tiers := GetLeagueTiers()
+	if level := t.Level(); level >= 0 && level < len(tiers)-1 {
+		return tiers[level+1]
+	}
+	return t
+}
+
+// Below returns the next tier down, or the tier itself if it is the lowest
+func (t LeagueTier) Below() LeagueTier {
+	if level := t.Level(); level > 0 {
+		return GetLeagueTiers()[level-1]
+	}
+	return t
+}
+
+// LeagueMovement describes what happens to a pet's tier at the end of a season
+type LeagueMovement string
+
+const (
+	LeagueMovementPromoted  LeagueMovement = "promoted"
+	LeagueMovementStayed    LeagueMovement = "stayed"
+	LeagueMovementRelegated LeagueMovement = "relegated"
+)
+
+// SeasonStatus represents the lifecycle of a season
+type SeasonStatus string
+
+const (
+	SeasonStatusActive SeasonStatus = "active"
+	SeasonStatusClosed SeasonStatus = "closed"
+)
+
+// MaxSeasonDays bounds the length of a season
+const MaxSeasonDays = 366
+
+// Season is a span of days over which the pets of a group accumulate points in their league tier
+type Season struct {
+	ID              uuid.UUID
+	GroupID         uuid.UUID
+	Name            string
+	StartDate       time.Time // First day of the season
+	EndDate         time.Time // Last day of the season (inclusive)
+	PromotionSlots  int       // Pets promoted from each tier except the highest
+	RelegationSlots int       // Pets relegated from each tier except the lowest
+	Status          SeasonStatus
+	CreatedBy       uuid.UUID
+	CreatedAt       time.Time
+	ClosedAt        *time.Time
+}
+
+// NewSeason creates a new season with validation
+func NewSeason(groupID, createdBy uuid.UUID, name string, startDate, endDate time.Time, promotionSlots, relegationSlots int) (*Season, error) {
+	if len(name) == 0 || len(name) > 100 {
+		return nil, fmt.Errorf("season name must be between 1 and 100 characters")
+	}
+
+	start := normalizeDate(startDate)
+	end := normalizeDate(endDate)
+	if end.Before(start) {
+		return nil, fmt.Errorf("season end date must not be before its start date")
+	}
+	if end.After(start.AddDate(0, 0, MaxSeasonDays-1)) {
+		return nil, fmt.Errorf("season cannot be longer than %d days", MaxSeasonDays)
+	}
+
+	if promotionSlots < 0 || relegationSlots < 0 {
+		return nil, fmt.Errorf("promotion and relegation slots cannot be negative")
+	}
+
+	return &Season{
+		ID:              uuid.New(),
+		GroupID:         groupID,
+		Name:            name,
+		StartDate:       start,
+		EndDate:         end,
+		PromotionSlots:  promotionSlots,
+		RelegationSlots: relegationSlots,
+		Status:          SeasonStatusActive,
+		CreatedBy:       createdBy,
+		CreatedAt:       time.Now(),
+	}, nil
+}
+
+// Contains checks if a day is part of the season
+func (s *Season) Contains(date time.Time) bool {
+	day := normalizeDate(date)
+	return !day.Before(s.StartDate) && !day.After(s.EndDate)
+}
+
+// Overlaps checks if two seasons share at least one day
+func (s *Season) Overlaps(other *Season) bool {
+	return !s.EndDate.Before(other.StartDate) && !other.EndDate.Before(s.StartDate)
+}
+
+// IsClosed returns true if the season results have been archived
+func (s *Season) IsClosed() bool {
+	return s.Status == SeasonStatusClosed
+}
+
+// IsDueForClose returns true if the given closed day is the season's last day or later
+func (s *Season) IsDueForClose(lastClosedDay time.Time) bool {
+	return !s.IsClosed() && !normalizeDate(lastClosedDay).Before(s.EndDate)
+}
+
+// Close marks the season as closed
+func (s *Season) Close(at time.Time) error {
+	if s.IsClosed() {
+		return fmt.Errorf("season is already closed")
+	}
+	s.Status = SeasonStatusClosed
+	s.ClosedAt = &at
+	return nil
+}
+
+// CurrentSeason picks the season shown as current on a given day: the open season containing
+// the day, otherwise an ended season still waiting to be closed by the daily reset.
+func CurrentSeason(seasons []*Season, date time.Time) *Season {
+	var pending *Season
+	for _, season := range seasons {
+		if season.IsClosed() {
+			continue
+		}
+
+		day := normalizeDate(date.In(season.StartDate.Location()))
+		if season.Contains(day) {
+			return season
+		}
+		if day.After(season.EndDate) && (pending == nil || season.EndDate.After(pending.EndDate)) {
+			pending = season
+		}
+	}
+	return pending
+}
+
+// SeasonStanding is a pet's position within its tier for a season.
+// Standings are computed live while a season runs and archived when it closes.
+type SeasonStanding struct {
+	ID                uuid.UUID
+	SeasonID          uuid.UUID
+	GroupID           uuid.UUID
+	PetID             uuid.UUID
+	PetName           string
+	OwnerName         string
+	Tier              LeagueTier
+	Rank              int // Position within the tier
+	IsTied            bool
+	Points            int
+	PositiveBehaviors int
+	NegativeBehaviors int
+	Movement          LeagueMovement
+	NextTier          LeagueTier
+	CreatedAt         time.Time
+}
+
+// ComputeSeasonStandings buckets season rankings into the pets' tiers, ranks each tier and
+// decides promotions and relegations. Pets without a tier start in the lowest tier, and pets
+// with a tier but no points this season are ranked with zero points. Tied pets move together,
+// so a tie across the promotion or relegation line keeps all of them in their tier.
+func ComputeSeasonStandings(season *Season, rankings []*PetRanking, tiers map[uuid.UUID]LeagueTier) []*SeasonStanding {
+	lowest := GetLeagueTiers()[0]
+
+	byTier := make(map[LeagueTier][]*PetRanking)
+	seen := make(map[uuid.UUID]bool, len(rankings))
+	for _, ranking := range rankings {
+		seen[ranking.PetID] = true
+		tier, exists := tiers[ranking.PetID]
+		if !exists || tier.Level() < 0 {
+			tier = lowest
+		}
+		byTier[tier] = append(byTier[tier], ranking)
+	}
+
+	// Sorted for a stable order among pets without points
+	inactive := make([]uuid.UUID, 0)
+	for petID := range tiers {
+		if !seen[petID] {
+			inactive = append(inactive, petID)
+		}
+	}
+	sort.Slice(inactive, func(i, j int) bool { return inactive[i].String() < inactive[j].String() })
+	for _, petID := range inactive {
+		tier := tiers[petID]
+		if tier.Level() < 0 {
+			tier = lowest
+		}
+		byTier[tier] = append(byTier[tier], NewPetRanking(petID, "", ""))
+	}
+
+	now := time.Now()
+	standings := make([]*SeasonStanding, 0, len(rankings)+len(inactive))
+
+	// Highest tier first
+	leagueTiers := GetLeagueTiers()
+	for level := len(leagueTiers) - 1; level >= 0; level-- {
+		tier := leagueTiers[level]
+		tierRankings := AssignRanks(byTier[tier])
+		size := len(tierRankings)
+
+		tied := make(map[int]int, size)
+		for _, ranking := range tierRankings {
+			tied[ranking.Rank]++
+		}
+
+		for _, ranking := range tierRankings {
+			// Position of the last pet sharing this rank
+			lastPosition := ranking.Rank + tied[ranking.Rank] - 1
+
+			movement := LeagueMovementStayed
+			switch {
+			case tier.Above() != tier && lastPosition <= season.PromotionSlots && ranking.TotalPoints > 0:
+				movement = LeagueMovementPromoted
+			case tier.Below() != tier && ranking.Rank > size-season.RelegationSlots:
+				movement = LeagueMovementRelegated
+			}
+
+			nextTier := tier
+			switch movement {
+			case LeagueMovementPromoted:
+				nextTier = tier.Above()
+			case LeagueMovementRelegated:
+				nextTier = tier.Below()
+			}
+
+			standings = append(standings, &SeasonStanding{
+				ID:                uuid.New(),
+				SeasonID:          season.ID,
+				GroupID:           season.GroupID,
+				PetID:             ranking.PetID,
+				PetName:           ranking.PetName,
+				OwnerName:         ranking.OwnerName,
+				Tier:              tier,
+				Rank:              ranking.Rank,
+				IsTied:            ranking.IsTied,
+				Points:            ranking.TotalPoints,
+				PositiveBehaviors: ranking.PositiveBehaviors,
+				NegativeBehaviors: ranking.NegativeBehaviors,
+				Movement:          movement,
+				NextTier:          nextTier,
+				CreatedAt:         now,
+			})
+		}
+	}
+
+	return standings
+}
+
+// NextLeagueTiers returns the tier each pet of the standings plays in next season
+func NextLeagueTiers(standings []*SeasonStanding) map[uuid.UUID]LeagueTier {
+	tiers := make(map[uuid.UUID]LeagueTier, len(standings))
+	for _, standing := range standings {
+		tiers[standing.PetID] = standing.NextTier
+	}
+	return tiers
+}
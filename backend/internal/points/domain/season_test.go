@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewSeason(t *testing.T) {
+	groupID, userID := uuid.New(), uuid.New()
+	start := time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC)
+
+	season, err := NewSeason(groupID, userID, "Spring", start, start.AddDate(0, 0, 30), 2, 1)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !season.StartDate.Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)) || season.Status != SeasonStatusActive {
+		t.Errorf("Expected active season starting at midnight, got %+v", season)
+	}
+
+	invalid := []struct {
+		name       string
+		seasonName string
+		end        time.Time
+		slots      int
+	}{
+		{"Empty name", "", start, 1},
+		{"End before start", "Spring", start.AddDate(0, 0, -1), 1},
+		{"Too long", "Spring", start.AddDate(0, 0, MaxSeasonDays), 1},
+		{"Negative slots", "Spring", start, -1},
+	}
+	for _, test := range invalid {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := NewSeason(groupID, userID, test.seasonName, start, test.end, test.slots, test.slots); err == nil {
+				t.Error("Expected validation error")
+			}
+		})
+	}
+}
+
+func TestComputeSeasonStandings(t *testing.T) {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	season, _ := NewSeason(uuid.New(), uuid.New(), "Spring", start, start.AddDate(0, 0, 27), 1, 1)
+
+	newRanking := func(points int) *PetRanking {
+		ranking := NewPetRanking(uuid.New(), "Pet", "Owner")
+		ranking.TotalPoints = points
+		return ranking
+	}
+
+	goldTop, goldLow := newRanking(30), newRanking(5)
+	silverTop, silverLow := newRanking(20), newRanking(1)
+	newcomer := newRanking(8)
+	idleGold := uuid.New()
+
+	tiers := map[uuid.UUID]LeagueTier{
+		goldTop.PetID:   LeagueTierGold,
+		goldLow.PetID:   LeagueTierGold,
+		idleGold:        LeagueTierGold,
+		silverTop.PetID: LeagueTierSilver,
+		silverLow.PetID: LeagueTierSilver,
+	}
+
+	standings := ComputeSeasonStandings(season, []*PetRanking{goldLow, silverLow, newcomer, goldTop, silverTop}, tiers)
+
+	expected := []struct {
+		petID    uuid.UUID
+		tier     LeagueTier
+		rank     int
+		movement LeagueMovement
+		next     LeagueTier
+	}{
+		{goldTop.PetID, LeagueTierGold, 1, LeagueMovementStayed, LeagueTierGold},
+		{goldLow.PetID, LeagueTierGold, 2, LeagueMovementStayed, LeagueTierGold},
+		{idleGold, LeagueTierGold, 3, LeagueMovementRelegated, LeagueTierSilver},
+		{silverTop.PetID, LeagueTierSilver, 1, LeagueMovementPromoted, LeagueTierGold},
+		{silverLow.PetID, LeagueTierSilver, 2, LeagueMovementRelegated, LeagueTierBronze},
+		{newcomer.PetID, LeagueTierBronze, 1, LeagueMovementPromoted, LeagueTierSilver},
+	}
+
+	if len(standings) != len(expected) {
+		t.Fatalf("Expected %d standings, got %d", len(expected), len(standings))
+	}
+	for i, want := range expected {
+		got := standings[i]
+		if got.PetID != want.petID || got.Tier != want.tier || got.Rank != want.rank || got.Movement != want.movement || got.NextTier != want.next {
+			t.Errorf("Position %d: expected %s #%d %s to %s, got %s #%d %s to %s",
+				i, want.tier, want.rank, want.movement, want.next, got.Tier, got.Rank, got.Movement, got.NextTier)
+		}
+	}
+
+	t.Run("Pets tied across the promotion line stay", func(t *testing.T) {
+		first, second := newRanking(10), newRanking(10)
+		standings := ComputeSeasonStandings(season, []*PetRanking{first, second}, nil)
+		for _, standing := range standings {
+			if standing.Movement == LeagueMovementPromoted {
+				t.Errorf("Expected tied pets to stay when only one can be promoted, got %+v", standing)
+			}
+		}
+	})
+
+	t.Run("Pets without points are not promoted", func(t *testing.T) {
+		idle := uuid.New()
+		standings := ComputeSeasonStandings(season, nil, map[uuid.UUID]LeagueTier{idle: LeagueTierBronze})
+		if len(standings) != 1 || standings[0].Movement != LeagueMovementStayed {
+			t.Errorf("Expected idle pet to stay in bronze, got %+v", standings)
+		}
+	})
+}
+
+func TestCurrentSeason(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+	groupID, userID := uuid.New(), uuid.New()
+
+	ended, _ := NewSeason(groupID, userID, "First", day(1), day(10), 1, 1)
+	running, _ := NewSeason(groupID, userID, "Second", day(11), day(20), 1, 1)
+	seasons := []*Season{running, ended}
+
+	if got := CurrentSeason(seasons, day(15).Add(10*time.Hour)); got != running {
+		t.Errorf("Expected running season, got %+v", got)
+	}
+	if got := CurrentSeason(seasons, day(21)); got != running {
+		t.Errorf("Expected ended season awaiting close, got %+v", got)
+	}
+
+	running.Close(day(21))
+	ended.Close(day(11))
+	if got := CurrentSeason(seasons, day(21)); got != nil {
+		t.Errorf("Expected no current season, got %+v", got)
+	}
+}
@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CardTemplate is the layout of a Pet of the Day share card
+type CardTemplate string
+
+const (
+	// CardTemplateCertificate is a landscape certificate with a border, suited to printing
+	CardTemplateCertificate CardTemplate = "certificate"
+	// CardTemplateSocial is a square card sized for social networks
+	CardTemplateSocial CardTemplate = "social"
+)
+
+// CardFont is one of the fonts bundled with the share card renderer
+type CardFont string
+
+const (
+	// CardFontClassic is the regular bundled font
+	CardFontClassic CardFont = "classic"
+	// CardFontBold is a heavier version of the classic font
+	CardFontBold CardFont = "bold"
+)
+
+// IsValidCardTemplate checks if a share card template is known
+func IsValidCardTemplate(template CardTemplate) bool {
+	return template == CardTemplateCertificate || template == CardTemplateSocial
+}
+
+// IsValidCardFont checks if a share card font is bundled
+func IsValidCardFont(font CardFont) bool {
+	return font == CardFontClassic || font == CardFontBold
+}
+
+// ShareCardSettings is the share card style of a group
+type ShareCardSettings struct {
+	GroupID   uuid.UUID    `json:"group_id"`
+	Template  CardTemplate `json:"template"`
+	Font      CardFont     `json:"font"`
+	UpdatedBy *uuid.UUID   `json:"updated_by,omitempty"`
+	UpdatedAt time.Time    `json:"updated_at"`
+}
+
+// DefaultShareCardSettings returns the share card style of groups that never changed it
+func DefaultShareCardSettings(groupID uuid.UUID) *ShareCardSettings {
+	return &ShareCardSettings{
+		GroupID:  groupID,
+		Template: CardTemplateCertificate,
+		Font:     CardFontClassic,
+	}
+}
+
+// NewShareCardSettings creates share card settings with validation
+func NewShareCardSettings(groupID, updatedBy uuid.UUID, template CardTemplate, font CardFont) (*ShareCardSettings, error) {
+	if !IsValidCardTemplate(template) {
+		return nil, fmt.Errorf("invalid share card template: %s", template)
+	}
+	if !IsValidCardFont(font) {
+		return nil, fmt.Errorf("invalid share card font: %s", font)
+	}
+
+	return &ShareCardSettings{
+		GroupID:   groupID,
+		Template:  template,
+		Font:      font,
+		UpdatedBy: &updatedBy,
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+// ShareCard holds what a Pet of the Day share card shows
+type ShareCard struct {
+	Template          CardTemplate
+	Font              CardFont
+	WinnerID          uuid.UUID
+	PetID             uuid.UUID
+	PetName           string
+	PhotoURL          string
+	OwnerName         string
+	GroupName         string
+	Date              time.Time
+	Score             int
+	PositiveBehaviors int
+	NegativeBehaviors int
+	Votes             int
+	Breakdown         []*DailyScoreBreakdown
+}
+
+// NewShareCard creates the share card of a Pet of the Day winner
+func NewShareCard(settings *ShareCardSettings, winner *PetOfTheDayWinner, groupName, photoURL string, breakdown []*DailyScoreBreakdown) *ShareCard {
+	return &ShareCard{
+		Template:          settings.Template,
+		Font:              settings.Font,
+		WinnerID:          winner.ID,
+		PetID:             winner.PetID,
+		PetName:           winner.PetName,
+		PhotoURL:          photoURL,
+		OwnerName:         winner.OwnerName,
+		GroupName:         groupName,
+		Date:              winner.Date,
+		Score:             winner.FinalScore,
+		PositiveBehaviors: winner.PositiveBehaviors,
+		NegativeBehaviors: winner.NegativeBehaviors,
+		Votes:             winner.Votes,
+		Breakdown:         breakdown,
+	}
+}
+
+// CacheKey identifies the rendering of a card. It changes whenever anything the card shows
+// changes, so a rendered card can be reused for as long as its key is the same.
+func (c *ShareCard) CacheKey() string {
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s|%s|%s|%s|%s|%s|%s|%s|%d|%d|%d|%d",
+		c.Template, c.Font, c.WinnerID, c.PetName, c.PhotoURL, c.OwnerName, c.GroupName,
+		c.Date.Format("2006-01-02"), c.Score, c.PositiveBehaviors, c.NegativeBehaviors, c.Votes)
+	for _, entry := range c.Breakdown {
+		fmt.Fprintf(hash, "|%s:%d:%d", entry.BehaviorName, entry.Count, entry.TotalPoints)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
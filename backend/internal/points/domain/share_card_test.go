@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewShareCardSettings(t *testing.T) {
+	groupID := uuid.New()
+	updatedBy := uuid.New()
+
+	settings, err := NewShareCardSettings(groupID, updatedBy, CardTemplateSocial, CardFontBold)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if settings.Template != CardTemplateSocial || settings.Font != CardFontBold {
+		t.Errorf("Expected social template with bold font, got %s with %s", settings.Template, settings.Font)
+	}
+	if settings.UpdatedBy == nil || *settings.UpdatedBy != updatedBy {
+		t.Error("Expected settings to record who updated them")
+	}
+
+	if _, err := NewShareCardSettings(groupID, updatedBy, "poster", CardFontClassic); err == nil {
+		t.Error("Expected error for an unknown template")
+	}
+	if _, err := NewShareCardSettings(groupID, updatedBy, CardTemplateCertificate, "comic"); err == nil {
+		t.Error("Expected error for an unknown font")
+	}
+}
+
+func TestShareCard_CacheKey(t *testing.T) {
+	groupID := uuid.New()
+	winner := NewPetOfTheDayWinner(groupID, uuid.New(), "Rex", "Alice", time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC), 12, 4, 1)
+	breakdown := []*DailyScoreBreakdown{{BehaviorName: "Fetch", Count: 4, PointsPerInstance: 3, TotalPoints: 12}}
+
+	card := NewShareCard(DefaultShareCardSettings(groupID), winner, "Park friends", "/uploads/rex.png", breakdown)
+	key := card.CacheKey()
+
+	if same := NewShareCard(DefaultShareCardSettings(groupID), winner, "Park friends", "/uploads/rex.png", breakdown); same.CacheKey() != key {
+		t.Error("Expected identical cards to share a cache key")
+	}
+
+	changes := map[string]func(card *ShareCard){
+		"template": func(card *ShareCard) { card.Template = CardTemplateSocial },
+		"score":    func(card *ShareCard) { card.Score = 15 },
+		"photo":    func(card *ShareCard) { card.PhotoURL = "/uploads/rex-2.png" },
+		"breakdown": func(card *ShareCard) {
+			card.Breakdown = []*DailyScoreBreakdown{{BehaviorName: "Fetch", Count: 5, PointsPerInstance: 3, TotalPoints: 15}}
+		},
+	}
+	for name, change := range changes {
+		changed := NewShareCard(DefaultShareCardSettings(groupID), winner, "Park friends", "/uploads/rex.png", breakdown)
+		change(changed)
+		if changed.CacheKey() == key {
+			t.Errorf("Expected the cache key to change with the %s", name)
+		}
+	}
+}
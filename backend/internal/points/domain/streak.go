@@ -0,0 +1,230 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StreakType represents what a streak counts
+type StreakType string
+
+const (
+	// StreakTypePositiveScore counts consecutive days with a positive total score
+	StreakTypePositiveScore StreakType = "positive_score"
+	// StreakTypeNoNegative counts consecutive days without a negative behavior of a category
+	StreakTypeNoNegative StreakType = "no_negative"
+	// StreakTypePetOfTheDay counts consecutive Pet of the Day wins in any group
+	StreakTypePetOfTheDay StreakType = "pet_of_the_day"
+)
+
+// PetStreak holds the runs of consecutive days of one streak of a pet.
+// Days are dates normalized to midnight in the pet owner's timezone.
+type PetStreak struct {
+	ID          uuid.UUID
+	PetID       uuid.UUID
+	Type        StreakType
+	Category    BehaviorCategory // Only set for no-negative streaks
+	LatestStart *time.Time       // First day of the most recent run
+	LatestEnd   *time.Time       // Last day of the most recent run, nil while it is still running
+	BestDays    int              // Length of the longest finished run
+	BestStart   *time.Time
+	BestEnd     *time.Time
+	UpdatedAt   time.Time
+}
+
+// StreakStatus is a streak evaluated on a given day
+type StreakStatus struct {
+	Type         StreakType       `json:"type"`
+	Category     BehaviorCategory `json:"category,omitempty"`
+	Current      int              `json:"current"`
+	CurrentSince *time.Time       `json:"current_since,omitempty"`
+	Best         int              `json:"best"`
+}
+
+// NewConsecutiveDaysStreak builds a streak from the days on which the pet qualified.
+// The days do not need to be sorted or unique.
+func NewConsecutiveDaysStreak(petID uuid.UUID, streakType StreakType, days []time.Time) *PetStreak {
+	streak := newPetStreak(petID, streakType, "")
+
+	days = uniqueSortedDays(days)
+	for i := 0; i < len(days); {
+		j := i
+		for j+1 < len(days) && dayNumber(days[j+1]) == dayNumber(days[j])+1 {
+			j++
+		}
+		streak.addFinishedRun(days[i], days[j])
+		streak.LatestStart = &days[i]
+		streak.LatestEnd = &days[j]
+		i = j + 1
+	}
+
+	return streak
+}
+
+// NewNoNegativeStreak builds a streak of days without a negative behavior of a category.
+// Tracking starts on the pet's first logged day; every day without a negative log counts,
+// including days without any log. The latest run is still running.
+func NewNoNegativeStreak(petID uuid.UUID, category BehaviorCategory, trackingStart time.Time, negativeDays []time.Time) *PetStreak {
+	streak := newPetStreak(petID, StreakTypeNoNegative, category)
+
+	start := normalizeDate(trackingStart)
+	for _, negativeDay := range uniqueSortedDays(negativeDays) {
+		if dayNumber(negativeDay) < dayNumber(start) {
+			continue
+		}
+		if dayNumber(negativeDay) > dayNumber(start) {
+			streak.addFinishedRun(start, negativeDay.AddDate(0, 0, -1))
+		}
+		start = negativeDay.AddDate(0, 0, 1)
+	}
+
+	streak.LatestStart = &start
+	return streak
+}
+
+// Current returns the length of the running streak on the given day. A finished run still
+// counts while its last day is today or yesterday, as today may not have qualified yet.
+func (s *PetStreak) Current(today time.Time) int {
+	if s.LatestStart == nil {
+		return 0
+	}
+
+	if s.LatestEnd == nil {
+		return daysInclusive(*s.LatestStart, today)
+	}
+
+	if dayNumber(*s.LatestEnd) < dayNumber(today)-1 {
+		return 0
+	}
+	return daysInclusive(*s.LatestStart, *s.LatestEnd)
+}
+
+// Best returns the length of the longest run, including the running one
+func (s *PetStreak) Best(today time.Time) int {
+	if current := s.Current(today); current > s.BestDays {
+		return current
+	}
+	return s.BestDays
+}
+
+// Status evaluates the streak on the given day
+func (s *PetStreak) Status(today time.Time) *StreakStatus {
+	status := &StreakStatus{
+		Type:     s.Type,
+		Category: s.Category,
+		Current:  s.Current(today),
+		Best:     s.Best(today),
+	}
+	if status.Current > 0 {
+		status.CurrentSince = s.LatestStart
+	}
+	return status
+}
+
+// ApplyDay updates the streak after the logs of one day changed, given whether the day now
+// qualifies. Only changes at the end of the history can be applied from the streak alone; it
+// returns false when the streak must be rebuilt from the pet's history, e.g. for a backdated
+// log before the latest run or a deletion that breaks it.
+func (s *PetStreak) ApplyDay(day time.Time, qualifies bool) bool {
+	day = normalizeDate(day)
+	if s.Type == StreakTypeNoNegative {
+		return s.applyNoNegativeDay(day, qualifies)
+	}
+	return s.applyConsecutiveDay(day, qualifies)
+}
+
+func (s *PetStreak) applyConsecutiveDay(day time.Time, qualifies bool) bool {
+	// Days after the latest run extend it or start a new one
+	if s.LatestEnd == nil || dayNumber(day) > dayNumber(*s.LatestEnd) {
+		if !qualifies {
+			return true
+		}
+		if s.LatestEnd == nil || dayNumber(day) > dayNumber(*s.LatestEnd)+1 {
+			s.LatestStart = &day
+		}
+		s.LatestEnd = &day
+		s.addFinishedRun(*s.LatestStart, day)
+		s.UpdatedAt = time.Now()
+		return true
+	}
+
+	// A day of the latest run that still qualifies changes nothing
+	return qualifies && dayNumber(day) >= dayNumber(*s.LatestStart)
+}
+
+func (s *PetStreak) applyNoNegativeDay(day time.Time, qualifies bool) bool {
+	if s.LatestStart == nil {
+		return false
+	}
+
+	start := *s.LatestStart
+	switch {
+	case dayNumber(day) >= dayNumber(start):
+		// A negative day ends the running streak
+		if qualifies {
+			return true
+		}
+		if dayNumber(day) > dayNumber(start) {
+			s.addFinishedRun(start, day.AddDate(0, 0, -1))
+		}
+		next := day.AddDate(0, 0, 1)
+		s.LatestStart = &next
+		s.UpdatedAt = time.Now()
+		return true
+	case dayNumber(day) == dayNumber(start)-1:
+		// The negative day before the running streak is unchanged while it stays negative
+		return !qualifies
+	default:
+		return false
+	}
+}
+
+func newPetStreak(petID uuid.UUID, streakType StreakType, category BehaviorCategory) *PetStreak {
+	return &PetStreak{
+		ID:        uuid.New(),
+		PetID:     petID,
+		Type:      streakType,
+		Category:  category,
+		UpdatedAt: time.Now(),
+	}
+}
+
+// addFinishedRun records a finished run, keeping the earliest of equally long runs as best
+func (s *PetStreak) addFinishedRun(start, end time.Time) {
+	if days := daysInclusive(start, end); days > s.BestDays {
+		s.BestDays = days
+		s.BestStart = &start
+		s.BestEnd = &end
+	}
+}
+
+// dayNumber returns the number of days since the Unix epoch of a date's calendar day,
+// so days compare correctly across locations and DST changes
+func dayNumber(date time.Time) int {
+	return int(time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
+
+// daysInclusive counts the days from start to end, both included (0 if end is before start)
+func daysInclusive(start, end time.Time) int {
+	if days := dayNumber(end) - dayNumber(start) + 1; days > 0 {
+		return days
+	}
+	return 0
+}
+
+// uniqueSortedDays normalizes days to midnight, sorts them and removes duplicates
+func uniqueSortedDays(days []time.Time) []time.Time {
+	seen := make(map[int]bool, len(days))
+	unique := make([]time.Time, 0, len(days))
+	for _, day := range days {
+		if number := dayNumber(day); !seen[number] {
+			seen[number] = true
+			unique = append(unique, normalizeDate(day))
+		}
+	}
+
+	sort.Slice(unique, func(i, j int) bool { return dayNumber(unique[i]) < dayNumber(unique[j]) })
+	return unique
+}
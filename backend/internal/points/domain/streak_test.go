@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewConsecutiveDaysStreak(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+
+	// Unsorted with a duplicate: runs 1-3 and 6-7
+	streak := NewConsecutiveDaysStreak(uuid.New(), StreakTypePositiveScore, []time.Time{day(7), day(2), day(1), day(6), day(3), day(2)})
+
+	tests := []struct {
+		name    string
+		today   time.Time
+		current int
+		best    int
+	}{
+		{"Last day is today", day(7), 2, 3},
+		{"Last day is yesterday", day(8), 2, 3},
+		{"Broken after a missed day", day(9), 0, 3},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if current, best := streak.Current(test.today), streak.Best(test.today); current != test.current || best != test.best {
+				t.Errorf("Expected current %d and best %d, got %d and %d", test.current, test.best, current, best)
+			}
+		})
+	}
+
+	if empty := NewConsecutiveDaysStreak(uuid.New(), StreakTypePetOfTheDay, nil); empty.Current(day(1)) != 0 || empty.Best(day(1)) != 0 {
+		t.Error("Expected empty streak without qualifying days")
+	}
+}
+
+func TestNewNoNegativeStreak(t *testing.T) {
+	paris, _ := time.LoadLocation("Europe/Paris")
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, paris) }
+
+	// Tracking from March 1 with accidents on March 4 and March 20; DST starts on March 29
+	streak := NewNoNegativeStreak(uuid.New(), BehaviorCategoryPottyTraining, day(1), []time.Time{day(20), day(4)})
+
+	if got := streak.Current(day(31)); got != 11 {
+		t.Errorf("Expected 11 days since the last accident across DST, got %d", got)
+	}
+	if got := streak.Best(day(31)); got != 15 {
+		t.Errorf("Expected best of 15 days between accidents, got %d", got)
+	}
+
+	status := streak.Status(day(20))
+	if status.Current != 0 || status.CurrentSince != nil {
+		t.Errorf("Expected no streak on the day of an accident, got %+v", status)
+	}
+}
+
+func TestPetStreak_ApplyDay(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+	days := func(ds ...int) []time.Time {
+		dates := make([]time.Time, len(ds))
+		for i, d := range ds {
+			dates[i] = day(d)
+		}
+		return dates
+	}
+
+	// Consecutive days streaks are built from positive days, no-negative streaks from negative
+	// days tracked since March 1
+	petID := uuid.New()
+	build := func(streakType StreakType, history []time.Time) *PetStreak {
+		if streakType == StreakTypeNoNegative {
+			return NewNoNegativeStreak(petID, BehaviorCategoryPottyTraining, day(1), history)
+		}
+		return NewConsecutiveDaysStreak(petID, streakType, history)
+	}
+
+	tests := []struct {
+		name       string
+		streakType StreakType
+		history    []time.Time
+		day        time.Time
+		qualifies  bool
+		wantResult []time.Time // History the updated streak must match, nil if it cannot be applied
+	}{
+		{"Next positive day extends the latest run", StreakTypePositiveScore, days(1, 2), day(3), true, days(1, 2, 3)},
+		{"Positive day after a gap starts a new run", StreakTypePositiveScore, days(1, 2), day(5), true, days(1, 2, 5)},
+		{"Day after the latest run that does not qualify", StreakTypePositiveScore, days(1, 2), day(4), false, days(1, 2)},
+		{"Day of the latest run that stops qualifying", StreakTypePositiveScore, days(1, 2), day(2), false, nil},
+		{"Backdated positive day", StreakTypePositiveScore, days(4, 5), day(2), true, nil},
+		{"Negative day ends the running streak", StreakTypeNoNegative, days(3), day(8), false, days(3, 8)},
+		{"Clean day of the running streak", StreakTypeNoNegative, days(3), day(6), true, days(3)},
+		{"Negative day that becomes clean", StreakTypeNoNegative, days(3), day(3), true, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			streak := build(test.streakType, test.history)
+			if applied := streak.ApplyDay(test.day, test.qualifies); applied != (test.wantResult != nil) {
+				t.Fatalf("Expected applied %v, got %v", test.wantResult != nil, applied)
+			}
+			if test.wantResult == nil {
+				return
+			}
+
+			today := day(10)
+			got, want := streak.Status(today), build(test.streakType, test.wantResult).Status(today)
+			if got.Current != want.Current || got.Best != want.Best {
+				t.Errorf("Expected %+v, got %+v", want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VerificationMode tells which behavior logs need peer verification in a group
+type VerificationMode string
+
+const (
+	// VerificationModeOff counts every log as soon as it is shared
+	VerificationModeOff VerificationMode = "off"
+	// VerificationModeThreshold holds logs awarding more than the threshold
+	VerificationModeThreshold VerificationMode = "threshold"
+	// VerificationModeAll holds every log
+	VerificationModeAll VerificationMode = "all"
+)
+
+// VerificationPolicy is the peer verification setting of a group. Held logs start as pending
+// on the group and only count toward its daily scores once another member confirms them.
+type VerificationPolicy struct {
+	GroupID         uuid.UUID
+	Mode            VerificationMode
+	Threshold       int  // Points above which a log is held, in threshold mode
+	AutoHoldFlagged bool // Hold logs flagged by the anomaly detector until the group admin resolves the flag
+	UpdatedBy       *uuid.UUID
+	UpdatedAt       time.Time
+}
+
+// DefaultVerificationPolicy returns the policy of groups that never enabled verification
+func DefaultVerificationPolicy(groupID uuid.UUID) *VerificationPolicy {
+	return &VerificationPolicy{
+		GroupID: groupID,
+		Mode:    VerificationModeOff,
+	}
+}
+
+// NewVerificationPolicy creates a verification policy with validation
+func NewVerificationPolicy(groupID, updatedBy uuid.UUID, mode VerificationMode, threshold int, autoHoldFlagged bool) (*VerificationPolicy, error) {
+	switch mode {
+	case VerificationModeOff, VerificationModeAll:
+		threshold = 0
+	case VerificationModeThreshold:
+		if threshold < 0 {
+			return nil, fmt.Errorf("verification threshold cannot be negative")
+		}
+	default:
+		return nil, fmt.Errorf("invalid verification mode: %s", mode)
+	}
+
+	return &VerificationPolicy{
+		GroupID:         groupID,
+		Mode:            mode,
+		Threshold:       threshold,
+		AutoHoldFlagged: autoHoldFlagged,
+		UpdatedBy:       &updatedBy,
+		UpdatedAt:       time.Now(),
+	}, nil
+}
+
+// RequiresVerification checks if a log awarding the given points in the group must be confirmed
+func (p *VerificationPolicy) RequiresVerification(points int) bool {
+	switch p.Mode {
+	case VerificationModeAll:
+		return true
+	case VerificationModeThreshold:
+		return points > p.Threshold
+	default:
+		return false
+	}
+}
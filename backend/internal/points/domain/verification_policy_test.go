@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestVerificationPolicy_RequiresVerification(t *testing.T) {
+	groupID, adminID := uuid.New(), uuid.New()
+
+	if DefaultVerificationPolicy(groupID).RequiresVerification(100) {
+		t.Error("Expected no verification by default")
+	}
+
+	all, err := NewVerificationPolicy(groupID, adminID, VerificationModeAll, 0, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !all.RequiresVerification(-3) {
+		t.Error("Expected every log to need verification in all mode")
+	}
+
+	threshold, err := NewVerificationPolicy(groupID, adminID, VerificationModeThreshold, 5, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if threshold.RequiresVerification(5) || !threshold.RequiresVerification(6) {
+		t.Error("Expected only logs above the threshold to need verification")
+	}
+
+	if _, err := NewVerificationPolicy(groupID, adminID, VerificationModeThreshold, -1, false); err == nil {
+		t.Error("Expected error for negative threshold")
+	}
+	if _, err := NewVerificationPolicy(groupID, adminID, "sometimes", 0, false); err == nil {
+		t.Error("Expected error for unknown mode")
+	}
+}
+
+func TestBehaviorLog_PeerVerification(t *testing.T) {
+	groupID, loggerID, memberID, adminID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	now := time.Now()
+
+	newPendingLog := func() *BehaviorLog {
+		log := &BehaviorLog{ID: uuid.New(), PetID: uuid.New(), UserID: loggerID, PointsAwarded: 8, LoggedAt: now}
+		log.AddGroupShare(groupID)
+		log.RequireVerification(groupID)
+		return log
+	}
+
+	t.Run("Legacy shares count", func(t *testing.T) {
+		log := &BehaviorLog{GroupShares: []BehaviorLogGroupShare{{GroupID: groupID}}}
+		if !log.IsCountedInGroup(groupID) {
+			t.Error("Expected a share without status to count")
+		}
+	})
+
+	t.Run("Confirm", func(t *testing.T) {
+		log := newPendingLog()
+		if log.IsCountedInGroup(groupID) {
+			t.Fatal("Expected a pending log not to count")
+		}
+		if err := log.ConfirmInGroup(groupID, loggerID, now); err == nil {
+			t.Error("Expected error when the logger confirms their own log")
+		}
+		if err := log.ConfirmInGroup(groupID, memberID, now); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !log.IsCountedInGroup(groupID) || *log.GetGroupShare(groupID).ReviewedBy != memberID {
+			t.Error("Expected the confirmed log to count")
+		}
+		if err := log.ConfirmInGroup(groupID, memberID, now); err == nil {
+			t.Error("Expected error when confirming a verified log")
+		}
+	})
+
+	t.Run("Dispute and resolve", func(t *testing.T) {
+		log := newPendingLog()
+		log.ConfirmInGroup(groupID, memberID, now)
+
+		if err := log.DisputeInGroup(groupID, memberID, "", now); err == nil {
+			t.Error("Expected error without a reason")
+		}
+		if err := log.DisputeInGroup(groupID, memberID, "Was asleep all day", now); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if log.IsCountedInGroup(groupID) {
+			t.Error("Expected a disputed log not to count")
+		}
+		if err := log.DisputeInGroup(groupID, memberID, "Again", now); err == nil {
+			t.Error("Expected error when disputing twice")
+		}
+
+		if err := log.ResolveDisputeInGroup(groupID, adminID, true, now); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !log.IsCountedInGroup(groupID) {
+			t.Error("Expected an upheld log to count")
+		}
+
+		log.DisputeInGroup(groupID, memberID, "Still doubtful", now)
+		log.ResolveDisputeInGroup(groupID, adminID, false, now)
+		if status := log.GetGroupShare(groupID).Status; status != ShareStatusRejected {
+			t.Errorf("Expected rejected log, got %s", status)
+		}
+		if err := log.ResolveDisputeInGroup(groupID, adminID, true, now); err == nil {
+			t.Error("Expected error when resolving a log that is not disputed")
+		}
+	})
+
+	t.Run("Daily score recalculation skips logs that do not count", func(t *testing.T) {
+		counted := newPendingLog()
+		counted.ConfirmInGroup(groupID, memberID, now)
+		pending := newPendingLog()
+
+		score, _ := NewDailyScore(counted.PetID, groupID, now)
+		if err := score.Recalculate([]*BehaviorLog{counted, pending}, nil); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if score.TotalPoints != 8 || score.PositiveBehaviors != 1 {
+			t.Errorf("Expected only the confirmed log, got %d points from %d behaviors", score.TotalPoints, score.PositiveBehaviors)
+		}
+	})
+}
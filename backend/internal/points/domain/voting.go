@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultVotingWindowHours is how long before the daily reset voting opens when a group
+	// does not choose
+	DefaultVotingWindowHours = 2
+	// MaxVotingWindowHours bounds the voting window a group can choose
+	MaxVotingWindowHours = 24
+)
+
+// VotingPolicy is the Pet of the Day voting setting of a group. Groups that vote select Pet of
+// the Day by the votes of their members, with points breaking ties, instead of by points alone.
+type VotingPolicy struct {
+	GroupID        uuid.UUID
+	Enabled        bool
+	WindowHours    int  // How long before the daily reset members can vote
+	WeightByPoints bool // Weigh votes by the standing of the voter's pets in the points ranking
+	UpdatedBy      *uuid.UUID
+	UpdatedAt      time.Time
+}
+
+// DefaultVotingPolicy returns the policy of groups that never changed it: Pet of the Day is
+// selected by points
+func DefaultVotingPolicy(groupID uuid.UUID) *VotingPolicy {
+	return &VotingPolicy{
+		GroupID:     groupID,
+		WindowHours: DefaultVotingWindowHours,
+	}
+}
+
+// NewVotingPolicy creates a voting policy with validation
+func NewVotingPolicy(groupID, updatedBy uuid.UUID, enabled bool, windowHours int, weightByPoints bool) (*VotingPolicy, error) {
+	if windowHours < 1 || windowHours > MaxVotingWindowHours {
+		return nil, fmt.Errorf("voting window must be between 1 and %d hours", MaxVotingWindowHours)
+	}
+
+	return &VotingPolicy{
+		GroupID:        groupID,
+		Enabled:        enabled,
+		WindowHours:    windowHours,
+		WeightByPoints: weightByPoints,
+		UpdatedBy:      &updatedBy,
+		UpdatedAt:      time.Now(),
+	}, nil
+}
+
+// WindowFor returns the voting window of a group day closing at the given reset time
+func (p *VotingPolicy) WindowFor(date, resetAt time.Time) *VotingWindow {
+	return &VotingWindow{
+		Date:     normalizeDate(date),
+		OpensAt:  resetAt.Add(-time.Duration(p.WindowHours) * time.Hour),
+		ClosesAt: resetAt,
+	}
+}
+
+// VoteWeight returns the weight of a vote cast by a member whose best pet holds the given rank
+// in a points ranking of rankedPets pets. Members whose pets rank higher weigh more, and members
+// without ranked pets cast a single vote.
+func (p *VotingPolicy) VoteWeight(bestRank, rankedPets int) int {
+	if !p.WeightByPoints || bestRank < 1 || bestRank > rankedPets {
+		return 1
+	}
+	return rankedPets - bestRank + 1
+}
+
+// VotingWindow is the time span during which members vote for the Pet of the Day of a group day
+type VotingWindow struct {
+	Date     time.Time `json:"date"`
+	OpensAt  time.Time `json:"opens_at"`
+	ClosesAt time.Time `json:"closes_at"`
+}
+
+// IsOpen checks if votes are accepted at the given time
+func (w *VotingWindow) IsOpen(now time.Time) bool {
+	return !now.Before(w.OpensAt) && now.Before(w.ClosesAt)
+}
+
+// PetOfTheDayVote is the vote of a group member for the Pet of the Day of a group day. Each
+// member has one vote per day, voting again replaces it.
+type PetOfTheDayVote struct {
+	ID        uuid.UUID `json:"id"`
+	GroupID   uuid.UUID `json:"group_id"`
+	Date      time.Time `json:"date"`
+	VoterID   uuid.UUID `json:"voter_id"`
+	PetID     uuid.UUID `json:"pet_id"`
+	Weight    int       `json:"weight"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewPetOfTheDayVote creates a vote with validation
+func NewPetOfTheDayVote(groupID uuid.UUID, date time.Time, voterID, petID uuid.UUID, weight int) (*PetOfTheDayVote, error) {
+	if weight < 1 {
+		return nil, fmt.Errorf("vote weight must be at least 1")
+	}
+
+	return &PetOfTheDayVote{
+		ID:        uuid.New(),
+		GroupID:   groupID,
+		Date:      normalizeDate(date),
+		VoterID:   voterID,
+		PetID:     petID,
+		Weight:    weight,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// VoteTally is the count of the votes for a pet on a group day
+type VoteTally struct {
+	PetID  uuid.UUID `json:"pet_id"`
+	Votes  int       `json:"votes"`  // Number of members who voted for the pet
+	Weight int       `json:"weight"` // Sum of the weights of those votes, which decides the winner
+}
+
+// TallyVotes counts votes per pet, the most voted pets first
+func TallyVotes(votes []*PetOfTheDayVote) []*VoteTally {
+	talliesByPet := make(map[uuid.UUID]*VoteTally)
+	for _, vote := range votes {
+		tally, exists := talliesByPet[vote.PetID]
+		if !exists {
+			tally = &VoteTally{PetID: vote.PetID}
+			talliesByPet[vote.PetID] = tally
+		}
+		tally.Votes++
+		tally.Weight += vote.Weight
+	}
+
+	tallies := make([]*VoteTally, 0, len(talliesByPet))
+	for _, tally := range talliesByPet {
+		tallies = append(tallies, tally)
+	}
+
+	sort.Slice(tallies, func(i, j int) bool {
+		if tallies[i].Weight != tallies[j].Weight {
+			return tallies[i].Weight > tallies[j].Weight
+		}
+		if tallies[i].Votes != tallies[j].Votes {
+			return tallies[i].Votes > tallies[j].Votes
+		}
+		return bytes.Compare(tallies[i].PetID[:], tallies[j].PetID[:]) < 0
+	})
+
+	return tallies
+}
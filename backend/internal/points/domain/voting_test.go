@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNewVotingPolicy(t *testing.T) {
+	groupID, userID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name        string
+		windowHours int
+		wantErr     bool
+	}{
+		{"Default window", DefaultVotingWindowHours, false},
+		{"Whole day", MaxVotingWindowHours, false},
+		{"No window", 0, true},
+		{"Longer than a day", MaxVotingWindowHours + 1, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewVotingPolicy(groupID, userID, true, test.windowHours, false)
+			if (err != nil) != test.wantErr {
+				t.Errorf("Expected error %v, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestVotingPolicy_WindowFor(t *testing.T) {
+	policy := DefaultVotingPolicy(uuid.New())
+	resetAt := time.Date(2025, time.March, 10, 21, 0, 0, 0, time.UTC)
+	window := policy.WindowFor(time.Date(2025, time.March, 10, 15, 0, 0, 0, time.UTC), resetAt)
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want bool
+	}{
+		{"Before the window", resetAt.Add(-3 * time.Hour), false},
+		{"Window opens", resetAt.Add(-2 * time.Hour), true},
+		{"Inside the window", resetAt.Add(-time.Minute), true},
+		{"Reset closes the window", resetAt, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := window.IsOpen(test.now); got != test.want {
+				t.Errorf("Expected open %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestVotingPolicy_VoteWeight(t *testing.T) {
+	weighted := &VotingPolicy{WeightByPoints: true}
+
+	tests := []struct {
+		name     string
+		policy   *VotingPolicy
+		bestRank int
+		want     int
+	}{
+		{"Unweighted", &VotingPolicy{}, 1, 1},
+		{"Leader", weighted, 1, 5},
+		{"Last place", weighted, 5, 1},
+		{"No ranked pet", weighted, 0, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.policy.VoteWeight(test.bestRank, 5); got != test.want {
+				t.Errorf("Expected weight %d, got %d", test.want, got)
+			}
+		})
+	}
+}
+
+func TestTallyVotes(t *testing.T) {
+	groupID := uuid.New()
+	day := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+	rexID, lunaID := uuid.New(), uuid.New()
+
+	vote := func(petID uuid.UUID, weight int) *PetOfTheDayVote {
+		v, err := NewPetOfTheDayVote(groupID, day, uuid.New(), petID, weight)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		return v
+	}
+
+	// Rex gets more votes, but Luna's single vote weighs more
+	tallies := TallyVotes([]*PetOfTheDayVote{vote(rexID, 1), vote(rexID, 1), vote(lunaID, 3)})
+
+	if len(tallies) != 2 {
+		t.Fatalf("Expected 2 tallies, got %d", len(tallies))
+	}
+	if tallies[0].PetID != lunaID || tallies[0].Weight != 3 || tallies[0].Votes != 1 {
+		t.Errorf("Expected Luna first with weight 3, got %+v", tallies[0])
+	}
+	if tallies[1].PetID != rexID || tallies[1].Weight != 2 || tallies[1].Votes != 2 {
+		t.Errorf("Expected Rex second with 2 votes, got %+v", tallies[1])
+	}
+
+	if _, err := NewPetOfTheDayVote(groupID, day, uuid.New(), rexID, 0); err == nil {
+		t.Error("Expected error for a vote without weight")
+	}
+}
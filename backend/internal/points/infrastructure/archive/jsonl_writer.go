@@ -0,0 +1,117 @@
+package archive
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+)
+
+// logRecord is the line of a behavior log in an archive file
+type logRecord struct {
+	ID            uuid.UUID     `json:"id"`
+	PetID         uuid.UUID     `json:"pet_id"`
+	BehaviorID    uuid.UUID     `json:"behavior_id"`
+	UserID        uuid.UUID     `json:"user_id"`
+	PointsAwarded int           `json:"points_awarded"`
+	LoggedAt      time.Time     `json:"logged_at"`
+	CreatedAt     time.Time     `json:"created_at"`
+	Notes         string        `json:"notes,omitempty"`
+	GroupShares   []shareRecord `json:"group_shares"`
+}
+
+// shareRecord is a group share of an archived behavior log
+type shareRecord struct {
+	GroupID       uuid.UUID  `json:"group_id"`
+	PointsAwarded int        `json:"points_awarded"`
+	Status        string     `json:"status"`
+	ReviewedBy    *uuid.UUID `json:"reviewed_by,omitempty"`
+	ReviewedAt    *time.Time `json:"reviewed_at,omitempty"`
+	DisputeReason string     `json:"dispute_reason,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// JSONLWriter exports behavior logs to gzip compressed JSON Lines files on local disk, one log
+// per line
+type JSONLWriter struct {
+	dir string
+}
+
+// NewJSONLWriter creates a writer of archive files under a directory
+func NewJSONLWriter(dir string) *JSONLWriter {
+	return &JSONLWriter{dir: dir}
+}
+
+// WriteLogs writes logs to the archive file of a name and returns its path. An existing file of
+// the same name is replaced once the new one is complete, so a failed export can be run again.
+func (w *JSONLWriter) WriteLogs(name string, behaviorLogs []*domain.BehaviorLog) (string, error) {
+	if err := os.MkdirAll(w.dir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create archive directory: %w", err)
+	}
+
+	path := filepath.Join(w.dir, name+".jsonl.gz")
+	tmp, err := os.CreateTemp(w.dir, name+"-*.tmp")
+	if err != nil {
+		return "", fmt.Errorf("failed to create archive file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	gz := gzip.NewWriter(tmp)
+	encoder := json.NewEncoder(gz)
+	for _, behaviorLog := range behaviorLogs {
+		if err := encoder.Encode(newLogRecord(behaviorLog)); err != nil {
+			return "", fmt.Errorf("failed to write behavior log %s: %w", behaviorLog.ID, err)
+		}
+	}
+
+	if err := gz.Close(); err != nil {
+		return "", fmt.Errorf("failed to compress archive file: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		return "", fmt.Errorf("failed to flush archive file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("failed to close archive file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", fmt.Errorf("failed to save archive file: %w", err)
+	}
+
+	return path, nil
+}
+
+// newLogRecord converts a behavior log into its archive line
+func newLogRecord(behaviorLog *domain.BehaviorLog) logRecord {
+	record := logRecord{
+		ID:            behaviorLog.ID,
+		PetID:         behaviorLog.PetID,
+		BehaviorID:    behaviorLog.BehaviorID,
+		UserID:        behaviorLog.UserID,
+		PointsAwarded: behaviorLog.PointsAwarded,
+		LoggedAt:      behaviorLog.LoggedAt,
+		CreatedAt:     behaviorLog.CreatedAt,
+		Notes:         behaviorLog.Notes,
+		GroupShares:   make([]shareRecord, len(behaviorLog.GroupShares)),
+	}
+
+	for i, share := range behaviorLog.GroupShares {
+		record.GroupShares[i] = shareRecord{
+			GroupID:       share.GroupID,
+			PointsAwarded: share.PointsAwarded,
+			Status:        string(share.CurrentStatus()),
+			ReviewedBy:    share.ReviewedBy,
+			ReviewedAt:    share.ReviewedAt,
+			DisputeReason: share.DisputeReason,
+			CreatedAt:     share.CreatedAt,
+		}
+	}
+
+	return record
+}
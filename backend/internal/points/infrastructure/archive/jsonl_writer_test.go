@@ -0,0 +1,94 @@
+package archive
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+)
+
+func readRecords(t *testing.T, path string) []logRecord {
+	t.Helper()
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Expected the archive file, got %v", err)
+	}
+	defer file.Close()
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		t.Fatalf("Expected a gzip file, got %v", err)
+	}
+
+	records := make([]logRecord, 0)
+	scanner := bufio.NewScanner(gz)
+	for scanner.Scan() {
+		var record logRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("Expected a JSON line, got %v", err)
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	return records
+}
+
+func TestJSONLWriter_WriteLogs(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "behavior-logs")
+	writer := NewJSONLWriter(dir)
+
+	groupID := uuid.New()
+	behaviorLog := &domain.BehaviorLog{
+		ID:            uuid.New(),
+		PetID:         uuid.New(),
+		BehaviorID:    uuid.New(),
+		UserID:        uuid.New(),
+		PointsAwarded: 3,
+		LoggedAt:      time.Date(2025, time.March, 10, 10, 0, 0, 0, time.UTC),
+		Notes:         "at the park",
+	}
+	behaviorLog.AddGroupShareWithPoints(groupID, 5)
+
+	path, err := writer.WriteLogs("behavior-logs-before-2025-04-01", []*domain.BehaviorLog{behaviorLog, behaviorLog})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if path != filepath.Join(dir, "behavior-logs-before-2025-04-01.jsonl.gz") {
+		t.Errorf("Expected the file to be named after the archive, got %s", path)
+	}
+
+	records := readRecords(t, path)
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 lines, got %d", len(records))
+	}
+	record := records[0]
+	if record.ID != behaviorLog.ID || record.Notes != "at the park" || !record.LoggedAt.Equal(behaviorLog.LoggedAt) {
+		t.Errorf("Expected the log to be exported, got %+v", record)
+	}
+	if len(record.GroupShares) != 1 || record.GroupShares[0].GroupID != groupID || record.GroupShares[0].PointsAwarded != 5 {
+		t.Errorf("Expected the group share to be exported, got %+v", record.GroupShares)
+	}
+
+	// Writing the same archive again replaces it
+	if _, err := writer.WriteLogs("behavior-logs-before-2025-04-01", []*domain.BehaviorLog{behaviorLog}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if records := readRecords(t, path); len(records) != 1 {
+		t.Errorf("Expected the file to be replaced, got %d lines", len(records))
+	}
+
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 {
+		t.Errorf("Expected no temporary files left, got %d files", len(entries))
+	}
+}
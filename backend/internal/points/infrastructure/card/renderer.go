@@ -0,0 +1,278 @@
+package card
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"sort"
+	"strings"
+
+	"pet-of-the-day/internal/points/domain"
+)
+
+// palette holds the colors of a card template
+type palette struct {
+	background color.RGBA
+	accent     color.RGBA
+	text       color.RGBA
+	muted      color.RGBA
+}
+
+// cardTemplate is the size, colors and layout of a card template
+type cardTemplate struct {
+	width   int
+	height  int
+	palette palette
+	layout  func(c *canvas, card *domain.ShareCard, photo image.Image)
+}
+
+// templates holds the bundled card templates
+var templates = map[domain.CardTemplate]cardTemplate{
+	domain.CardTemplateCertificate: {
+		width:  1200,
+		height: 900,
+		palette: palette{
+			background: color.RGBA{250, 246, 233, 255},
+			accent:     color.RGBA{184, 134, 11, 255},
+			text:       color.RGBA{45, 36, 24, 255},
+			muted:      color.RGBA{120, 104, 80, 255},
+		},
+		layout: layoutCertificate,
+	},
+	domain.CardTemplateSocial: {
+		width:  1080,
+		height: 1080,
+		palette: palette{
+			background: color.RGBA{33, 37, 71, 255},
+			accent:     color.RGBA{255, 196, 61, 255},
+			text:       color.RGBA{255, 255, 255, 255},
+			muted:      color.RGBA{170, 176, 214, 255},
+		},
+		layout: layoutSocial,
+	},
+}
+
+// Renderer draws Pet of the Day share cards as PNG images with the bundled templates and fonts
+type Renderer struct{}
+
+// NewRenderer creates a new share card renderer
+func NewRenderer() *Renderer {
+	return &Renderer{}
+}
+
+// Render writes the PNG image of a card. Cards without a photo show the initial of the pet.
+func (r *Renderer) Render(w io.Writer, card *domain.ShareCard, photo image.Image) error {
+	template, exists := templates[card.Template]
+	if !exists {
+		return fmt.Errorf("unknown share card template: %s", card.Template)
+	}
+
+	c := &canvas{
+		img:     image.NewRGBA(image.Rect(0, 0, template.width, template.height)),
+		font:    bitmapFont{bold: card.Font == domain.CardFontBold},
+		palette: template.palette,
+	}
+	c.fill(c.img.Bounds(), c.palette.background)
+	template.layout(c, card, photo)
+
+	if err := png.Encode(w, c.img); err != nil {
+		return fmt.Errorf("failed to encode share card: %w", err)
+	}
+	return nil
+}
+
+// layoutCertificate draws a landscape certificate: a framed photo on the left and the details
+// of the win on the right
+func layoutCertificate(c *canvas, card *domain.ShareCard, photo image.Image) {
+	width := c.img.Bounds().Dx()
+	height := c.img.Bounds().Dy()
+
+	c.frame(image.Rect(24, 24, width-24, height-24), 12, c.palette.accent)
+	c.frame(image.Rect(52, 52, width-52, height-52), 3, c.palette.accent)
+
+	c.textCentered("PET OF THE DAY", 92, 9, c.palette.accent)
+	c.textCentered(c.font.fit(card.GroupName, 4, width-200), 186, 4, c.palette.muted)
+
+	c.photo(image.Rect(110, 270, 440, 600), 8, photo, card.PetName)
+
+	left, right := 500, width-110
+	nameScale := c.font.fitScale(card.PetName, 8, 4, right-left)
+	c.text(c.font.fit(card.PetName, nameScale, right-left), left, 270, nameScale, c.palette.text)
+	c.text(c.font.fit("Owner: "+card.OwnerName, 3, right-left), left, 360, 3, c.palette.muted)
+	c.text(card.Date.Format("Monday, January 2, 2006"), left, 400, 3, c.palette.muted)
+	c.text(scoreLine(card), left, 460, 6, c.palette.accent)
+	c.text(behaviorLine(card), left, 540, 3, c.palette.text)
+
+	y := 600
+	for _, line := range breakdownLines(card, 5) {
+		c.text(c.font.fit(line, 3, right-left), left, y, 3, c.palette.text)
+		y += 36
+	}
+
+	c.textCentered("Congratulations!", height-140, 4, c.palette.accent)
+}
+
+// layoutSocial draws a square card with the photo in the middle
+func layoutSocial(c *canvas, card *domain.ShareCard, photo image.Image) {
+	width := c.img.Bounds().Dx()
+	height := c.img.Bounds().Dy()
+
+	c.textCentered("PET OF THE DAY", 60, 8, c.palette.accent)
+	c.photo(image.Rect(width/2-220, 150, width/2+220, 590), 10, photo, card.PetName)
+
+	nameScale := c.font.fitScale(card.PetName, 9, 4, width-120)
+	c.textCentered(c.font.fit(card.PetName, nameScale, width-120), 630, nameScale, c.palette.text)
+	c.textCentered(scoreLine(card), 730, 6, c.palette.accent)
+
+	y := 810
+	for _, line := range breakdownLines(card, 3) {
+		c.textCentered(c.font.fit(line, 3, width-120), y, 3, c.palette.text)
+		y += 40
+	}
+
+	footer := card.GroupName + " - " + card.Date.Format("January 2, 2006")
+	c.textCentered(c.font.fit(footer, 3, width-120), height-70, 3, c.palette.muted)
+}
+
+// scoreLine returns the score of a card, with its votes in groups that vote
+func scoreLine(card *domain.ShareCard) string {
+	line := plural(card.Score, "POINT")
+	if card.Votes > 0 {
+		line += " - " + plural(card.Votes, "VOTE")
+	}
+	return line
+}
+
+// behaviorLine returns the number of good and bad behaviors of a card
+func behaviorLine(card *domain.ShareCard) string {
+	return fmt.Sprintf("%d good, %d bad", card.PositiveBehaviors, card.NegativeBehaviors)
+}
+
+// breakdownLines returns the behaviors that earned the most points, one line each
+func breakdownLines(card *domain.ShareCard, limit int) []string {
+	breakdown := append([]*domain.DailyScoreBreakdown{}, card.Breakdown...)
+	sort.SliceStable(breakdown, func(i, j int) bool {
+		return breakdown[i].TotalPoints > breakdown[j].TotalPoints
+	})
+	if len(breakdown) > limit {
+		breakdown = breakdown[:limit]
+	}
+
+	lines := make([]string, 0, len(breakdown))
+	for _, entry := range breakdown {
+		lines = append(lines, fmt.Sprintf("%dx %s %+d", entry.Count, entry.BehaviorName, entry.TotalPoints))
+	}
+	return lines
+}
+
+// plural returns a count with its unit, adding an S past one
+func plural(count int, unit string) string {
+	if count == 1 || count == -1 {
+		return fmt.Sprintf("%d %s", count, unit)
+	}
+	return fmt.Sprintf("%d %sS", count, unit)
+}
+
+// canvas is a card being drawn
+type canvas struct {
+	img     *image.RGBA
+	font    bitmapFont
+	palette palette
+}
+
+// fill paints a rectangle
+func (c *canvas) fill(rect image.Rectangle, col color.Color) {
+	draw.Draw(c.img, rect, image.NewUniform(col), image.Point{}, draw.Src)
+}
+
+// frame paints the border of a rectangle, inside it
+func (c *canvas) frame(rect image.Rectangle, thickness int, col color.Color) {
+	c.fill(image.Rect(rect.Min.X, rect.Min.Y, rect.Max.X, rect.Min.Y+thickness), col)
+	c.fill(image.Rect(rect.Min.X, rect.Max.Y-thickness, rect.Max.X, rect.Max.Y), col)
+	c.fill(image.Rect(rect.Min.X, rect.Min.Y, rect.Min.X+thickness, rect.Max.Y), col)
+	c.fill(image.Rect(rect.Max.X-thickness, rect.Min.Y, rect.Max.X, rect.Max.Y), col)
+}
+
+// text draws a text with its top left corner at the given point
+func (c *canvas) text(text string, x, y, scale int, col color.Color) {
+	c.font.draw(c.img, text, x, y, scale, col)
+}
+
+// textCentered draws a text centered horizontally
+func (c *canvas) textCentered(text string, y, scale int, col color.Color) {
+	x := (c.img.Bounds().Dx() - c.font.measure(text, scale)) / 2
+	c.font.draw(c.img, text, x, y, scale, col)
+}
+
+// photo draws a framed photo cropped to fill a rectangle, or the initial of the pet without one
+func (c *canvas) photo(rect image.Rectangle, frame int, photo image.Image, petName string) {
+	c.fill(rect, c.palette.accent)
+	inner := rect.Inset(frame)
+
+	if photo == nil || photo.Bounds().Empty() {
+		c.fill(inner, c.palette.background)
+		initial := "?"
+		if name := []rune(strings.TrimSpace(petName)); len(name) > 0 {
+			initial = strings.ToUpper(string(name[0]))
+		}
+		scale := inner.Dy() / (glyphHeight * 2)
+		x := inner.Min.X + (inner.Dx()-c.font.measure(initial, scale))/2
+		y := inner.Min.Y + (inner.Dy()-glyphHeight*scale)/2
+		c.font.draw(c.img, initial, x, y, scale, c.palette.accent)
+		return
+	}
+
+	drawCover(c.img, inner, photo)
+}
+
+// drawCover scales an image to cover a rectangle, cropping its center, and draws it. Each
+// pixel is the average of the source pixels it covers.
+func drawCover(dst *image.RGBA, rect image.Rectangle, src image.Image) {
+	bounds := src.Bounds()
+	srcWidth, srcHeight := bounds.Dx(), bounds.Dy()
+	dstWidth, dstHeight := rect.Dx(), rect.Dy()
+
+	// Crop the source to the aspect ratio of the rectangle
+	crop := bounds
+	if srcWidth*dstHeight > srcHeight*dstWidth {
+		cropWidth := srcHeight * dstWidth / dstHeight
+		crop.Min.X += (srcWidth - cropWidth) / 2
+		crop.Max.X = crop.Min.X + cropWidth
+	} else {
+		cropHeight := srcWidth * dstHeight / dstWidth
+		crop.Min.Y += (srcHeight - cropHeight) / 2
+		crop.Max.Y = crop.Min.Y + cropHeight
+	}
+	cropWidth, cropHeight := crop.Dx(), crop.Dy()
+
+	for y := 0; y < dstHeight; y++ {
+		y0 := crop.Min.Y + y*cropHeight/dstHeight
+		y1 := crop.Min.Y + (y+1)*cropHeight/dstHeight
+		if y1 <= y0 {
+			y1 = y0 + 1
+		}
+		for x := 0; x < dstWidth; x++ {
+			x0 := crop.Min.X + x*cropWidth/dstWidth
+			x1 := crop.Min.X + (x+1)*cropWidth/dstWidth
+			if x1 <= x0 {
+				x1 = x0 + 1
+			}
+
+			var r, g, b, a, count uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := src.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa)
+					count++
+				}
+			}
+
+			dst.Set(rect.Min.X+x, rect.Min.Y+y, color.RGBA64{
+				R: uint16(r / count), G: uint16(g / count), B: uint16(b / count), A: uint16(a / count),
+			})
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package card
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+)
+
+func TestRenderer_Render(t *testing.T) {
+	winner := domain.NewPetOfTheDayWinner(uuid.New(), uuid.New(), "Rex", "Alice", time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC), 12, 4, 1)
+	breakdown := []*domain.DailyScoreBreakdown{
+		{BehaviorName: "Fetch", Count: 3, PointsPerInstance: 3, TotalPoints: 9},
+		{BehaviorName: "Chewed a shoe", Count: 1, PointsPerInstance: -2, TotalPoints: -2},
+	}
+
+	photo := image.NewRGBA(image.Rect(0, 0, 64, 48))
+	for y := 0; y < 48; y++ {
+		for x := 0; x < 64; x++ {
+			photo.Set(x, y, color.RGBA{200, 80, 40, 255})
+		}
+	}
+
+	tests := []struct {
+		name          string
+		template      domain.CardTemplate
+		font          domain.CardFont
+		photo         image.Image
+		width, height int
+	}{
+		{"Certificate with photo", domain.CardTemplateCertificate, domain.CardFontClassic, photo, 1200, 900},
+		{"Certificate without photo", domain.CardTemplateCertificate, domain.CardFontBold, nil, 1200, 900},
+		{"Social card", domain.CardTemplateSocial, domain.CardFontClassic, photo, 1080, 1080},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			settings, err := domain.NewShareCardSettings(winner.GroupID, uuid.New(), test.template, test.font)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			card := domain.NewShareCard(settings, winner, "Park friends", "", breakdown)
+
+			var buf bytes.Buffer
+			if err := NewRenderer().Render(&buf, card, test.photo); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			img, err := png.Decode(&buf)
+			if err != nil {
+				t.Fatalf("Expected a PNG image, got %v", err)
+			}
+			if img.Bounds().Dx() != test.width || img.Bounds().Dy() != test.height {
+				t.Errorf("Expected a %dx%d card, got %v", test.width, test.height, img.Bounds())
+			}
+		})
+	}
+
+	t.Run("Unknown template", func(t *testing.T) {
+		card := &domain.ShareCard{Template: "poster"}
+		if err := NewRenderer().Render(&bytes.Buffer{}, card, nil); err == nil {
+			t.Error("Expected error for an unknown template")
+		}
+	})
+}
+
+func TestBitmapFont(t *testing.T) {
+	font := bitmapFont{}
+
+	if width := font.measure("Rex", 2); width != (3*glyphAdvance-1)*2 {
+		t.Errorf("Expected Rex to be %d pixels wide, got %d", (3*glyphAdvance-1)*2, width)
+	}
+	if got := font.fit("Sir Fluffington the Third", 1, font.measure("Sir Fluff...", 1)); got != "Sir Fluff..." {
+		t.Errorf("Expected a shortened name, got %q", got)
+	}
+	if glyph('é') != glyphs['e'] || glyph('✓') != glyphs['?'] {
+		t.Error("Expected accents to be folded and unknown characters replaced")
+	}
+}
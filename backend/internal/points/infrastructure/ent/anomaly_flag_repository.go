@@ -0,0 +1,135 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/ent"
+	"pet-of-the-day/ent/anomalyflag"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// AnomalyFlagRepository implements the domain.AnomalyFlagRepository interface using Ent ORM
+type AnomalyFlagRepository struct {
+	client *ent.Client
+}
+
+// NewAnomalyFlagRepository creates a new Ent-based anomaly flag repository
+func NewAnomalyFlagRepository(client *ent.Client) *AnomalyFlagRepository {
+	return &AnomalyFlagRepository{
+		client: client,
+	}
+}
+
+// Create records a new flag
+func (r *AnomalyFlagRepository) Create(ctx context.Context, flag *domain.AnomalyFlag) error {
+	_, err := r.client.AnomalyFlag.
+		Create().
+		SetID(flag.ID).
+		SetBehaviorLogID(flag.BehaviorLogID).
+		SetPetID(flag.PetID).
+		SetUserID(flag.UserID).
+		SetGroupID(flag.GroupID).
+		SetReasons(flag.Reasons).
+		SetHeld(flag.Held).
+		SetStatus(anomalyflag.Status(flag.Status)).
+		SetCreatedAt(flag.CreatedAt).
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to create anomaly flag: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a flag by ID
+func (r *AnomalyFlagRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.AnomalyFlag, error) {
+	entFlag, err := r.client.AnomalyFlag.
+		Query().
+		Where(anomalyflag.ID(id)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get anomaly flag: %w", err)
+	}
+
+	return r.entToDomain(entFlag), nil
+}
+
+// Find retrieves flags based on filter criteria, most recent first
+func (r *AnomalyFlagRepository) Find(ctx context.Context, filter *domain.AnomalyFlagFilter) ([]*domain.AnomalyFlag, error) {
+	query := r.client.AnomalyFlag.Query()
+
+	// Apply filters
+	if filter.GroupID != nil {
+		query = query.Where(anomalyflag.GroupID(*filter.GroupID))
+	}
+
+	if filter.BehaviorLogID != nil {
+		query = query.Where(anomalyflag.BehaviorLogID(*filter.BehaviorLogID))
+	}
+
+	if filter.Status != nil {
+		query = query.Where(anomalyflag.StatusEQ(anomalyflag.Status(*filter.Status)))
+	}
+
+	// Apply pagination
+	query = query.Limit(filter.Limit).Offset(filter.Offset)
+
+	// Order by created_at descending
+	query = query.Order(ent.Desc(anomalyflag.FieldCreatedAt))
+
+	entFlags, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find anomaly flags: %w", err)
+	}
+
+	flags := make([]*domain.AnomalyFlag, len(entFlags))
+	for i, entFlag := range entFlags {
+		flags[i] = r.entToDomain(entFlag)
+	}
+
+	return flags, nil
+}
+
+// Update updates the moderation state of a flag
+func (r *AnomalyFlagRepository) Update(ctx context.Context, flag *domain.AnomalyFlag) error {
+	_, err := r.client.AnomalyFlag.
+		UpdateOneID(flag.ID).
+		SetHeld(flag.Held).
+		SetStatus(anomalyflag.Status(flag.Status)).
+		SetNillableResolvedBy(flag.ResolvedBy).
+		SetNillableResolvedAt(flag.ResolvedAt).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("anomaly flag not found")
+		}
+		return fmt.Errorf("failed to update anomaly flag: %w", err)
+	}
+
+	return nil
+}
+
+func (r *AnomalyFlagRepository) entToDomain(entFlag *ent.AnomalyFlag) *domain.AnomalyFlag {
+	return &domain.AnomalyFlag{
+		ID:            entFlag.ID,
+		BehaviorLogID: entFlag.BehaviorLogID,
+		PetID:         entFlag.PetID,
+		UserID:        entFlag.UserID,
+		GroupID:       entFlag.GroupID,
+		Reasons:       entFlag.Reasons,
+		Held:          entFlag.Held,
+		Status:        domain.AnomalyFlagStatus(entFlag.Status),
+		ResolvedBy:    entFlag.ResolvedBy,
+		ResolvedAt:    entFlag.ResolvedAt,
+		CreatedAt:     entFlag.CreatedAt,
+	}
+}
@@ -0,0 +1,94 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/ent"
+	"pet-of-the-day/ent/archiveddailyscore"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// ArchivedDailyScoreRepository implements the domain.ArchivedDailyScoreRepository interface using Ent ORM
+type ArchivedDailyScoreRepository struct {
+	client *ent.Client
+}
+
+// NewArchivedDailyScoreRepository creates a new Ent-based archived daily score repository
+func NewArchivedDailyScoreRepository(client *ent.Client) *ArchivedDailyScoreRepository {
+	return &ArchivedDailyScoreRepository{
+		client: client,
+	}
+}
+
+// CreateBatch saves archived daily scores, all of them or none
+func (r *ArchivedDailyScoreRepository) CreateBatch(ctx context.Context, archived []*domain.ArchivedDailyScore) error {
+	if len(archived) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.ArchivedDailyScoreCreate, len(archived))
+	for i, summary := range archived {
+		builders[i] = r.client.ArchivedDailyScore.
+			Create().
+			SetID(summary.ID).
+			SetPetID(summary.PetID).
+			SetGroupID(summary.GroupID).
+			SetDate(dayKey(summary.Date)).
+			SetTotalPoints(summary.TotalPoints).
+			SetPositiveBehaviors(summary.PositiveBehaviors).
+			SetNegativeBehaviors(summary.NegativeBehaviors).
+			SetBehaviorPointTotal(summary.BehaviorPointTotal).
+			SetLogCount(summary.LogCount).
+			SetBreakdown(summary.Breakdown).
+			SetArchiveFile(summary.ArchiveFile).
+			SetArchivedAt(summary.ArchivedAt)
+	}
+
+	// A single insert statement, so a failure saves none of them
+	if _, err := r.client.ArchivedDailyScore.CreateBulk(builders...).Save(ctx); err != nil {
+		return fmt.Errorf("failed to create archived daily scores: %w", err)
+	}
+
+	return nil
+}
+
+// GetByPetGroupAndDate retrieves the summary of a pet's day in a group, nil if the day is not archived
+func (r *ArchivedDailyScoreRepository) GetByPetGroupAndDate(ctx context.Context, petID, groupID uuid.UUID, date time.Time) (*domain.ArchivedDailyScore, error) {
+	day := dayKey(date)
+
+	entArchived, err := r.client.ArchivedDailyScore.
+		Query().
+		Where(
+			archiveddailyscore.PetID(petID),
+			archiveddailyscore.GroupID(groupID),
+			archiveddailyscore.DateGTE(day),
+			archiveddailyscore.DateLT(day.AddDate(0, 0, 1)),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get archived daily score: %w", err)
+	}
+
+	return &domain.ArchivedDailyScore{
+		ID:                 entArchived.ID,
+		PetID:              entArchived.PetID,
+		GroupID:            entArchived.GroupID,
+		Date:               entArchived.Date.UTC(),
+		TotalPoints:        entArchived.TotalPoints,
+		PositiveBehaviors:  entArchived.PositiveBehaviors,
+		NegativeBehaviors:  entArchived.NegativeBehaviors,
+		BehaviorPointTotal: entArchived.BehaviorPointTotal,
+		LogCount:           entArchived.LogCount,
+		Breakdown:          entArchived.Breakdown,
+		ArchiveFile:        entArchived.ArchiveFile,
+		ArchivedAt:         entArchived.ArchivedAt,
+	}, nil
+}
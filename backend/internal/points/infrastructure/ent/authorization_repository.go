@@ -0,0 +1,221 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/google/uuid"
+
+	"pet-of-the-day/ent"
+	"pet-of-the-day/ent/group"
+	"pet-of-the-day/ent/membership"
+	"pet-of-the-day/ent/pet"
+	"pet-of-the-day/ent/predicate"
+	"pet-of-the-day/ent/user"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// AuthorizationRepository implements the domain.AuthorizationRepository interface using Ent ORM.
+// Group members are users with an active membership, and the pets of a group are the pets their
+// memberships bring.
+type AuthorizationRepository struct {
+	client *ent.Client
+}
+
+// NewAuthorizationRepository creates a new Ent-based authorization repository
+func NewAuthorizationRepository(client *ent.Client) *AuthorizationRepository {
+	return &AuthorizationRepository{
+		client: client,
+	}
+}
+
+// CanUserAccessPet checks if a user owns a pet
+func (r *AuthorizationRepository) CanUserAccessPet(ctx context.Context, userID, petID uuid.UUID) (bool, error) {
+	// TODO: Check co-owners when schema is ready
+	exists, err := r.client.Pet.
+		Query().
+		Where(
+			pet.ID(petID),
+			pet.HasOwnerWith(user.ID(userID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check pet access: %w", err)
+	}
+
+	return exists, nil
+}
+
+// CanUserAccessGroup checks if a user is an active member of a group
+func (r *AuthorizationRepository) CanUserAccessGroup(ctx context.Context, userID, groupID uuid.UUID) (bool, error) {
+	exists, err := r.activeMemberships().
+		Where(
+			membership.HasGroupWith(group.ID(groupID)),
+			membership.HasUserWith(user.ID(userID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check group access: %w", err)
+	}
+
+	return exists, nil
+}
+
+// IsPetInGroup checks if an active membership of a group brings a pet
+func (r *AuthorizationRepository) IsPetInGroup(ctx context.Context, petID, groupID uuid.UUID) (bool, error) {
+	exists, err := r.activeMemberships().
+		Where(
+			membership.HasGroupWith(group.ID(groupID)),
+			withPet(petID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check pet group: %w", err)
+	}
+
+	return exists, nil
+}
+
+// GetUserPets retrieves the pets owned by a user
+func (r *AuthorizationRepository) GetUserPets(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	petIDs, err := r.client.Pet.
+		Query().
+		Where(pet.HasOwnerWith(user.ID(userID))).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user pets: %w", err)
+	}
+
+	return petIDs, nil
+}
+
+// GetUserGroups retrieves the groups a user is an active member of
+func (r *AuthorizationRepository) GetUserGroups(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	groupIDs, err := r.activeMemberships().
+		Where(membership.HasUserWith(user.ID(userID))).
+		QueryGroup().
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user groups: %w", err)
+	}
+
+	return groupIDs, nil
+}
+
+// GetPetGroups retrieves the groups an active membership brings a pet to, in one query
+func (r *AuthorizationRepository) GetPetGroups(ctx context.Context, petID uuid.UUID) ([]uuid.UUID, error) {
+	groupIDs, err := r.activeMemberships().
+		Where(withPet(petID)).
+		QueryGroup().
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pet groups: %w", err)
+	}
+
+	return groupIDs, nil
+}
+
+// GetAllGroups retrieves the IDs of every group
+func (r *AuthorizationRepository) GetAllGroups(ctx context.Context) ([]uuid.UUID, error) {
+	groupIDs, err := r.client.Group.Query().IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get groups: %w", err)
+	}
+
+	return groupIDs, nil
+}
+
+// GetAllUsers retrieves the IDs of every user
+func (r *AuthorizationRepository) GetAllUsers(ctx context.Context) ([]uuid.UUID, error) {
+	userIDs, err := r.client.User.Query().IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+
+	return userIDs, nil
+}
+
+// GetPetInfo retrieves basic pet information with its owner
+func (r *AuthorizationRepository) GetPetInfo(ctx context.Context, petID uuid.UUID) (*domain.PetInfo, error) {
+	entPet, err := r.client.Pet.
+		Query().
+		Where(pet.ID(petID)).
+		WithOwner().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("pet not found")
+		}
+		return nil, fmt.Errorf("failed to get pet: %w", err)
+	}
+
+	petInfo := &domain.PetInfo{
+		ID:       entPet.ID,
+		Name:     entPet.Name,
+		Species:  domain.Species(entPet.Species),
+		PhotoURL: entPet.PhotoURL,
+	}
+	if entPet.Edges.Owner != nil {
+		petInfo.OwnerID = entPet.Edges.Owner.ID
+	}
+
+	return petInfo, nil
+}
+
+// GetGroupInfo retrieves basic group information, its creator being the owner
+func (r *AuthorizationRepository) GetGroupInfo(ctx context.Context, groupID uuid.UUID) (*domain.GroupInfo, error) {
+	entGroup, err := r.client.Group.
+		Query().
+		Where(group.ID(groupID)).
+		WithCreator().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("group not found")
+		}
+		return nil, fmt.Errorf("failed to get group: %w", err)
+	}
+
+	groupInfo := &domain.GroupInfo{
+		ID:          entGroup.ID,
+		Name:        entGroup.Name,
+		Description: entGroup.Description,
+	}
+	if entGroup.Edges.Creator != nil {
+		groupInfo.OwnerID = entGroup.Edges.Creator.ID
+	}
+
+	return groupInfo, nil
+}
+
+// GetUserInfo retrieves basic user information
+func (r *AuthorizationRepository) GetUserInfo(ctx context.Context, userID uuid.UUID) (*domain.UserInfo, error) {
+	entUser, err := r.client.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("user not found")
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return &domain.UserInfo{
+		ID:   entUser.ID,
+		Name: entUser.FirstName + " " + entUser.LastName,
+	}, nil
+}
+
+// activeMemberships selects the memberships that were accepted and not left
+func (r *AuthorizationRepository) activeMemberships() *ent.MembershipQuery {
+	return r.client.Membership.
+		Query().
+		Where(membership.StatusEQ(membership.StatusActive))
+}
+
+// withPet matches the memberships that bring a pet to their group
+func withPet(petID uuid.UUID) predicate.Membership {
+	return predicate.Membership(func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(membership.FieldPetIds, petID.String()))
+	})
+}
@@ -0,0 +1,83 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/ent"
+	"pet-of-the-day/ent/backfillpolicy"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// BackfillPolicyRepository implements the domain.BackfillPolicyRepository interface using Ent ORM
+type BackfillPolicyRepository struct {
+	client *ent.Client
+}
+
+// NewBackfillPolicyRepository creates a new Ent-based backfill policy repository
+func NewBackfillPolicyRepository(client *ent.Client) *BackfillPolicyRepository {
+	return &BackfillPolicyRepository{
+		client: client,
+	}
+}
+
+// GetByGroup retrieves the policy of a group, the default policy if none was saved
+func (r *BackfillPolicyRepository) GetByGroup(ctx context.Context, groupID uuid.UUID) (*domain.BackfillPolicy, error) {
+	entPolicy, err := r.client.BackfillPolicy.
+		Query().
+		Where(backfillpolicy.GroupID(groupID)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.DefaultBackfillPolicy(groupID), nil
+		}
+		return nil, fmt.Errorf("failed to get backfill policy: %w", err)
+	}
+
+	return &domain.BackfillPolicy{
+		GroupID:          entPolicy.GroupID,
+		MaxBackfillHours: entPolicy.MaxBackfillHours,
+		AllowClosedDays:  entPolicy.AllowClosedDays,
+		RerunPetOfTheDay: entPolicy.RerunPetOfTheDay,
+		UpdatedBy:        entPolicy.UpdatedBy,
+		UpdatedAt:        entPolicy.UpdatedAt,
+	}, nil
+}
+
+// Save creates or replaces the policy of a group
+func (r *BackfillPolicyRepository) Save(ctx context.Context, policy *domain.BackfillPolicy) error {
+	updated, err := r.client.BackfillPolicy.
+		Update().
+		Where(backfillpolicy.GroupID(policy.GroupID)).
+		SetMaxBackfillHours(policy.MaxBackfillHours).
+		SetAllowClosedDays(policy.AllowClosedDays).
+		SetRerunPetOfTheDay(policy.RerunPetOfTheDay).
+		SetNillableUpdatedBy(policy.UpdatedBy).
+		SetUpdatedAt(policy.UpdatedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update backfill policy: %w", err)
+	}
+
+	if updated > 0 {
+		return nil
+	}
+
+	_, err = r.client.BackfillPolicy.
+		Create().
+		SetGroupID(policy.GroupID).
+		SetMaxBackfillHours(policy.MaxBackfillHours).
+		SetAllowClosedDays(policy.AllowClosedDays).
+		SetRerunPetOfTheDay(policy.RerunPetOfTheDay).
+		SetNillableUpdatedBy(policy.UpdatedBy).
+		SetUpdatedAt(policy.UpdatedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create backfill policy: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/ent"
+	"pet-of-the-day/ent/badge"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// BadgeRepository implements the domain.BadgeRepository interface using Ent ORM.
+// Badges are unique per holder and code, which makes awarding idempotent: the partial unique
+// indexes idx_badges_pet_code and idx_badges_user_code cover each scope, as the holder column
+// of the other scope is NULL.
+type BadgeRepository struct {
+	client *ent.Client
+}
+
+// NewBadgeRepository creates a new Ent-based badge repository
+func NewBadgeRepository(client *ent.Client) *BadgeRepository {
+	return &BadgeRepository{
+		client: client,
+	}
+}
+
+// Award saves a badge unless its holder already has it
+func (r *BadgeRepository) Award(ctx context.Context, b *domain.Badge) (bool, error) {
+	_, err := r.client.Badge.
+		Create().
+		SetID(b.ID).
+		SetCode(b.Code).
+		SetScope(string(b.Scope)).
+		SetNillablePetID(b.PetID).
+		SetNillableUserID(b.UserID).
+		SetAwardedAt(b.AwardedAt).
+		Save(ctx)
+
+	if err != nil {
+		// Unique index of the badge's scope: the badge was already awarded
+		if ent.IsConstraintError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to award badge: %w", err)
+	}
+
+	return true, nil
+}
+
+// GetByPet retrieves the badges awarded to a pet, oldest first
+func (r *BadgeRepository) GetByPet(ctx context.Context, petID uuid.UUID) ([]*domain.Badge, error) {
+	entBadges, err := r.client.Badge.
+		Query().
+		Where(badge.PetID(petID)).
+		Order(ent.Asc(badge.FieldAwardedAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pet badges: %w", err)
+	}
+
+	return r.entToDomainSlice(entBadges), nil
+}
+
+// GetByUser retrieves the badges awarded to a user, oldest first
+func (r *BadgeRepository) GetByUser(ctx context.Context, userID uuid.UUID) ([]*domain.Badge, error) {
+	entBadges, err := r.client.Badge.
+		Query().
+		Where(badge.UserID(userID)).
+		Order(ent.Asc(badge.FieldAwardedAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user badges: %w", err)
+	}
+
+	return r.entToDomainSlice(entBadges), nil
+}
+
+func (r *BadgeRepository) entToDomainSlice(entBadges []*ent.Badge) []*domain.Badge {
+	badges := make([]*domain.Badge, len(entBadges))
+	for i, entBadge := range entBadges {
+		badges[i] = &domain.Badge{
+			ID:        entBadge.ID,
+			Code:      entBadge.Code,
+			Scope:     domain.BadgeScope(entBadge.Scope),
+			PetID:     entBadge.PetID,
+			UserID:    entBadge.UserID,
+			AwardedAt: entBadge.AwardedAt,
+		}
+	}
+	return badges
+}
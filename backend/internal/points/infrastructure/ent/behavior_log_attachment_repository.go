@@ -0,0 +1,109 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/ent"
+	"pet-of-the-day/ent/behaviorlogattachment"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// BehaviorLogAttachmentRepository implements the domain.BehaviorLogAttachmentRepository interface using Ent ORM
+type BehaviorLogAttachmentRepository struct {
+	client *ent.Client
+}
+
+// NewBehaviorLogAttachmentRepository creates a new Ent-based behavior log attachment repository
+func NewBehaviorLogAttachmentRepository(client *ent.Client) *BehaviorLogAttachmentRepository {
+	return &BehaviorLogAttachmentRepository{
+		client: client,
+	}
+}
+
+// Create records a new attachment
+func (r *BehaviorLogAttachmentRepository) Create(ctx context.Context, attachment *domain.BehaviorLogAttachment) error {
+	_, err := r.client.BehaviorLogAttachment.
+		Create().
+		SetID(attachment.ID).
+		SetBehaviorLogID(attachment.BehaviorLogID).
+		SetUploadedBy(attachment.UploadedBy).
+		SetOriginalName(attachment.OriginalName).
+		SetMimeType(attachment.MimeType).
+		SetSize(attachment.Size).
+		SetPath(attachment.Path).
+		SetThumbnailPath(attachment.ThumbnailPath).
+		SetCreatedAt(attachment.CreatedAt).
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to create behavior log attachment: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves an attachment by ID
+func (r *BehaviorLogAttachmentRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.BehaviorLogAttachment, error) {
+	entAttachment, err := r.client.BehaviorLogAttachment.
+		Query().
+		Where(behaviorlogattachment.ID(id)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get behavior log attachment: %w", err)
+	}
+
+	return r.entToDomain(entAttachment), nil
+}
+
+// GetByBehaviorLog retrieves the attachments of a behavior log, oldest first
+func (r *BehaviorLogAttachmentRepository) GetByBehaviorLog(ctx context.Context, behaviorLogID uuid.UUID) ([]*domain.BehaviorLogAttachment, error) {
+	entAttachments, err := r.client.BehaviorLogAttachment.
+		Query().
+		Where(behaviorlogattachment.BehaviorLogID(behaviorLogID)).
+		Order(ent.Asc(behaviorlogattachment.FieldCreatedAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get behavior log attachments: %w", err)
+	}
+
+	attachments := make([]*domain.BehaviorLogAttachment, len(entAttachments))
+	for i, entAttachment := range entAttachments {
+		attachments[i] = r.entToDomain(entAttachment)
+	}
+
+	return attachments, nil
+}
+
+// Delete deletes an attachment
+func (r *BehaviorLogAttachmentRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.client.BehaviorLogAttachment.DeleteOneID(id).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("attachment not found")
+		}
+		return fmt.Errorf("failed to delete behavior log attachment: %w", err)
+	}
+
+	return nil
+}
+
+func (r *BehaviorLogAttachmentRepository) entToDomain(entAttachment *ent.BehaviorLogAttachment) *domain.BehaviorLogAttachment {
+	return &domain.BehaviorLogAttachment{
+		ID:            entAttachment.ID,
+		BehaviorLogID: entAttachment.BehaviorLogID,
+		UploadedBy:    entAttachment.UploadedBy,
+		OriginalName:  entAttachment.OriginalName,
+		MimeType:      entAttachment.MimeType,
+		Size:          entAttachment.Size,
+		Path:          entAttachment.Path,
+		ThumbnailPath: entAttachment.ThumbnailPath,
+		CreatedAt:     entAttachment.CreatedAt,
+	}
+}
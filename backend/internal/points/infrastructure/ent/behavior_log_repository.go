@@ -5,36 +5,71 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 
 	"pet-of-the-day/ent"
+	"pet-of-the-day/ent/behavior"
 	"pet-of-the-day/ent/behaviorlog"
 	"pet-of-the-day/ent/behaviorloggroupshare"
+	"pet-of-the-day/ent/groupbehavior"
+	"pet-of-the-day/ent/predicate"
 	"pet-of-the-day/internal/points/domain"
 )
 
-// BehaviorLogRepository implements the domain.BehaviorLogRepository interface using Ent ORM
+// BehaviorLogRepository implements the domain.BehaviorLogRepository interface using Ent ORM.
+// Breakdowns cover a group day, between two daily resets of the group.
 type BehaviorLogRepository struct {
-	client *ent.Client
+	client           *ent.Client
+	authRepo         domain.AuthorizationRepository
+	userSettingsRepo domain.UserSettingsRepository
 }
 
 // NewBehaviorLogRepository creates a new Ent-based behavior log repository
-func NewBehaviorLogRepository(client *ent.Client) *BehaviorLogRepository {
+func NewBehaviorLogRepository(
+	client *ent.Client,
+	authRepo domain.AuthorizationRepository,
+	userSettingsRepo domain.UserSettingsRepository,
+) *BehaviorLogRepository {
 	return &BehaviorLogRepository{
-		client: client,
+		client:           client,
+		authRepo:         authRepo,
+		userSettingsRepo: userSettingsRepo,
 	}
 }
 
 // Create creates a new behavior log with group shares
 func (r *BehaviorLogRepository) Create(ctx context.Context, domainBehaviorLog *domain.BehaviorLog) error {
+	return r.CreateBatch(ctx, []*domain.BehaviorLog{domainBehaviorLog})
+}
+
+// CreateBatch creates behavior logs with their group shares in a single transaction
+func (r *BehaviorLogRepository) CreateBatch(ctx context.Context, domainBehaviorLogs []*domain.BehaviorLog) error {
 	// Start a transaction
 	tx, err := r.client.Tx(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to start transaction: %w", err)
 	}
 
+	for _, domainBehaviorLog := range domainBehaviorLogs {
+		if err := r.createInTx(ctx, tx, domainBehaviorLog); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// createInTx creates a behavior log and its group shares within a transaction
+func (r *BehaviorLogRepository) createInTx(ctx context.Context, tx *ent.Tx, domainBehaviorLog *domain.BehaviorLog) error {
 	// Create the behavior log
-	_, err = tx.BehaviorLog.
+	_, err := tx.BehaviorLog.
 		Create().
 		SetID(domainBehaviorLog.ID).
 		SetPetID(domainBehaviorLog.PetID).
@@ -47,7 +82,6 @@ func (r *BehaviorLogRepository) Create(ctx context.Context, domainBehaviorLog *d
 		Save(ctx)
 
 	if err != nil {
-		tx.Rollback()
 		return fmt.Errorf("failed to create behavior log: %w", err)
 	}
 
@@ -58,20 +92,19 @@ func (r *BehaviorLogRepository) Create(ctx context.Context, domainBehaviorLog *d
 			SetID(groupShare.ID).
 			SetBehaviorLogID(groupShare.BehaviorLogID).
 			SetGroupID(groupShare.GroupID).
+			SetPointsAwarded(groupShare.PointsAwarded).
+			SetStatus(string(groupShare.CurrentStatus())).
+			SetNillableReviewedBy(groupShare.ReviewedBy).
+			SetNillableReviewedAt(groupShare.ReviewedAt).
+			SetDisputeReason(groupShare.DisputeReason).
 			SetCreatedAt(groupShare.CreatedAt).
 			Save(ctx)
 
 		if err != nil {
-			tx.Rollback()
 			return fmt.Errorf("failed to create group share: %w", err)
 		}
 	}
 
-	// Commit transaction
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
-	}
-
 	return nil
 }
 
@@ -85,7 +118,7 @@ func (r *BehaviorLogRepository) GetByID(ctx context.Context, id uuid.UUID) (*dom
 
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, fmt.Errorf("behavior log not found")
+			return nil, domain.ErrBehaviorLogNotFound
 		}
 		return nil, fmt.Errorf("failed to get behavior log: %w", err)
 	}
@@ -93,11 +126,58 @@ func (r *BehaviorLogRepository) GetByID(ctx context.Context, id uuid.UUID) (*dom
 	return r.entToDomain(entBehaviorLog), nil
 }
 
+// FindExistingIDs retrieves which of the given IDs belong to a behavior log, in one query
+func (r *BehaviorLogRepository) FindExistingIDs(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error) {
+	if len(ids) == 0 {
+		return []uuid.UUID{}, nil
+	}
+
+	existing, err := r.client.BehaviorLog.
+		Query().
+		Where(behaviorlog.IDIn(ids...)).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find behavior log IDs: %w", err)
+	}
+
+	return existing, nil
+}
+
 // Find retrieves behavior logs based on filter criteria
 func (r *BehaviorLogRepository) Find(ctx context.Context, filter *domain.BehaviorLogFilter) ([]*domain.BehaviorLog, error) {
-	query := r.client.BehaviorLog.Query().WithGroupShares()
+	query := r.applyFilter(r.client.BehaviorLog.Query().WithGroupShares(), filter)
+
+	// Apply pagination
+	query = query.Limit(filter.Limit).Offset(filter.Offset)
+
+	// Order by logged_at descending
+	query = query.Order(ent.Desc(behaviorlog.FieldLoggedAt))
+
+	entBehaviorLogs, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find behavior logs: %w", err)
+	}
+
+	behaviorLogs := make([]*domain.BehaviorLog, len(entBehaviorLogs))
+	for i, entBehaviorLog := range entBehaviorLogs {
+		behaviorLogs[i] = r.entToDomain(entBehaviorLog)
+	}
+
+	return behaviorLogs, nil
+}
+
+// Count counts the behavior logs matching the filter criteria, ignoring pagination
+func (r *BehaviorLogRepository) Count(ctx context.Context, filter *domain.BehaviorLogFilter) (int, error) {
+	count, err := r.applyFilter(r.client.BehaviorLog.Query(), filter).Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count behavior logs: %w", err)
+	}
+
+	return count, nil
+}
 
-	// Apply filters
+// applyFilter adds the criteria of a filter, except pagination, to a behavior log query
+func (r *BehaviorLogRepository) applyFilter(query *ent.BehaviorLogQuery, filter *domain.BehaviorLogFilter) *ent.BehaviorLogQuery {
 	if filter.PetID != nil {
 		query = query.Where(behaviorlog.PetID(*filter.PetID))
 	}
@@ -125,23 +205,14 @@ func (r *BehaviorLogRepository) Find(ctx context.Context, filter *domain.Behavio
 		))
 	}
 
-	// Apply pagination
-	query = query.Limit(filter.Limit).Offset(filter.Offset)
-
-	// Order by logged_at descending
-	query = query.Order(ent.Desc(behaviorlog.FieldLoggedAt))
-
-	entBehaviorLogs, err := query.All(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to find behavior logs: %w", err)
-	}
-
-	behaviorLogs := make([]*domain.BehaviorLog, len(entBehaviorLogs))
-	for i, entBehaviorLog := range entBehaviorLogs {
-		behaviorLogs[i] = r.entToDomain(entBehaviorLog)
+	if filter.GroupID != nil && filter.ShareStatus != nil {
+		query = query.Where(behaviorlog.HasGroupSharesWith(
+			behaviorloggroupshare.GroupID(*filter.GroupID),
+			behaviorloggroupshare.Status(string(*filter.ShareStatus)),
+		))
 	}
 
-	return behaviorLogs, nil
+	return query
 }
 
 // Update updates an existing behavior log
@@ -181,6 +252,11 @@ func (r *BehaviorLogRepository) Update(ctx context.Context, domainBehaviorLog *d
 			SetID(groupShare.ID).
 			SetBehaviorLogID(groupShare.BehaviorLogID).
 			SetGroupID(groupShare.GroupID).
+			SetPointsAwarded(groupShare.PointsAwarded).
+			SetStatus(string(groupShare.CurrentStatus())).
+			SetNillableReviewedBy(groupShare.ReviewedBy).
+			SetNillableReviewedAt(groupShare.ReviewedAt).
+			SetDisputeReason(groupShare.DisputeReason).
 			SetCreatedAt(groupShare.CreatedAt).
 			Save(ctx)
 
@@ -198,6 +274,38 @@ func (r *BehaviorLogRepository) Update(ctx context.Context, domainBehaviorLog *d
 	return nil
 }
 
+// UpdateGroupShare updates the review state of a group share if its status is still the
+// expected one, so concurrent reviews of the same share cannot overwrite each other
+func (r *BehaviorLogRepository) UpdateGroupShare(ctx context.Context, share *domain.BehaviorLogGroupShare, expectedStatus domain.ShareStatus) error {
+	statuses := []string{string(expectedStatus)}
+	if expectedStatus == domain.ShareStatusVerified {
+		// Shares recorded before verification existed have no status
+		statuses = append(statuses, "")
+	}
+
+	updated, err := r.client.BehaviorLogGroupShare.
+		Update().
+		Where(
+			behaviorloggroupshare.BehaviorLogID(share.BehaviorLogID),
+			behaviorloggroupshare.GroupID(share.GroupID),
+			behaviorloggroupshare.StatusIn(statuses...),
+		).
+		SetStatus(string(share.CurrentStatus())).
+		SetNillableReviewedBy(share.ReviewedBy).
+		SetNillableReviewedAt(share.ReviewedAt).
+		SetDisputeReason(share.DisputeReason).
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to update group share: %w", err)
+	}
+	if updated == 0 {
+		return domain.ErrShareStatusChanged
+	}
+
+	return nil
+}
+
 // Delete deletes a behavior log (hard delete for data integrity)
 func (r *BehaviorLogRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	// Start a transaction
@@ -253,66 +361,105 @@ func (r *BehaviorLogRepository) GetLastLoggedAt(ctx context.Context, petID, beha
 	return &entBehaviorLog.LoggedAt, nil
 }
 
-// GetBreakdown retrieves behavior breakdown for daily score calculation
+// GetBreakdown aggregates per behavior the logs counted toward a pet's daily score in a group,
+// with the points awarded in the group, highest totals first. Custom group behaviors are named
+// from the group's catalog, missing behaviors are named Unknown.
 func (r *BehaviorLogRepository) GetBreakdown(ctx context.Context, petID uuid.UUID, groupID uuid.UUID, date time.Time) ([]*domain.DailyScoreBreakdown, error) {
-	// Calculate date boundaries (start and end of day)
-	startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
-	endOfDay := startOfDay.Add(24 * time.Hour)
+	breakdowns, err := r.groupBreakdowns(ctx, groupID, date, behaviorlog.PetID(petID))
+	if err != nil {
+		return nil, err
+	}
 
-	// Query behavior logs with behavior details
-	rows, err := r.client.BehaviorLog.
-		Query().
-		Where(
-			behaviorlog.PetID(petID),
-			behaviorlog.LoggedAtGTE(startOfDay),
-			behaviorlog.LoggedAtLT(endOfDay),
-			behaviorlog.HasGroupSharesWith(
-				behaviorloggroupshare.GroupID(groupID),
-			),
-		).
-		WithBehavior().
-		All(ctx)
+	breakdown, exists := breakdowns[petID]
+	if !exists {
+		return make([]*domain.DailyScoreBreakdown, 0), nil
+	}
+	return breakdown, nil
+}
+
+// GetGroupBreakdowns aggregates the breakdown of every pet with logs counted in a group on a
+// day in one query, like GetBreakdown for each of them
+func (r *BehaviorLogRepository) GetGroupBreakdowns(ctx context.Context, groupID uuid.UUID, date time.Time) (map[uuid.UUID][]*domain.DailyScoreBreakdown, error) {
+	return r.groupBreakdowns(ctx, groupID, date)
+}
 
+// groupBreakdowns aggregates per pet and behavior the logs of a group day that match the
+// predicates, highest totals first
+func (r *BehaviorLogRepository) groupBreakdowns(ctx context.Context, groupID uuid.UUID, date time.Time, predicates ...predicate.BehaviorLog) (map[uuid.UUID][]*domain.DailyScoreBreakdown, error) {
+	boundary, err := groupDayBoundary(ctx, r.authRepo, r.userSettingsRepo, groupID, date)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get behavior breakdown: %w", err)
+		return nil, err
 	}
 
-	// Aggregate by behavior ID
-	breakdown := make(map[uuid.UUID]*domain.DailyScoreBreakdown)
-
-	for _, entBehaviorLog := range rows {
-		behaviorID := entBehaviorLog.BehaviorID
-		
-		if existing, exists := breakdown[behaviorID]; exists {
-			existing.Count++
-			existing.TotalPoints += entBehaviorLog.PointsAwarded
-		} else {
-			behaviorName := "Unknown"
-			behaviorCategory := domain.BehaviorCategoryPottyTraining
-			
-			if entBehaviorLog.Edges.Behavior != nil {
-				behaviorName = entBehaviorLog.Edges.Behavior.Name
-				behaviorCategory = domain.BehaviorCategory(entBehaviorLog.Edges.Behavior.Category)
-			}
+	var rows []struct {
+		PetID       uuid.UUID `json:"pet_id"`
+		BehaviorID  uuid.UUID `json:"behavior_id"`
+		Name        string    `json:"name"`
+		Category    string    `json:"category"`
+		Count       int       `json:"count"`
+		TotalPoints int       `json:"total_points"`
+	}
 
-			breakdown[behaviorID] = &domain.DailyScoreBreakdown{
-				BehaviorID:        behaviorID,
-				BehaviorName:      behaviorName,
-				BehaviorCategory:  behaviorCategory,
-				Count:             1,
-				PointsPerInstance: entBehaviorLog.PointsAwarded,
-				TotalPoints:       entBehaviorLog.PointsAwarded,
-			}
-		}
+	// A log at the reset time belongs to the day that ends with it
+	err = r.client.BehaviorLog.
+		Query().
+		Where(
+			behaviorlog.LoggedAtGT(boundary.Start),
+			behaviorlog.LoggedAtLTE(boundary.End),
+		).
+		Where(predicates...).
+		Modify(func(s *sql.Selector) {
+			shares := sql.Table(behaviorloggroupshare.Table)
+			behaviors := sql.Table(behavior.Table)
+			groupBehaviors := sql.Table(groupbehavior.Table)
+			s.Join(shares).On(s.C(behaviorlog.FieldID), shares.C(behaviorloggroupshare.FieldBehaviorLogID)).
+				LeftJoin(behaviors).On(s.C(behaviorlog.FieldBehaviorID), behaviors.C(behavior.FieldID)).
+				LeftJoin(groupBehaviors).On(s.C(behaviorlog.FieldBehaviorID), groupBehaviors.C(groupbehavior.FieldID))
+
+			// Only shares counted in the group, those recorded before verification have no status
+			status := shares.C(behaviorloggroupshare.FieldStatus)
+			s.Where(sql.And(
+				sql.EQ(shares.C(behaviorloggroupshare.FieldGroupID), groupID),
+				sql.Or(
+					sql.IsNull(status),
+					sql.EQ(status, ""),
+					sql.EQ(status, string(domain.ShareStatusVerified)),
+				),
+			))
+
+			// Shares without their own points count the log's default, like PointsForGroup
+			sharePoints := shares.C(behaviorloggroupshare.FieldPointsAwarded)
+			points := "CASE WHEN " + sharePoints + " <> 0 THEN " + sharePoints + " ELSE " + s.C(behaviorlog.FieldPointsAwarded) + " END"
+			s.Select(
+				sql.As(s.C(behaviorlog.FieldPetID), "pet_id"),
+				sql.As(s.C(behaviorlog.FieldBehaviorID), "behavior_id"),
+				sql.As("COALESCE(MAX("+behaviors.C(behavior.FieldName)+"), MAX("+groupBehaviors.C(groupbehavior.FieldName)+"), 'Unknown')", "name"),
+				sql.As("COALESCE(MAX("+behaviors.C(behavior.FieldCategory)+"), MAX("+groupBehaviors.C(groupbehavior.FieldCategory)+"), '')", "category"),
+				sql.As(sql.Count("*"), "count"),
+				sql.As("COALESCE(SUM("+points+"), 0)", "total_points"),
+			).
+				GroupBy(s.C(behaviorlog.FieldPetID), s.C(behaviorlog.FieldBehaviorID)).
+				OrderBy(sql.Desc("total_points"))
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get behavior breakdown: %w", err)
 	}
 
-	// Convert map to slice
-	result := make([]*domain.DailyScoreBreakdown, 0, len(breakdown))
-	for _, item := range breakdown {
-		result = append(result, item)
+	breakdowns := make(map[uuid.UUID][]*domain.DailyScoreBreakdown)
+	for _, row := range rows {
+		breakdowns[row.PetID] = append(breakdowns[row.PetID], &domain.DailyScoreBreakdown{
+			BehaviorID:       row.BehaviorID,
+			BehaviorName:     row.Name,
+			BehaviorCategory: domain.BehaviorCategory(row.Category),
+			Count:            row.Count,
+			// The average when the group changed the behavior's points during the day
+			PointsPerInstance: row.TotalPoints / row.Count,
+			TotalPoints:       row.TotalPoints,
+		})
 	}
 
-	return result, nil
+	return breakdowns, nil
 }
 
 // CountByDateRange counts behavior logs within a date range
@@ -344,7 +491,7 @@ func (r *BehaviorLogRepository) GetByGroup(ctx context.Context, groupID uuid.UUI
 	return r.Find(ctx, filter)
 }
 
-// CleanupOldLogs removes behavior logs older than the retention period (6 months)
+// CleanupOldLogs removes behavior logs logged before the cutoff date
 func (r *BehaviorLogRepository) CleanupOldLogs(ctx context.Context, cutoffDate time.Time) (int, error) {
 	// Start a transaction
 	tx, err := r.client.Tx(ctx)
@@ -355,7 +502,7 @@ func (r *BehaviorLogRepository) CleanupOldLogs(ctx context.Context, cutoffDate t
 	// Get behavior log IDs to delete
 	oldLogs, err := tx.BehaviorLog.
 		Query().
-		Where(behaviorlog.CreatedAtLT(cutoffDate)).
+		Where(behaviorlog.LoggedAtLT(cutoffDate)).
 		Select(behaviorlog.FieldID).
 		All(ctx)
 
@@ -426,6 +573,11 @@ func (r *BehaviorLogRepository) entToDomain(entBehaviorLog *ent.BehaviorLog) *do
 				ID:            entGroupShare.ID,
 				BehaviorLogID: entGroupShare.BehaviorLogID,
 				GroupID:       entGroupShare.GroupID,
+				PointsAwarded: entGroupShare.PointsAwarded,
+				Status:        domain.ShareStatus(entGroupShare.Status),
+				ReviewedBy:    entGroupShare.ReviewedBy,
+				ReviewedAt:    entGroupShare.ReviewedAt,
+				DisputeReason: entGroupShare.DisputeReason,
 				CreatedAt:     entGroupShare.CreatedAt,
 			}
 			domainBehaviorLog.GroupShares = append(domainBehaviorLog.GroupShares, domainGroupShare)
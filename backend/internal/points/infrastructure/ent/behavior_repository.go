@@ -73,7 +73,7 @@ func (r *BehaviorRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain
 
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, fmt.Errorf("behavior not found")
+			return nil, domain.ErrBehaviorNotFound
 		}
 		return nil, fmt.Errorf("failed to get behavior: %w", err)
 	}
@@ -224,7 +224,7 @@ func (r *BehaviorRepository) Delete(ctx context.Context, id uuid.UUID) error {
 func (r *BehaviorRepository) GetByName(ctx context.Context, name string) (*domain.Behavior, error) {
 	entBehavior, err := r.client.Behavior.
 		Query().
-		Where(behavior.Name(name)).
+		Where(behavior.NameEqualFold(name)).
 		First(ctx)
 
 	if err != nil {
@@ -237,6 +237,38 @@ func (r *BehaviorRepository) GetByName(ctx context.Context, name string) (*domai
 	return r.entToDomain(entBehavior), nil
 }
 
+// GetAllIncludingInactive retrieves every behavior of the catalog, including deactivated ones
+func (r *BehaviorRepository) GetAllIncludingInactive(ctx context.Context) ([]*domain.Behavior, error) {
+	entBehaviors, err := r.client.Behavior.
+		Query().
+		Order(ent.Asc(behavior.FieldCategory), ent.Asc(behavior.FieldName)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get behaviors: %w", err)
+	}
+
+	behaviors := make([]*domain.Behavior, len(entBehaviors))
+	for i, entBehavior := range entBehaviors {
+		behaviors[i] = r.entToDomain(entBehavior)
+	}
+
+	return behaviors, nil
+}
+
+// HardDelete permanently removes a behavior (only for behaviors that were never logged)
+func (r *BehaviorRepository) HardDelete(ctx context.Context, id uuid.UUID) error {
+	err := r.client.Behavior.
+		DeleteOneID(id).
+		Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to hard delete behavior: %w", err)
+	}
+
+	return nil
+}
+
 // entToDomain converts an Ent behavior entity to a domain behavior
 func (r *BehaviorRepository) entToDomain(entBehavior *ent.Behavior) *domain.Behavior {
 	return &domain.Behavior{
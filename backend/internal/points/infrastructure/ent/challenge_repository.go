@@ -0,0 +1,274 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/ent"
+	"pet-of-the-day/ent/challenge"
+	"pet-of-the-day/ent/challengeprogress"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// ChallengeRepository implements the domain.ChallengeRepository interface using Ent ORM
+type ChallengeRepository struct {
+	client *ent.Client
+}
+
+// NewChallengeRepository creates a new Ent-based challenge repository
+func NewChallengeRepository(client *ent.Client) *ChallengeRepository {
+	return &ChallengeRepository{
+		client: client,
+	}
+}
+
+// Create creates a new challenge
+func (r *ChallengeRepository) Create(ctx context.Context, domainChallenge *domain.Challenge) error {
+	create := r.client.Challenge.
+		Create().
+		SetID(domainChallenge.ID).
+		SetGroupID(domainChallenge.GroupID).
+		SetStatus(challenge.Status(domainChallenge.Status)).
+		SetCreatedBy(domainChallenge.CreatedBy).
+		SetCreatedAt(domainChallenge.CreatedAt)
+
+	r.setDefinition(create.Mutation(), domainChallenge)
+
+	if _, err := create.Save(ctx); err != nil {
+		return fmt.Errorf("failed to create challenge: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a challenge by ID
+func (r *ChallengeRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Challenge, error) {
+	entChallenge, err := r.client.Challenge.
+		Query().
+		Where(challenge.ID(id)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get challenge: %w", err)
+	}
+
+	return r.entToDomain(entChallenge), nil
+}
+
+// GetByGroup retrieves the challenges of a group, latest deadline first
+func (r *ChallengeRepository) GetByGroup(ctx context.Context, groupID uuid.UUID) ([]*domain.Challenge, error) {
+	entChallenges, err := r.client.Challenge.
+		Query().
+		Where(challenge.GroupID(groupID)).
+		Order(ent.Desc(challenge.FieldEndsAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group challenges: %w", err)
+	}
+
+	return r.entToDomainSlice(entChallenges), nil
+}
+
+// GetDue retrieves the active challenges whose deadline has passed
+func (r *ChallengeRepository) GetDue(ctx context.Context, now time.Time) ([]*domain.Challenge, error) {
+	entChallenges, err := r.client.Challenge.
+		Query().
+		Where(
+			challenge.StatusEQ(challenge.StatusActive),
+			challenge.EndsAtLTE(now),
+		).
+		Order(ent.Asc(challenge.FieldEndsAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due challenges: %w", err)
+	}
+
+	return r.entToDomainSlice(entChallenges), nil
+}
+
+// Update updates an existing challenge
+func (r *ChallengeRepository) Update(ctx context.Context, domainChallenge *domain.Challenge) error {
+	update := r.client.Challenge.
+		UpdateOneID(domainChallenge.ID).
+		SetStatus(challenge.Status(domainChallenge.Status)).
+		SetNillableClosedAt(domainChallenge.ClosedAt)
+
+	if domainChallenge.Target.BehaviorID == nil {
+		update.ClearBehaviorID()
+	}
+	if domainChallenge.Target.Category == nil {
+		update.ClearCategory()
+	}
+
+	r.setDefinition(update.Mutation(), domainChallenge)
+
+	if _, err := update.Save(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("challenge not found")
+		}
+		return fmt.Errorf("failed to update challenge: %w", err)
+	}
+
+	return nil
+}
+
+// Delete deletes a challenge and the progress of its pets
+func (r *ChallengeRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ChallengeProgress.
+		Delete().
+		Where(challengeprogress.ChallengeID(id)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete challenge progress: %w", err)
+	}
+
+	if err := tx.Challenge.DeleteOneID(id).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("challenge not found")
+		}
+		return fmt.Errorf("failed to delete challenge: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// GetProgress retrieves the progress of every pet taking part in a challenge
+func (r *ChallengeRepository) GetProgress(ctx context.Context, challengeID uuid.UUID) ([]*domain.ChallengeProgress, error) {
+	entProgress, err := r.client.ChallengeProgress.
+		Query().
+		Where(challengeprogress.ChallengeID(challengeID)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get challenge progress: %w", err)
+	}
+
+	progress := make([]*domain.ChallengeProgress, len(entProgress))
+	for i, entPetProgress := range entProgress {
+		progress[i] = &domain.ChallengeProgress{
+			ID:           entPetProgress.ID,
+			ChallengeID:  entPetProgress.ChallengeID,
+			PetID:        entPetProgress.PetID,
+			Count:        entPetProgress.Count,
+			Points:       entPetProgress.Points,
+			Outcome:      domain.ChallengeOutcome(entPetProgress.Outcome),
+			BonusAwarded: entPetProgress.BonusAwarded,
+			UpdatedAt:    entPetProgress.UpdatedAt,
+		}
+	}
+
+	return progress, nil
+}
+
+// SaveProgress creates or replaces the progress of a pet in a challenge
+func (r *ChallengeRepository) SaveProgress(ctx context.Context, progress *domain.ChallengeProgress) error {
+	updated, err := r.client.ChallengeProgress.
+		Update().
+		Where(
+			challengeprogress.ChallengeID(progress.ChallengeID),
+			challengeprogress.PetID(progress.PetID),
+		).
+		SetCount(progress.Count).
+		SetPoints(progress.Points).
+		SetOutcome(string(progress.Outcome)).
+		SetBonusAwarded(progress.BonusAwarded).
+		SetUpdatedAt(progress.UpdatedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update challenge progress: %w", err)
+	}
+
+	if updated > 0 {
+		return nil
+	}
+
+	_, err = r.client.ChallengeProgress.
+		Create().
+		SetID(progress.ID).
+		SetChallengeID(progress.ChallengeID).
+		SetPetID(progress.PetID).
+		SetCount(progress.Count).
+		SetPoints(progress.Points).
+		SetOutcome(string(progress.Outcome)).
+		SetBonusAwarded(progress.BonusAwarded).
+		SetUpdatedAt(progress.UpdatedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create challenge progress: %w", err)
+	}
+
+	return nil
+}
+
+// setDefinition sets the fields an admin can change on a create or update mutation
+func (r *ChallengeRepository) setDefinition(m *ent.ChallengeMutation, c *domain.Challenge) {
+	m.SetName(c.Name)
+	m.SetDescription(c.Description)
+	if c.Target.BehaviorID != nil {
+		m.SetBehaviorID(*c.Target.BehaviorID)
+	}
+	if c.Target.Category != nil {
+		m.SetCategory(string(*c.Target.Category))
+	}
+	m.SetPolarity(string(c.Target.Polarity))
+	m.SetMetric(string(c.Target.Metric))
+	m.SetGoal(string(c.Target.Goal))
+	m.SetTargetValue(c.Target.Value)
+	m.SetStartsAt(c.StartsAt)
+	m.SetEndsAt(c.EndsAt)
+	m.SetBonusPoints(c.BonusPoints)
+	m.SetUpdatedAt(c.UpdatedAt)
+}
+
+func (r *ChallengeRepository) entToDomain(entChallenge *ent.Challenge) *domain.Challenge {
+	var category *domain.BehaviorCategory
+	if entChallenge.Category != nil {
+		value := domain.BehaviorCategory(*entChallenge.Category)
+		category = &value
+	}
+
+	return &domain.Challenge{
+		ID:          entChallenge.ID,
+		GroupID:     entChallenge.GroupID,
+		Name:        entChallenge.Name,
+		Description: entChallenge.Description,
+		Target: domain.ChallengeTarget{
+			BehaviorID: entChallenge.BehaviorID,
+			Category:   category,
+			Polarity:   domain.ChallengePolarity(entChallenge.Polarity),
+			Metric:     domain.ChallengeMetric(entChallenge.Metric),
+			Goal:       domain.ChallengeGoal(entChallenge.Goal),
+			Value:      entChallenge.TargetValue,
+		},
+		StartsAt:    entChallenge.StartsAt,
+		EndsAt:      entChallenge.EndsAt,
+		BonusPoints: entChallenge.BonusPoints,
+		Status:      domain.ChallengeStatus(entChallenge.Status),
+		CreatedBy:   entChallenge.CreatedBy,
+		CreatedAt:   entChallenge.CreatedAt,
+		UpdatedAt:   entChallenge.UpdatedAt,
+		ClosedAt:    entChallenge.ClosedAt,
+	}
+}
+
+func (r *ChallengeRepository) entToDomainSlice(entChallenges []*ent.Challenge) []*domain.Challenge {
+	challenges := make([]*domain.Challenge, len(entChallenges))
+	for i, entChallenge := range entChallenges {
+		challenges[i] = r.entToDomain(entChallenge)
+	}
+	return challenges
+}
@@ -0,0 +1,142 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/ent"
+	"pet-of-the-day/ent/comment"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// CommentRepository implements the domain.CommentRepository interface using Ent ORM
+type CommentRepository struct {
+	client *ent.Client
+}
+
+// NewCommentRepository creates a new Ent-based comment repository
+func NewCommentRepository(client *ent.Client) *CommentRepository {
+	return &CommentRepository{
+		client: client,
+	}
+}
+
+// Create creates a new comment
+func (r *CommentRepository) Create(ctx context.Context, domainComment *domain.Comment) error {
+	_, err := r.client.Comment.
+		Create().
+		SetID(domainComment.ID).
+		SetBehaviorLogID(domainComment.BehaviorLogID).
+		SetGroupID(domainComment.GroupID).
+		SetUserID(domainComment.UserID).
+		SetNillableParentID(domainComment.ParentID).
+		SetBody(domainComment.Body).
+		SetCreatedAt(domainComment.CreatedAt).
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to create comment: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a comment by ID
+func (r *CommentRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Comment, error) {
+	entComment, err := r.client.Comment.
+		Query().
+		Where(comment.ID(id)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get comment: %w", err)
+	}
+
+	return r.entToDomain(entComment), nil
+}
+
+// Find retrieves comments based on filter criteria, oldest first
+func (r *CommentRepository) Find(ctx context.Context, filter *domain.CommentFilter) ([]*domain.Comment, error) {
+	entComments, err := r.query(filter).
+		Order(ent.Asc(comment.FieldCreatedAt)).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to find comments: %w", err)
+	}
+
+	comments := make([]*domain.Comment, len(entComments))
+	for i, entComment := range entComments {
+		comments[i] = r.entToDomain(entComment)
+	}
+
+	return comments, nil
+}
+
+// Count returns the number of comments matching the filter, ignoring pagination
+func (r *CommentRepository) Count(ctx context.Context, filter *domain.CommentFilter) (int, error) {
+	count, err := r.query(filter).Count(ctx)
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to count comments: %w", err)
+	}
+
+	return count, nil
+}
+
+// Update updates an existing comment
+func (r *CommentRepository) Update(ctx context.Context, domainComment *domain.Comment) error {
+	_, err := r.client.Comment.
+		UpdateOneID(domainComment.ID).
+		SetBody(domainComment.Body).
+		SetNillableEditedAt(domainComment.EditedAt).
+		SetNillableDeletedAt(domainComment.DeletedAt).
+		SetNillableDeletedBy(domainComment.DeletedBy).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("comment not found")
+		}
+		return fmt.Errorf("failed to update comment: %w", err)
+	}
+
+	return nil
+}
+
+// query builds the query of the comments matching a filter, without pagination
+func (r *CommentRepository) query(filter *domain.CommentFilter) *ent.CommentQuery {
+	query := r.client.Comment.Query()
+
+	// Apply filters
+	if filter.BehaviorLogID != nil {
+		query = query.Where(comment.BehaviorLogID(*filter.BehaviorLogID))
+	}
+	if filter.GroupID != nil {
+		query = query.Where(comment.GroupID(*filter.GroupID))
+	}
+
+	return query
+}
+
+func (r *CommentRepository) entToDomain(entComment *ent.Comment) *domain.Comment {
+	return &domain.Comment{
+		ID:            entComment.ID,
+		BehaviorLogID: entComment.BehaviorLogID,
+		GroupID:       entComment.GroupID,
+		UserID:        entComment.UserID,
+		ParentID:      entComment.ParentID,
+		Body:          entComment.Body,
+		CreatedAt:     entComment.CreatedAt,
+		EditedAt:      entComment.EditedAt,
+		DeletedAt:     entComment.DeletedAt,
+		DeletedBy:     entComment.DeletedBy,
+	}
+}
@@ -0,0 +1,90 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/ent"
+	"pet-of-the-day/ent/groupresetstate"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// DailyResetStateRepository implements the domain.DailyResetStateRepository interface using Ent ORM
+type DailyResetStateRepository struct {
+	client *ent.Client
+}
+
+// NewDailyResetStateRepository creates a new Ent-based daily reset state repository
+func NewDailyResetStateRepository(client *ent.Client) *DailyResetStateRepository {
+	return &DailyResetStateRepository{
+		client: client,
+	}
+}
+
+// GetByGroup retrieves the reset state for a group (nil if the group was never reset)
+func (r *DailyResetStateRepository) GetByGroup(ctx context.Context, groupID uuid.UUID) (*domain.GroupResetState, error) {
+	entState, err := r.client.GroupResetState.
+		Query().
+		Where(groupresetstate.GroupID(groupID)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil // Group has never been reset
+		}
+		return nil, fmt.Errorf("failed to get group reset state: %w", err)
+	}
+
+	return &domain.GroupResetState{
+		GroupID:       entState.GroupID,
+		LastResetDate: entState.LastResetDate,
+		LastRunAt:     entState.LastRunAt,
+		UpdatedAt:     entState.UpdatedAt,
+	}, nil
+}
+
+// Save creates or updates the reset state for a group. The update and the create run in one
+// transaction, and the unique index on group_id rejects a concurrent create.
+func (r *DailyResetStateRepository) Save(ctx context.Context, state *domain.GroupResetState) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	updated, err := tx.GroupResetState.
+		Update().
+		Where(groupresetstate.GroupID(state.GroupID)).
+		SetLastResetDate(state.LastResetDate).
+		SetLastRunAt(state.LastRunAt).
+		SetUpdatedAt(state.UpdatedAt).
+		Save(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to update group reset state: %w", err)
+	}
+
+	if updated == 0 {
+		_, err = tx.GroupResetState.
+			Create().
+			SetGroupID(state.GroupID).
+			SetLastResetDate(state.LastResetDate).
+			SetLastRunAt(state.LastRunAt).
+			SetUpdatedAt(state.UpdatedAt).
+			Save(ctx)
+
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to create group reset state: %w", err)
+		}
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
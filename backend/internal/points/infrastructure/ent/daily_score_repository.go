@@ -0,0 +1,553 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/ent"
+	"pet-of-the-day/ent/behaviorlog"
+	"pet-of-the-day/ent/behaviorloggroupshare"
+	"pet-of-the-day/ent/dailyscore"
+	"pet-of-the-day/ent/pet"
+	"pet-of-the-day/ent/pointadjustment"
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/timezone"
+)
+
+// DailyScoreRepository implements the domain.DailyScoreRepository interface using Ent ORM.
+// A daily score is unique per pet, group and date (idx_daily_scores_pet_group_date). Its day
+// covers the logs between two daily resets of the group, see domain.GetGroupTimezoneSettings.
+type DailyScoreRepository struct {
+	client           *ent.Client
+	authRepo         domain.AuthorizationRepository
+	userSettingsRepo domain.UserSettingsRepository
+	behaviorLogs     *BehaviorLogRepository
+	adjustments      *PointAdjustmentRepository
+}
+
+// NewDailyScoreRepository creates a new Ent-based daily score repository
+func NewDailyScoreRepository(
+	client *ent.Client,
+	authRepo domain.AuthorizationRepository,
+	userSettingsRepo domain.UserSettingsRepository,
+) *DailyScoreRepository {
+	return &DailyScoreRepository{
+		client:           client,
+		authRepo:         authRepo,
+		userSettingsRepo: userSettingsRepo,
+		behaviorLogs:     NewBehaviorLogRepository(client, authRepo, userSettingsRepo),
+		adjustments:      NewPointAdjustmentRepository(client),
+	}
+}
+
+// Create creates a new daily score entry
+func (r *DailyScoreRepository) Create(ctx context.Context, dailyScore *domain.DailyScore) error {
+	return r.createWith(ctx, r.client.DailyScore, dailyScore)
+}
+
+// GetByID retrieves a daily score by ID
+func (r *DailyScoreRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.DailyScore, error) {
+	entDailyScore, err := r.client.DailyScore.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get daily score: %w", err)
+	}
+
+	return r.entToDomain(entDailyScore), nil
+}
+
+// GetOrCreate retrieves an existing daily score or creates a new one
+func (r *DailyScoreRepository) GetOrCreate(ctx context.Context, petID, groupID uuid.UUID, date time.Time) (*domain.DailyScore, error) {
+	return r.getOrCreateWith(ctx, r.client.DailyScore, petID, groupID, date)
+}
+
+// Update updates an existing daily score
+func (r *DailyScoreRepository) Update(ctx context.Context, dailyScore *domain.DailyScore) error {
+	return r.updateWith(ctx, r.client.DailyScore, dailyScore)
+}
+
+// Find retrieves daily scores based on filter criteria, most recent first
+func (r *DailyScoreRepository) Find(ctx context.Context, filter *domain.DailyScoreFilter) ([]*domain.DailyScore, error) {
+	query := r.client.DailyScore.Query()
+
+	// Apply filters
+	if filter.PetID != nil {
+		query = query.Where(dailyscore.PetID(*filter.PetID))
+	}
+
+	if filter.GroupID != nil {
+		query = query.Where(dailyscore.GroupID(*filter.GroupID))
+	}
+
+	if filter.Date != nil {
+		day := dayKey(*filter.Date)
+		query = query.Where(dailyscore.DateGTE(day), dailyscore.DateLT(day.AddDate(0, 0, 1)))
+	}
+
+	if filter.DateFrom != nil {
+		query = query.Where(dailyscore.DateGTE(dayKey(*filter.DateFrom)))
+	}
+
+	if filter.DateTo != nil {
+		query = query.Where(dailyscore.DateLT(dayKey(*filter.DateTo).AddDate(0, 0, 1)))
+	}
+
+	if filter.MinPoints != nil {
+		query = query.Where(dailyscore.TotalPointsGTE(*filter.MinPoints))
+	}
+
+	if filter.HasActivity != nil {
+		if *filter.HasActivity {
+			query = query.Where(dailyscore.Or(dailyscore.PositiveBehaviorsGT(0), dailyscore.NegativeBehaviorsGT(0)))
+		} else {
+			query = query.Where(dailyscore.PositiveBehaviors(0), dailyscore.NegativeBehaviors(0))
+		}
+	}
+
+	entDailyScores, err := query.
+		Order(ent.Desc(dailyscore.FieldDate)).
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find daily scores: %w", err)
+	}
+
+	return r.entToDomainSlice(entDailyScores), nil
+}
+
+// GetRankings retrieves pet rankings for a group on a specific date
+func (r *DailyScoreRepository) GetRankings(ctx context.Context, groupID uuid.UUID, date time.Time) ([]*domain.PetRanking, error) {
+	return r.GetRankingsByDateRange(ctx, groupID, date, date)
+}
+
+// GetRankingsByDateRange retrieves pet rankings for a group within a date range, both days
+// included. The scores of each pet are added up, the best ranked pet first.
+func (r *DailyScoreRepository) GetRankingsByDateRange(ctx context.Context, groupID uuid.UUID, from, to time.Time) ([]*domain.PetRanking, error) {
+	entDailyScores, err := r.client.DailyScore.
+		Query().
+		Where(
+			dailyscore.GroupID(groupID),
+			dailyscore.DateGTE(dayKey(from)),
+			dailyscore.DateLT(dayKey(to).AddDate(0, 0, 1)),
+		).
+		Order(ent.Asc(dailyscore.FieldDate)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daily scores: %w", err)
+	}
+
+	petIDs := make([]uuid.UUID, 0)
+	scoresByPet := make(map[uuid.UUID][]*domain.DailyScore)
+	for _, entDailyScore := range entDailyScores {
+		if _, exists := scoresByPet[entDailyScore.PetID]; !exists {
+			petIDs = append(petIDs, entDailyScore.PetID)
+		}
+		scoresByPet[entDailyScore.PetID] = append(scoresByPet[entDailyScore.PetID], r.entToDomain(entDailyScore))
+	}
+
+	// Pets that no longer exist are left out
+	entPets, err := r.client.Pet.
+		Query().
+		Where(pet.IDIn(petIDs...)).
+		WithOwner().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ranked pets: %w", err)
+	}
+
+	rankings := make([]*domain.PetRanking, 0, len(entPets))
+	for _, entPet := range entPets {
+		ownerName := "Unknown"
+		if entPet.Edges.Owner != nil {
+			ownerName = entPet.Edges.Owner.FirstName + " " + entPet.Edges.Owner.LastName
+		}
+
+		ranking := domain.NewPetRanking(entPet.ID, entPet.Name, ownerName)
+		for _, dailyScore := range scoresByPet[entPet.ID] {
+			ranking.UpdateFromDailyScore(dailyScore)
+		}
+		rankings = append(rankings, ranking)
+	}
+
+	sort.SliceStable(rankings, func(i, j int) bool {
+		return rankings[i].CompareForRanking(rankings[j]) > 0
+	})
+
+	return rankings, nil
+}
+
+// GetTopScorers retrieves the daily scores of a group day, highest points first
+func (r *DailyScoreRepository) GetTopScorers(ctx context.Context, groupID uuid.UUID, date time.Time) ([]*domain.DailyScore, error) {
+	day := dayKey(date)
+
+	entDailyScores, err := r.client.DailyScore.
+		Query().
+		Where(
+			dailyscore.GroupID(groupID),
+			dailyscore.DateGTE(day),
+			dailyscore.DateLT(day.AddDate(0, 0, 1)),
+		).
+		Order(ent.Desc(dailyscore.FieldTotalPoints)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top scorers: %w", err)
+	}
+
+	return r.entToDomainSlice(entDailyScores), nil
+}
+
+// RecalculateFromLogs rebuilds a daily score from the behavior logs shared with its group during
+// the group day and from the point adjustments of the day, in one transaction
+func (r *DailyScoreRepository) RecalculateFromLogs(ctx context.Context, petID, groupID uuid.UUID, date time.Time) (*domain.DailyScore, error) {
+	boundary, err := groupDayBoundary(ctx, r.authRepo, r.userSettingsRepo, groupID, date)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	dailyScore, err := r.getOrCreateWith(ctx, tx.DailyScore, petID, groupID, date)
+	if err != nil {
+		return nil, err
+	}
+
+	// A log at the reset time belongs to the day that ends with it
+	entBehaviorLogs, err := tx.BehaviorLog.
+		Query().
+		Where(
+			behaviorlog.PetID(petID),
+			behaviorlog.LoggedAtGT(boundary.Start),
+			behaviorlog.LoggedAtLTE(boundary.End),
+			behaviorlog.HasGroupSharesWith(behaviorloggroupshare.GroupID(groupID)),
+		).
+		WithGroupShares().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get behavior logs: %w", err)
+	}
+
+	behaviorLogs := make([]*domain.BehaviorLog, len(entBehaviorLogs))
+	for i, entBehaviorLog := range entBehaviorLogs {
+		behaviorLogs[i] = r.behaviorLogs.entToDomain(entBehaviorLog)
+	}
+
+	day := dayKey(dailyScore.Date)
+	entAdjustments, err := tx.PointAdjustment.
+		Query().
+		Where(
+			pointadjustment.PetID(petID),
+			pointadjustment.GroupID(groupID),
+			pointadjustment.DateGTE(day),
+			pointadjustment.DateLT(day.AddDate(0, 0, 1)),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get point adjustments: %w", err)
+	}
+
+	adjustments := make([]*domain.PointAdjustment, len(entAdjustments))
+	for i, entAdjustment := range entAdjustments {
+		adjustments[i] = r.adjustments.entToDomain(entAdjustment)
+	}
+
+	if err := dailyScore.Recalculate(behaviorLogs, adjustments); err != nil {
+		return nil, err
+	}
+
+	if err := r.updateWith(ctx, tx.DailyScore, dailyScore); err != nil {
+		return nil, err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return dailyScore, nil
+}
+
+// RecordAdjustment saves a point ledger entry and adds it to the daily score of its day in one
+// transaction
+func (r *DailyScoreRepository) RecordAdjustment(ctx context.Context, adjustment *domain.PointAdjustment) (*domain.DailyScore, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := r.adjustments.createWith(ctx, tx.PointAdjustment, adjustment); err != nil {
+		return nil, err
+	}
+
+	dailyScore, err := r.getOrCreateWith(ctx, tx.DailyScore, adjustment.PetID, adjustment.GroupID, adjustment.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := dailyScore.AddAdjustment(adjustment); err != nil {
+		return nil, fmt.Errorf("failed to apply point adjustment: %w", err)
+	}
+
+	if err := r.updateWith(ctx, tx.DailyScore, dailyScore); err != nil {
+		return nil, err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return dailyScore, nil
+}
+
+// RecordBehaviorLogs saves new behavior logs and adds them to the daily scores of their group
+// days in one transaction, saving each daily score once
+func (r *DailyScoreRepository) RecordBehaviorLogs(ctx context.Context, scoredLogs []domain.ScoredBehaviorLog) ([]*domain.DailyScore, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	type scoreKey struct {
+		petID   uuid.UUID
+		groupID uuid.UUID
+		date    time.Time
+	}
+	scores := make(map[scoreKey]*domain.DailyScore)
+	var dailyScores []*domain.DailyScore
+
+	for _, scoredLog := range scoredLogs {
+		behaviorLog := scoredLog.BehaviorLog
+		if err := r.behaviorLogs.createInTx(ctx, tx, behaviorLog); err != nil {
+			return nil, err
+		}
+
+		for groupID, date := range scoredLog.GroupDays {
+			key := scoreKey{petID: behaviorLog.PetID, groupID: groupID, date: dayKey(date)}
+			dailyScore, exists := scores[key]
+			if !exists {
+				if dailyScore, err = r.getOrCreateWith(ctx, tx.DailyScore, key.petID, groupID, date); err != nil {
+					return nil, err
+				}
+				scores[key] = dailyScore
+				dailyScores = append(dailyScores, dailyScore)
+			}
+
+			if err := dailyScore.AddBehaviorLog(behaviorLog); err != nil {
+				return nil, fmt.Errorf("failed to add behavior log to daily score: %w", err)
+			}
+		}
+	}
+
+	for _, dailyScore := range dailyScores {
+		if err := r.updateWith(ctx, tx.DailyScore, dailyScore); err != nil {
+			return nil, err
+		}
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return dailyScores, nil
+}
+
+// Delete deletes a daily score entry
+func (r *DailyScoreRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.client.DailyScore.DeleteOneID(id).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("daily score not found")
+		}
+		return fmt.Errorf("failed to delete daily score: %w", err)
+	}
+
+	return nil
+}
+
+// GetHistoricalData retrieves the daily scores of a pet in a group over the last days, oldest first
+func (r *DailyScoreRepository) GetHistoricalData(ctx context.Context, petID, groupID uuid.UUID, days int) ([]*domain.DailyScore, error) {
+	since := dayKey(time.Now().UTC()).AddDate(0, 0, -days)
+
+	entDailyScores, err := r.client.DailyScore.
+		Query().
+		Where(
+			dailyscore.PetID(petID),
+			dailyscore.GroupID(groupID),
+			dailyscore.DateGTE(since),
+		).
+		Order(ent.Asc(dailyscore.FieldDate)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get historical daily scores: %w", err)
+	}
+
+	return r.entToDomainSlice(entDailyScores), nil
+}
+
+// getOrCreateWith retrieves the daily score of a pet on a group day or creates it. A score
+// created concurrently makes the insert fail on the unique index; outside a transaction it is
+// then read again, inside one the transaction fails and can be retried.
+func (r *DailyScoreRepository) getOrCreateWith(ctx context.Context, dailyScores *ent.DailyScoreClient, petID, groupID uuid.UUID, date time.Time) (*domain.DailyScore, error) {
+	day := dayKey(date)
+	query := func() (*ent.DailyScore, error) {
+		return dailyScores.
+			Query().
+			Where(
+				dailyscore.PetID(petID),
+				dailyscore.GroupID(groupID),
+				dailyscore.DateGTE(day),
+				dailyscore.DateLT(day.AddDate(0, 0, 1)),
+			).
+			Only(ctx)
+	}
+
+	entDailyScore, err := query()
+	if err == nil {
+		return r.entToDomain(entDailyScore), nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get daily score: %w", err)
+	}
+
+	dailyScore, err := domain.NewDailyScore(petID, groupID, day)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.createWith(ctx, dailyScores, dailyScore); err != nil {
+		if !ent.IsConstraintError(err) {
+			return nil, err
+		}
+		if entDailyScore, err = query(); err != nil {
+			return nil, fmt.Errorf("failed to get daily score: %w", err)
+		}
+		return r.entToDomain(entDailyScore), nil
+	}
+
+	return dailyScore, nil
+}
+
+// createWith creates a daily score with the client of a transaction or of the repository
+func (r *DailyScoreRepository) createWith(ctx context.Context, dailyScores *ent.DailyScoreClient, dailyScore *domain.DailyScore) error {
+	_, err := dailyScores.
+		Create().
+		SetID(dailyScore.ID).
+		SetPetID(dailyScore.PetID).
+		SetGroupID(dailyScore.GroupID).
+		SetDate(dayKey(dailyScore.Date)).
+		SetTotalPoints(dailyScore.TotalPoints).
+		SetPositiveBehaviors(dailyScore.PositiveBehaviors).
+		SetNegativeBehaviors(dailyScore.NegativeBehaviors).
+		SetBehaviorPointTotal(dailyScore.BehaviorPointTotal).
+		SetNillableLastActivityAt(dailyScore.LastActivityAt).
+		SetNillableArchivedAt(dailyScore.ArchivedAt).
+		SetCreatedAt(dailyScore.CreatedAt).
+		SetUpdatedAt(dailyScore.UpdatedAt).
+		Save(ctx)
+
+	if err != nil {
+		// Keep constraint errors recognizable, see getOrCreateWith
+		if ent.IsConstraintError(err) {
+			return err
+		}
+		return fmt.Errorf("failed to create daily score: %w", err)
+	}
+
+	return nil
+}
+
+// updateWith saves a daily score with the client of a transaction or of the repository
+func (r *DailyScoreRepository) updateWith(ctx context.Context, dailyScores *ent.DailyScoreClient, dailyScore *domain.DailyScore) error {
+	update := dailyScores.
+		UpdateOneID(dailyScore.ID).
+		SetTotalPoints(dailyScore.TotalPoints).
+		SetPositiveBehaviors(dailyScore.PositiveBehaviors).
+		SetNegativeBehaviors(dailyScore.NegativeBehaviors).
+		SetBehaviorPointTotal(dailyScore.BehaviorPointTotal).
+		SetNillableArchivedAt(dailyScore.ArchivedAt).
+		SetUpdatedAt(dailyScore.UpdatedAt)
+
+	if dailyScore.LastActivityAt != nil {
+		update = update.SetLastActivityAt(*dailyScore.LastActivityAt)
+	} else {
+		update = update.ClearLastActivityAt()
+	}
+
+	if _, err := update.Save(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("daily score not found")
+		}
+		return fmt.Errorf("failed to update daily score: %w", err)
+	}
+
+	return nil
+}
+
+// groupDayBoundary returns the moments a group day starts and ends at
+func groupDayBoundary(
+	ctx context.Context,
+	authRepo domain.AuthorizationRepository,
+	userSettingsRepo domain.UserSettingsRepository,
+	groupID uuid.UUID,
+	date time.Time,
+) (timezone.DailyBoundary, error) {
+	settings, err := domain.GetGroupTimezoneSettings(ctx, authRepo, userSettingsRepo, groupID)
+	if err != nil {
+		return timezone.DailyBoundary{}, err
+	}
+
+	boundary, err := timezone.GetDailyBoundaryForDay(date, timezone.UserTimeConfig{
+		DailyResetTime: settings.DailyResetTime,
+		Timezone:       settings.Timezone,
+	})
+	if err != nil {
+		return timezone.DailyBoundary{}, fmt.Errorf("failed to calculate group day: %w", err)
+	}
+
+	return boundary, nil
+}
+
+// dayKey returns the key a day is stored under, midnight UTC of the date's calendar day. Group
+// days are midnights in the group's timezone while queries may carry dates in any location, so
+// the repositories of daily figures go through this key for every read and write of a day.
+func dayKey(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func (r *DailyScoreRepository) entToDomain(entDailyScore *ent.DailyScore) *domain.DailyScore {
+	return &domain.DailyScore{
+		ID:                 entDailyScore.ID,
+		PetID:              entDailyScore.PetID,
+		GroupID:            entDailyScore.GroupID,
+		Date:               entDailyScore.Date.UTC(),
+		TotalPoints:        entDailyScore.TotalPoints,
+		PositiveBehaviors:  entDailyScore.PositiveBehaviors,
+		NegativeBehaviors:  entDailyScore.NegativeBehaviors,
+		BehaviorPointTotal: entDailyScore.BehaviorPointTotal,
+		LastActivityAt:     entDailyScore.LastActivityAt,
+		ArchivedAt:         entDailyScore.ArchivedAt,
+		CreatedAt:          entDailyScore.CreatedAt,
+		UpdatedAt:          entDailyScore.UpdatedAt,
+	}
+}
+
+func (r *DailyScoreRepository) entToDomainSlice(entDailyScores []*ent.DailyScore) []*domain.DailyScore {
+	dailyScores := make([]*domain.DailyScore, len(entDailyScores))
+	for i, entDailyScore := range entDailyScores {
+		dailyScores[i] = r.entToDomain(entDailyScore)
+	}
+	return dailyScores
+}
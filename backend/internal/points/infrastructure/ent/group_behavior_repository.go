@@ -0,0 +1,161 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/ent"
+	"pet-of-the-day/ent/groupbehavior"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// GroupBehaviorRepository implements the domain.GroupBehaviorRepository interface using Ent ORM
+type GroupBehaviorRepository struct {
+	client *ent.Client
+}
+
+// NewGroupBehaviorRepository creates a new Ent-based group behavior repository
+func NewGroupBehaviorRepository(client *ent.Client) *GroupBehaviorRepository {
+	return &GroupBehaviorRepository{
+		client: client,
+	}
+}
+
+// Create creates a new custom group behavior or override
+func (r *GroupBehaviorRepository) Create(ctx context.Context, domainGroupBehavior *domain.GroupBehavior) error {
+	_, err := r.client.GroupBehavior.
+		Create().
+		SetID(domainGroupBehavior.ID).
+		SetGroupID(domainGroupBehavior.GroupID).
+		SetNillableBaseBehaviorID(domainGroupBehavior.BaseBehaviorID).
+		SetName(domainGroupBehavior.Name).
+		SetDescription(domainGroupBehavior.Description).
+		SetCategory(groupbehavior.Category(string(domainGroupBehavior.Category))).
+		SetPointValue(domainGroupBehavior.PointValue).
+		SetMinIntervalMinutes(domainGroupBehavior.MinIntervalMinutes).
+		SetSpecies(groupbehavior.Species(string(domainGroupBehavior.Species))).
+		SetIcon(domainGroupBehavior.Icon).
+		SetIsActive(domainGroupBehavior.IsActive).
+		SetCreatedBy(domainGroupBehavior.CreatedBy).
+		SetCreatedAt(domainGroupBehavior.CreatedAt).
+		SetUpdatedAt(domainGroupBehavior.UpdatedAt).
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to create group behavior: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a group behavior by ID
+func (r *GroupBehaviorRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.GroupBehavior, error) {
+	entGroupBehavior, err := r.client.GroupBehavior.
+		Query().
+		Where(groupbehavior.ID(id)).
+		First(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get group behavior: %w", err)
+	}
+
+	return r.entToDomain(entGroupBehavior), nil
+}
+
+// GetByGroup retrieves all custom behaviors and overrides of a group
+func (r *GroupBehaviorRepository) GetByGroup(ctx context.Context, groupID uuid.UUID) ([]*domain.GroupBehavior, error) {
+	entGroupBehaviors, err := r.client.GroupBehavior.
+		Query().
+		Where(groupbehavior.GroupID(groupID)).
+		Order(ent.Asc(groupbehavior.FieldCreatedAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group behaviors: %w", err)
+	}
+
+	groupBehaviors := make([]*domain.GroupBehavior, len(entGroupBehaviors))
+	for i, entGroupBehavior := range entGroupBehaviors {
+		groupBehaviors[i] = r.entToDomain(entGroupBehavior)
+	}
+
+	return groupBehaviors, nil
+}
+
+// GetOverride retrieves a group's override of a global behavior
+func (r *GroupBehaviorRepository) GetOverride(ctx context.Context, groupID, behaviorID uuid.UUID) (*domain.GroupBehavior, error) {
+	entGroupBehavior, err := r.client.GroupBehavior.
+		Query().
+		Where(
+			groupbehavior.GroupID(groupID),
+			groupbehavior.BaseBehaviorID(behaviorID),
+		).
+		First(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil // Behavior is not overridden in this group
+		}
+		return nil, fmt.Errorf("failed to get group behavior override: %w", err)
+	}
+
+	return r.entToDomain(entGroupBehavior), nil
+}
+
+// Update updates an existing group behavior
+func (r *GroupBehaviorRepository) Update(ctx context.Context, domainGroupBehavior *domain.GroupBehavior) error {
+	_, err := r.client.GroupBehavior.
+		UpdateOneID(domainGroupBehavior.ID).
+		SetName(domainGroupBehavior.Name).
+		SetDescription(domainGroupBehavior.Description).
+		SetPointValue(domainGroupBehavior.PointValue).
+		SetMinIntervalMinutes(domainGroupBehavior.MinIntervalMinutes).
+		SetIcon(domainGroupBehavior.Icon).
+		SetIsActive(domainGroupBehavior.IsActive).
+		SetUpdatedAt(domainGroupBehavior.UpdatedAt).
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to update group behavior: %w", err)
+	}
+
+	return nil
+}
+
+// Delete deletes a group behavior
+func (r *GroupBehaviorRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	err := r.client.GroupBehavior.
+		DeleteOneID(id).
+		Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to delete group behavior: %w", err)
+	}
+
+	return nil
+}
+
+// entToDomain converts an Ent group behavior entity to a domain group behavior
+func (r *GroupBehaviorRepository) entToDomain(entGroupBehavior *ent.GroupBehavior) *domain.GroupBehavior {
+	return &domain.GroupBehavior{
+		ID:                 entGroupBehavior.ID,
+		GroupID:            entGroupBehavior.GroupID,
+		BaseBehaviorID:     entGroupBehavior.BaseBehaviorID,
+		Name:               entGroupBehavior.Name,
+		Description:        entGroupBehavior.Description,
+		Category:           domain.BehaviorCategory(string(entGroupBehavior.Category)),
+		PointValue:         entGroupBehavior.PointValue,
+		MinIntervalMinutes: entGroupBehavior.MinIntervalMinutes,
+		Species:            domain.Species(string(entGroupBehavior.Species)),
+		Icon:               entGroupBehavior.Icon,
+		IsActive:           entGroupBehavior.IsActive,
+		CreatedBy:          entGroupBehavior.CreatedBy,
+		CreatedAt:          entGroupBehavior.CreatedAt,
+		UpdatedAt:          entGroupBehavior.UpdatedAt,
+	}
+}
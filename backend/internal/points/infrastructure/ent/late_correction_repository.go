@@ -0,0 +1,85 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/ent"
+	"pet-of-the-day/ent/latecorrection"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// LateCorrectionRepository implements the domain.LateCorrectionRepository interface using Ent ORM
+type LateCorrectionRepository struct {
+	client *ent.Client
+}
+
+// NewLateCorrectionRepository creates a new Ent-based late correction repository
+func NewLateCorrectionRepository(client *ent.Client) *LateCorrectionRepository {
+	return &LateCorrectionRepository{
+		client: client,
+	}
+}
+
+// Create records a new late correction
+func (r *LateCorrectionRepository) Create(ctx context.Context, correction *domain.LateCorrection) error {
+	_, err := r.client.LateCorrection.
+		Create().
+		SetID(correction.ID).
+		SetGroupID(correction.GroupID).
+		SetDate(correction.Date).
+		SetCause(latecorrection.Cause(correction.Cause)).
+		SetBehaviorLogID(correction.BehaviorLogID).
+		SetPetID(correction.PetID).
+		SetPreviousWinnerIds(correction.PreviousWinnerIDs).
+		SetWinnerIds(correction.WinnerIDs).
+		SetReselected(correction.Reselected).
+		SetCreatedAt(correction.CreatedAt).
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to create late correction: %w", err)
+	}
+
+	return nil
+}
+
+// GetByGroup retrieves the late corrections of a group, most recent first
+func (r *LateCorrectionRepository) GetByGroup(ctx context.Context, groupID uuid.UUID, limit, offset int) ([]*domain.LateCorrection, error) {
+	query := r.client.LateCorrection.
+		Query().
+		Where(latecorrection.GroupID(groupID)).
+		Order(ent.Desc(latecorrection.FieldCreatedAt))
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+
+	entCorrections, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get late corrections: %w", err)
+	}
+
+	corrections := make([]*domain.LateCorrection, len(entCorrections))
+	for i, entCorrection := range entCorrections {
+		corrections[i] = &domain.LateCorrection{
+			ID:                entCorrection.ID,
+			GroupID:           entCorrection.GroupID,
+			Date:              entCorrection.Date,
+			Cause:             domain.LateCorrectionCause(entCorrection.Cause),
+			BehaviorLogID:     entCorrection.BehaviorLogID,
+			PetID:             entCorrection.PetID,
+			PreviousWinnerIDs: entCorrection.PreviousWinnerIds,
+			WinnerIDs:         entCorrection.WinnerIds,
+			Reselected:        entCorrection.Reselected,
+			CreatedAt:         entCorrection.CreatedAt,
+		}
+	}
+
+	return corrections, nil
+}
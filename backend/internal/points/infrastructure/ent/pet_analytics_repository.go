@@ -0,0 +1,256 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"pet-of-the-day/ent"
+	"pet-of-the-day/ent/behavior"
+	"pet-of-the-day/ent/behaviorlog"
+	"pet-of-the-day/ent/behaviorloggroupshare"
+	"pet-of-the-day/ent/dailyscore"
+	"pet-of-the-day/ent/groupbehavior"
+	"pet-of-the-day/ent/predicate"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// PetAnalyticsRepository implements the domain.PetAnalyticsRepository interface using Ent ORM.
+// Every aggregate is a single GROUP BY query, logs are never loaded.
+type PetAnalyticsRepository struct {
+	client *ent.Client
+}
+
+// NewPetAnalyticsRepository creates a new Ent-based pet analytics repository
+func NewPetAnalyticsRepository(client *ent.Client) *PetAnalyticsRepository {
+	return &PetAnalyticsRepository{
+		client: client,
+	}
+}
+
+// GetHourlyHeatmap counts the logs of a pet per weekday and hour in the owner's timezone
+func (r *PetAnalyticsRepository) GetHourlyHeatmap(ctx context.Context, filter *domain.PetAnalyticsFilter) ([]*domain.HeatmapCell, error) {
+	var rows []struct {
+		Weekday int `json:"weekday"`
+		Hour    int `json:"hour"`
+		Logs    int `json:"logs"`
+		Points  int `json:"points"`
+	}
+
+	err := r.logQuery(filter).
+		Modify(func(s *sql.Selector) {
+			points := logPoints(s, filter)
+			loggedAt := localTime(s.C(behaviorlog.FieldLoggedAt), filter.Location)
+			s.Select(
+				sql.As("EXTRACT(DOW FROM "+loggedAt+")::int", "weekday"),
+				sql.As("EXTRACT(HOUR FROM "+loggedAt+")::int", "hour"),
+				sql.As(sql.Count("*"), "logs"),
+				sql.As("COALESCE(SUM("+points+"), 0)", "points"),
+			).GroupBy("weekday", "hour")
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate behavior logs per hour: %w", err)
+	}
+
+	cells := make([]*domain.HeatmapCell, 0, len(rows))
+	for _, row := range rows {
+		cells = append(cells, &domain.HeatmapCell{
+			Weekday: time.Weekday(row.Weekday),
+			Hour:    row.Hour,
+			Logs:    row.Logs,
+			Points:  row.Points,
+		})
+	}
+	return cells, nil
+}
+
+// GetCategoryMix counts the logs of a pet per behavior category and period
+func (r *PetAnalyticsRepository) GetCategoryMix(ctx context.Context, filter *domain.PetAnalyticsFilter, interval domain.AnalyticsInterval) ([]*domain.CategoryMixBucket, error) {
+	// date_trunc starts weeks on Monday, as the domain does
+	precision := "day"
+	if interval == domain.AnalyticsIntervalWeek {
+		precision = "week"
+	}
+
+	var rows []struct {
+		PeriodStart time.Time `json:"period_start"`
+		Category    string    `json:"behavior_category"`
+		Logs        int       `json:"logs"`
+		Points      int       `json:"points"`
+	}
+
+	err := r.logQuery(filter).
+		Modify(func(s *sql.Selector) {
+			// Logs of a group's custom behaviors have no global behavior
+			behaviors := sql.Table(behavior.Table)
+			groupBehaviors := sql.Table(groupbehavior.Table)
+			s.LeftJoin(behaviors).On(s.C(behaviorlog.FieldBehaviorID), behaviors.C(behavior.FieldID)).
+				LeftJoin(groupBehaviors).On(s.C(behaviorlog.FieldBehaviorID), groupBehaviors.C(groupbehavior.FieldID))
+
+			points := logPoints(s, filter)
+			loggedAt := localTime(s.C(behaviorlog.FieldLoggedAt), filter.Location)
+			s.Select(
+				sql.As("date_trunc('"+precision+"', "+loggedAt+")::date", "period_start"),
+				sql.As("COALESCE("+behaviors.C(behavior.FieldCategory)+", "+groupBehaviors.C(groupbehavior.FieldCategory)+", '')", "behavior_category"),
+				sql.As(sql.Count("*"), "logs"),
+				sql.As("COALESCE(SUM("+points+"), 0)", "points"),
+			).GroupBy("period_start", "behavior_category")
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate behavior logs per category: %w", err)
+	}
+
+	buckets := make([]*domain.CategoryMixBucket, 0, len(rows))
+	for _, row := range rows {
+		buckets = append(buckets, &domain.CategoryMixBucket{
+			PeriodStart: row.PeriodStart,
+			Category:    domain.BehaviorCategory(row.Category),
+			Logs:        row.Logs,
+			Points:      row.Points,
+		})
+	}
+	return buckets, nil
+}
+
+// GetDailyBehaviorTotals counts the good and bad logs of a pet per day in the owner's timezone
+func (r *PetAnalyticsRepository) GetDailyBehaviorTotals(ctx context.Context, filter *domain.PetAnalyticsFilter) ([]*domain.DailyBehaviorTotals, error) {
+	var rows []struct {
+		Date         time.Time `json:"date"`
+		PositiveLogs int       `json:"positive_logs"`
+		NegativeLogs int       `json:"negative_logs"`
+		Points       int       `json:"points"`
+	}
+
+	err := r.logQuery(filter).
+		Modify(func(s *sql.Selector) {
+			points := logPoints(s, filter)
+			s.Select(
+				sql.As("("+localTime(s.C(behaviorlog.FieldLoggedAt), filter.Location)+")::date", "date"),
+				sql.As("COUNT(*) FILTER (WHERE "+points+" > 0)", "positive_logs"),
+				sql.As("COUNT(*) FILTER (WHERE "+points+" < 0)", "negative_logs"),
+				sql.As("COALESCE(SUM("+points+"), 0)", "points"),
+			).GroupBy("date")
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate behavior logs per day: %w", err)
+	}
+
+	totals := make([]*domain.DailyBehaviorTotals, 0, len(rows))
+	for _, row := range rows {
+		totals = append(totals, &domain.DailyBehaviorTotals{
+			Date:         row.Date,
+			PositiveLogs: row.PositiveLogs,
+			NegativeLogs: row.NegativeLogs,
+			Points:       row.Points,
+		})
+	}
+	return totals, nil
+}
+
+// GetDailyScoreTotals retrieves the daily scores of a pet, averaged over its groups unless the
+// filter selects one
+func (r *PetAnalyticsRepository) GetDailyScoreTotals(ctx context.Context, filter *domain.PetAnalyticsFilter) ([]*domain.DailyScoreTotal, error) {
+	query := r.client.DailyScore.
+		Query().
+		Where(
+			dailyscore.PetID(filter.PetID),
+			dailyscore.DateGTE(dayKey(filter.From)),
+			dailyscore.DateLT(dayKey(filter.To).AddDate(0, 0, 1)),
+		)
+	if filter.GroupID != nil {
+		query = query.Where(dailyscore.GroupID(*filter.GroupID))
+	}
+
+	var rows []struct {
+		Date  time.Time `json:"date"`
+		Score float64   `json:"score"`
+	}
+
+	err := query.
+		GroupBy(dailyscore.FieldDate).
+		Aggregate(ent.As(ent.Mean(dailyscore.FieldTotalPoints), "score")).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate daily scores: %w", err)
+	}
+
+	totals := make([]*domain.DailyScoreTotal, 0, len(rows))
+	for _, row := range rows {
+		totals = append(totals, &domain.DailyScoreTotal{
+			Date:  row.Date,
+			Score: row.Score,
+		})
+	}
+	return totals, nil
+}
+
+// logQuery selects the behavior logs of a filter, as PetAnalyticsFilter.Includes does: those
+// counted in the filter's group, or counted toward the pet's own records without one
+func (r *PetAnalyticsRepository) logQuery(filter *domain.PetAnalyticsFilter) *ent.BehaviorLogQuery {
+	start, end := filter.Bounds()
+	query := r.client.BehaviorLog.
+		Query().
+		Where(
+			behaviorlog.PetID(filter.PetID),
+			behaviorlog.LoggedAtGTE(start),
+			behaviorlog.LoggedAtLT(end),
+		)
+	if filter.GroupID != nil {
+		query = query.Where(behaviorlog.HasGroupSharesWith(
+			behaviorloggroupshare.GroupID(*filter.GroupID),
+			countedShare(),
+		))
+	} else {
+		query = query.Where(behaviorlog.Or(
+			behaviorlog.Not(behaviorlog.HasGroupShares()),
+			behaviorlog.HasGroupSharesWith(countedShare()),
+		))
+	}
+	return query
+}
+
+// countedShare matches the shares counted in their group, those recorded before verification
+// have no status
+func countedShare() predicate.BehaviorLogGroupShare {
+	return predicate.BehaviorLogGroupShare(func(s *sql.Selector) {
+		status := s.C(behaviorloggroupshare.FieldStatus)
+		s.Where(sql.Or(
+			sql.IsNull(status),
+			sql.EQ(status, ""),
+			sql.EQ(status, string(domain.ShareStatusVerified)),
+		))
+	})
+}
+
+// logPoints returns the expression of the points a log counts for, as PetAnalyticsFilter.LogPoints
+// does. With a group it joins the log's share there, whose points fall back to the log's default.
+func logPoints(s *sql.Selector, filter *domain.PetAnalyticsFilter) string {
+	if filter.GroupID == nil {
+		return s.C(behaviorlog.FieldPointsAwarded)
+	}
+
+	shares := sql.Table(behaviorloggroupshare.Table)
+	s.Join(shares).OnP(sql.And(
+		sql.ColumnsEQ(s.C(behaviorlog.FieldID), shares.C(behaviorloggroupshare.FieldBehaviorLogID)),
+		sql.EQ(shares.C(behaviorloggroupshare.FieldGroupID), *filter.GroupID),
+	))
+
+	sharePoints := shares.C(behaviorloggroupshare.FieldPointsAwarded)
+	return "CASE WHEN " + sharePoints + " <> 0 THEN " + sharePoints + " ELSE " + s.C(behaviorlog.FieldPointsAwarded) + " END"
+}
+
+// localTime converts a timestamp column to the wall clock of a timezone. Location names come
+// from the timezone database and never hold quotes, UTC is used if one ever does.
+func localTime(column string, location *time.Location) string {
+	name := location.String()
+	if strings.ContainsAny(name, `'\`) {
+		name = "UTC"
+	}
+	return column + " AT TIME ZONE '" + name + "'"
+}
@@ -0,0 +1,340 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"pet-of-the-day/ent"
+	"pet-of-the-day/ent/petofthedaywinner"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// PetOfTheDayRepository implements the domain.PetOfTheDayRepository interface using Ent ORM.
+// Hall of fame queries are aggregated per pet in the database, winners are never all loaded.
+type PetOfTheDayRepository struct {
+	client *ent.Client
+}
+
+// NewPetOfTheDayRepository creates a new Ent-based Pet of the Day repository
+func NewPetOfTheDayRepository(client *ent.Client) *PetOfTheDayRepository {
+	return &PetOfTheDayRepository{
+		client: client,
+	}
+}
+
+// decoratedPetRow is a pet's wins aggregated by a hall of fame query
+type decoratedPetRow struct {
+	PeriodStart  time.Time `json:"period_start"`
+	PetID        uuid.UUID `json:"pet_id"`
+	PetName      string    `json:"pet_name"`
+	OwnerName    string    `json:"owner_name"`
+	Wins         int       `json:"wins"`
+	TotalScore   int       `json:"total_score"`
+	BestScore    int       `json:"best_score"`
+	FirstWinDate time.Time `json:"first_win_date"`
+	LastWinDate  time.Time `json:"last_win_date"`
+}
+
+// Create creates a new Pet of the Day winner record
+func (r *PetOfTheDayRepository) Create(ctx context.Context, winner *domain.PetOfTheDayWinner) error {
+	_, err := r.client.PetOfTheDayWinner.
+		Create().
+		SetID(winner.ID).
+		SetGroupID(winner.GroupID).
+		SetPetID(winner.PetID).
+		SetPetName(winner.PetName).
+		SetOwnerName(winner.OwnerName).
+		SetDate(winner.Date).
+		SetFinalScore(winner.FinalScore).
+		SetPositiveBehaviors(winner.PositiveBehaviors).
+		SetNegativeBehaviors(winner.NegativeBehaviors).
+		SetVotes(winner.Votes).
+		SetCreatedAt(winner.CreatedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create Pet of the Day winner: %w", err)
+	}
+
+	return nil
+}
+
+// GetByGroupAndDate retrieves all Pet of the Day winners for a group on a specific date
+func (r *PetOfTheDayRepository) GetByGroupAndDate(ctx context.Context, groupID uuid.UUID, date time.Time) ([]*domain.PetOfTheDayWinner, error) {
+	entWinners, err := r.client.PetOfTheDayWinner.
+		Query().
+		Where(
+			petofthedaywinner.GroupID(groupID),
+			petofthedaywinner.Date(date),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Pet of the Day winners: %w", err)
+	}
+
+	return r.entToDomains(entWinners), nil
+}
+
+// GetWinnerHistory retrieves the winners of a group between two days, both included, the most
+// recent first
+func (r *PetOfTheDayRepository) GetWinnerHistory(ctx context.Context, groupID uuid.UUID, from, to time.Time) ([]*domain.PetOfTheDayWinner, error) {
+	entWinners, err := r.client.PetOfTheDayWinner.
+		Query().
+		Where(
+			petofthedaywinner.GroupID(groupID),
+			petofthedaywinner.DateGTE(from),
+			petofthedaywinner.DateLTE(to),
+		).
+		Order(ent.Desc(petofthedaywinner.FieldDate)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get winner history: %w", err)
+	}
+
+	return r.entToDomains(entWinners), nil
+}
+
+// GetPetWinCount retrieves the number of times a pet has won Pet of the Day
+func (r *PetOfTheDayRepository) GetPetWinCount(ctx context.Context, petID uuid.UUID) (int, error) {
+	count, err := r.client.PetOfTheDayWinner.
+		Query().
+		Where(petofthedaywinner.PetID(petID)).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count Pet of the Day wins: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetPetWins retrieves all Pet of the Day wins of a pet across groups, oldest first
+func (r *PetOfTheDayRepository) GetPetWins(ctx context.Context, petID uuid.UUID) ([]*domain.PetOfTheDayWinner, error) {
+	entWinners, err := r.client.PetOfTheDayWinner.
+		Query().
+		Where(petofthedaywinner.PetID(petID)).
+		Order(ent.Asc(petofthedaywinner.FieldDate)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Pet of the Day wins: %w", err)
+	}
+
+	return r.entToDomains(entWinners), nil
+}
+
+// GetGroupStats retrieves the Pet of the Day statistics of a group
+func (r *PetOfTheDayRepository) GetGroupStats(ctx context.Context, groupID uuid.UUID) (*domain.GroupPetOfTheDayStats, error) {
+	stats := &domain.GroupPetOfTheDayStats{GroupID: groupID}
+
+	pets, err := r.GetMostDecorated(ctx, groupID, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(pets) == 0 {
+		return stats, nil
+	}
+
+	var rows []struct {
+		TotalWins    int        `json:"total_wins"`
+		UniquePets   int        `json:"unique_pets"`
+		AverageScore float64    `json:"average_score"`
+		LastWinDate  *time.Time `json:"last_win_date"`
+	}
+
+	err = r.client.PetOfTheDayWinner.
+		Query().
+		Where(petofthedaywinner.GroupID(groupID)).
+		Modify(func(s *sql.Selector) {
+			s.Select(
+				sql.As(sql.Count("*"), "total_wins"),
+				sql.As("COUNT(DISTINCT "+s.C(petofthedaywinner.FieldPetID)+")", "unique_pets"),
+				sql.As("AVG("+s.C(petofthedaywinner.FieldFinalScore)+")", "average_score"),
+				sql.As(sql.Max(s.C(petofthedaywinner.FieldDate)), "last_win_date"),
+			)
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate group winners: %w", err)
+	}
+
+	if len(rows) > 0 {
+		stats.TotalWins = rows[0].TotalWins
+		stats.UniquePets = rows[0].UniquePets
+		stats.AverageScore = rows[0].AverageScore
+		stats.LastWinDate = rows[0].LastWinDate
+	}
+	stats.MostWins = pets[0].Wins
+	stats.MostWinsPetID = &pets[0].PetID
+
+	return stats, nil
+}
+
+// GetMostDecorated retrieves the pets of a group with the most wins, at most limit pets, in one
+// query grouped by pet
+func (r *PetOfTheDayRepository) GetMostDecorated(ctx context.Context, groupID uuid.UUID, limit int) ([]*domain.DecoratedPet, error) {
+	var rows []*decoratedPetRow
+
+	err := r.client.PetOfTheDayWinner.
+		Query().
+		Where(petofthedaywinner.GroupID(groupID)).
+		Modify(func(s *sql.Selector) {
+			s.Select(decoratedPetColumns(s)...).
+				GroupBy(s.C(petofthedaywinner.FieldPetID)).
+				OrderBy(sql.Desc("wins"), sql.Desc("last_win_date"), "pet_name").
+				Limit(limit)
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get most decorated pets: %w", err)
+	}
+
+	pets := make([]*domain.DecoratedPet, 0, len(rows))
+	for _, row := range rows {
+		pets = append(pets, row.toDomain())
+	}
+
+	domain.RankDecoratedPets(pets)
+	return pets, nil
+}
+
+// GetHallOfFamePeriods retrieves the wins of a group summarized per month or year, the most
+// recent period first. The wins are aggregated per period and pet in one query.
+func (r *PetOfTheDayRepository) GetHallOfFamePeriods(ctx context.Context, groupID uuid.UUID, period domain.HistoryPeriod) ([]*domain.HallOfFamePeriod, error) {
+	precision := "month"
+	if period == domain.HistoryPeriodYear {
+		precision = "year"
+	}
+
+	var rows []*decoratedPetRow
+
+	err := r.client.PetOfTheDayWinner.
+		Query().
+		Where(petofthedaywinner.GroupID(groupID)).
+		Modify(func(s *sql.Selector) {
+			columns := append(
+				[]string{sql.As("date_trunc('"+precision+"', "+s.C(petofthedaywinner.FieldDate)+")::date", "period_start")},
+				decoratedPetColumns(s)...,
+			)
+			s.Select(columns...).
+				GroupBy("period_start", s.C(petofthedaywinner.FieldPetID)).
+				OrderBy(sql.Desc("period_start"))
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate hall of fame periods: %w", err)
+	}
+
+	periods := make([]*domain.HallOfFamePeriod, 0)
+	for i := 0; i < len(rows); {
+		from := rows[i].PeriodStart
+		pets := make([]*domain.DecoratedPet, 0)
+		totalScore := 0
+		for ; i < len(rows) && rows[i].PeriodStart.Equal(from); i++ {
+			pets = append(pets, rows[i].toDomain())
+			totalScore += rows[i].TotalScore
+		}
+		periods = append(periods, domain.NewHallOfFamePeriod(from, period, pets, totalScore))
+	}
+
+	return periods, nil
+}
+
+// DeleteByGroupAndDate deletes winners for a specific group and date (for recalculation)
+func (r *PetOfTheDayRepository) DeleteByGroupAndDate(ctx context.Context, groupID uuid.UUID, date time.Time) error {
+	_, err := r.client.PetOfTheDayWinner.
+		Delete().
+		Where(
+			petofthedaywinner.GroupID(groupID),
+			petofthedaywinner.Date(date),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete Pet of the Day winners: %w", err)
+	}
+
+	return nil
+}
+
+// GetLatestWinners retrieves the most recent winners across all groups
+func (r *PetOfTheDayRepository) GetLatestWinners(ctx context.Context, limit int) ([]*domain.PetOfTheDayWinner, error) {
+	entWinners, err := r.client.PetOfTheDayWinner.
+		Query().
+		Order(ent.Desc(petofthedaywinner.FieldDate)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest winners: %w", err)
+	}
+
+	return r.entToDomains(entWinners), nil
+}
+
+// GetLatestGroupWinners retrieves the most recent winners of the given groups
+func (r *PetOfTheDayRepository) GetLatestGroupWinners(ctx context.Context, groupIDs []uuid.UUID, limit int) ([]*domain.PetOfTheDayWinner, error) {
+	if len(groupIDs) == 0 {
+		return []*domain.PetOfTheDayWinner{}, nil
+	}
+
+	entWinners, err := r.client.PetOfTheDayWinner.
+		Query().
+		Where(petofthedaywinner.GroupIDIn(groupIDs...)).
+		Order(ent.Desc(petofthedaywinner.FieldDate)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest group winners: %w", err)
+	}
+
+	return r.entToDomains(entWinners), nil
+}
+
+// decoratedPetColumns selects the wins of a pet, named after its most recent win like
+// domain.RankMostDecorated since names can change
+func decoratedPetColumns(s *sql.Selector) []string {
+	date := s.C(petofthedaywinner.FieldDate)
+	score := s.C(petofthedaywinner.FieldFinalScore)
+	return []string{
+		sql.As(s.C(petofthedaywinner.FieldPetID), "pet_id"),
+		sql.As("(array_agg("+s.C(petofthedaywinner.FieldPetName)+" ORDER BY "+date+" DESC))[1]", "pet_name"),
+		sql.As("(array_agg("+s.C(petofthedaywinner.FieldOwnerName)+" ORDER BY "+date+" DESC))[1]", "owner_name"),
+		sql.As(sql.Count("*"), "wins"),
+		sql.As(sql.Sum(score), "total_score"),
+		sql.As(sql.Max(score), "best_score"),
+		sql.As(sql.Min(date), "first_win_date"),
+		sql.As(sql.Max(date), "last_win_date"),
+	}
+}
+
+func (row *decoratedPetRow) toDomain() *domain.DecoratedPet {
+	return &domain.DecoratedPet{
+		PetID:        row.PetID,
+		PetName:      row.PetName,
+		OwnerName:    row.OwnerName,
+		Wins:         row.Wins,
+		BestScore:    row.BestScore,
+		FirstWinDate: row.FirstWinDate,
+		LastWinDate:  row.LastWinDate,
+	}
+}
+
+func (r *PetOfTheDayRepository) entToDomains(entWinners []*ent.PetOfTheDayWinner) []*domain.PetOfTheDayWinner {
+	winners := make([]*domain.PetOfTheDayWinner, len(entWinners))
+	for i, entWinner := range entWinners {
+		winners[i] = &domain.PetOfTheDayWinner{
+			ID:                entWinner.ID,
+			GroupID:           entWinner.GroupID,
+			PetID:             entWinner.PetID,
+			PetName:           entWinner.PetName,
+			OwnerName:         entWinner.OwnerName,
+			Date:              entWinner.Date,
+			FinalScore:        entWinner.FinalScore,
+			PositiveBehaviors: entWinner.PositiveBehaviors,
+			NegativeBehaviors: entWinner.NegativeBehaviors,
+			Votes:             entWinner.Votes,
+			CreatedAt:         entWinner.CreatedAt,
+		}
+	}
+	return winners
+}
@@ -0,0 +1,107 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/ent"
+	"pet-of-the-day/ent/petofthedayvote"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// PetOfTheDayVoteRepository implements the domain.PetOfTheDayVoteRepository interface using Ent ORM
+type PetOfTheDayVoteRepository struct {
+	client *ent.Client
+}
+
+// NewPetOfTheDayVoteRepository creates a new Ent-based Pet of the Day vote repository
+func NewPetOfTheDayVoteRepository(client *ent.Client) *PetOfTheDayVoteRepository {
+	return &PetOfTheDayVoteRepository{
+		client: client,
+	}
+}
+
+// Save records a vote, replacing the vote of the same member for the same group day in a
+// single upsert on idx_pet_of_the_day_votes_group_date_voter
+func (r *PetOfTheDayVoteRepository) Save(ctx context.Context, vote *domain.PetOfTheDayVote) error {
+	err := r.client.PetOfTheDayVote.
+		Create().
+		SetID(vote.ID).
+		SetGroupID(vote.GroupID).
+		SetDate(vote.Date).
+		SetVoterID(vote.VoterID).
+		SetPetID(vote.PetID).
+		SetWeight(vote.Weight).
+		SetCreatedAt(vote.CreatedAt).
+		OnConflictColumns(
+			petofthedayvote.FieldGroupID,
+			petofthedayvote.FieldDate,
+			petofthedayvote.FieldVoterID,
+		).
+		UpdatePetID().
+		UpdateWeight().
+		UpdateCreatedAt().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save vote: %w", err)
+	}
+
+	return nil
+}
+
+// GetByGroupAndDate retrieves the votes of a group day
+func (r *PetOfTheDayVoteRepository) GetByGroupAndDate(ctx context.Context, groupID uuid.UUID, date time.Time) ([]*domain.PetOfTheDayVote, error) {
+	entVotes, err := r.client.PetOfTheDayVote.
+		Query().
+		Where(
+			petofthedayvote.GroupID(groupID),
+			petofthedayvote.Date(date),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get votes: %w", err)
+	}
+
+	votes := make([]*domain.PetOfTheDayVote, len(entVotes))
+	for i, entVote := range entVotes {
+		votes[i] = r.entToDomain(entVote)
+	}
+
+	return votes, nil
+}
+
+// GetByVoter retrieves the vote of a member for a group day, nil if the member did not vote
+func (r *PetOfTheDayVoteRepository) GetByVoter(ctx context.Context, groupID uuid.UUID, date time.Time, voterID uuid.UUID) (*domain.PetOfTheDayVote, error) {
+	entVote, err := r.client.PetOfTheDayVote.
+		Query().
+		Where(
+			petofthedayvote.GroupID(groupID),
+			petofthedayvote.Date(date),
+			petofthedayvote.VoterID(voterID),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get vote: %w", err)
+	}
+
+	return r.entToDomain(entVote), nil
+}
+
+func (r *PetOfTheDayVoteRepository) entToDomain(entVote *ent.PetOfTheDayVote) *domain.PetOfTheDayVote {
+	return &domain.PetOfTheDayVote{
+		ID:        entVote.ID,
+		GroupID:   entVote.GroupID,
+		Date:      entVote.Date,
+		VoterID:   entVote.VoterID,
+		PetID:     entVote.PetID,
+		Weight:    entVote.Weight,
+		CreatedAt: entVote.CreatedAt,
+	}
+}
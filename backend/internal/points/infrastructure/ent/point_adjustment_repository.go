@@ -0,0 +1,150 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/ent"
+	"pet-of-the-day/ent/pointadjustment"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// PointAdjustmentRepository implements the domain.PointAdjustmentRepository interface using Ent ORM
+type PointAdjustmentRepository struct {
+	client *ent.Client
+}
+
+// NewPointAdjustmentRepository creates a new Ent-based point adjustment repository
+func NewPointAdjustmentRepository(client *ent.Client) *PointAdjustmentRepository {
+	return &PointAdjustmentRepository{
+		client: client,
+	}
+}
+
+// Create records a new ledger entry
+func (r *PointAdjustmentRepository) Create(ctx context.Context, adjustment *domain.PointAdjustment) error {
+	return r.createWith(ctx, r.client.PointAdjustment, adjustment)
+}
+
+// createWith records a ledger entry with the client of a transaction or of the repository
+func (r *PointAdjustmentRepository) createWith(ctx context.Context, adjustments *ent.PointAdjustmentClient, adjustment *domain.PointAdjustment) error {
+	_, err := adjustments.
+		Create().
+		SetID(adjustment.ID).
+		SetPetID(adjustment.PetID).
+		SetGroupID(adjustment.GroupID).
+		SetDate(dayKey(adjustment.Date)).
+		SetPoints(adjustment.Points).
+		SetKind(pointadjustment.Kind(adjustment.Kind)).
+		SetReason(adjustment.Reason).
+		SetAuthorID(adjustment.AuthorID).
+		SetNillableChallengeID(adjustment.ChallengeID).
+		SetNillableReversesID(adjustment.ReversesID).
+		SetCreatedAt(adjustment.CreatedAt).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return fmt.Errorf("point adjustment already recorded")
+		}
+		return fmt.Errorf("failed to create point adjustment: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a ledger entry by ID
+func (r *PointAdjustmentRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.PointAdjustment, error) {
+	entAdjustment, err := r.client.PointAdjustment.
+		Query().
+		Where(pointadjustment.ID(id)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get point adjustment: %w", err)
+	}
+
+	return r.entToDomain(entAdjustment), nil
+}
+
+// Find retrieves ledger entries based on filter criteria, most recent first
+func (r *PointAdjustmentRepository) Find(ctx context.Context, filter *domain.PointAdjustmentFilter) ([]*domain.PointAdjustment, error) {
+	query := r.client.PointAdjustment.Query()
+
+	// Apply filters
+	if filter.GroupID != nil {
+		query = query.Where(pointadjustment.GroupID(*filter.GroupID))
+	}
+
+	if filter.PetID != nil {
+		query = query.Where(pointadjustment.PetID(*filter.PetID))
+	}
+
+	if filter.ChallengeID != nil {
+		query = query.Where(pointadjustment.ChallengeID(*filter.ChallengeID))
+	}
+
+	if filter.DateFrom != nil {
+		query = query.Where(pointadjustment.DateGTE(dayKey(*filter.DateFrom)))
+	}
+
+	if filter.DateTo != nil {
+		query = query.Where(pointadjustment.DateLT(dayKey(*filter.DateTo).AddDate(0, 0, 1)))
+	}
+
+	// Apply pagination
+	query = query.Limit(filter.Limit).Offset(filter.Offset)
+
+	// Order by created_at descending
+	query = query.Order(ent.Desc(pointadjustment.FieldCreatedAt))
+
+	entAdjustments, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find point adjustments: %w", err)
+	}
+
+	adjustments := make([]*domain.PointAdjustment, len(entAdjustments))
+	for i, entAdjustment := range entAdjustments {
+		adjustments[i] = r.entToDomain(entAdjustment)
+	}
+
+	return adjustments, nil
+}
+
+// GetReversal retrieves the entry reversing an adjustment
+func (r *PointAdjustmentRepository) GetReversal(ctx context.Context, adjustmentID uuid.UUID) (*domain.PointAdjustment, error) {
+	entAdjustment, err := r.client.PointAdjustment.
+		Query().
+		Where(pointadjustment.ReversesID(adjustmentID)).
+		First(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get point adjustment reversal: %w", err)
+	}
+
+	return r.entToDomain(entAdjustment), nil
+}
+
+func (r *PointAdjustmentRepository) entToDomain(entAdjustment *ent.PointAdjustment) *domain.PointAdjustment {
+	return &domain.PointAdjustment{
+		ID:          entAdjustment.ID,
+		PetID:       entAdjustment.PetID,
+		GroupID:     entAdjustment.GroupID,
+		Date:        entAdjustment.Date.UTC(),
+		Points:      entAdjustment.Points,
+		Kind:        domain.PointAdjustmentKind(entAdjustment.Kind),
+		Reason:      entAdjustment.Reason,
+		AuthorID:    entAdjustment.AuthorID,
+		ChallengeID: entAdjustment.ChallengeID,
+		ReversesID:  entAdjustment.ReversesID,
+		CreatedAt:   entAdjustment.CreatedAt,
+	}
+}
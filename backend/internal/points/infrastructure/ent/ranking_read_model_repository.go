@@ -0,0 +1,131 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"pet-of-the-day/ent"
+	"pet-of-the-day/ent/dailyscore"
+	"pet-of-the-day/ent/pet"
+	"pet-of-the-day/ent/predicate"
+	"pet-of-the-day/ent/user"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// RankingReadModelRepository implements the domain.RankingReadModelRepository interface using
+// Ent ORM. Daily scores are joined with their pets and owners, a group day is a single query.
+type RankingReadModelRepository struct {
+	client *ent.Client
+}
+
+// NewRankingReadModelRepository creates a new Ent-based rankings read model repository
+func NewRankingReadModelRepository(client *ent.Client) *RankingReadModelRepository {
+	return &RankingReadModelRepository{
+		client: client,
+	}
+}
+
+// rankingEntryRow is a daily score with the names selected by the join
+type rankingEntryRow struct {
+	ID                 uuid.UUID  `json:"id"`
+	PetID              uuid.UUID  `json:"pet_id"`
+	GroupID            uuid.UUID  `json:"group_id"`
+	Date               time.Time  `json:"date"`
+	TotalPoints        int        `json:"total_points"`
+	PositiveBehaviors  int        `json:"positive_behaviors"`
+	NegativeBehaviors  int        `json:"negative_behaviors"`
+	BehaviorPointTotal int        `json:"behavior_point_total"`
+	LastActivityAt     *time.Time `json:"last_activity_at"`
+	ArchivedAt         *time.Time `json:"archived_at"`
+	CreatedAt          time.Time  `json:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at"`
+	PetName            string     `json:"pet_name"`
+	OwnerID            uuid.UUID  `json:"owner_id"`
+	OwnerName          string     `json:"owner_name"`
+}
+
+// GetGroupDay retrieves the entries of a group day in one query, highest points first. Pets that
+// no longer exist are left out.
+func (r *RankingReadModelRepository) GetGroupDay(ctx context.Context, groupID uuid.UUID, date time.Time) ([]*domain.RankingEntry, error) {
+	rows, err := r.queryDay(ctx, date, dailyscore.GroupID(groupID))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*domain.RankingEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, row.toDomain())
+	}
+	return entries, nil
+}
+
+// GetPetDay retrieves the entry of a pet on a group day, nil if the pet has no score that day
+func (r *RankingReadModelRepository) GetPetDay(ctx context.Context, petID, groupID uuid.UUID, date time.Time) (*domain.RankingEntry, error) {
+	rows, err := r.queryDay(ctx, date, dailyscore.GroupID(groupID), dailyscore.PetID(petID))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return rows[0].toDomain(), nil
+}
+
+// queryDay selects the daily scores of a day with the names of their pets and owners
+func (r *RankingReadModelRepository) queryDay(ctx context.Context, date time.Time, predicates ...predicate.DailyScore) ([]rankingEntryRow, error) {
+	day := dayKey(date)
+
+	var rows []rankingEntryRow
+	err := r.client.DailyScore.
+		Query().
+		Where(
+			dailyscore.DateGTE(day),
+			dailyscore.DateLT(day.AddDate(0, 0, 1)),
+		).
+		Where(predicates...).
+		Order(ent.Desc(dailyscore.FieldTotalPoints)).
+		Modify(func(s *sql.Selector) {
+			pets := sql.Table(pet.Table)
+			owners := sql.Table(user.Table)
+			s.Join(pets).On(s.C(dailyscore.FieldPetID), pets.C(pet.FieldID)).
+				Join(owners).On(pets.C(pet.OwnerColumn), owners.C(user.FieldID))
+
+			s.Select(s.Columns(dailyscore.Columns...)...).
+				AppendSelect(
+					sql.As(pets.C(pet.FieldName), "pet_name"),
+					sql.As(owners.C(user.FieldID), "owner_id"),
+					sql.As("CONCAT("+owners.C(user.FieldFirstName)+", ' ', "+owners.C(user.FieldLastName)+")", "owner_name"),
+				)
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query rankings read model: %w", err)
+	}
+
+	return rows, nil
+}
+
+// toDomain converts a joined row to a rankings read model entry
+func (row rankingEntryRow) toDomain() *domain.RankingEntry {
+	dailyScore := &domain.DailyScore{
+		ID:                 row.ID,
+		PetID:              row.PetID,
+		GroupID:            row.GroupID,
+		Date:               row.Date,
+		TotalPoints:        row.TotalPoints,
+		PositiveBehaviors:  row.PositiveBehaviors,
+		NegativeBehaviors:  row.NegativeBehaviors,
+		BehaviorPointTotal: row.BehaviorPointTotal,
+		LastActivityAt:     row.LastActivityAt,
+		ArchivedAt:         row.ArchivedAt,
+		CreatedAt:          row.CreatedAt,
+		UpdatedAt:          row.UpdatedAt,
+	}
+
+	return domain.NewRankingEntry(dailyScore, row.PetName, row.OwnerID, row.OwnerName)
+}
@@ -0,0 +1,109 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/ent"
+	"pet-of-the-day/ent/reaction"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// ReactionRepository implements the domain.ReactionRepository interface using Ent ORM
+type ReactionRepository struct {
+	client *ent.Client
+}
+
+// NewReactionRepository creates a new Ent-based reaction repository
+func NewReactionRepository(client *ent.Client) *ReactionRepository {
+	return &ReactionRepository{
+		client: client,
+	}
+}
+
+// Add records a reaction, doing nothing if the user already reacted with the same emoji
+func (r *ReactionRepository) Add(ctx context.Context, domainReaction *domain.Reaction) error {
+	exists, err := r.client.Reaction.
+		Query().
+		Where(
+			reaction.BehaviorLogID(domainReaction.BehaviorLogID),
+			reaction.GroupID(domainReaction.GroupID),
+			reaction.UserID(domainReaction.UserID),
+			reaction.Emoji(domainReaction.Emoji),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check reaction: %w", err)
+	}
+
+	if exists {
+		return nil
+	}
+
+	_, err = r.client.Reaction.
+		Create().
+		SetID(domainReaction.ID).
+		SetBehaviorLogID(domainReaction.BehaviorLogID).
+		SetGroupID(domainReaction.GroupID).
+		SetUserID(domainReaction.UserID).
+		SetEmoji(domainReaction.Emoji).
+		SetCreatedAt(domainReaction.CreatedAt).
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to add reaction: %w", err)
+	}
+
+	return nil
+}
+
+// Remove deletes the reaction of a user with an emoji, doing nothing if there is none
+func (r *ReactionRepository) Remove(ctx context.Context, behaviorLogID, groupID, userID uuid.UUID, emoji string) error {
+	_, err := r.client.Reaction.
+		Delete().
+		Where(
+			reaction.BehaviorLogID(behaviorLogID),
+			reaction.GroupID(groupID),
+			reaction.UserID(userID),
+			reaction.Emoji(emoji),
+		).
+		Exec(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to remove reaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetByBehaviorLog retrieves the reactions on a behavior log in a group
+func (r *ReactionRepository) GetByBehaviorLog(ctx context.Context, behaviorLogID, groupID uuid.UUID) ([]*domain.Reaction, error) {
+	entReactions, err := r.client.Reaction.
+		Query().
+		Where(
+			reaction.BehaviorLogID(behaviorLogID),
+			reaction.GroupID(groupID),
+		).
+		Order(ent.Asc(reaction.FieldCreatedAt)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reactions: %w", err)
+	}
+
+	reactions := make([]*domain.Reaction, len(entReactions))
+	for i, entReaction := range entReactions {
+		reactions[i] = &domain.Reaction{
+			ID:            entReaction.ID,
+			BehaviorLogID: entReaction.BehaviorLogID,
+			GroupID:       entReaction.GroupID,
+			UserID:        entReaction.UserID,
+			Emoji:         entReaction.Emoji,
+			CreatedAt:     entReaction.CreatedAt,
+		}
+	}
+
+	return reactions, nil
+}
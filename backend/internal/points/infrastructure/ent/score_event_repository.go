@@ -189,6 +189,23 @@ func (r *ScoreEventRepository) DeleteByGroupID(ctx context.Context, groupID uuid
 	return err
 }
 
+// ListScoreEvents returns a page of every score event, the oldest recorded first
+func (r *ScoreEventRepository) ListScoreEvents(ctx context.Context, offset, limit int) ([]domain.ScoreEvent, error) {
+	events, err := r.client.ScoreEvent.Query().
+		WithPet().
+		WithBehavior().
+		WithRecordedBy().
+		Order(ent.Asc(scoreevent.FieldRecordedAt), ent.Asc(scoreevent.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.toDomainScoreEvents(events), nil
+}
+
 // GetRecentActivitiesForUser returns recent activities for groups the user is a member of
 func (r *ScoreEventRepository) GetRecentActivitiesForUser(ctx context.Context, userID uuid.UUID, limit int) ([]domain.ActivityItem, error) {
 	// First, get groups where the user is a member or creator
@@ -0,0 +1,99 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/ent"
+	"pet-of-the-day/ent/scoringrules"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// ScoringRulesRepository implements the domain.ScoringRulesRepository interface using Ent ORM
+type ScoringRulesRepository struct {
+	client *ent.Client
+}
+
+// NewScoringRulesRepository creates a new Ent-based scoring rules repository
+func NewScoringRulesRepository(client *ent.Client) *ScoringRulesRepository {
+	return &ScoringRulesRepository{
+		client: client,
+	}
+}
+
+// GetByGroup retrieves the rules of a group, the default rules if none were saved
+func (r *ScoringRulesRepository) GetByGroup(ctx context.Context, groupID uuid.UUID) (*domain.ScoringRules, error) {
+	entRules, err := r.client.ScoringRules.
+		Query().
+		Where(scoringrules.GroupID(groupID)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.DefaultScoringRules(groupID), nil
+		}
+		return nil, fmt.Errorf("failed to get scoring rules: %w", err)
+	}
+
+	tieBreakers := make([]domain.TieBreaker, len(entRules.TieBreakers))
+	for i, tieBreaker := range entRules.TieBreakers {
+		tieBreakers[i] = domain.TieBreaker(tieBreaker)
+	}
+
+	return &domain.ScoringRules{
+		GroupID:          entRules.GroupID,
+		Mode:             domain.ScoringMode(entRules.Mode),
+		CategoryCap:      entRules.CategoryCap,
+		DiminishingAfter: entRules.DiminishingAfter,
+		TieBreakers:      tieBreakers,
+		SharedTitles:     entRules.SharedTitles,
+		UpdatedBy:        entRules.UpdatedBy,
+		UpdatedAt:        entRules.UpdatedAt,
+	}, nil
+}
+
+// Save creates or replaces the rules of a group
+func (r *ScoringRulesRepository) Save(ctx context.Context, rules *domain.ScoringRules) error {
+	tieBreakers := make([]string, len(rules.TieBreakers))
+	for i, tieBreaker := range rules.TieBreakers {
+		tieBreakers[i] = string(tieBreaker)
+	}
+
+	updated, err := r.client.ScoringRules.
+		Update().
+		Where(scoringrules.GroupID(rules.GroupID)).
+		SetMode(string(rules.Mode)).
+		SetCategoryCap(rules.CategoryCap).
+		SetDiminishingAfter(rules.DiminishingAfter).
+		SetTieBreakers(tieBreakers).
+		SetSharedTitles(rules.SharedTitles).
+		SetNillableUpdatedBy(rules.UpdatedBy).
+		SetUpdatedAt(rules.UpdatedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update scoring rules: %w", err)
+	}
+
+	if updated > 0 {
+		return nil
+	}
+
+	_, err = r.client.ScoringRules.
+		Create().
+		SetGroupID(rules.GroupID).
+		SetMode(string(rules.Mode)).
+		SetCategoryCap(rules.CategoryCap).
+		SetDiminishingAfter(rules.DiminishingAfter).
+		SetTieBreakers(tieBreakers).
+		SetSharedTitles(rules.SharedTitles).
+		SetNillableUpdatedBy(rules.UpdatedBy).
+		SetUpdatedAt(rules.UpdatedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create scoring rules: %w", err)
+	}
+
+	return nil
+}
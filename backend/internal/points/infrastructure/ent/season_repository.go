@@ -0,0 +1,316 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/ent"
+	"pet-of-the-day/ent/leaguemembership"
+	"pet-of-the-day/ent/season"
+	"pet-of-the-day/ent/seasonstanding"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// SeasonRepository implements the domain.SeasonRepository interface using Ent ORM
+type SeasonRepository struct {
+	client *ent.Client
+}
+
+// NewSeasonRepository creates a new Ent-based season repository
+func NewSeasonRepository(client *ent.Client) *SeasonRepository {
+	return &SeasonRepository{
+		client: client,
+	}
+}
+
+// Create creates a new season
+func (r *SeasonRepository) Create(ctx context.Context, domainSeason *domain.Season) error {
+	_, err := r.client.Season.
+		Create().
+		SetID(domainSeason.ID).
+		SetGroupID(domainSeason.GroupID).
+		SetName(domainSeason.Name).
+		SetStartDate(domainSeason.StartDate).
+		SetEndDate(domainSeason.EndDate).
+		SetPromotionSlots(domainSeason.PromotionSlots).
+		SetRelegationSlots(domainSeason.RelegationSlots).
+		SetStatus(season.Status(domainSeason.Status)).
+		SetCreatedBy(domainSeason.CreatedBy).
+		SetCreatedAt(domainSeason.CreatedAt).
+		SetNillableClosedAt(domainSeason.ClosedAt).
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to create season: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a season by ID
+func (r *SeasonRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Season, error) {
+	entSeason, err := r.client.Season.
+		Query().
+		Where(season.ID(id)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get season: %w", err)
+	}
+
+	return r.entToDomain(entSeason), nil
+}
+
+// GetByGroup retrieves all seasons of a group, most recent first
+func (r *SeasonRepository) GetByGroup(ctx context.Context, groupID uuid.UUID) ([]*domain.Season, error) {
+	entSeasons, err := r.client.Season.
+		Query().
+		Where(season.GroupID(groupID)).
+		Order(ent.Desc(season.FieldStartDate)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group seasons: %w", err)
+	}
+
+	seasons := make([]*domain.Season, len(entSeasons))
+	for i, entSeason := range entSeasons {
+		seasons[i] = r.entToDomain(entSeason)
+	}
+
+	return seasons, nil
+}
+
+// Update updates an existing season
+func (r *SeasonRepository) Update(ctx context.Context, domainSeason *domain.Season) error {
+	_, err := r.client.Season.
+		UpdateOneID(domainSeason.ID).
+		SetName(domainSeason.Name).
+		SetStartDate(domainSeason.StartDate).
+		SetEndDate(domainSeason.EndDate).
+		SetPromotionSlots(domainSeason.PromotionSlots).
+		SetRelegationSlots(domainSeason.RelegationSlots).
+		SetStatus(season.Status(domainSeason.Status)).
+		SetNillableClosedAt(domainSeason.ClosedAt).
+		Save(ctx)
+
+	if err != nil {
+		return fmt.Errorf("failed to update season: %w", err)
+	}
+
+	return nil
+}
+
+// CloseSeason saves a closed season with its final standings, replacing any previous archive,
+// and moves the group's league to the next tiers of the standings in one transaction
+func (r *SeasonRepository) CloseSeason(ctx context.Context, domainSeason *domain.Season, standings []*domain.SeasonStanding) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.SeasonStanding.
+		Delete().
+		Where(seasonstanding.SeasonID(domainSeason.ID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to clear season standings: %w", err)
+	}
+
+	builders := make([]*ent.SeasonStandingCreate, len(standings))
+	for i, standing := range standings {
+		builders[i] = tx.SeasonStanding.
+			Create().
+			SetID(standing.ID).
+			SetSeasonID(domainSeason.ID).
+			SetGroupID(standing.GroupID).
+			SetPetID(standing.PetID).
+			SetPetName(standing.PetName).
+			SetOwnerName(standing.OwnerName).
+			SetTier(string(standing.Tier)).
+			SetRank(standing.Rank).
+			SetIsTied(standing.IsTied).
+			SetPoints(standing.Points).
+			SetPositiveBehaviors(standing.PositiveBehaviors).
+			SetNegativeBehaviors(standing.NegativeBehaviors).
+			SetMovement(string(standing.Movement)).
+			SetNextTier(string(standing.NextTier)).
+			SetCreatedAt(standing.CreatedAt)
+	}
+
+	if _, err := tx.SeasonStanding.CreateBulk(builders...).Save(ctx); err != nil {
+		return fmt.Errorf("failed to save season standings: %w", err)
+	}
+
+	// The league of the group is exactly the pets of the archived standings
+	tiers := domain.NextLeagueTiers(standings)
+	petIDs := make([]uuid.UUID, 0, len(tiers))
+	for petID := range tiers {
+		petIDs = append(petIDs, petID)
+	}
+
+	_, err = tx.LeagueMembership.
+		Delete().
+		Where(
+			leaguemembership.GroupID(domainSeason.GroupID),
+			leaguemembership.PetIDNotIn(petIDs...),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to remove league members: %w", err)
+	}
+
+	if err := saveLeagueTiers(ctx, tx.LeagueMembership, domainSeason.GroupID, tiers); err != nil {
+		return err
+	}
+
+	_, err = tx.Season.
+		UpdateOneID(domainSeason.ID).
+		SetStatus(season.Status(domainSeason.Status)).
+		SetNillableClosedAt(domainSeason.ClosedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to close season: %w", err)
+	}
+
+	// Commit transaction
+	return tx.Commit()
+}
+
+// GetFinalStandings retrieves the archived standings of a closed season
+func (r *SeasonRepository) GetFinalStandings(ctx context.Context, seasonID uuid.UUID) ([]*domain.SeasonStanding, error) {
+	entStandings, err := r.client.SeasonStanding.
+		Query().
+		Where(seasonstanding.SeasonID(seasonID)).
+		Order(ent.Asc(seasonstanding.FieldRank)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season standings: %w", err)
+	}
+
+	// Highest tier first, then by rank within the tier
+	byTier := make(map[domain.LeagueTier][]*domain.SeasonStanding)
+	for _, entStanding := range entStandings {
+		standing := &domain.SeasonStanding{
+			ID:                entStanding.ID,
+			SeasonID:          entStanding.SeasonID,
+			GroupID:           entStanding.GroupID,
+			PetID:             entStanding.PetID,
+			PetName:           entStanding.PetName,
+			OwnerName:         entStanding.OwnerName,
+			Tier:              domain.LeagueTier(entStanding.Tier),
+			Rank:              entStanding.Rank,
+			IsTied:            entStanding.IsTied,
+			Points:            entStanding.Points,
+			PositiveBehaviors: entStanding.PositiveBehaviors,
+			NegativeBehaviors: entStanding.NegativeBehaviors,
+			Movement:          domain.LeagueMovement(entStanding.Movement),
+			NextTier:          domain.LeagueTier(entStanding.NextTier),
+			CreatedAt:         entStanding.CreatedAt,
+		}
+		byTier[standing.Tier] = append(byTier[standing.Tier], standing)
+	}
+
+	tiers := domain.GetLeagueTiers()
+	standings := make([]*domain.SeasonStanding, 0, len(entStandings))
+	for level := len(tiers) - 1; level >= 0; level-- {
+		standings = append(standings, byTier[tiers[level]]...)
+	}
+
+	return standings, nil
+}
+
+// GetLeagueTiers retrieves the current tier of every pet placed in a group's league
+func (r *SeasonRepository) GetLeagueTiers(ctx context.Context, groupID uuid.UUID) (map[uuid.UUID]domain.LeagueTier, error) {
+	memberships, err := r.client.LeagueMembership.
+		Query().
+		Where(leaguemembership.GroupID(groupID)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get league tiers: %w", err)
+	}
+
+	tiers := make(map[uuid.UUID]domain.LeagueTier, len(memberships))
+	for _, membership := range memberships {
+		tiers[membership.PetID] = domain.LeagueTier(membership.Tier)
+	}
+
+	return tiers, nil
+}
+
+// SaveLeagueTiers creates or updates the tiers of pets in a group's league
+func (r *SeasonRepository) SaveLeagueTiers(ctx context.Context, groupID uuid.UUID, tiers map[uuid.UUID]domain.LeagueTier) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := saveLeagueTiers(ctx, tx.LeagueMembership, groupID, tiers); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// saveLeagueTiers updates the tier of each pet, creating the memberships that do not exist yet
+func saveLeagueTiers(ctx context.Context, memberships *ent.LeagueMembershipClient, groupID uuid.UUID, tiers map[uuid.UUID]domain.LeagueTier) error {
+	now := time.Now()
+	for petID, tier := range tiers {
+		updated, err := memberships.
+			Update().
+			Where(
+				leaguemembership.GroupID(groupID),
+				leaguemembership.PetID(petID),
+			).
+			SetTier(string(tier)).
+			SetUpdatedAt(now).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update league tier: %w", err)
+		}
+
+		if updated > 0 {
+			continue
+		}
+
+		_, err = memberships.
+			Create().
+			SetGroupID(groupID).
+			SetPetID(petID).
+			SetTier(string(tier)).
+			SetUpdatedAt(now).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create league tier: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// entToDomain converts an Ent season entity to a domain season
+func (r *SeasonRepository) entToDomain(entSeason *ent.Season) *domain.Season {
+	return &domain.Season{
+		ID:              entSeason.ID,
+		GroupID:         entSeason.GroupID,
+		Name:            entSeason.Name,
+		StartDate:       entSeason.StartDate,
+		EndDate:         entSeason.EndDate,
+		PromotionSlots:  entSeason.PromotionSlots,
+		RelegationSlots: entSeason.RelegationSlots,
+		Status:          domain.SeasonStatus(entSeason.Status),
+		CreatedBy:       entSeason.CreatedBy,
+		CreatedAt:       entSeason.CreatedAt,
+		ClosedAt:        entSeason.ClosedAt,
+	}
+}
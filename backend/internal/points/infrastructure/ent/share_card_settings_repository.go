@@ -0,0 +1,80 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/ent"
+	"pet-of-the-day/ent/sharecardsettings"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// ShareCardSettingsRepository implements the domain.ShareCardSettingsRepository interface using Ent ORM
+type ShareCardSettingsRepository struct {
+	client *ent.Client
+}
+
+// NewShareCardSettingsRepository creates a new Ent-based share card settings repository
+func NewShareCardSettingsRepository(client *ent.Client) *ShareCardSettingsRepository {
+	return &ShareCardSettingsRepository{
+		client: client,
+	}
+}
+
+// GetByGroup retrieves the settings of a group, the default settings if none were saved
+func (r *ShareCardSettingsRepository) GetByGroup(ctx context.Context, groupID uuid.UUID) (*domain.ShareCardSettings, error) {
+	entSettings, err := r.client.ShareCardSettings.
+		Query().
+		Where(sharecardsettings.GroupID(groupID)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.DefaultShareCardSettings(groupID), nil
+		}
+		return nil, fmt.Errorf("failed to get share card settings: %w", err)
+	}
+
+	return &domain.ShareCardSettings{
+		GroupID:   entSettings.GroupID,
+		Template:  domain.CardTemplate(entSettings.Template),
+		Font:      domain.CardFont(entSettings.Font),
+		UpdatedBy: entSettings.UpdatedBy,
+		UpdatedAt: entSettings.UpdatedAt,
+	}, nil
+}
+
+// Save creates or replaces the settings of a group
+func (r *ShareCardSettingsRepository) Save(ctx context.Context, settings *domain.ShareCardSettings) error {
+	updated, err := r.client.ShareCardSettings.
+		Update().
+		Where(sharecardsettings.GroupID(settings.GroupID)).
+		SetTemplate(string(settings.Template)).
+		SetFont(string(settings.Font)).
+		SetNillableUpdatedBy(settings.UpdatedBy).
+		SetUpdatedAt(settings.UpdatedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update share card settings: %w", err)
+	}
+
+	if updated > 0 {
+		return nil
+	}
+
+	_, err = r.client.ShareCardSettings.
+		Create().
+		SetGroupID(settings.GroupID).
+		SetTemplate(string(settings.Template)).
+		SetFont(string(settings.Font)).
+		SetNillableUpdatedBy(settings.UpdatedBy).
+		SetUpdatedAt(settings.UpdatedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create share card settings: %w", err)
+	}
+
+	return nil
+}
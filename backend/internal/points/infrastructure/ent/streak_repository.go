@@ -0,0 +1,94 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/ent"
+	"pet-of-the-day/ent/petstreak"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// StreakRepository implements the domain.StreakRepository interface using Ent ORM
+type StreakRepository struct {
+	client *ent.Client
+}
+
+// NewStreakRepository creates a new Ent-based streak repository
+func NewStreakRepository(client *ent.Client) *StreakRepository {
+	return &StreakRepository{
+		client: client,
+	}
+}
+
+// GetByPet retrieves all streaks of a pet
+func (r *StreakRepository) GetByPet(ctx context.Context, petID uuid.UUID) ([]*domain.PetStreak, error) {
+	entStreaks, err := r.client.PetStreak.
+		Query().
+		Where(petstreak.PetID(petID)).
+		Order(ent.Asc(petstreak.FieldType), ent.Asc(petstreak.FieldCategory)).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pet streaks: %w", err)
+	}
+
+	streaks := make([]*domain.PetStreak, len(entStreaks))
+	for i, entStreak := range entStreaks {
+		streaks[i] = &domain.PetStreak{
+			ID:          entStreak.ID,
+			PetID:       entStreak.PetID,
+			Type:        domain.StreakType(entStreak.Type),
+			Category:    domain.BehaviorCategory(entStreak.Category),
+			LatestStart: entStreak.LatestStart,
+			LatestEnd:   entStreak.LatestEnd,
+			BestDays:    entStreak.BestDays,
+			BestStart:   entStreak.BestStart,
+			BestEnd:     entStreak.BestEnd,
+			UpdatedAt:   entStreak.UpdatedAt,
+		}
+	}
+
+	return streaks, nil
+}
+
+// ReplaceForPet replaces all streaks of a pet with freshly computed ones
+func (r *StreakRepository) ReplaceForPet(ctx context.Context, petID uuid.UUID, streaks []*domain.PetStreak) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.PetStreak.
+		Delete().
+		Where(petstreak.PetID(petID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to clear pet streaks: %w", err)
+	}
+
+	builders := make([]*ent.PetStreakCreate, len(streaks))
+	for i, streak := range streaks {
+		builders[i] = tx.PetStreak.
+			Create().
+			SetID(streak.ID).
+			SetPetID(petID).
+			SetType(string(streak.Type)).
+			SetCategory(string(streak.Category)).
+			SetNillableLatestStart(streak.LatestStart).
+			SetNillableLatestEnd(streak.LatestEnd).
+			SetBestDays(streak.BestDays).
+			SetNillableBestStart(streak.BestStart).
+			SetNillableBestEnd(streak.BestEnd).
+			SetUpdatedAt(streak.UpdatedAt)
+	}
+
+	if _, err := tx.PetStreak.CreateBulk(builders...).Save(ctx); err != nil {
+		return fmt.Errorf("failed to save pet streaks: %w", err)
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,70 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/ent"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// UserSettingsRepository implements the domain.UserSettingsRepository interface using Ent ORM.
+// Timezone settings are stored on the user, empty until the user changes them.
+type UserSettingsRepository struct {
+	client *ent.Client
+}
+
+// NewUserSettingsRepository creates a new Ent-based user settings repository
+func NewUserSettingsRepository(client *ent.Client) *UserSettingsRepository {
+	return &UserSettingsRepository{
+		client: client,
+	}
+}
+
+// GetUserTimezone retrieves a user's timezone settings, the defaults for the ones never set
+func (r *UserSettingsRepository) GetUserTimezone(ctx context.Context, userID uuid.UUID) (*domain.UserTimezoneSettings, error) {
+	entUser, err := r.client.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("user not found")
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	settings := domain.NewUserTimezoneSettings(userID)
+	if entUser.Timezone != "" {
+		settings.Timezone = entUser.Timezone
+	}
+	if entUser.DailyResetTime != "" {
+		settings.DailyResetTime = entUser.DailyResetTime
+	}
+	settings.UpdatedAt = entUser.UpdatedAt
+
+	return settings, nil
+}
+
+// UpdateUserTimezone updates a user's timezone settings
+func (r *UserSettingsRepository) UpdateUserTimezone(ctx context.Context, userID uuid.UUID, settings *domain.UserTimezoneSettings) error {
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+
+	settings.UpdatedAt = time.Now()
+	err := r.client.User.
+		UpdateOneID(userID).
+		SetTimezone(settings.Timezone).
+		SetDailyResetTime(settings.DailyResetTime).
+		SetUpdatedAt(settings.UpdatedAt).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("user not found")
+		}
+		return fmt.Errorf("failed to update user timezone: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/ent"
+	"pet-of-the-day/ent/verificationpolicy"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// VerificationPolicyRepository implements the domain.VerificationPolicyRepository interface using Ent ORM
+type VerificationPolicyRepository struct {
+	client *ent.Client
+}
+
+// NewVerificationPolicyRepository creates a new Ent-based verification policy repository
+func NewVerificationPolicyRepository(client *ent.Client) *VerificationPolicyRepository {
+	return &VerificationPolicyRepository{
+		client: client,
+	}
+}
+
+// GetByGroup retrieves the policy of a group, the default policy if none was saved
+func (r *VerificationPolicyRepository) GetByGroup(ctx context.Context, groupID uuid.UUID) (*domain.VerificationPolicy, error) {
+	entPolicy, err := r.client.VerificationPolicy.
+		Query().
+		Where(verificationpolicy.GroupID(groupID)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.DefaultVerificationPolicy(groupID), nil
+		}
+		return nil, fmt.Errorf("failed to get verification policy: %w", err)
+	}
+
+	return &domain.VerificationPolicy{
+		GroupID:         entPolicy.GroupID,
+		Mode:            domain.VerificationMode(entPolicy.Mode),
+		Threshold:       entPolicy.Threshold,
+		AutoHoldFlagged: entPolicy.AutoHoldFlagged,
+		UpdatedBy:       entPolicy.UpdatedBy,
+		UpdatedAt:       entPolicy.UpdatedAt,
+	}, nil
+}
+
+// Save creates or replaces the policy of a group
+func (r *VerificationPolicyRepository) Save(ctx context.Context, policy *domain.VerificationPolicy) error {
+	updated, err := r.client.VerificationPolicy.
+		Update().
+		Where(verificationpolicy.GroupID(policy.GroupID)).
+		SetMode(verificationpolicy.Mode(policy.Mode)).
+		SetThreshold(policy.Threshold).
+		SetAutoHoldFlagged(policy.AutoHoldFlagged).
+		SetNillableUpdatedBy(policy.UpdatedBy).
+		SetUpdatedAt(policy.UpdatedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update verification policy: %w", err)
+	}
+
+	if updated > 0 {
+		return nil
+	}
+
+	_, err = r.client.VerificationPolicy.
+		Create().
+		SetGroupID(policy.GroupID).
+		SetMode(verificationpolicy.Mode(policy.Mode)).
+		SetThreshold(policy.Threshold).
+		SetAutoHoldFlagged(policy.AutoHoldFlagged).
+		SetNillableUpdatedBy(policy.UpdatedBy).
+		SetUpdatedAt(policy.UpdatedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create verification policy: %w", err)
+	}
+
+	return nil
+}
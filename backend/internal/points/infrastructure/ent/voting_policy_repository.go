@@ -0,0 +1,83 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/ent"
+	"pet-of-the-day/ent/votingpolicy"
+	"pet-of-the-day/internal/points/domain"
+)
+
+// VotingPolicyRepository implements the domain.VotingPolicyRepository interface using Ent ORM
+type VotingPolicyRepository struct {
+	client *ent.Client
+}
+
+// NewVotingPolicyRepository creates a new Ent-based voting policy repository
+func NewVotingPolicyRepository(client *ent.Client) *VotingPolicyRepository {
+	return &VotingPolicyRepository{
+		client: client,
+	}
+}
+
+// GetByGroup retrieves the policy of a group, the default policy if none was saved
+func (r *VotingPolicyRepository) GetByGroup(ctx context.Context, groupID uuid.UUID) (*domain.VotingPolicy, error) {
+	entPolicy, err := r.client.VotingPolicy.
+		Query().
+		Where(votingpolicy.GroupID(groupID)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.DefaultVotingPolicy(groupID), nil
+		}
+		return nil, fmt.Errorf("failed to get voting policy: %w", err)
+	}
+
+	return &domain.VotingPolicy{
+		GroupID:        entPolicy.GroupID,
+		Enabled:        entPolicy.Enabled,
+		WindowHours:    entPolicy.WindowHours,
+		WeightByPoints: entPolicy.WeightByPoints,
+		UpdatedBy:      entPolicy.UpdatedBy,
+		UpdatedAt:      entPolicy.UpdatedAt,
+	}, nil
+}
+
+// Save creates or replaces the policy of a group
+func (r *VotingPolicyRepository) Save(ctx context.Context, policy *domain.VotingPolicy) error {
+	updated, err := r.client.VotingPolicy.
+		Update().
+		Where(votingpolicy.GroupID(policy.GroupID)).
+		SetEnabled(policy.Enabled).
+		SetWindowHours(policy.WindowHours).
+		SetWeightByPoints(policy.WeightByPoints).
+		SetNillableUpdatedBy(policy.UpdatedBy).
+		SetUpdatedAt(policy.UpdatedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update voting policy: %w", err)
+	}
+
+	if updated > 0 {
+		return nil
+	}
+
+	_, err = r.client.VotingPolicy.
+		Create().
+		SetGroupID(policy.GroupID).
+		SetEnabled(policy.Enabled).
+		SetWindowHours(policy.WindowHours).
+		SetWeightByPoints(policy.WeightByPoints).
+		SetNillableUpdatedBy(policy.UpdatedBy).
+		SetUpdatedAt(policy.UpdatedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create voting policy: %w", err)
+	}
+
+	return nil
+}
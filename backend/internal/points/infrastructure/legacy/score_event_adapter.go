@@ -0,0 +1,309 @@
+package legacy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+)
+
+// pageSize is the number of logs or scores read at once when a legacy call covers all of them
+const pageSize = 500
+
+// BehaviorLogCreator creates behavior logs like the behavior log API, with its validation,
+// verification and events. It is implemented by commands.CreateBehaviorLogHandler.
+type BehaviorLogCreator interface {
+	HandleScoreEvent(ctx context.Context, event domain.ScoreEvent) (*domain.BehaviorLog, error)
+}
+
+// DailyScoreRebuilder rebuilds the daily scores of a deleted behavior log on the days its groups
+// count it on. It is implemented by commands.DeleteBehaviorLogHandler.
+type DailyScoreRebuilder interface {
+	RebuildDailyScores(ctx context.Context, behaviorLog *domain.BehaviorLog) error
+}
+
+// ScoreEventAdapter serves the legacy score event API from the behavior log model, so that both
+// APIs agree. Score events are behavior logs counted in one group: creating an event creates a
+// log, and totals and leaderboards come from the daily scores. It implements
+// domain.ScoreEventRepository and domain.ScoreEventOwnerChecker.
+type ScoreEventAdapter struct {
+	behaviorLogCreator  BehaviorLogCreator
+	dailyScoreRebuilder DailyScoreRebuilder
+	behaviorLogRepo     domain.BehaviorLogRepository
+	dailyScoreRepo      domain.DailyScoreRepository
+	behaviorRepo        domain.BehaviorRepository
+	authRepo            domain.AuthorizationRepository
+}
+
+// NewScoreEventAdapter creates a new legacy score event adapter
+func NewScoreEventAdapter(
+	behaviorLogCreator BehaviorLogCreator,
+	dailyScoreRebuilder DailyScoreRebuilder,
+	behaviorLogRepo domain.BehaviorLogRepository,
+	dailyScoreRepo domain.DailyScoreRepository,
+	behaviorRepo domain.BehaviorRepository,
+	authRepo domain.AuthorizationRepository,
+) *ScoreEventAdapter {
+	return &ScoreEventAdapter{
+		behaviorLogCreator:  behaviorLogCreator,
+		dailyScoreRebuilder: dailyScoreRebuilder,
+		behaviorLogRepo:     behaviorLogRepo,
+		dailyScoreRepo:      dailyScoreRepo,
+		behaviorRepo:        behaviorRepo,
+		authRepo:            authRepo,
+	}
+}
+
+// Create records a score event through the behavior log API, as a log shared with the event's
+// group that also updates its daily score
+func (a *ScoreEventAdapter) Create(ctx context.Context, event domain.ScoreEvent) (*domain.ScoreEvent, error) {
+	behaviorLog, err := a.behaviorLogCreator.HandleScoreEvent(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+
+	created := domain.NewScoreEventFromBehaviorLog(behaviorLog, event.GroupID)
+	return &created, nil
+}
+
+// GetByID returns the behavior log with an ID as the score event of its first group, nil if
+// there is none or it is not shared with a group
+func (a *ScoreEventAdapter) GetByID(ctx context.Context, id uuid.UUID) (*domain.ScoreEvent, error) {
+	behaviorLog, err := a.behaviorLogRepo.GetByID(ctx, id)
+	if errors.Is(err, domain.ErrBehaviorLogNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get behavior log: %w", err)
+	}
+	if len(behaviorLog.GroupShares) == 0 {
+		return nil, nil
+	}
+
+	event := domain.NewScoreEventFromBehaviorLog(behaviorLog, behaviorLog.GroupShares[0].GroupID)
+	return &event, nil
+}
+
+// GetByPetAndGroup returns the logs of a pet counted in a group, the most recent first
+func (a *ScoreEventAdapter) GetByPetAndGroup(ctx context.Context, petID, groupID uuid.UUID, limit int) ([]domain.ScoreEvent, error) {
+	filter := domain.NewBehaviorLogFilter().
+		WithPet(petID).
+		WithGroup(groupID).
+		WithShareStatus(domain.ShareStatusVerified).
+		WithPagination(limit, 0)
+	behaviorLogs, err := a.behaviorLogRepo.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find behavior logs: %w", err)
+	}
+
+	events := make([]domain.ScoreEvent, 0, len(behaviorLogs))
+	for _, behaviorLog := range behaviorLogs {
+		events = append(events, domain.NewScoreEventFromBehaviorLog(behaviorLog, groupID))
+	}
+	return events, nil
+}
+
+// GetTotalPointsByPetAndGroup returns the sum of the daily scores of a pet in a group
+func (a *ScoreEventAdapter) GetTotalPointsByPetAndGroup(ctx context.Context, petID, groupID uuid.UUID) (int, error) {
+	total := 0
+	for offset := 0; ; offset += pageSize {
+		filter := domain.NewDailyScoreFilter().WithPet(petID).WithGroup(groupID)
+		filter.Limit, filter.Offset = pageSize, offset
+
+		dailyScores, err := a.dailyScoreRepo.Find(ctx, filter)
+		if err != nil {
+			return 0, fmt.Errorf("failed to find daily scores: %w", err)
+		}
+		for _, dailyScore := range dailyScores {
+			total += dailyScore.TotalPoints
+		}
+		if len(dailyScores) < pageSize {
+			return total, nil
+		}
+	}
+}
+
+// GetLeaderboardData returns the group rankings of the days between two moments, the end excluded
+func (a *ScoreEventAdapter) GetLeaderboardData(ctx context.Context, groupID uuid.UUID, startDate, endDate time.Time) ([]domain.LeaderboardEntry, error) {
+	// Rankings include the last day, legacy periods end at midnight of the next one
+	rankings, err := a.dailyScoreRepo.GetRankingsByDateRange(ctx, groupID, startDate, endDate.Add(-time.Nanosecond))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rankings: %w", err)
+	}
+
+	leaderboard := make([]domain.LeaderboardEntry, 0, len(rankings))
+	for _, ranking := range rankings {
+		entry := domain.LeaderboardEntry{
+			PetID:       ranking.PetID,
+			PetName:     ranking.PetName,
+			OwnerName:   ranking.OwnerName,
+			TotalPoints: ranking.TotalPoints,
+			ActionCount: ranking.PositiveBehaviors + ranking.NegativeBehaviors,
+		}
+		if petInfo, err := a.authRepo.GetPetInfo(ctx, ranking.PetID); err == nil {
+			entry.Species = string(petInfo.Species)
+		}
+		leaderboard = append(leaderboard, entry)
+	}
+
+	sort.SliceStable(leaderboard, func(i, j int) bool {
+		return leaderboard[i].TotalPoints > leaderboard[j].TotalPoints
+	})
+
+	return leaderboard, nil
+}
+
+// Delete deletes a behavior log and recomputes the daily scores it counted in
+func (a *ScoreEventAdapter) Delete(ctx context.Context, id uuid.UUID) error {
+	behaviorLog, err := a.behaviorLogRepo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get behavior log: %w", err)
+	}
+
+	if err := a.behaviorLogRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete behavior log: %w", err)
+	}
+
+	if err := a.dailyScoreRebuilder.RebuildDailyScores(ctx, behaviorLog); err != nil {
+		return fmt.Errorf("failed to update daily scores: %w", err)
+	}
+	return nil
+}
+
+// DeleteByGroupID removes the logs and daily scores of a deleted group. Logs shared with other
+// groups are kept there.
+func (a *ScoreEventAdapter) DeleteByGroupID(ctx context.Context, groupID uuid.UUID) error {
+	// Handled logs leave the group, so the first page is read until it is empty
+	for {
+		behaviorLogs, err := a.behaviorLogRepo.GetByGroup(ctx, groupID, domain.NewBehaviorLogFilter().WithPagination(pageSize, 0))
+		if err != nil {
+			return fmt.Errorf("failed to get group behavior logs: %w", err)
+		}
+		if len(behaviorLogs) == 0 {
+			break
+		}
+
+		for _, behaviorLog := range behaviorLogs {
+			if len(behaviorLog.GroupShares) <= 1 {
+				err = a.behaviorLogRepo.Delete(ctx, behaviorLog.ID)
+			} else if err = behaviorLog.RemoveGroupShare(groupID); err == nil {
+				err = a.behaviorLogRepo.Update(ctx, behaviorLog)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to remove behavior log %s from group: %w", behaviorLog.ID, err)
+			}
+		}
+	}
+
+	for {
+		filter := domain.NewDailyScoreFilter().WithGroup(groupID)
+		filter.Limit = pageSize
+
+		dailyScores, err := a.dailyScoreRepo.Find(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("failed to find group daily scores: %w", err)
+		}
+		if len(dailyScores) == 0 {
+			return nil
+		}
+
+		for _, dailyScore := range dailyScores {
+			if err := a.dailyScoreRepo.Delete(ctx, dailyScore.ID); err != nil {
+				return fmt.Errorf("failed to delete daily score: %w", err)
+			}
+		}
+	}
+}
+
+// GetRecentActivitiesForUser returns the most recent logs counted in the groups of a user
+func (a *ScoreEventAdapter) GetRecentActivitiesForUser(ctx context.Context, userID uuid.UUID, limit int) ([]domain.ActivityItem, error) {
+	groupIDs, err := a.authRepo.GetUserGroups(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user groups: %w", err)
+	}
+
+	activities := make([]domain.ActivityItem, 0)
+	for _, groupID := range groupIDs {
+		filter := domain.NewBehaviorLogFilter().
+			WithShareStatus(domain.ShareStatusVerified).
+			WithPagination(limit, 0)
+		behaviorLogs, err := a.behaviorLogRepo.GetByGroup(ctx, groupID, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get group behavior logs: %w", err)
+		}
+
+		for _, behaviorLog := range behaviorLogs {
+			event := domain.NewScoreEventFromBehaviorLog(behaviorLog, groupID)
+			activities = append(activities, domain.ActivityItem{
+				ID:         event.ID,
+				PetID:      event.PetID,
+				BehaviorID: event.BehaviorID,
+				GroupID:    event.GroupID,
+				Points:     event.Points,
+				Comment:    event.Comment,
+				RecordedAt: event.RecordedAt,
+				ActionDate: event.ActionDate,
+				RecordedBy: event.RecordedByID,
+			})
+		}
+	}
+
+	sort.SliceStable(activities, func(i, j int) bool {
+		return activities[i].RecordedAt.After(activities[j].RecordedAt)
+	})
+	if len(activities) > limit {
+		activities = activities[:limit]
+	}
+
+	a.addActivityNames(ctx, activities)
+	return activities, nil
+}
+
+// IsScoreEventOwner checks if a user recorded a behavior log
+func (a *ScoreEventAdapter) IsScoreEventOwner(ctx context.Context, userID, eventID uuid.UUID) (bool, error) {
+	behaviorLog, err := a.behaviorLogRepo.GetByID(ctx, eventID)
+	if errors.Is(err, domain.ErrBehaviorLogNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get behavior log: %w", err)
+	}
+	return behaviorLog.UserID == userID, nil
+}
+
+// addActivityNames fills in the names of the pets, behaviors and groups of activities. Names that
+// cannot be found are left empty.
+func (a *ScoreEventAdapter) addActivityNames(ctx context.Context, activities []domain.ActivityItem) {
+	petNames := make(map[uuid.UUID]string)
+	behaviorNames := make(map[uuid.UUID]string)
+	groupNames := make(map[uuid.UUID]string)
+
+	for i := range activities {
+		activity := &activities[i]
+
+		if _, exists := petNames[activity.PetID]; !exists {
+			if petInfo, err := a.authRepo.GetPetInfo(ctx, activity.PetID); err == nil {
+				petNames[activity.PetID] = petInfo.Name
+			}
+		}
+		if _, exists := behaviorNames[activity.BehaviorID]; !exists {
+			if behavior, err := a.behaviorRepo.GetByID(ctx, activity.BehaviorID); err == nil && behavior != nil {
+				behaviorNames[activity.BehaviorID] = behavior.Name
+			}
+		}
+		if _, exists := groupNames[activity.GroupID]; !exists {
+			if groupInfo, err := a.authRepo.GetGroupInfo(ctx, activity.GroupID); err == nil {
+				groupNames[activity.GroupID] = groupInfo.Name
+			}
+		}
+
+		activity.PetName = petNames[activity.PetID]
+		activity.BehaviorName = behaviorNames[activity.BehaviorID]
+		activity.GroupName = groupNames[activity.GroupID]
+	}
+}
@@ -0,0 +1,253 @@
+package legacy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+)
+
+// behaviorLogCreatorFunc lets a function stand in for the behavior log API
+type behaviorLogCreatorFunc func(ctx context.Context, event domain.ScoreEvent) (*domain.BehaviorLog, error)
+
+func (f behaviorLogCreatorFunc) HandleScoreEvent(ctx context.Context, event domain.ScoreEvent) (*domain.BehaviorLog, error) {
+	return f(ctx, event)
+}
+
+// dailyScoreRebuilderFunc lets a function stand in for the behavior log API when logs are deleted
+type dailyScoreRebuilderFunc func(ctx context.Context, behaviorLog *domain.BehaviorLog) error
+
+func (f dailyScoreRebuilderFunc) RebuildDailyScores(ctx context.Context, behaviorLog *domain.BehaviorLog) error {
+	return f(ctx, behaviorLog)
+}
+
+// failingBehaviorLogRepository fails to read behavior logs
+type failingBehaviorLogRepository struct {
+	*mock.MockBehaviorLogRepository
+}
+
+func (r *failingBehaviorLogRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.BehaviorLog, error) {
+	return nil, errors.New("connection lost")
+}
+
+func TestScoreEventAdapter(t *testing.T) {
+	ctx := context.Background()
+
+	// Setup: Alice owns Rex, who plays fetch for 3 points in the Park friends group
+	behaviorLogRepo := mock.NewMockBehaviorLogRepository()
+	dailyScoreRepo := mock.NewMockDailyScoreRepository().
+		WithRecalculationSources(behaviorLogRepo, mock.NewMockPointAdjustmentRepository())
+	behaviorRepo := mock.NewMockBehaviorRepository()
+	authRepo := mock.NewMockAuthorizationRepository()
+
+	aliceID, rexID, groupID := uuid.New(), uuid.New(), uuid.New()
+	authRepo.AddUserPet(aliceID, rexID, &domain.PetInfo{ID: rexID, Name: "Rex", Species: domain.SpeciesDog, OwnerID: aliceID})
+	authRepo.AddUserGroup(aliceID, groupID, &domain.GroupInfo{ID: groupID, Name: "Park friends"})
+	authRepo.AddPetToGroup(rexID, groupID)
+
+	fetch, err := domain.NewBehavior("Fetch session", "Played fetch", domain.BehaviorCategoryPlay, 3, 60, domain.SpeciesDog, "🎾")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	behaviorRepo.Create(ctx, fetch)
+
+	// The behavior log API stores the log and rebuilds the day it lands on, and rebuilds the days
+	// of the logs deleted
+	var created []domain.ScoreEvent
+	var rebuilt []uuid.UUID
+	adapter := NewScoreEventAdapter(
+		behaviorLogCreatorFunc(func(ctx context.Context, event domain.ScoreEvent) (*domain.BehaviorLog, error) {
+			created = append(created, event)
+			event.ID = uuid.New()
+			behaviorLog, err := domain.NewBehaviorLogFromScoreEvent(event)
+			if err != nil {
+				return nil, err
+			}
+			if err := behaviorLogRepo.Create(ctx, behaviorLog); err != nil {
+				return nil, err
+			}
+			_, err = dailyScoreRepo.RecalculateFromLogs(ctx, event.PetID, event.GroupID, event.ActionDate.Truncate(24*time.Hour))
+			return behaviorLog, err
+		}),
+		dailyScoreRebuilderFunc(func(ctx context.Context, behaviorLog *domain.BehaviorLog) error {
+			rebuilt = append(rebuilt, behaviorLog.ID)
+			_, err := dailyScoreRepo.RecalculateFromLogs(ctx, behaviorLog.PetID, groupID, behaviorLog.LoggedAt.Truncate(24*time.Hour))
+			return err
+		}),
+		behaviorLogRepo, dailyScoreRepo, behaviorRepo, authRepo,
+	)
+
+	record := func(t *testing.T, day, points int) *domain.ScoreEvent {
+		t.Helper()
+
+		event, err := adapter.Create(ctx, domain.ScoreEvent{
+			PetID:        rexID,
+			BehaviorID:   fetch.ID,
+			GroupID:      groupID,
+			RecordedByID: aliceID,
+			Points:       points,
+			ActionDate:   time.Date(2025, time.March, day, 10, 0, 0, 0, time.UTC),
+			RecordedAt:   time.Date(2025, time.March, day, 10, 5, 0, 0, time.UTC),
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		return event
+	}
+
+	first := record(t, 10, 3)
+	record(t, 10, 4)
+	latest := record(t, 11, -2)
+
+	t.Run("Creates events through the behavior log API", func(t *testing.T) {
+		if len(created) != 3 || created[0].GroupID != groupID || created[0].RecordedByID != aliceID {
+			t.Fatalf("Expected the 3 events to be passed on, got %+v", created)
+		}
+
+		found, err := adapter.GetByID(ctx, first.ID)
+		if err != nil || found == nil {
+			t.Fatalf("Expected the event to be found, got %v", err)
+		}
+		if found.GroupID != groupID || found.Points != 3 || found.RecordedByID != aliceID {
+			t.Errorf("Expected the event to round trip, got %+v", found)
+		}
+	})
+
+	t.Run("Serves totals and leaderboards from the daily scores", func(t *testing.T) {
+		total, err := adapter.GetTotalPointsByPetAndGroup(ctx, rexID, groupID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if total != 5 {
+			t.Errorf("Expected 5 points, got %d", total)
+		}
+
+		events, err := adapter.GetByPetAndGroup(ctx, rexID, groupID, 10)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(events) != 3 {
+			t.Errorf("Expected 3 events, got %d", len(events))
+		}
+
+		// Legacy periods end at midnight of the day after the last one
+		leaderboard, err := adapter.GetLeaderboardData(ctx, groupID,
+			time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC), time.Date(2025, time.March, 11, 0, 0, 0, 0, time.UTC))
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(leaderboard) != 1 || leaderboard[0].TotalPoints != 7 || leaderboard[0].ActionCount != 2 || leaderboard[0].Species != "dog" {
+			t.Errorf("Expected Rex with 7 points from 2 actions, got %+v", leaderboard)
+		}
+	})
+
+	t.Run("Fills in the names of recent activities", func(t *testing.T) {
+		activities, err := adapter.GetRecentActivitiesForUser(ctx, aliceID, 1)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(activities) != 1 || activities[0].ID != latest.ID {
+			t.Fatalf("Expected the latest event, got %+v", activities)
+		}
+		if activities[0].PetName != "Rex" || activities[0].BehaviorName != "Fetch session" || activities[0].GroupName != "Park friends" {
+			t.Errorf("Expected names to be filled in, got %+v", activities[0])
+		}
+	})
+
+	owners := []struct {
+		name    string
+		userID  uuid.UUID
+		eventID uuid.UUID
+		want    bool
+	}{
+		{name: "The recorder owns the event", userID: aliceID, eventID: first.ID, want: true},
+		{name: "Other members do not", userID: uuid.New(), eventID: first.ID},
+		{name: "Nobody owns an unknown event", userID: aliceID, eventID: uuid.New()},
+	}
+
+	for _, tt := range owners {
+		t.Run(tt.name, func(t *testing.T) {
+			owner, err := adapter.IsScoreEventOwner(ctx, tt.userID, tt.eventID)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if owner != tt.want {
+				t.Errorf("Expected owner %t, got %t", tt.want, owner)
+			}
+		})
+	}
+
+	t.Run("Reports unknown events as missing", func(t *testing.T) {
+		missing, err := adapter.GetByID(ctx, uuid.New())
+		if err != nil || missing != nil {
+			t.Errorf("Expected no event and no error, got %v, %v", missing, err)
+		}
+	})
+
+	t.Run("Reports failed lookups as errors", func(t *testing.T) {
+		failing := NewScoreEventAdapter(nil, nil, &failingBehaviorLogRepository{behaviorLogRepo}, dailyScoreRepo, behaviorRepo, authRepo)
+
+		if _, err := failing.GetByID(ctx, first.ID); err == nil {
+			t.Error("Expected an error from GetByID")
+		}
+		if _, err := failing.IsScoreEventOwner(ctx, aliceID, first.ID); err == nil {
+			t.Error("Expected an error from IsScoreEventOwner")
+		}
+	})
+
+	t.Run("Deletes events and rebuilds their day", func(t *testing.T) {
+		if err := adapter.Delete(ctx, first.ID); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if len(rebuilt) != 1 || rebuilt[0] != first.ID {
+			t.Errorf("Expected the days of the deleted log to be rebuilt, got %v", rebuilt)
+		}
+		if total, _ := adapter.GetTotalPointsByPetAndGroup(ctx, rexID, groupID); total != 2 {
+			t.Errorf("Expected the points to drop to 2, got %d", total)
+		}
+		if found, _ := adapter.GetByID(ctx, first.ID); found != nil {
+			t.Errorf("Expected the event to be gone")
+		}
+	})
+
+	t.Run("Deleting the group keeps logs shared with other groups", func(t *testing.T) {
+		otherGroupID := uuid.New()
+		loggedAt := time.Date(2025, time.March, 12, 11, 0, 0, 0, time.UTC)
+		shared := &domain.BehaviorLog{
+			ID:            uuid.New(),
+			PetID:         rexID,
+			BehaviorID:    fetch.ID,
+			UserID:        aliceID,
+			PointsAwarded: 3,
+			LoggedAt:      loggedAt,
+			CreatedAt:     loggedAt,
+		}
+		shared.AddGroupShare(groupID)
+		shared.AddGroupShare(otherGroupID)
+		behaviorLogRepo.Create(ctx, shared)
+
+		if err := adapter.DeleteByGroupID(ctx, groupID); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if _, err := behaviorLogRepo.GetByID(ctx, latest.ID); !errors.Is(err, domain.ErrBehaviorLogNotFound) {
+			t.Errorf("Expected the log of the group only to be deleted, got %v", err)
+		}
+		kept, err := behaviorLogRepo.GetByID(ctx, shared.ID)
+		if err != nil {
+			t.Fatalf("Expected the shared log to be kept, got %v", err)
+		}
+		if kept.IsSharedWithGroup(groupID) || !kept.IsSharedWithGroup(otherGroupID) {
+			t.Errorf("Expected the shared log to leave the deleted group only")
+		}
+		if total, _ := adapter.GetTotalPointsByPetAndGroup(ctx, rexID, groupID); total != 0 {
+			t.Errorf("Expected no points left in the group, got %d", total)
+		}
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,9 +15,9 @@ import (
 
 // MockBehaviorRepository provides a mock implementation of domain.BehaviorRepository
 type MockBehaviorRepository struct {
-	mu         sync.RWMutex
-	behaviors  map[uuid.UUID]*domain.Behavior
-	nameIndex  map[string]uuid.UUID
+	mu        sync.RWMutex
+	behaviors map[uuid.UUID]*domain.Behavior
+	nameIndex map[string]uuid.UUID
 }
 
 // NewMockBehaviorRepository creates a new mock behavior repository
@@ -30,24 +31,24 @@ func NewMockBehaviorRepository() *MockBehaviorRepository {
 func (r *MockBehaviorRepository) Create(ctx context.Context, behavior *domain.Behavior) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	
+
 	// Check name uniqueness
-	if _, exists := r.nameIndex[behavior.Name]; exists {
+	if _, exists := r.nameIndex[strings.ToLower(behavior.Name)]; exists {
 		return fmt.Errorf("behavior with name '%s' already exists", behavior.Name)
 	}
-	
+
 	r.behaviors[behavior.ID] = behavior
-	r.nameIndex[behavior.Name] = behavior.ID
+	r.nameIndex[strings.ToLower(behavior.Name)] = behavior.ID
 	return nil
 }
 
 func (r *MockBehaviorRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Behavior, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	
+
 	behavior, exists := r.behaviors[id]
 	if !exists {
-		return nil, fmt.Errorf("behavior not found")
+		return nil, domain.ErrBehaviorNotFound
 	}
 	return behavior, nil
 }
@@ -55,7 +56,7 @@ func (r *MockBehaviorRepository) GetByID(ctx context.Context, id uuid.UUID) (*do
 func (r *MockBehaviorRepository) GetAll(ctx context.Context) ([]domain.Behavior, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	
+
 	var behaviors []domain.Behavior
 	for _, behavior := range r.behaviors {
 		if behavior.IsActive {
@@ -68,7 +69,7 @@ func (r *MockBehaviorRepository) GetAll(ctx context.Context) ([]domain.Behavior,
 func (r *MockBehaviorRepository) GetBySpecies(ctx context.Context, species domain.Species) ([]domain.Behavior, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	
+
 	var behaviors []domain.Behavior
 	for _, behavior := range r.behaviors {
 		if behavior.IsActive && (behavior.Species == species || behavior.Species == domain.SpeciesBoth) {
@@ -81,7 +82,7 @@ func (r *MockBehaviorRepository) GetBySpecies(ctx context.Context, species domai
 func (r *MockBehaviorRepository) GetAllActive(ctx context.Context, species *domain.Species) ([]*domain.Behavior, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	
+
 	var behaviors []*domain.Behavior
 	for _, behavior := range r.behaviors {
 		if behavior.IsActive {
@@ -96,7 +97,7 @@ func (r *MockBehaviorRepository) GetAllActive(ctx context.Context, species *doma
 func (r *MockBehaviorRepository) GetByCategory(ctx context.Context, category domain.BehaviorCategory, species *domain.Species) ([]*domain.Behavior, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	
+
 	var behaviors []*domain.Behavior
 	for _, behavior := range r.behaviors {
 		if behavior.IsActive && behavior.Category == category {
@@ -111,24 +112,27 @@ func (r *MockBehaviorRepository) GetByCategory(ctx context.Context, category dom
 func (r *MockBehaviorRepository) Update(ctx context.Context, behavior *domain.Behavior) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	
-	if _, exists := r.behaviors[behavior.ID]; !exists {
+
+	existing, exists := r.behaviors[behavior.ID]
+	if !exists {
 		return fmt.Errorf("behavior not found")
 	}
-	
+
+	delete(r.nameIndex, strings.ToLower(existing.Name))
 	r.behaviors[behavior.ID] = behavior
+	r.nameIndex[strings.ToLower(behavior.Name)] = behavior.ID
 	return nil
 }
 
 func (r *MockBehaviorRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	
+
 	behavior, exists := r.behaviors[id]
 	if !exists {
 		return fmt.Errorf("behavior not found")
 	}
-	
+
 	behavior.IsActive = false
 	return nil
 }
@@ -136,107 +140,181 @@ func (r *MockBehaviorRepository) Delete(ctx context.Context, id uuid.UUID) error
 func (r *MockBehaviorRepository) GetByName(ctx context.Context, name string) (*domain.Behavior, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	
-	id, exists := r.nameIndex[name]
+
+	id, exists := r.nameIndex[strings.ToLower(name)]
 	if !exists {
 		return nil, nil
 	}
-	
+
 	return r.behaviors[id], nil
 }
 
+func (r *MockBehaviorRepository) GetAllIncludingInactive(ctx context.Context) ([]*domain.Behavior, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	behaviors := make([]*domain.Behavior, 0, len(r.behaviors))
+	for _, behavior := range r.behaviors {
+		behaviors = append(behaviors, behavior)
+	}
+	return behaviors, nil
+}
+
+func (r *MockBehaviorRepository) HardDelete(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	behavior, exists := r.behaviors[id]
+	if !exists {
+		return fmt.Errorf("behavior not found")
+	}
+
+	delete(r.nameIndex, strings.ToLower(behavior.Name))
+	delete(r.behaviors, id)
+	return nil
+}
+
 // MockBehaviorLogRepository provides a mock implementation of domain.BehaviorLogRepository
 type MockBehaviorLogRepository struct {
-	mu           sync.RWMutex
-	behaviorLogs map[uuid.UUID]*domain.BehaviorLog
-	lastLogged   map[string]*time.Time // key: petID_behaviorID
+	mu            sync.RWMutex
+	behaviorLogs  map[uuid.UUID]*domain.BehaviorLog
+	lastLogged    map[string]*time.Time          // key: petID_behaviorID
+	shareStatuses map[string]domain.ShareStatus  // key: behaviorLogID_groupID, status last saved
+	behaviors     map[uuid.UUID]*domain.Behavior // Behaviors named in breakdowns
 }
 
 // NewMockBehaviorLogRepository creates a new mock behavior log repository
 func NewMockBehaviorLogRepository() *MockBehaviorLogRepository {
 	return &MockBehaviorLogRepository{
-		behaviorLogs: make(map[uuid.UUID]*domain.BehaviorLog),
-		lastLogged:   make(map[string]*time.Time),
+		behaviorLogs:  make(map[uuid.UUID]*domain.BehaviorLog),
+		lastLogged:    make(map[string]*time.Time),
+		shareStatuses: make(map[string]domain.ShareStatus),
+		behaviors:     make(map[uuid.UUID]*domain.Behavior),
 	}
 }
 
+// AddBehavior names a behavior in breakdowns, other behaviors are named Unknown
+func (r *MockBehaviorLogRepository) AddBehavior(behavior *domain.Behavior) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.behaviors[behavior.ID] = behavior
+}
+
 func (r *MockBehaviorLogRepository) Create(ctx context.Context, behaviorLog *domain.BehaviorLog) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	
+
 	r.behaviorLogs[behaviorLog.ID] = behaviorLog
-	
+	r.saveShareStatuses(behaviorLog)
+
 	// Update last logged time index
 	key := fmt.Sprintf("%s_%s", behaviorLog.PetID, behaviorLog.BehaviorID)
 	r.lastLogged[key] = &behaviorLog.LoggedAt
-	
+
+	return nil
+}
+
+func (r *MockBehaviorLogRepository) CreateBatch(ctx context.Context, behaviorLogs []*domain.BehaviorLog) error {
+	for _, behaviorLog := range behaviorLogs {
+		if err := r.Create(ctx, behaviorLog); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func (r *MockBehaviorLogRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.BehaviorLog, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	
+
 	behaviorLog, exists := r.behaviorLogs[id]
 	if !exists {
-		return nil, fmt.Errorf("behavior log not found")
+		return nil, domain.ErrBehaviorLogNotFound
 	}
 	return behaviorLog, nil
 }
 
+func (r *MockBehaviorLogRepository) FindExistingIDs(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	existing := make([]uuid.UUID, 0)
+	for _, id := range ids {
+		if _, exists := r.behaviorLogs[id]; exists {
+			existing = append(existing, id)
+		}
+	}
+	return existing, nil
+}
+
 func (r *MockBehaviorLogRepository) Find(ctx context.Context, filter *domain.BehaviorLogFilter) ([]*domain.BehaviorLog, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	
+
 	var matches []*domain.BehaviorLog
-	
+
 	for _, behaviorLog := range r.behaviorLogs {
 		if r.matchesFilter(behaviorLog, filter) {
 			matches = append(matches, behaviorLog)
 		}
 	}
-	
+
 	// Sort by logged_at descending
 	sort.Slice(matches, func(i, j int) bool {
 		return matches[i].LoggedAt.After(matches[j].LoggedAt)
 	})
-	
+
 	// Apply pagination
 	start := filter.Offset
 	end := start + filter.Limit
-	
+
 	if start >= len(matches) {
 		return []*domain.BehaviorLog{}, nil
 	}
-	
+
 	if end > len(matches) {
 		end = len(matches)
 	}
-	
+
 	return matches[start:end], nil
 }
 
+func (r *MockBehaviorLogRepository) Count(ctx context.Context, filter *domain.BehaviorLogFilter) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	count := 0
+	for _, behaviorLog := range r.behaviorLogs {
+		if r.matchesFilter(behaviorLog, filter) {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
 func (r *MockBehaviorLogRepository) matchesFilter(behaviorLog *domain.BehaviorLog, filter *domain.BehaviorLogFilter) bool {
 	if filter.PetID != nil && behaviorLog.PetID != *filter.PetID {
 		return false
 	}
-	
+
 	if filter.BehaviorID != nil && behaviorLog.BehaviorID != *filter.BehaviorID {
 		return false
 	}
-	
+
 	if filter.UserID != nil && behaviorLog.UserID != *filter.UserID {
 		return false
 	}
-	
+
 	if filter.DateFrom != nil && behaviorLog.LoggedAt.Before(*filter.DateFrom) {
 		return false
 	}
-	
+
 	if filter.DateTo != nil && behaviorLog.LoggedAt.After(*filter.DateTo) {
 		return false
 	}
-	
+
 	if filter.GroupID != nil {
 		groupFound := false
 		for _, share := range behaviorLog.GroupShares {
@@ -249,35 +327,76 @@ func (r *MockBehaviorLogRepository) matchesFilter(behaviorLog *domain.BehaviorLo
 			return false
 		}
 	}
-	
+
+	if filter.ShareStatus != nil {
+		if filter.GroupID == nil {
+			return false
+		}
+		share := behaviorLog.GetGroupShare(*filter.GroupID)
+		if share == nil || share.CurrentStatus() != *filter.ShareStatus {
+			return false
+		}
+	}
+
 	return true
 }
 
 func (r *MockBehaviorLogRepository) Update(ctx context.Context, behaviorLog *domain.BehaviorLog) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	
+
 	if _, exists := r.behaviorLogs[behaviorLog.ID]; !exists {
 		return fmt.Errorf("behavior log not found")
 	}
-	
+
 	r.behaviorLogs[behaviorLog.ID] = behaviorLog
+	r.saveShareStatuses(behaviorLog)
+	return nil
+}
+
+// UpdateGroupShare saves a share if its last saved status is the expected one. Stored logs are
+// shared with callers, so the saved statuses are tracked apart from them.
+func (r *MockBehaviorLogRepository) UpdateGroupShare(ctx context.Context, share *domain.BehaviorLogGroupShare, expectedStatus domain.ShareStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	behaviorLog, exists := r.behaviorLogs[share.BehaviorLogID]
+	if !exists {
+		return fmt.Errorf("behavior log not found")
+	}
+	stored := behaviorLog.GetGroupShare(share.GroupID)
+	if stored == nil {
+		return fmt.Errorf("group share not found for group %s", share.GroupID)
+	}
+	key := fmt.Sprintf("%s_%s", share.BehaviorLogID, share.GroupID)
+	if r.shareStatuses[key] != expectedStatus {
+		return domain.ErrShareStatusChanged
+	}
+
+	*stored = *share
+	r.shareStatuses[key] = share.CurrentStatus()
 	return nil
 }
 
+func (r *MockBehaviorLogRepository) saveShareStatuses(behaviorLog *domain.BehaviorLog) {
+	for _, share := range behaviorLog.GroupShares {
+		r.shareStatuses[fmt.Sprintf("%s_%s", behaviorLog.ID, share.GroupID)] = share.CurrentStatus()
+	}
+}
+
 func (r *MockBehaviorLogRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	
+
 	behaviorLog, exists := r.behaviorLogs[id]
 	if !exists {
 		return fmt.Errorf("behavior log not found")
 	}
-	
+
 	// Remove from last logged index
 	key := fmt.Sprintf("%s_%s", behaviorLog.PetID, behaviorLog.BehaviorID)
 	delete(r.lastLogged, key)
-	
+
 	delete(r.behaviorLogs, id)
 	return nil
 }
@@ -285,82 +404,72 @@ func (r *MockBehaviorLogRepository) Delete(ctx context.Context, id uuid.UUID) er
 func (r *MockBehaviorLogRepository) GetLastLoggedAt(ctx context.Context, petID, behaviorID uuid.UUID) (*time.Time, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	
+
 	key := fmt.Sprintf("%s_%s", petID, behaviorID)
 	return r.lastLogged[key], nil
 }
 
+// GetBreakdown aggregates the logs counted in the group on the calendar day of the date, in its location
 func (r *MockBehaviorLogRepository) GetBreakdown(ctx context.Context, petID uuid.UUID, groupID uuid.UUID, date time.Time) ([]*domain.DailyScoreBreakdown, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	
-	// Calculate date boundaries
-	startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
-	endOfDay := startOfDay.Add(24 * time.Hour)
-	
-	// Aggregate by behavior ID
-	breakdown := make(map[uuid.UUID]*domain.DailyScoreBreakdown)
-	
-	for _, behaviorLog := range r.behaviorLogs {
-		if behaviorLog.PetID != petID {
-			continue
-		}
-		
-		if behaviorLog.LoggedAt.Before(startOfDay) || behaviorLog.LoggedAt.After(endOfDay) {
-			continue
+
+	behaviorLogs := make([]*domain.BehaviorLog, 0)
+	for _, behaviorLog := range r.logsOfDay(date) {
+		if behaviorLog.PetID == petID {
+			behaviorLogs = append(behaviorLogs, behaviorLog)
 		}
-		
-		// Check if shared with group
-		sharedWithGroup := false
-		for _, share := range behaviorLog.GroupShares {
-			if share.GroupID == groupID {
-				sharedWithGroup = true
-				break
-			}
+	}
+
+	return domain.NewDailyScoreBreakdown(groupID, behaviorLogs, r.behaviors), nil
+}
+
+func (r *MockBehaviorLogRepository) GetGroupBreakdowns(ctx context.Context, groupID uuid.UUID, date time.Time) (map[uuid.UUID][]*domain.DailyScoreBreakdown, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	logsByPet := make(map[uuid.UUID][]*domain.BehaviorLog)
+	for _, behaviorLog := range r.logsOfDay(date) {
+		if behaviorLog.IsCountedInGroup(groupID) {
+			logsByPet[behaviorLog.PetID] = append(logsByPet[behaviorLog.PetID], behaviorLog)
 		}
-		if !sharedWithGroup {
+	}
+
+	breakdowns := make(map[uuid.UUID][]*domain.DailyScoreBreakdown, len(logsByPet))
+	for petID, behaviorLogs := range logsByPet {
+		breakdowns[petID] = domain.NewDailyScoreBreakdown(groupID, behaviorLogs, r.behaviors)
+	}
+	return breakdowns, nil
+}
+
+// logsOfDay returns the logs logged on the calendar day of a date, the caller holds the lock
+func (r *MockBehaviorLogRepository) logsOfDay(date time.Time) []*domain.BehaviorLog {
+	startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	endOfDay := startOfDay.AddDate(0, 0, 1)
+
+	behaviorLogs := make([]*domain.BehaviorLog, 0)
+	for _, behaviorLog := range r.behaviorLogs {
+		if behaviorLog.LoggedAt.Before(startOfDay) || !behaviorLog.LoggedAt.Before(endOfDay) {
 			continue
 		}
-		
-		behaviorID := behaviorLog.BehaviorID
-		
-		if existing, exists := breakdown[behaviorID]; exists {
-			existing.Count++
-			existing.TotalPoints += behaviorLog.PointsAwarded
-		} else {
-			breakdown[behaviorID] = &domain.DailyScoreBreakdown{
-				BehaviorID:        behaviorID,
-				BehaviorName:      "Mock Behavior",
-				BehaviorCategory:  domain.BehaviorCategoryPottyTraining,
-				Count:             1,
-				PointsPerInstance: behaviorLog.PointsAwarded,
-				TotalPoints:       behaviorLog.PointsAwarded,
-			}
-		}
+		behaviorLogs = append(behaviorLogs, behaviorLog)
 	}
-	
-	// Convert to slice
-	result := make([]*domain.DailyScoreBreakdown, 0, len(breakdown))
-	for _, item := range breakdown {
-		result = append(result, item)
-	}
-	
-	return result, nil
+	return behaviorLogs
 }
 
 func (r *MockBehaviorLogRepository) CountByDateRange(ctx context.Context, petID uuid.UUID, from, to time.Time) (int, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	
+
 	count := 0
 	for _, behaviorLog := range r.behaviorLogs {
-		if behaviorLog.PetID == petID && 
-		   behaviorLog.LoggedAt.After(from) && 
-		   behaviorLog.LoggedAt.Before(to) {
+		if behaviorLog.PetID == petID &&
+			behaviorLog.LoggedAt.After(from) &&
+			behaviorLog.LoggedAt.Before(to) {
 			count++
 		}
 	}
-	
+
 	return count, nil
 }
 
@@ -369,41 +478,41 @@ func (r *MockBehaviorLogRepository) GetByGroup(ctx context.Context, groupID uuid
 		filter = domain.NewBehaviorLogFilter()
 	}
 	filter.GroupID = &groupID
-	
+
 	return r.Find(ctx, filter)
 }
 
 func (r *MockBehaviorLogRepository) CleanupOldLogs(ctx context.Context, cutoffDate time.Time) (int, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	
+
 	var toDelete []uuid.UUID
-	
+
 	for id, behaviorLog := range r.behaviorLogs {
-		if behaviorLog.CreatedAt.Before(cutoffDate) {
+		if behaviorLog.LoggedAt.Before(cutoffDate) {
 			toDelete = append(toDelete, id)
 		}
 	}
-	
+
 	for _, id := range toDelete {
 		behaviorLog := r.behaviorLogs[id]
 		key := fmt.Sprintf("%s_%s", behaviorLog.PetID, behaviorLog.BehaviorID)
 		delete(r.lastLogged, key)
 		delete(r.behaviorLogs, id)
 	}
-	
+
 	return len(toDelete), nil
 }
 
 // MockAuthorizationRepository provides a mock implementation of domain.AuthorizationRepository
 type MockAuthorizationRepository struct {
-	mu              sync.RWMutex
-	userPets        map[uuid.UUID][]uuid.UUID
-	userGroups      map[uuid.UUID][]uuid.UUID
-	petGroups       map[uuid.UUID][]uuid.UUID
-	pets            map[uuid.UUID]*domain.PetInfo
-	groups          map[uuid.UUID]*domain.GroupInfo
-	users           map[uuid.UUID]*domain.UserInfo
+	mu         sync.RWMutex
+	userPets   map[uuid.UUID][]uuid.UUID
+	userGroups map[uuid.UUID][]uuid.UUID
+	petGroups  map[uuid.UUID][]uuid.UUID
+	pets       map[uuid.UUID]*domain.PetInfo
+	groups     map[uuid.UUID]*domain.GroupInfo
+	users      map[uuid.UUID]*domain.UserInfo
 }
 
 // NewMockAuthorizationRepository creates a new mock authorization repository
@@ -422,7 +531,7 @@ func NewMockAuthorizationRepository() *MockAuthorizationRepository {
 func (r *MockAuthorizationRepository) AddUserPet(userID, petID uuid.UUID, petInfo *domain.PetInfo) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	
+
 	r.userPets[userID] = append(r.userPets[userID], petID)
 	r.pets[petID] = petInfo
 }
@@ -431,108 +540,159 @@ func (r *MockAuthorizationRepository) AddUserPet(userID, petID uuid.UUID, petInf
 func (r *MockAuthorizationRepository) AddUserGroup(userID, groupID uuid.UUID, groupInfo *domain.GroupInfo) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	
+
 	r.userGroups[userID] = append(r.userGroups[userID], groupID)
 	r.groups[groupID] = groupInfo
 }
 
+// AddUser adds basic user information
+func (r *MockAuthorizationRepository) AddUser(userID uuid.UUID, userInfo *domain.UserInfo) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.users[userID] = userInfo
+}
+
 // AddPetToGroup adds a pet to a group
 func (r *MockAuthorizationRepository) AddPetToGroup(petID, groupID uuid.UUID) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	
+
 	r.petGroups[petID] = append(r.petGroups[petID], groupID)
 }
 
 func (r *MockAuthorizationRepository) CanUserAccessPet(ctx context.Context, userID, petID uuid.UUID) (bool, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	
+
 	pets, exists := r.userPets[userID]
 	if !exists {
 		return false, nil
 	}
-	
+
 	for _, id := range pets {
 		if id == petID {
 			return true, nil
 		}
 	}
-	
+
 	return false, nil
 }
 
 func (r *MockAuthorizationRepository) CanUserAccessGroup(ctx context.Context, userID, groupID uuid.UUID) (bool, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	
+
 	groups, exists := r.userGroups[userID]
 	if !exists {
 		return false, nil
 	}
-	
+
 	for _, id := range groups {
 		if id == groupID {
 			return true, nil
 		}
 	}
-	
+
 	return false, nil
 }
 
 func (r *MockAuthorizationRepository) IsPetInGroup(ctx context.Context, petID, groupID uuid.UUID) (bool, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	
+
 	groups, exists := r.petGroups[petID]
 	if !exists {
 		return false, nil
 	}
-	
+
 	for _, id := range groups {
 		if id == groupID {
 			return true, nil
 		}
 	}
-	
+
 	return false, nil
 }
 
 func (r *MockAuthorizationRepository) GetUserPets(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	
+
 	return r.userPets[userID], nil
 }
 
 func (r *MockAuthorizationRepository) GetUserGroups(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	
+
 	return r.userGroups[userID], nil
 }
 
+func (r *MockAuthorizationRepository) GetPetGroups(ctx context.Context, petID uuid.UUID) ([]uuid.UUID, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.petGroups[petID], nil
+}
+
+func (r *MockAuthorizationRepository) GetAllGroups(ctx context.Context) ([]uuid.UUID, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	groupIDs := make([]uuid.UUID, 0, len(r.groups))
+	for groupID := range r.groups {
+		groupIDs = append(groupIDs, groupID)
+	}
+
+	return groupIDs, nil
+}
+
+func (r *MockAuthorizationRepository) GetAllUsers(ctx context.Context) ([]uuid.UUID, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	seen := make(map[uuid.UUID]bool)
+	userIDs := make([]uuid.UUID, 0, len(r.users))
+	for _, known := range []map[uuid.UUID][]uuid.UUID{r.userPets, r.userGroups} {
+		for userID := range known {
+			if !seen[userID] {
+				seen[userID] = true
+				userIDs = append(userIDs, userID)
+			}
+		}
+	}
+	for userID := range r.users {
+		if !seen[userID] {
+			seen[userID] = true
+			userIDs = append(userIDs, userID)
+		}
+	}
+
+	return userIDs, nil
+}
+
 func (r *MockAuthorizationRepository) GetPetInfo(ctx context.Context, petID uuid.UUID) (*domain.PetInfo, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	
+
 	info, exists := r.pets[petID]
 	if !exists {
 		return nil, fmt.Errorf("pet not found")
 	}
-	
+
 	return info, nil
 }
 
 func (r *MockAuthorizationRepository) GetGroupInfo(ctx context.Context, groupID uuid.UUID) (*domain.GroupInfo, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	
+
 	info, exists := r.groups[groupID]
 	if !exists {
 		return nil, fmt.Errorf("group not found")
 	}
-	
+
 	return info, nil
 }
 
@@ -553,6 +713,10 @@ type MockDailyScoreRepository struct {
 	mu          sync.RWMutex
 	dailyScores map[uuid.UUID]*domain.DailyScore
 	dateIndex   map[string][]*domain.DailyScore // key: groupID_date
+
+	// Optional sources replayed by RecalculateFromLogs
+	behaviorLogRepo domain.BehaviorLogRepository
+	adjustmentRepo  domain.PointAdjustmentRepository
 }
 
 // NewMockDailyScoreRepository creates a new mock daily score repository
@@ -563,6 +727,16 @@ func NewMockDailyScoreRepository() *MockDailyScoreRepository {
 	}
 }
 
+// WithRecalculationSources makes RecalculateFromLogs replay the behavior logs and point
+// adjustments of the day, and RecordAdjustment and RecordBehaviorLogs save their entries in
+// the adjustment and behavior log repositories.
+// Days are calendar days in the location of the given date.
+func (r *MockDailyScoreRepository) WithRecalculationSources(behaviorLogRepo domain.BehaviorLogRepository, adjustmentRepo domain.PointAdjustmentRepository) *MockDailyScoreRepository {
+	r.behaviorLogRepo = behaviorLogRepo
+	r.adjustmentRepo = adjustmentRepo
+	return r
+}
+
 func (r *MockDailyScoreRepository) Create(ctx context.Context, dailyScore *domain.DailyScore) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -702,9 +876,43 @@ func (r *MockDailyScoreRepository) GetRankings(ctx context.Context, groupID uuid
 }
 
 func (r *MockDailyScoreRepository) GetRankingsByDateRange(ctx context.Context, groupID uuid.UUID, from, to time.Time) ([]*domain.PetRanking, error) {
-	// This would aggregate scores across the date range
-	// For mock purposes, just return current rankings
-	return r.GetRankings(ctx, groupID, from)
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// Collect the group's daily scores within the range (inclusive), oldest first
+	fromKey, toKey := from.Format("2006-01-02"), to.Format("2006-01-02")
+	scores := make([]*domain.DailyScore, 0)
+	for _, score := range r.dailyScores {
+		dateKey := score.Date.Format("2006-01-02")
+		if score.GroupID == groupID && dateKey >= fromKey && dateKey <= toKey {
+			scores = append(scores, score)
+		}
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		if !scores[i].Date.Equal(scores[j].Date) {
+			return scores[i].Date.Before(scores[j].Date)
+		}
+		return scores[i].PetID.String() < scores[j].PetID.String()
+	})
+
+	// Aggregate each pet's daily scores across the range
+	rankingsByPet := make(map[uuid.UUID]*domain.PetRanking)
+	rankings := make([]*domain.PetRanking, 0)
+	for _, score := range scores {
+		ranking, exists := rankingsByPet[score.PetID]
+		if !exists {
+			ranking = domain.NewPetRanking(score.PetID, "Mock Pet", "Mock Owner")
+			rankingsByPet[score.PetID] = ranking
+			rankings = append(rankings, ranking)
+		}
+		ranking.UpdateFromDailyScore(score)
+	}
+
+	sort.SliceStable(rankings, func(i, j int) bool {
+		return rankings[i].CompareForRanking(rankings[j]) > 0
+	})
+
+	return rankings, nil
 }
 
 func (r *MockDailyScoreRepository) GetTopScorers(ctx context.Context, groupID uuid.UUID, date time.Time) ([]*domain.DailyScore, error) {
@@ -729,42 +937,134 @@ func (r *MockDailyScoreRepository) GetTopScorers(ctx context.Context, groupID uu
 }
 
 func (r *MockDailyScoreRepository) RecalculateFromLogs(ctx context.Context, petID, groupID uuid.UUID, date time.Time) (*domain.DailyScore, error) {
-	// For mock purposes, just return the existing score or create a new one
-	return r.GetOrCreate(ctx, petID, groupID, date)
-}
+	dailyScore, err := r.GetOrCreate(ctx, petID, groupID, date)
+	if err != nil || r.behaviorLogRepo == nil || r.adjustmentRepo == nil {
+		// Without sources, just return the existing score or create a new one
+		return dailyScore, err
+	}
+
+	dayStart := dailyScore.Date
+	logFilter := domain.NewBehaviorLogFilter().
+		WithPet(petID).
+		WithGroup(groupID).
+		WithDateRange(dayStart, dayStart.AddDate(0, 0, 1).Add(-time.Nanosecond)).
+		WithPagination(10000, 0)
+	behaviorLogs, err := r.behaviorLogRepo.Find(ctx, logFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	adjustmentFilter := domain.NewPointAdjustmentFilter().
+		WithPet(petID).
+		WithGroup(groupID).
+		WithDateRange(dayStart, dayStart).
+		WithPagination(10000, 0)
+	adjustments, err := r.adjustmentRepo.Find(ctx, adjustmentFilter)
+	if err != nil {
+		return nil, err
+	}
 
-func (r *MockDailyScoreRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	dailyScore, exists := r.dailyScores[id]
-	if !exists {
-		return fmt.Errorf("daily score not found")
+	if err := dailyScore.Recalculate(behaviorLogs, adjustments); err != nil {
+		return nil, err
 	}
+	return dailyScore, nil
+}
 
-	delete(r.dailyScores, id)
+func (r *MockDailyScoreRepository) RecordAdjustment(ctx context.Context, adjustment *domain.PointAdjustment) (*domain.DailyScore, error) {
+	if r.adjustmentRepo == nil {
+		return nil, fmt.Errorf("no point adjustment repository")
+	}
+	if err := r.adjustmentRepo.Create(ctx, adjustment); err != nil {
+		return nil, err
+	}
 
-	// Remove from date index
-	dateKey := fmt.Sprintf("%s_%s", dailyScore.GroupID, dailyScore.Date.Format("2006-01-02"))
-	if scores, exists := r.dateIndex[dateKey]; exists {
-		filtered := make([]*domain.DailyScore, 0, len(scores)-1)
-		for _, score := range scores {
-			if score.ID != id {
-				filtered = append(filtered, score)
-			}
-		}
-		if len(filtered) == 0 {
-			delete(r.dateIndex, dateKey)
-		} else {
-			r.dateIndex[dateKey] = filtered
-		}
+	dailyScore, err := r.GetOrCreate(ctx, adjustment.PetID, adjustment.GroupID, adjustment.Date)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := dailyScore.AddAdjustment(adjustment); err != nil {
+		return nil, err
+	}
+	return dailyScore, nil
 }
 
-func (r *MockDailyScoreRepository) GetHistoricalData(ctx context.Context, petID, groupID uuid.UUID, days int) ([]*domain.DailyScore, error) {
-	r.mu.RLock()
+func (r *MockDailyScoreRepository) RecordBehaviorLogs(ctx context.Context, scoredLogs []domain.ScoredBehaviorLog) ([]*domain.DailyScore, error) {
+	if r.behaviorLogRepo == nil {
+		return nil, fmt.Errorf("no behavior log repository")
+	}
+
+	behaviorLogs := make([]*domain.BehaviorLog, len(scoredLogs))
+	for i, scoredLog := range scoredLogs {
+		behaviorLogs[i] = scoredLog.BehaviorLog
+	}
+	if err := r.behaviorLogRepo.CreateBatch(ctx, behaviorLogs); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uuid.UUID]bool)
+	var dailyScores []*domain.DailyScore
+	for _, scoredLog := range scoredLogs {
+		for groupID, date := range scoredLog.GroupDays {
+			dailyScore, err := r.GetOrCreate(ctx, scoredLog.BehaviorLog.PetID, groupID, date)
+			if err != nil {
+				return nil, err
+			}
+
+			r.mu.Lock()
+			err = dailyScore.AddBehaviorLog(scoredLog.BehaviorLog)
+			r.mu.Unlock()
+			if err != nil {
+				return nil, err
+			}
+
+			if !seen[dailyScore.ID] {
+				seen[dailyScore.ID] = true
+				dailyScores = append(dailyScores, dailyScore)
+			}
+		}
+	}
+	return dailyScores, nil
+}
+
+func (r *MockDailyScoreRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dailyScore, exists := r.dailyScores[id]
+	if !exists {
+		return fmt.Errorf("daily score not found")
+	}
+
+	delete(r.dailyScores, id)
+
+	// Remove from date index
+	dateKey := fmt.Sprintf("%s_%s", dailyScore.GroupID, dailyScore.Date.Format("2006-01-02"))
+	if scores, exists := r.dateIndex[dateKey]; exists {
+		filtered := make([]*domain.DailyScore, 0, len(scores)-1)
+		for _, score := range scores {
+			if score.ID != id {
+				filtered = append(filtered, score)
+			}
+		}
+		if len(filtered) == 0 {
+			delete(r.dateIndex, dateKey)
+		} else {
+			r.dateIndex[dateKey] = filtered
+		}
+	}
+
+	return nil
+}
+
+func (r *MockDailyScoreRepository) GetHistoricalData(ctx context.Context, petID, groupID uuid.UUID, days int) ([]*domain.DailyScore, error) {
+	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	var historical []*domain.DailyScore
@@ -788,8 +1088,8 @@ func (r *MockDailyScoreRepository) GetHistoricalData(ctx context.Context, petID,
 
 // MockPetOfTheDayRepository provides a mock implementation of domain.PetOfTheDayRepository
 type MockPetOfTheDayRepository struct {
-	mu      sync.RWMutex
-	winners map[uuid.UUID]*domain.PetOfTheDayWinner
+	mu        sync.RWMutex
+	winners   map[uuid.UUID]*domain.PetOfTheDayWinner
 	dateIndex map[string][]*domain.PetOfTheDayWinner // key: groupID_date
 }
 
@@ -834,8 +1134,8 @@ func (r *MockPetOfTheDayRepository) GetWinnerHistory(ctx context.Context, groupI
 	var history []*domain.PetOfTheDayWinner
 	for _, winner := range r.winners {
 		if winner.GroupID == groupID &&
-		   winner.Date.After(from.AddDate(0, 0, -1)) &&
-		   winner.Date.Before(to.AddDate(0, 0, 1)) {
+			winner.Date.After(from.AddDate(0, 0, -1)) &&
+			winner.Date.Before(to.AddDate(0, 0, 1)) {
 			history = append(history, winner)
 		}
 	}
@@ -862,6 +1162,24 @@ func (r *MockPetOfTheDayRepository) GetPetWinCount(ctx context.Context, petID uu
 	return count, nil
 }
 
+func (r *MockPetOfTheDayRepository) GetPetWins(ctx context.Context, petID uuid.UUID) ([]*domain.PetOfTheDayWinner, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var wins []*domain.PetOfTheDayWinner
+	for _, winner := range r.winners {
+		if winner.PetID == petID {
+			wins = append(wins, winner)
+		}
+	}
+
+	sort.Slice(wins, func(i, j int) bool {
+		return wins[i].Date.Before(wins[j].Date)
+	})
+
+	return wins, nil
+}
+
 func (r *MockPetOfTheDayRepository) GetGroupStats(ctx context.Context, groupID uuid.UUID) (*domain.GroupPetOfTheDayStats, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
@@ -910,6 +1228,32 @@ func (r *MockPetOfTheDayRepository) GetGroupStats(ctx context.Context, groupID u
 	return stats, nil
 }
 
+func (r *MockPetOfTheDayRepository) GetMostDecorated(ctx context.Context, groupID uuid.UUID, limit int) ([]*domain.DecoratedPet, error) {
+	pets := domain.RankMostDecorated(r.groupWinners(groupID))
+	if len(pets) > limit {
+		pets = pets[:limit]
+	}
+	return pets, nil
+}
+
+func (r *MockPetOfTheDayRepository) GetHallOfFamePeriods(ctx context.Context, groupID uuid.UUID, period domain.HistoryPeriod) ([]*domain.HallOfFamePeriod, error) {
+	return domain.AggregateWinners(r.groupWinners(groupID), period), nil
+}
+
+// groupWinners returns every winner of a group
+func (r *MockPetOfTheDayRepository) groupWinners(groupID uuid.UUID) []*domain.PetOfTheDayWinner {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var winners []*domain.PetOfTheDayWinner
+	for _, winner := range r.winners {
+		if winner.GroupID == groupID {
+			winners = append(winners, winner)
+		}
+	}
+	return winners
+}
+
 func (r *MockPetOfTheDayRepository) DeleteByGroupAndDate(ctx context.Context, groupID uuid.UUID, date time.Time) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -952,6 +1296,34 @@ func (r *MockPetOfTheDayRepository) GetLatestWinners(ctx context.Context, limit
 	return allWinners, nil
 }
 
+func (r *MockPetOfTheDayRepository) GetLatestGroupWinners(ctx context.Context, groupIDs []uuid.UUID, limit int) ([]*domain.PetOfTheDayWinner, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	inGroups := make(map[uuid.UUID]bool, len(groupIDs))
+	for _, groupID := range groupIDs {
+		inGroups[groupID] = true
+	}
+
+	var winners []*domain.PetOfTheDayWinner
+	for _, winner := range r.winners {
+		if inGroups[winner.GroupID] {
+			winners = append(winners, winner)
+		}
+	}
+
+	// Sort by date descending
+	sort.Slice(winners, func(i, j int) bool {
+		return winners[i].Date.After(winners[j].Date)
+	})
+
+	if len(winners) > limit {
+		winners = winners[:limit]
+	}
+
+	return winners, nil
+}
+
 // MockUserSettingsRepository provides a mock implementation of domain.UserSettingsRepository
 type MockUserSettingsRepository struct {
 	mu       sync.RWMutex
@@ -991,3 +1363,1306 @@ func (r *MockUserSettingsRepository) UpdateUserTimezone(ctx context.Context, use
 
 	return nil
 }
+
+// MockDailyResetStateRepository provides a mock implementation of domain.DailyResetStateRepository
+type MockDailyResetStateRepository struct {
+	mu     sync.RWMutex
+	states map[uuid.UUID]*domain.GroupResetState
+}
+
+// NewMockDailyResetStateRepository creates a new mock daily reset state repository
+func NewMockDailyResetStateRepository() *MockDailyResetStateRepository {
+	return &MockDailyResetStateRepository{
+		states: make(map[uuid.UUID]*domain.GroupResetState),
+	}
+}
+
+func (r *MockDailyResetStateRepository) GetByGroup(ctx context.Context, groupID uuid.UUID) (*domain.GroupResetState, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	state, exists := r.states[groupID]
+	if !exists {
+		return nil, nil
+	}
+
+	stateCopy := *state
+	return &stateCopy, nil
+}
+
+func (r *MockDailyResetStateRepository) Save(ctx context.Context, state *domain.GroupResetState) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stateCopy := *state
+	r.states[state.GroupID] = &stateCopy
+	return nil
+}
+
+// MockGroupBehaviorRepository provides a mock implementation of domain.GroupBehaviorRepository
+type MockGroupBehaviorRepository struct {
+	mu             sync.RWMutex
+	groupBehaviors map[uuid.UUID]*domain.GroupBehavior
+}
+
+// NewMockGroupBehaviorRepository creates a new mock group behavior repository
+func NewMockGroupBehaviorRepository() *MockGroupBehaviorRepository {
+	return &MockGroupBehaviorRepository{
+		groupBehaviors: make(map[uuid.UUID]*domain.GroupBehavior),
+	}
+}
+
+func (r *MockGroupBehaviorRepository) Create(ctx context.Context, groupBehavior *domain.GroupBehavior) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if groupBehavior.IsOverride() {
+		for _, existing := range r.groupBehaviors {
+			if existing.GroupID == groupBehavior.GroupID && existing.IsOverride() && *existing.BaseBehaviorID == *groupBehavior.BaseBehaviorID {
+				return fmt.Errorf("behavior is already overridden in group")
+			}
+		}
+	}
+
+	r.groupBehaviors[groupBehavior.ID] = groupBehavior
+	return nil
+}
+
+func (r *MockGroupBehaviorRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.GroupBehavior, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	groupBehavior, exists := r.groupBehaviors[id]
+	if !exists {
+		return nil, nil
+	}
+	return groupBehavior, nil
+}
+
+func (r *MockGroupBehaviorRepository) GetByGroup(ctx context.Context, groupID uuid.UUID) ([]*domain.GroupBehavior, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var groupBehaviors []*domain.GroupBehavior
+	for _, groupBehavior := range r.groupBehaviors {
+		if groupBehavior.GroupID == groupID {
+			groupBehaviors = append(groupBehaviors, groupBehavior)
+		}
+	}
+
+	sort.Slice(groupBehaviors, func(i, j int) bool {
+		return groupBehaviors[i].CreatedAt.Before(groupBehaviors[j].CreatedAt)
+	})
+
+	return groupBehaviors, nil
+}
+
+func (r *MockGroupBehaviorRepository) GetOverride(ctx context.Context, groupID, behaviorID uuid.UUID) (*domain.GroupBehavior, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, groupBehavior := range r.groupBehaviors {
+		if groupBehavior.GroupID == groupID && groupBehavior.IsOverride() && *groupBehavior.BaseBehaviorID == behaviorID {
+			return groupBehavior, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *MockGroupBehaviorRepository) Update(ctx context.Context, groupBehavior *domain.GroupBehavior) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.groupBehaviors[groupBehavior.ID]; !exists {
+		return fmt.Errorf("group behavior not found")
+	}
+
+	r.groupBehaviors[groupBehavior.ID] = groupBehavior
+	return nil
+}
+
+func (r *MockGroupBehaviorRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.groupBehaviors[id]; !exists {
+		return fmt.Errorf("group behavior not found")
+	}
+
+	delete(r.groupBehaviors, id)
+	return nil
+}
+
+// MockSeasonRepository provides a mock implementation of domain.SeasonRepository
+type MockSeasonRepository struct {
+	mu        sync.RWMutex
+	seasons   map[uuid.UUID]*domain.Season
+	standings map[uuid.UUID][]*domain.SeasonStanding        // key: season ID
+	tiers     map[uuid.UUID]map[uuid.UUID]domain.LeagueTier // key: group ID
+}
+
+// NewMockSeasonRepository creates a new mock season repository
+func NewMockSeasonRepository() *MockSeasonRepository {
+	return &MockSeasonRepository{
+		seasons:   make(map[uuid.UUID]*domain.Season),
+		standings: make(map[uuid.UUID][]*domain.SeasonStanding),
+		tiers:     make(map[uuid.UUID]map[uuid.UUID]domain.LeagueTier),
+	}
+}
+
+func (r *MockSeasonRepository) Create(ctx context.Context, season *domain.Season) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.seasons[season.ID] = season
+	return nil
+}
+
+func (r *MockSeasonRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Season, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	season, exists := r.seasons[id]
+	if !exists {
+		return nil, nil
+	}
+	return season, nil
+}
+
+func (r *MockSeasonRepository) GetByGroup(ctx context.Context, groupID uuid.UUID) ([]*domain.Season, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var seasons []*domain.Season
+	for _, season := range r.seasons {
+		if season.GroupID == groupID {
+			seasons = append(seasons, season)
+		}
+	}
+
+	sort.Slice(seasons, func(i, j int) bool {
+		return seasons[i].StartDate.After(seasons[j].StartDate)
+	})
+
+	return seasons, nil
+}
+
+func (r *MockSeasonRepository) Update(ctx context.Context, season *domain.Season) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.seasons[season.ID]; !exists {
+		return fmt.Errorf("season not found")
+	}
+
+	r.seasons[season.ID] = season
+	return nil
+}
+
+func (r *MockSeasonRepository) CloseSeason(ctx context.Context, season *domain.Season, standings []*domain.SeasonStanding) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.seasons[season.ID]; !exists {
+		return fmt.Errorf("season not found")
+	}
+
+	r.seasons[season.ID] = season
+	r.standings[season.ID] = standings
+	r.tiers[season.GroupID] = domain.NextLeagueTiers(standings)
+	return nil
+}
+
+func (r *MockSeasonRepository) GetFinalStandings(ctx context.Context, seasonID uuid.UUID) ([]*domain.SeasonStanding, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.standings[seasonID], nil
+}
+
+func (r *MockSeasonRepository) GetLeagueTiers(ctx context.Context, groupID uuid.UUID) (map[uuid.UUID]domain.LeagueTier, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tiers := make(map[uuid.UUID]domain.LeagueTier, len(r.tiers[groupID]))
+	for petID, tier := range r.tiers[groupID] {
+		tiers[petID] = tier
+	}
+	return tiers, nil
+}
+
+func (r *MockSeasonRepository) SaveLeagueTiers(ctx context.Context, groupID uuid.UUID, tiers map[uuid.UUID]domain.LeagueTier) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.tiers[groupID]; !exists {
+		r.tiers[groupID] = make(map[uuid.UUID]domain.LeagueTier)
+	}
+	for petID, tier := range tiers {
+		r.tiers[groupID][petID] = tier
+	}
+	return nil
+}
+
+// MockStreakRepository provides a mock implementation of domain.StreakRepository
+type MockStreakRepository struct {
+	mu      sync.RWMutex
+	streaks map[uuid.UUID][]*domain.PetStreak // key: petID
+}
+
+// NewMockStreakRepository creates a new mock streak repository
+func NewMockStreakRepository() *MockStreakRepository {
+	return &MockStreakRepository{
+		streaks: make(map[uuid.UUID][]*domain.PetStreak),
+	}
+}
+
+func (r *MockStreakRepository) GetByPet(ctx context.Context, petID uuid.UUID) ([]*domain.PetStreak, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.streaks[petID], nil
+}
+
+func (r *MockStreakRepository) ReplaceForPet(ctx context.Context, petID uuid.UUID, streaks []*domain.PetStreak) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.streaks[petID] = streaks
+	return nil
+}
+
+// MockBadgeRepository provides a mock implementation of domain.BadgeRepository
+type MockBadgeRepository struct {
+	mu     sync.RWMutex
+	badges []*domain.Badge
+}
+
+// NewMockBadgeRepository creates a new mock badge repository
+func NewMockBadgeRepository() *MockBadgeRepository {
+	return &MockBadgeRepository{
+		badges: make([]*domain.Badge, 0),
+	}
+}
+
+func (r *MockBadgeRepository) Award(ctx context.Context, badge *domain.Badge) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.badges {
+		if existing.Code == badge.Code && sameHolder(existing.PetID, badge.PetID) && sameHolder(existing.UserID, badge.UserID) {
+			return false, nil
+		}
+	}
+
+	r.badges = append(r.badges, badge)
+	return true, nil
+}
+
+func (r *MockBadgeRepository) GetByPet(ctx context.Context, petID uuid.UUID) ([]*domain.Badge, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	badges := make([]*domain.Badge, 0)
+	for _, badge := range r.badges {
+		if badge.PetID != nil && *badge.PetID == petID {
+			badges = append(badges, badge)
+		}
+	}
+	return badges, nil
+}
+
+func (r *MockBadgeRepository) GetByUser(ctx context.Context, userID uuid.UUID) ([]*domain.Badge, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	badges := make([]*domain.Badge, 0)
+	for _, badge := range r.badges {
+		if badge.UserID != nil && *badge.UserID == userID {
+			badges = append(badges, badge)
+		}
+	}
+	return badges, nil
+}
+
+func sameHolder(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// MockChallengeRepository provides a mock implementation of domain.ChallengeRepository
+type MockChallengeRepository struct {
+	mu         sync.RWMutex
+	challenges map[uuid.UUID]*domain.Challenge
+	progress   map[uuid.UUID]map[uuid.UUID]*domain.ChallengeProgress // challengeID -> petID -> progress
+}
+
+// NewMockChallengeRepository creates a new mock challenge repository
+func NewMockChallengeRepository() *MockChallengeRepository {
+	return &MockChallengeRepository{
+		challenges: make(map[uuid.UUID]*domain.Challenge),
+		progress:   make(map[uuid.UUID]map[uuid.UUID]*domain.ChallengeProgress),
+	}
+}
+
+func (r *MockChallengeRepository) Create(ctx context.Context, challenge *domain.Challenge) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.challenges[challenge.ID]; exists {
+		return fmt.Errorf("challenge already exists")
+	}
+
+	r.challenges[challenge.ID] = challenge
+	return nil
+}
+
+func (r *MockChallengeRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Challenge, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.challenges[id], nil
+}
+
+func (r *MockChallengeRepository) GetByGroup(ctx context.Context, groupID uuid.UUID) ([]*domain.Challenge, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	challenges := make([]*domain.Challenge, 0)
+	for _, challenge := range r.challenges {
+		if challenge.GroupID == groupID {
+			challenges = append(challenges, challenge)
+		}
+	}
+
+	sort.Slice(challenges, func(i, j int) bool {
+		return challenges[i].EndsAt.After(challenges[j].EndsAt)
+	})
+	return challenges, nil
+}
+
+func (r *MockChallengeRepository) GetDue(ctx context.Context, now time.Time) ([]*domain.Challenge, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	challenges := make([]*domain.Challenge, 0)
+	for _, challenge := range r.challenges {
+		if challenge.IsDue(now) {
+			challenges = append(challenges, challenge)
+		}
+	}
+	return challenges, nil
+}
+
+func (r *MockChallengeRepository) Update(ctx context.Context, challenge *domain.Challenge) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.challenges[challenge.ID]; !exists {
+		return fmt.Errorf("challenge not found")
+	}
+
+	r.challenges[challenge.ID] = challenge
+	return nil
+}
+
+func (r *MockChallengeRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.challenges[id]; !exists {
+		return fmt.Errorf("challenge not found")
+	}
+
+	delete(r.challenges, id)
+	delete(r.progress, id)
+	return nil
+}
+
+func (r *MockChallengeRepository) GetProgress(ctx context.Context, challengeID uuid.UUID) ([]*domain.ChallengeProgress, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	progress := make([]*domain.ChallengeProgress, 0, len(r.progress[challengeID]))
+	for _, petProgress := range r.progress[challengeID] {
+		progress = append(progress, petProgress)
+	}
+	return progress, nil
+}
+
+func (r *MockChallengeRepository) SaveProgress(ctx context.Context, progress *domain.ChallengeProgress) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.progress[progress.ChallengeID]; !exists {
+		r.progress[progress.ChallengeID] = make(map[uuid.UUID]*domain.ChallengeProgress)
+	}
+
+	r.progress[progress.ChallengeID][progress.PetID] = progress
+	return nil
+}
+
+// MockPointAdjustmentRepository provides a mock implementation of domain.PointAdjustmentRepository
+type MockPointAdjustmentRepository struct {
+	mu          sync.RWMutex
+	adjustments map[uuid.UUID]*domain.PointAdjustment
+}
+
+// NewMockPointAdjustmentRepository creates a new mock point adjustment repository
+func NewMockPointAdjustmentRepository() *MockPointAdjustmentRepository {
+	return &MockPointAdjustmentRepository{
+		adjustments: make(map[uuid.UUID]*domain.PointAdjustment),
+	}
+}
+
+func (r *MockPointAdjustmentRepository) Create(ctx context.Context, adjustment *domain.PointAdjustment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.adjustments[adjustment.ID]; exists {
+		return fmt.Errorf("point adjustment already exists")
+	}
+
+	r.adjustments[adjustment.ID] = adjustment
+	return nil
+}
+
+func (r *MockPointAdjustmentRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.PointAdjustment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.adjustments[id], nil
+}
+
+func (r *MockPointAdjustmentRepository) Find(ctx context.Context, filter *domain.PointAdjustmentFilter) ([]*domain.PointAdjustment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	matches := make([]*domain.PointAdjustment, 0)
+	for _, adjustment := range r.adjustments {
+		if r.matchesAdjustmentFilter(adjustment, filter) {
+			matches = append(matches, adjustment)
+		}
+	}
+
+	// Sort by creation time descending
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].CreatedAt.After(matches[j].CreatedAt)
+	})
+
+	// Apply pagination
+	start := filter.Offset
+	end := start + filter.Limit
+
+	if start >= len(matches) {
+		return []*domain.PointAdjustment{}, nil
+	}
+
+	if end > len(matches) {
+		end = len(matches)
+	}
+
+	return matches[start:end], nil
+}
+
+func (r *MockPointAdjustmentRepository) matchesAdjustmentFilter(adjustment *domain.PointAdjustment, filter *domain.PointAdjustmentFilter) bool {
+	if filter.GroupID != nil && adjustment.GroupID != *filter.GroupID {
+		return false
+	}
+
+	if filter.PetID != nil && adjustment.PetID != *filter.PetID {
+		return false
+	}
+
+	if filter.ChallengeID != nil && (adjustment.ChallengeID == nil || *adjustment.ChallengeID != *filter.ChallengeID) {
+		return false
+	}
+
+	dateKey := adjustment.Date.Format("2006-01-02")
+	if filter.DateFrom != nil && dateKey < filter.DateFrom.Format("2006-01-02") {
+		return false
+	}
+
+	if filter.DateTo != nil && dateKey > filter.DateTo.Format("2006-01-02") {
+		return false
+	}
+
+	return true
+}
+
+func (r *MockPointAdjustmentRepository) GetReversal(ctx context.Context, adjustmentID uuid.UUID) (*domain.PointAdjustment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, adjustment := range r.adjustments {
+		if adjustment.ReversesID != nil && *adjustment.ReversesID == adjustmentID {
+			return adjustment, nil
+		}
+	}
+	return nil, nil
+}
+
+// MockVerificationPolicyRepository provides a mock implementation of domain.VerificationPolicyRepository
+type MockVerificationPolicyRepository struct {
+	mu       sync.RWMutex
+	policies map[uuid.UUID]*domain.VerificationPolicy
+}
+
+// NewMockVerificationPolicyRepository creates a new mock verification policy repository
+func NewMockVerificationPolicyRepository() *MockVerificationPolicyRepository {
+	return &MockVerificationPolicyRepository{
+		policies: make(map[uuid.UUID]*domain.VerificationPolicy),
+	}
+}
+
+func (r *MockVerificationPolicyRepository) GetByGroup(ctx context.Context, groupID uuid.UUID) (*domain.VerificationPolicy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if policy, exists := r.policies[groupID]; exists {
+		return policy, nil
+	}
+	return domain.DefaultVerificationPolicy(groupID), nil
+}
+
+func (r *MockVerificationPolicyRepository) Save(ctx context.Context, policy *domain.VerificationPolicy) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.policies[policy.GroupID] = policy
+	return nil
+}
+
+// MockAnomalyFlagRepository provides a mock implementation of domain.AnomalyFlagRepository
+type MockAnomalyFlagRepository struct {
+	mu    sync.RWMutex
+	flags map[uuid.UUID]*domain.AnomalyFlag
+}
+
+// NewMockAnomalyFlagRepository creates a new mock anomaly flag repository
+func NewMockAnomalyFlagRepository() *MockAnomalyFlagRepository {
+	return &MockAnomalyFlagRepository{
+		flags: make(map[uuid.UUID]*domain.AnomalyFlag),
+	}
+}
+
+func (r *MockAnomalyFlagRepository) Create(ctx context.Context, flag *domain.AnomalyFlag) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.flags[flag.ID] = flag
+	return nil
+}
+
+func (r *MockAnomalyFlagRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.AnomalyFlag, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.flags[id], nil
+}
+
+func (r *MockAnomalyFlagRepository) Find(ctx context.Context, filter *domain.AnomalyFlagFilter) ([]*domain.AnomalyFlag, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	matches := make([]*domain.AnomalyFlag, 0)
+	for _, flag := range r.flags {
+		if filter.GroupID != nil && flag.GroupID != *filter.GroupID {
+			continue
+		}
+		if filter.BehaviorLogID != nil && flag.BehaviorLogID != *filter.BehaviorLogID {
+			continue
+		}
+		if filter.Status != nil && flag.Status != *filter.Status {
+			continue
+		}
+		matches = append(matches, flag)
+	}
+
+	// Sort by creation time descending
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].CreatedAt.After(matches[j].CreatedAt)
+	})
+
+	// Apply pagination
+	start := filter.Offset
+	end := start + filter.Limit
+
+	if start >= len(matches) {
+		return []*domain.AnomalyFlag{}, nil
+	}
+
+	if end > len(matches) {
+		end = len(matches)
+	}
+
+	return matches[start:end], nil
+}
+
+func (r *MockAnomalyFlagRepository) Update(ctx context.Context, flag *domain.AnomalyFlag) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.flags[flag.ID]; !exists {
+		return fmt.Errorf("anomaly flag not found")
+	}
+
+	r.flags[flag.ID] = flag
+	return nil
+}
+
+// MockBehaviorLogAttachmentRepository provides a mock implementation of domain.BehaviorLogAttachmentRepository
+type MockBehaviorLogAttachmentRepository struct {
+	mu          sync.RWMutex
+	attachments map[uuid.UUID]*domain.BehaviorLogAttachment
+}
+
+// NewMockBehaviorLogAttachmentRepository creates a new mock behavior log attachment repository
+func NewMockBehaviorLogAttachmentRepository() *MockBehaviorLogAttachmentRepository {
+	return &MockBehaviorLogAttachmentRepository{
+		attachments: make(map[uuid.UUID]*domain.BehaviorLogAttachment),
+	}
+}
+
+func (r *MockBehaviorLogAttachmentRepository) Create(ctx context.Context, attachment *domain.BehaviorLogAttachment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.attachments[attachment.ID] = attachment
+	return nil
+}
+
+func (r *MockBehaviorLogAttachmentRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.BehaviorLogAttachment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.attachments[id], nil
+}
+
+func (r *MockBehaviorLogAttachmentRepository) GetByBehaviorLog(ctx context.Context, behaviorLogID uuid.UUID) ([]*domain.BehaviorLogAttachment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	attachments := make([]*domain.BehaviorLogAttachment, 0)
+	for _, attachment := range r.attachments {
+		if attachment.BehaviorLogID == behaviorLogID {
+			attachments = append(attachments, attachment)
+		}
+	}
+
+	// Sort by creation time ascending
+	sort.Slice(attachments, func(i, j int) bool {
+		return attachments[i].CreatedAt.Before(attachments[j].CreatedAt)
+	})
+
+	return attachments, nil
+}
+
+func (r *MockBehaviorLogAttachmentRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.attachments[id]; !exists {
+		return fmt.Errorf("attachment not found")
+	}
+
+	delete(r.attachments, id)
+	return nil
+}
+
+// MockCommentRepository provides a mock implementation of domain.CommentRepository
+type MockCommentRepository struct {
+	mu       sync.RWMutex
+	comments map[uuid.UUID]*domain.Comment
+}
+
+// NewMockCommentRepository creates a new mock comment repository
+func NewMockCommentRepository() *MockCommentRepository {
+	return &MockCommentRepository{
+		comments: make(map[uuid.UUID]*domain.Comment),
+	}
+}
+
+func (r *MockCommentRepository) Create(ctx context.Context, comment *domain.Comment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.comments[comment.ID] = comment
+	return nil
+}
+
+func (r *MockCommentRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Comment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.comments[id], nil
+}
+
+func (r *MockCommentRepository) Find(ctx context.Context, filter *domain.CommentFilter) ([]*domain.Comment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	matches := r.matching(filter)
+
+	// Apply pagination
+	start := filter.Offset
+	end := start + filter.Limit
+
+	if start >= len(matches) {
+		return []*domain.Comment{}, nil
+	}
+
+	if end > len(matches) {
+		end = len(matches)
+	}
+
+	return matches[start:end], nil
+}
+
+func (r *MockCommentRepository) Count(ctx context.Context, filter *domain.CommentFilter) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.matching(filter)), nil
+}
+
+func (r *MockCommentRepository) Update(ctx context.Context, comment *domain.Comment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.comments[comment.ID]; !exists {
+		return fmt.Errorf("comment not found")
+	}
+
+	r.comments[comment.ID] = comment
+	return nil
+}
+
+// matching returns the comments matching a filter, oldest first
+func (r *MockCommentRepository) matching(filter *domain.CommentFilter) []*domain.Comment {
+	matches := make([]*domain.Comment, 0)
+	for _, comment := range r.comments {
+		if filter.BehaviorLogID != nil && comment.BehaviorLogID != *filter.BehaviorLogID {
+			continue
+		}
+		if filter.GroupID != nil && comment.GroupID != *filter.GroupID {
+			continue
+		}
+		matches = append(matches, comment)
+	}
+
+	// Sort by creation time ascending
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].CreatedAt.Before(matches[j].CreatedAt)
+	})
+
+	return matches
+}
+
+// MockReactionRepository provides a mock implementation of domain.ReactionRepository
+type MockReactionRepository struct {
+	mu        sync.RWMutex
+	reactions []*domain.Reaction
+}
+
+// NewMockReactionRepository creates a new mock reaction repository
+func NewMockReactionRepository() *MockReactionRepository {
+	return &MockReactionRepository{
+		reactions: make([]*domain.Reaction, 0),
+	}
+}
+
+func (r *MockReactionRepository) Add(ctx context.Context, reaction *domain.Reaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.reactions {
+		if existing.BehaviorLogID == reaction.BehaviorLogID && existing.GroupID == reaction.GroupID &&
+			existing.UserID == reaction.UserID && existing.Emoji == reaction.Emoji {
+			return nil
+		}
+	}
+
+	r.reactions = append(r.reactions, reaction)
+	return nil
+}
+
+func (r *MockReactionRepository) Remove(ctx context.Context, behaviorLogID, groupID, userID uuid.UUID, emoji string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, existing := range r.reactions {
+		if existing.BehaviorLogID == behaviorLogID && existing.GroupID == groupID &&
+			existing.UserID == userID && existing.Emoji == emoji {
+			r.reactions = append(r.reactions[:i], r.reactions[i+1:]...)
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (r *MockReactionRepository) GetByBehaviorLog(ctx context.Context, behaviorLogID, groupID uuid.UUID) ([]*domain.Reaction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	reactions := make([]*domain.Reaction, 0)
+	for _, reaction := range r.reactions {
+		if reaction.BehaviorLogID == behaviorLogID && reaction.GroupID == groupID {
+			reactions = append(reactions, reaction)
+		}
+	}
+
+	return reactions, nil
+}
+
+// MockBackfillPolicyRepository provides a mock implementation of domain.BackfillPolicyRepository
+type MockBackfillPolicyRepository struct {
+	mu       sync.RWMutex
+	policies map[uuid.UUID]*domain.BackfillPolicy
+}
+
+// NewMockBackfillPolicyRepository creates a new mock backfill policy repository
+func NewMockBackfillPolicyRepository() *MockBackfillPolicyRepository {
+	return &MockBackfillPolicyRepository{
+		policies: make(map[uuid.UUID]*domain.BackfillPolicy),
+	}
+}
+
+func (r *MockBackfillPolicyRepository) GetByGroup(ctx context.Context, groupID uuid.UUID) (*domain.BackfillPolicy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if policy, exists := r.policies[groupID]; exists {
+		return policy, nil
+	}
+	return domain.DefaultBackfillPolicy(groupID), nil
+}
+
+func (r *MockBackfillPolicyRepository) Save(ctx context.Context, policy *domain.BackfillPolicy) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.policies[policy.GroupID] = policy
+	return nil
+}
+
+// MockLateCorrectionRepository provides a mock implementation of domain.LateCorrectionRepository
+type MockLateCorrectionRepository struct {
+	mu          sync.RWMutex
+	corrections []*domain.LateCorrection
+}
+
+// NewMockLateCorrectionRepository creates a new mock late correction repository
+func NewMockLateCorrectionRepository() *MockLateCorrectionRepository {
+	return &MockLateCorrectionRepository{
+		corrections: make([]*domain.LateCorrection, 0),
+	}
+}
+
+func (r *MockLateCorrectionRepository) Create(ctx context.Context, correction *domain.LateCorrection) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.corrections = append(r.corrections, correction)
+	return nil
+}
+
+func (r *MockLateCorrectionRepository) GetByGroup(ctx context.Context, groupID uuid.UUID, limit, offset int) ([]*domain.LateCorrection, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// Corrections are appended in order, so the most recent ones are at the end
+	var corrections []*domain.LateCorrection
+	for i := len(r.corrections) - 1; i >= 0; i-- {
+		if r.corrections[i].GroupID == groupID {
+			corrections = append(corrections, r.corrections[i])
+		}
+	}
+
+	if offset >= len(corrections) {
+		return []*domain.LateCorrection{}, nil
+	}
+	corrections = corrections[offset:]
+	if limit > 0 && limit < len(corrections) {
+		corrections = corrections[:limit]
+	}
+
+	return corrections, nil
+}
+
+// MockScoringRulesRepository provides a mock implementation of domain.ScoringRulesRepository
+type MockScoringRulesRepository struct {
+	mu    sync.RWMutex
+	rules map[uuid.UUID]*domain.ScoringRules
+}
+
+// NewMockScoringRulesRepository creates a new mock scoring rules repository
+func NewMockScoringRulesRepository() *MockScoringRulesRepository {
+	return &MockScoringRulesRepository{
+		rules: make(map[uuid.UUID]*domain.ScoringRules),
+	}
+}
+
+func (r *MockScoringRulesRepository) GetByGroup(ctx context.Context, groupID uuid.UUID) (*domain.ScoringRules, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if rules, exists := r.rules[groupID]; exists {
+		return rules, nil
+	}
+	return domain.DefaultScoringRules(groupID), nil
+}
+
+func (r *MockScoringRulesRepository) Save(ctx context.Context, rules *domain.ScoringRules) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.rules[rules.GroupID] = rules
+	return nil
+}
+
+// MockVotingPolicyRepository provides a mock implementation of domain.VotingPolicyRepository
+type MockVotingPolicyRepository struct {
+	mu       sync.RWMutex
+	policies map[uuid.UUID]*domain.VotingPolicy
+}
+
+// NewMockVotingPolicyRepository creates a new mock voting policy repository
+func NewMockVotingPolicyRepository() *MockVotingPolicyRepository {
+	return &MockVotingPolicyRepository{
+		policies: make(map[uuid.UUID]*domain.VotingPolicy),
+	}
+}
+
+func (r *MockVotingPolicyRepository) GetByGroup(ctx context.Context, groupID uuid.UUID) (*domain.VotingPolicy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if policy, exists := r.policies[groupID]; exists {
+		return policy, nil
+	}
+	return domain.DefaultVotingPolicy(groupID), nil
+}
+
+func (r *MockVotingPolicyRepository) Save(ctx context.Context, policy *domain.VotingPolicy) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.policies[policy.GroupID] = policy
+	return nil
+}
+
+// MockPetOfTheDayVoteRepository provides a mock implementation of domain.PetOfTheDayVoteRepository
+type MockPetOfTheDayVoteRepository struct {
+	mu    sync.RWMutex
+	votes map[string]map[uuid.UUID]*domain.PetOfTheDayVote // group and date -> voter ID -> vote
+}
+
+// NewMockPetOfTheDayVoteRepository creates a new mock Pet of the Day vote repository
+func NewMockPetOfTheDayVoteRepository() *MockPetOfTheDayVoteRepository {
+	return &MockPetOfTheDayVoteRepository{
+		votes: make(map[string]map[uuid.UUID]*domain.PetOfTheDayVote),
+	}
+}
+
+func (r *MockPetOfTheDayVoteRepository) Save(ctx context.Context, vote *domain.PetOfTheDayVote) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dateKey := fmt.Sprintf("%s_%s", vote.GroupID, vote.Date.Format("2006-01-02"))
+	if r.votes[dateKey] == nil {
+		r.votes[dateKey] = make(map[uuid.UUID]*domain.PetOfTheDayVote)
+	}
+	r.votes[dateKey][vote.VoterID] = vote
+	return nil
+}
+
+func (r *MockPetOfTheDayVoteRepository) GetByGroupAndDate(ctx context.Context, groupID uuid.UUID, date time.Time) ([]*domain.PetOfTheDayVote, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	dateKey := fmt.Sprintf("%s_%s", groupID, date.Format("2006-01-02"))
+	votes := make([]*domain.PetOfTheDayVote, 0, len(r.votes[dateKey]))
+	for _, vote := range r.votes[dateKey] {
+		votes = append(votes, vote)
+	}
+	return votes, nil
+}
+
+func (r *MockPetOfTheDayVoteRepository) GetByVoter(ctx context.Context, groupID uuid.UUID, date time.Time, voterID uuid.UUID) (*domain.PetOfTheDayVote, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	dateKey := fmt.Sprintf("%s_%s", groupID, date.Format("2006-01-02"))
+	return r.votes[dateKey][voterID], nil
+}
+
+// MockShareCardSettingsRepository provides a mock implementation of domain.ShareCardSettingsRepository
+type MockShareCardSettingsRepository struct {
+	mu       sync.RWMutex
+	settings map[uuid.UUID]*domain.ShareCardSettings
+}
+
+// NewMockShareCardSettingsRepository creates a new mock share card settings repository
+func NewMockShareCardSettingsRepository() *MockShareCardSettingsRepository {
+	return &MockShareCardSettingsRepository{
+		settings: make(map[uuid.UUID]*domain.ShareCardSettings),
+	}
+}
+
+func (r *MockShareCardSettingsRepository) GetByGroup(ctx context.Context, groupID uuid.UUID) (*domain.ShareCardSettings, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if settings, exists := r.settings[groupID]; exists {
+		return settings, nil
+	}
+	return domain.DefaultShareCardSettings(groupID), nil
+}
+
+func (r *MockShareCardSettingsRepository) Save(ctx context.Context, settings *domain.ShareCardSettings) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.settings[settings.GroupID] = settings
+	return nil
+}
+
+// MockPetAnalyticsRepository provides a mock implementation of domain.PetAnalyticsRepository
+// that aggregates the logs, behaviors and scores of other mock repositories in memory
+type MockPetAnalyticsRepository struct {
+	behaviorLogRepo *MockBehaviorLogRepository
+	behaviorRepo    *MockBehaviorRepository
+	dailyScoreRepo  *MockDailyScoreRepository
+}
+
+// NewMockPetAnalyticsRepository creates a new mock pet analytics repository
+func NewMockPetAnalyticsRepository(
+	behaviorLogRepo *MockBehaviorLogRepository,
+	behaviorRepo *MockBehaviorRepository,
+	dailyScoreRepo *MockDailyScoreRepository,
+) *MockPetAnalyticsRepository {
+	return &MockPetAnalyticsRepository{
+		behaviorLogRepo: behaviorLogRepo,
+		behaviorRepo:    behaviorRepo,
+		dailyScoreRepo:  dailyScoreRepo,
+	}
+}
+
+func (r *MockPetAnalyticsRepository) GetHourlyHeatmap(ctx context.Context, filter *domain.PetAnalyticsFilter) ([]*domain.HeatmapCell, error) {
+	cells := make(map[[2]int]*domain.HeatmapCell)
+	for _, behaviorLog := range r.logs(filter) {
+		local := behaviorLog.LoggedAt.In(filter.Location)
+		key := [2]int{int(local.Weekday()), local.Hour()}
+		cell, exists := cells[key]
+		if !exists {
+			cell = &domain.HeatmapCell{Weekday: local.Weekday(), Hour: local.Hour()}
+			cells[key] = cell
+		}
+		cell.Logs++
+		cell.Points += filter.LogPoints(behaviorLog)
+	}
+
+	result := make([]*domain.HeatmapCell, 0, len(cells))
+	for _, cell := range cells {
+		result = append(result, cell)
+	}
+	return result, nil
+}
+
+func (r *MockPetAnalyticsRepository) GetCategoryMix(ctx context.Context, filter *domain.PetAnalyticsFilter, interval domain.AnalyticsInterval) ([]*domain.CategoryMixBucket, error) {
+	r.behaviorRepo.mu.RLock()
+	defer r.behaviorRepo.mu.RUnlock()
+
+	var buckets []*domain.CategoryMixBucket
+	for _, behaviorLog := range r.logs(filter) {
+		behavior, exists := r.behaviorRepo.behaviors[behaviorLog.BehaviorID]
+		if !exists {
+			continue
+		}
+
+		// Buckets are per day, the domain folds them into weeks
+		local := behaviorLog.LoggedAt.In(filter.Location)
+		buckets = append(buckets, &domain.CategoryMixBucket{
+			PeriodStart: time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC),
+			Category:    behavior.Category,
+			Logs:        1,
+			Points:      filter.LogPoints(behaviorLog),
+		})
+	}
+	return buckets, nil
+}
+
+func (r *MockPetAnalyticsRepository) GetDailyBehaviorTotals(ctx context.Context, filter *domain.PetAnalyticsFilter) ([]*domain.DailyBehaviorTotals, error) {
+	totals := make(map[time.Time]*domain.DailyBehaviorTotals)
+	for _, behaviorLog := range r.logs(filter) {
+		local := behaviorLog.LoggedAt.In(filter.Location)
+		date := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+		total, exists := totals[date]
+		if !exists {
+			total = &domain.DailyBehaviorTotals{Date: date}
+			totals[date] = total
+		}
+		points := filter.LogPoints(behaviorLog)
+		if points > 0 {
+			total.PositiveLogs++
+		} else if points < 0 {
+			total.NegativeLogs++
+		}
+		total.Points += points
+	}
+
+	result := make([]*domain.DailyBehaviorTotals, 0, len(totals))
+	for _, total := range totals {
+		result = append(result, total)
+	}
+	return result, nil
+}
+
+func (r *MockPetAnalyticsRepository) GetDailyScoreTotals(ctx context.Context, filter *domain.PetAnalyticsFilter) ([]*domain.DailyScoreTotal, error) {
+	r.dailyScoreRepo.mu.RLock()
+	defer r.dailyScoreRepo.mu.RUnlock()
+
+	sums := make(map[time.Time]int)
+	counts := make(map[time.Time]int)
+	for _, dailyScore := range r.dailyScoreRepo.dailyScores {
+		if dailyScore.PetID != filter.PetID || (filter.GroupID != nil && dailyScore.GroupID != *filter.GroupID) {
+			continue
+		}
+		date := time.Date(dailyScore.Date.Year(), dailyScore.Date.Month(), dailyScore.Date.Day(), 0, 0, 0, 0, time.UTC)
+		if date.Before(filter.From) || date.After(filter.To) {
+			continue
+		}
+		sums[date] += dailyScore.TotalPoints
+		counts[date]++
+	}
+
+	result := make([]*domain.DailyScoreTotal, 0, len(sums))
+	for date, sum := range sums {
+		result = append(result, &domain.DailyScoreTotal{Date: date, Score: float64(sum) / float64(counts[date])})
+	}
+	return result, nil
+}
+
+// logs returns the behavior logs a filter selects
+func (r *MockPetAnalyticsRepository) logs(filter *domain.PetAnalyticsFilter) []*domain.BehaviorLog {
+	r.behaviorLogRepo.mu.RLock()
+	defer r.behaviorLogRepo.mu.RUnlock()
+
+	var matches []*domain.BehaviorLog
+	for _, behaviorLog := range r.behaviorLogRepo.behaviorLogs {
+		if filter.Includes(behaviorLog) {
+			matches = append(matches, behaviorLog)
+		}
+	}
+	return matches
+}
+
+// MockArchivedDailyScoreRepository provides a mock implementation of domain.ArchivedDailyScoreRepository
+type MockArchivedDailyScoreRepository struct {
+	mu       sync.RWMutex
+	archived map[string]*domain.ArchivedDailyScore // key: petID_groupID_date
+}
+
+// NewMockArchivedDailyScoreRepository creates a new mock archived daily score repository
+func NewMockArchivedDailyScoreRepository() *MockArchivedDailyScoreRepository {
+	return &MockArchivedDailyScoreRepository{
+		archived: make(map[string]*domain.ArchivedDailyScore),
+	}
+}
+
+func (r *MockArchivedDailyScoreRepository) CreateBatch(ctx context.Context, archived []*domain.ArchivedDailyScore) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, summary := range archived {
+		if _, exists := r.archived[archivedDailyScoreKey(summary.PetID, summary.GroupID, summary.Date)]; exists {
+			return fmt.Errorf("day %s is already archived", summary.Date.Format("2006-01-02"))
+		}
+	}
+	for _, summary := range archived {
+		r.archived[archivedDailyScoreKey(summary.PetID, summary.GroupID, summary.Date)] = summary
+	}
+	return nil
+}
+
+func (r *MockArchivedDailyScoreRepository) GetByPetGroupAndDate(ctx context.Context, petID, groupID uuid.UUID, date time.Time) (*domain.ArchivedDailyScore, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.archived[archivedDailyScoreKey(petID, groupID, date)], nil
+}
+
+func archivedDailyScoreKey(petID, groupID uuid.UUID, date time.Time) string {
+	return fmt.Sprintf("%s_%s_%s", petID, groupID, date.Format("2006-01-02"))
+}
+
+// MockRankingReadModelRepository provides a mock implementation of domain.RankingReadModelRepository,
+// joining the scores of a mock daily score repository with the pets and users of a mock
+// authorization repository. Scores are copied like rows read from a database.
+type MockRankingReadModelRepository struct {
+	dailyScoreRepo *MockDailyScoreRepository
+	authRepo       *MockAuthorizationRepository
+}
+
+// NewMockRankingReadModelRepository creates a new mock rankings read model repository
+func NewMockRankingReadModelRepository(dailyScoreRepo *MockDailyScoreRepository, authRepo *MockAuthorizationRepository) *MockRankingReadModelRepository {
+	return &MockRankingReadModelRepository{
+		dailyScoreRepo: dailyScoreRepo,
+		authRepo:       authRepo,
+	}
+}
+
+func (r *MockRankingReadModelRepository) GetGroupDay(ctx context.Context, groupID uuid.UUID, date time.Time) ([]*domain.RankingEntry, error) {
+	r.dailyScoreRepo.mu.RLock()
+	defer r.dailyScoreRepo.mu.RUnlock()
+
+	scores := r.dailyScoreRepo.dateIndex[fmt.Sprintf("%s_%s", groupID, date.Format("2006-01-02"))]
+	entries := make([]*domain.RankingEntry, 0, len(scores))
+	for _, score := range scores {
+		if entry := r.join(score); entry != nil {
+			entries = append(entries, entry)
+		}
+	}
+
+	// Highest points first
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].DailyScore.TotalPoints > entries[j].DailyScore.TotalPoints
+	})
+
+	return entries, nil
+}
+
+func (r *MockRankingReadModelRepository) GetPetDay(ctx context.Context, petID, groupID uuid.UUID, date time.Time) (*domain.RankingEntry, error) {
+	r.dailyScoreRepo.mu.RLock()
+	defer r.dailyScoreRepo.mu.RUnlock()
+
+	for _, score := range r.dailyScoreRepo.dateIndex[fmt.Sprintf("%s_%s", groupID, date.Format("2006-01-02"))] {
+		if score.PetID == petID {
+			return r.join(score), nil
+		}
+	}
+
+	return nil, nil
+}
+
+// join returns the entry of a score, nil if its pet or owner is unknown like with an inner join
+func (r *MockRankingReadModelRepository) join(score *domain.DailyScore) *domain.RankingEntry {
+	r.authRepo.mu.RLock()
+	defer r.authRepo.mu.RUnlock()
+
+	pet, exists := r.authRepo.pets[score.PetID]
+	if !exists {
+		return nil
+	}
+	owner, exists := r.authRepo.users[pet.OwnerID]
+	if !exists {
+		return nil
+	}
+
+	copied := *score
+	return domain.NewRankingEntry(&copied, pet.Name, pet.OwnerID, owner.Name)
+}
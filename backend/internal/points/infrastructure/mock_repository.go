@@ -116,6 +116,22 @@ func (r *MockBehaviorRepository) GetByName(ctx context.Context, name string) (*d
 	return nil, nil
 }
 
+// GetAllIncludingInactive returns every behavior, active or not
+func (r *MockBehaviorRepository) GetAllIncludingInactive(ctx context.Context) ([]*domain.Behavior, error) {
+	var result []*domain.Behavior
+	for _, behavior := range r.behaviors {
+		b := behavior // Create copy to avoid pointer issues
+		result = append(result, &b)
+	}
+	return result, nil
+}
+
+// HardDelete permanently removes a behavior
+func (r *MockBehaviorRepository) HardDelete(ctx context.Context, id uuid.UUID) error {
+	delete(r.behaviors, id)
+	return nil
+}
+
 // MockScoreEventRepository is a mock implementation of domain.ScoreEventRepository
 type MockScoreEventRepository struct {
 	events map[uuid.UUID]domain.ScoreEvent
@@ -228,6 +244,29 @@ func (r *MockScoreEventRepository) DeleteByGroupID(ctx context.Context, groupID
 	return nil
 }
 
+// ListScoreEvents returns a page of every score event, the oldest recorded first
+func (r *MockScoreEventRepository) ListScoreEvents(ctx context.Context, offset, limit int) ([]domain.ScoreEvent, error) {
+	events := make([]domain.ScoreEvent, 0, len(r.events))
+	for _, event := range r.events {
+		events = append(events, event)
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if !events[i].RecordedAt.Equal(events[j].RecordedAt) {
+			return events[i].RecordedAt.Before(events[j].RecordedAt)
+		}
+		return events[i].ID.String() < events[j].ID.String()
+	})
+
+	if offset >= len(events) {
+		return []domain.ScoreEvent{}, nil
+	}
+	end := offset + limit
+	if end > len(events) {
+		end = len(events)
+	}
+	return events[offset:end], nil
+}
+
 // GetRecentActivitiesForUser returns recent activities for groups the user is a member of
 func (r *MockScoreEventRepository) GetRecentActivitiesForUser(ctx context.Context, userID uuid.UUID, limit int) ([]domain.ActivityItem, error) {
 	var activities []domain.ActivityItem
@@ -0,0 +1,169 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/application/queries"
+	"pet-of-the-day/internal/shared/auth"
+)
+
+// AdminBehaviorController handles HTTP requests for administering the global behavior catalog
+type AdminBehaviorController struct {
+	getAllBehaviorsHandler *queries.GetAllBehaviorsHandler
+	createBehaviorHandler  *commands.CreateBehaviorHandler
+	updateBehaviorHandler  *commands.UpdateBehaviorHandler
+	deleteBehaviorHandler  *commands.DeleteBehaviorHandler
+}
+
+// NewAdminBehaviorController creates a new admin behavior controller
+func NewAdminBehaviorController(
+	getAllBehaviorsHandler *queries.GetAllBehaviorsHandler,
+	createBehaviorHandler *commands.CreateBehaviorHandler,
+	updateBehaviorHandler *commands.UpdateBehaviorHandler,
+	deleteBehaviorHandler *commands.DeleteBehaviorHandler,
+) *AdminBehaviorController {
+	return &AdminBehaviorController{
+		getAllBehaviorsHandler: getAllBehaviorsHandler,
+		createBehaviorHandler:  createBehaviorHandler,
+		updateBehaviorHandler:  updateBehaviorHandler,
+		deleteBehaviorHandler:  deleteBehaviorHandler,
+	}
+}
+
+// RegisterRoutes registers the admin behavior catalog routes
+func (c *AdminBehaviorController) RegisterRoutes(router *mux.Router, authMiddleware func(http.Handler) http.Handler) {
+	// Admin rights are checked by the command and query handlers
+	admin := router.PathPrefix("/api/admin").Subrouter()
+	admin.Use(authMiddleware)
+
+	admin.HandleFunc("/behaviors", c.getAllBehaviors).Methods("GET")
+	admin.HandleFunc("/behaviors", c.createBehavior).Methods("POST")
+	admin.HandleFunc("/behaviors/{id}", c.updateBehavior).Methods("PUT")
+	admin.HandleFunc("/behaviors/{id}", c.deleteBehavior).Methods("DELETE")
+}
+
+// getAllBehaviors handles GET /api/admin/behaviors
+func (c *AdminBehaviorController) getAllBehaviors(w http.ResponseWriter, r *http.Request) {
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute query
+	result, err := c.getAllBehaviorsHandler.Handle(r.Context(), &queries.GetAllBehaviorsQuery{UserID: userID})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// createBehavior handles POST /api/admin/behaviors
+func (c *AdminBehaviorController) createBehavior(w http.ResponseWriter, r *http.Request) {
+	// Parse request body
+	var cmd commands.CreateBehaviorCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		writeInvalidInput(w, "Invalid request body")
+		return
+	}
+
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+	cmd.UserID = userID
+
+	// Execute command
+	result, err := c.createBehaviorHandler.Handle(r.Context(), &cmd)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(result)
+}
+
+// updateBehavior handles PUT /api/admin/behaviors/{id}
+func (c *AdminBehaviorController) updateBehavior(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	behaviorID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid behavior ID")
+		return
+	}
+
+	// Parse request body
+	var cmd commands.UpdateBehaviorCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		writeInvalidInput(w, "Invalid request body")
+		return
+	}
+
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+	cmd.BehaviorID = behaviorID
+	cmd.UserID = userID
+
+	// Execute command
+	result, err := c.updateBehaviorHandler.Handle(r.Context(), &cmd)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// deleteBehavior handles DELETE /api/admin/behaviors/{id}
+func (c *AdminBehaviorController) deleteBehavior(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	behaviorID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid behavior ID")
+		return
+	}
+
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute command
+	result, err := c.deleteBehaviorHandler.Handle(r.Context(), &commands.DeleteBehaviorCommand{
+		BehaviorID: behaviorID,
+		UserID:     userID,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,86 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"pet-of-the-day/internal/points/application/queries"
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/auth"
+)
+
+// AnalyticsController handles HTTP requests for pet analytics
+type AnalyticsController struct {
+	getPetAnalyticsHandler *queries.GetPetAnalyticsHandler
+}
+
+// NewAnalyticsController creates a new analytics controller
+func NewAnalyticsController(getPetAnalyticsHandler *queries.GetPetAnalyticsHandler) *AnalyticsController {
+	return &AnalyticsController{
+		getPetAnalyticsHandler: getPetAnalyticsHandler,
+	}
+}
+
+// RegisterRoutes registers the analytics routes
+func (c *AnalyticsController) RegisterRoutes(router *mux.Router, authMiddleware func(http.Handler) http.Handler) {
+	api := router.PathPrefix("/api").Subrouter()
+	api.Use(authMiddleware)
+
+	api.HandleFunc("/pets/{id}/analytics", c.getPetAnalytics).Methods("GET")
+}
+
+// getPetAnalytics handles GET /api/pets/{id}/analytics
+func (c *AnalyticsController) getPetAnalytics(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	petID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid pet ID")
+		return
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Parse query parameters
+	query := &queries.GetPetAnalyticsQuery{
+		PetID:    petID,
+		UserID:   userID,
+		Interval: domain.AnalyticsInterval(r.URL.Query().Get("interval")),
+		Window:   parseIntParam(r.URL.Query().Get("window"), 0),
+	}
+	if groupParam := r.URL.Query().Get("group_id"); groupParam != "" {
+		groupID, err := uuid.Parse(groupParam)
+		if err != nil {
+			writeInvalidInput(w, "Invalid group ID")
+			return
+		}
+		query.GroupID = &groupID
+	}
+	if query.From, err = parseDateParam(r.URL.Query().Get("from")); err != nil {
+		writeInvalidInput(w, "Invalid from format (expected YYYY-MM-DD)")
+		return
+	}
+	if query.To, err = parseDateParam(r.URL.Query().Get("to")); err != nil {
+		writeInvalidInput(w, "Invalid to format (expected YYYY-MM-DD)")
+		return
+	}
+
+	// Execute query
+	result, err := c.getPetAnalyticsHandler.Handle(r.Context(), query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,126 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/application/queries"
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/auth"
+)
+
+// AnomalyController handles HTTP requests for the moderation queue of flagged behavior logs
+type AnomalyController struct {
+	getAnomalyFlagsHandler    *queries.GetAnomalyFlagsHandler
+	resolveAnomalyFlagHandler *commands.ResolveAnomalyFlagHandler
+}
+
+// NewAnomalyController creates a new anomaly controller
+func NewAnomalyController(
+	getAnomalyFlagsHandler *queries.GetAnomalyFlagsHandler,
+	resolveAnomalyFlagHandler *commands.ResolveAnomalyFlagHandler,
+) *AnomalyController {
+	return &AnomalyController{
+		getAnomalyFlagsHandler:    getAnomalyFlagsHandler,
+		resolveAnomalyFlagHandler: resolveAnomalyFlagHandler,
+	}
+}
+
+// resolveAnomalyFlagRequest is the body of POST /api/groups/{id}/anomalies/{flagId}/resolve
+type resolveAnomalyFlagRequest struct {
+	Confirmed bool `json:"confirmed"`
+}
+
+// RegisterRoutes registers the moderation routes
+func (c *AnomalyController) RegisterRoutes(router *mux.Router, authMiddleware func(http.Handler) http.Handler) {
+	api := router.PathPrefix("/api").Subrouter()
+	api.Use(authMiddleware)
+
+	api.HandleFunc("/groups/{id}/anomalies", c.getAnomalyFlags).Methods("GET")
+	api.HandleFunc("/groups/{id}/anomalies/{flagId}/resolve", c.resolveAnomalyFlag).Methods("POST")
+}
+
+// getAnomalyFlags handles GET /api/groups/{id}/anomalies?status=open|dismissed|confirmed
+func (c *AnomalyController) getAnomalyFlags(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute query
+	result, err := c.getAnomalyFlagsHandler.Handle(r.Context(), &queries.GetAnomalyFlagsQuery{
+		GroupID: groupID,
+		UserID:  userID,
+		Status:  domain.AnomalyFlagStatus(r.URL.Query().Get("status")),
+		Limit:   parseIntParam(r.URL.Query().Get("limit"), 50),
+		Offset:  parseIntParam(r.URL.Query().Get("offset"), 0),
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// resolveAnomalyFlag handles POST /api/groups/{id}/anomalies/{flagId}/resolve
+func (c *AnomalyController) resolveAnomalyFlag(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameters
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+	flagID, err := uuid.Parse(vars["flagId"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid anomaly flag ID")
+		return
+	}
+
+	// Parse request body
+	var req resolveAnomalyFlagRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeInvalidInput(w, "Invalid request body")
+		return
+	}
+
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute command
+	result, err := c.resolveAnomalyFlagHandler.Handle(r.Context(), &commands.ResolveAnomalyFlagCommand{
+		FlagID:    flagID,
+		GroupID:   groupID,
+		UserID:    userID,
+		Confirmed: req.Confirmed,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
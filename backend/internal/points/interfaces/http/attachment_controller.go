@@ -0,0 +1,124 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"pet-of-the-day/internal/points/application/queries"
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/auth"
+	"pet-of-the-day/internal/shared/upload"
+)
+
+// maxAttachmentsMemory is the part of a multipart form kept in memory, the rest goes to temporary files
+const maxAttachmentsMemory = 32 << 20
+
+// maxAttachmentsRequestSize bounds the body of a behavior log with photos: every attachment at
+// the largest accepted size, plus room for the form fields
+var maxAttachmentsRequestSize = domain.MaxBehaviorLogAttachments*upload.DefaultImageUploadConfig().MaxFileSize + 1<<20
+
+// AttachmentController handles HTTP requests for the photos attached to behavior logs
+type AttachmentController struct {
+	getBehaviorLogAttachmentsHandler *queries.GetBehaviorLogAttachmentsHandler
+	getAttachmentContentHandler      *queries.GetAttachmentContentHandler
+}
+
+// NewAttachmentController creates a new attachment controller
+func NewAttachmentController(
+	getBehaviorLogAttachmentsHandler *queries.GetBehaviorLogAttachmentsHandler,
+	getAttachmentContentHandler *queries.GetAttachmentContentHandler,
+) *AttachmentController {
+	return &AttachmentController{
+		getBehaviorLogAttachmentsHandler: getBehaviorLogAttachmentsHandler,
+		getAttachmentContentHandler:      getAttachmentContentHandler,
+	}
+}
+
+// RegisterRoutes registers the attachment routes
+func (c *AttachmentController) RegisterRoutes(router *mux.Router, authMiddleware func(http.Handler) http.Handler) {
+	api := router.PathPrefix("/api").Subrouter()
+	api.Use(authMiddleware)
+
+	api.HandleFunc("/behavior-logs/{id}/attachments", c.getAttachments).Methods("GET")
+	api.HandleFunc("/behavior-logs/{id}/attachments/{attachmentId}", c.downloadAttachment).Methods("GET")
+}
+
+// getAttachments handles GET /api/behavior-logs/{id}/attachments
+func (c *AttachmentController) getAttachments(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	behaviorLogID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid behavior log ID")
+		return
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute query
+	result, err := c.getBehaviorLogAttachmentsHandler.Handle(r.Context(), &queries.GetBehaviorLogAttachmentsQuery{
+		BehaviorLogID: behaviorLogID,
+		UserID:        userID,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// downloadAttachment handles GET /api/behavior-logs/{id}/attachments/{attachmentId}?thumbnail=true
+func (c *AttachmentController) downloadAttachment(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameters
+	vars := mux.Vars(r)
+	behaviorLogID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid behavior log ID")
+		return
+	}
+	attachmentID, err := uuid.Parse(vars["attachmentId"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid attachment ID")
+		return
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute query
+	result, err := c.getAttachmentContentHandler.Handle(r.Context(), &queries.GetAttachmentContentQuery{
+		BehaviorLogID: behaviorLogID,
+		AttachmentID:  attachmentID,
+		UserID:        userID,
+		Thumbnail:     r.URL.Query().Get("thumbnail") == "true",
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	defer result.Content.Close()
+
+	// Stream the file, it is private to the people who can see the log
+	w.Header().Set("Content-Type", result.MimeType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", result.Attachment.OriginalName))
+	w.Header().Set("Cache-Control", "private, max-age=86400")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	io.Copy(w, result.Content)
+}
@@ -0,0 +1,158 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/application/queries"
+	"pet-of-the-day/internal/shared/auth"
+)
+
+// BackfillController handles HTTP requests for the retroactive logging policy of groups
+type BackfillController struct {
+	getBackfillPolicyHandler    *queries.GetBackfillPolicyHandler
+	getLateCorrectionsHandler   *queries.GetLateCorrectionsHandler
+	updateBackfillPolicyHandler *commands.UpdateBackfillPolicyHandler
+}
+
+// NewBackfillController creates a new backfill controller
+func NewBackfillController(
+	getBackfillPolicyHandler *queries.GetBackfillPolicyHandler,
+	getLateCorrectionsHandler *queries.GetLateCorrectionsHandler,
+	updateBackfillPolicyHandler *commands.UpdateBackfillPolicyHandler,
+) *BackfillController {
+	return &BackfillController{
+		getBackfillPolicyHandler:    getBackfillPolicyHandler,
+		getLateCorrectionsHandler:   getLateCorrectionsHandler,
+		updateBackfillPolicyHandler: updateBackfillPolicyHandler,
+	}
+}
+
+// updateBackfillPolicyRequest is the body of PUT /api/groups/{id}/backfill
+type updateBackfillPolicyRequest struct {
+	MaxBackfillHours int  `json:"max_backfill_hours"`
+	AllowClosedDays  bool `json:"allow_closed_days"`
+	RerunPetOfTheDay bool `json:"rerun_pet_of_the_day"`
+}
+
+// RegisterRoutes registers the backfill routes
+func (c *BackfillController) RegisterRoutes(router *mux.Router, authMiddleware func(http.Handler) http.Handler) {
+	api := router.PathPrefix("/api").Subrouter()
+	api.Use(authMiddleware)
+
+	api.HandleFunc("/groups/{id}/backfill", c.getBackfillPolicy).Methods("GET")
+	api.HandleFunc("/groups/{id}/backfill", c.updateBackfillPolicy).Methods("PUT")
+	api.HandleFunc("/groups/{id}/late-corrections", c.getLateCorrections).Methods("GET")
+}
+
+// getBackfillPolicy handles GET /api/groups/{id}/backfill
+func (c *BackfillController) getBackfillPolicy(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute query
+	policy, err := c.getBackfillPolicyHandler.Handle(r.Context(), &queries.GetBackfillPolicyQuery{
+		GroupID: groupID,
+		UserID:  userID,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(policy)
+}
+
+// updateBackfillPolicy handles PUT /api/groups/{id}/backfill
+func (c *BackfillController) updateBackfillPolicy(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	// Parse request body
+	var req updateBackfillPolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeInvalidInput(w, "Invalid request body")
+		return
+	}
+
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute command
+	result, err := c.updateBackfillPolicyHandler.Handle(r.Context(), &commands.UpdateBackfillPolicyCommand{
+		GroupID:          groupID,
+		UserID:           userID,
+		MaxBackfillHours: req.MaxBackfillHours,
+		AllowClosedDays:  req.AllowClosedDays,
+		RerunPetOfTheDay: req.RerunPetOfTheDay,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// getLateCorrections handles GET /api/groups/{id}/late-corrections
+func (c *BackfillController) getLateCorrections(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute query
+	result, err := c.getLateCorrectionsHandler.Handle(r.Context(), &queries.GetLateCorrectionsQuery{
+		GroupID: groupID,
+		UserID:  userID,
+		Limit:   parseIntParam(r.URL.Query().Get("limit"), 50),
+		Offset:  parseIntParam(r.URL.Query().Get("offset"), 0),
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,136 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/application/queries"
+	"pet-of-the-day/internal/shared/auth"
+)
+
+// BadgeController handles HTTP requests for pet and user badges
+type BadgeController struct {
+	getPetBadgesHandler   *queries.GetPetBadgesHandler
+	getUserBadgesHandler  *queries.GetUserBadgesHandler
+	backfillBadgesHandler *commands.BackfillBadgesHandler
+}
+
+// NewBadgeController creates a new badge controller
+func NewBadgeController(
+	getPetBadgesHandler *queries.GetPetBadgesHandler,
+	getUserBadgesHandler *queries.GetUserBadgesHandler,
+	backfillBadgesHandler *commands.BackfillBadgesHandler,
+) *BadgeController {
+	return &BadgeController{
+		getPetBadgesHandler:   getPetBadgesHandler,
+		getUserBadgesHandler:  getUserBadgesHandler,
+		backfillBadgesHandler: backfillBadgesHandler,
+	}
+}
+
+// backfillBadgesRequest is the body of POST /api/admin/badges/backfill
+type backfillBadgesRequest struct {
+	UserID *uuid.UUID `json:"user_id,omitempty"`
+}
+
+// RegisterRoutes registers the badge routes
+func (c *BadgeController) RegisterRoutes(router *mux.Router, authMiddleware func(http.Handler) http.Handler) {
+	api := router.PathPrefix("/api").Subrouter()
+	api.Use(authMiddleware)
+
+	api.HandleFunc("/pets/{id}/badges", c.getPetBadges).Methods("GET")
+	api.HandleFunc("/users/me/badges", c.getUserBadges).Methods("GET")
+
+	// Admin rights are checked by the command handler
+	api.HandleFunc("/admin/badges/backfill", c.backfillBadges).Methods("POST")
+}
+
+// getPetBadges handles GET /api/pets/{id}/badges
+func (c *BadgeController) getPetBadges(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	petID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid pet ID")
+		return
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute query
+	result, err := c.getPetBadgesHandler.Handle(r.Context(), &queries.GetPetBadgesQuery{
+		PetID:  petID,
+		UserID: userID,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// getUserBadges handles GET /api/users/me/badges
+func (c *BadgeController) getUserBadges(w http.ResponseWriter, r *http.Request) {
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute query
+	result, err := c.getUserBadgesHandler.Handle(r.Context(), &queries.GetUserBadgesQuery{UserID: userID})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// backfillBadges handles POST /api/admin/badges/backfill
+func (c *BadgeController) backfillBadges(w http.ResponseWriter, r *http.Request) {
+	// Parse request body, an empty body backfills every user
+	var req backfillBadgesRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeInvalidInput(w, "Invalid request body")
+			return
+		}
+	}
+
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute command
+	result, err := c.backfillBadgesHandler.Handle(r.Context(), &commands.BackfillBadgesCommand{
+		UserID:       userID,
+		TargetUserID: req.UserID,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,269 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/application/queries"
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/auth"
+)
+
+// ChallengeController handles HTTP requests for group challenges
+type ChallengeController struct {
+	getGroupChallengesHandler   *queries.GetGroupChallengesHandler
+	getChallengeProgressHandler *queries.GetChallengeProgressHandler
+	createChallengeHandler      *commands.CreateChallengeHandler
+	updateChallengeHandler      *commands.UpdateChallengeHandler
+	deleteChallengeHandler      *commands.DeleteChallengeHandler
+}
+
+// NewChallengeController creates a new challenge controller
+func NewChallengeController(
+	getGroupChallengesHandler *queries.GetGroupChallengesHandler,
+	getChallengeProgressHandler *queries.GetChallengeProgressHandler,
+	createChallengeHandler *commands.CreateChallengeHandler,
+	updateChallengeHandler *commands.UpdateChallengeHandler,
+	deleteChallengeHandler *commands.DeleteChallengeHandler,
+) *ChallengeController {
+	return &ChallengeController{
+		getGroupChallengesHandler:   getGroupChallengesHandler,
+		getChallengeProgressHandler: getChallengeProgressHandler,
+		createChallengeHandler:      createChallengeHandler,
+		updateChallengeHandler:      updateChallengeHandler,
+		deleteChallengeHandler:      deleteChallengeHandler,
+	}
+}
+
+// challengeRequest is the body of POST and PUT challenge requests, times as RFC 3339
+type challengeRequest struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Target      domain.ChallengeTarget `json:"target"`
+	StartsAt    time.Time              `json:"starts_at"`
+	EndsAt      time.Time              `json:"ends_at"`
+	BonusPoints int                    `json:"bonus_points"`
+}
+
+// RegisterRoutes registers the challenge routes
+func (c *ChallengeController) RegisterRoutes(router *mux.Router, authMiddleware func(http.Handler) http.Handler) {
+	api := router.PathPrefix("/api").Subrouter()
+	api.Use(authMiddleware)
+
+	api.HandleFunc("/groups/{id}/challenges", c.getGroupChallenges).Methods("GET")
+	api.HandleFunc("/groups/{id}/challenges", c.createChallenge).Methods("POST")
+	api.HandleFunc("/groups/{id}/challenges/{challengeId}", c.getChallengeProgress).Methods("GET")
+	api.HandleFunc("/groups/{id}/challenges/{challengeId}", c.updateChallenge).Methods("PUT")
+	api.HandleFunc("/groups/{id}/challenges/{challengeId}", c.deleteChallenge).Methods("DELETE")
+	api.HandleFunc("/groups/{id}/challenges/{challengeId}/progress", c.getChallengeProgress).Methods("GET")
+}
+
+// getGroupChallenges handles GET /api/groups/{id}/challenges
+func (c *ChallengeController) getGroupChallenges(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute query
+	result, err := c.getGroupChallengesHandler.Handle(r.Context(), &queries.GetGroupChallengesQuery{
+		GroupID: groupID,
+		UserID:  userID,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// getChallengeProgress handles GET /api/groups/{id}/challenges/{challengeId} and its /progress alias
+func (c *ChallengeController) getChallengeProgress(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameters
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+	challengeID, err := uuid.Parse(vars["challengeId"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid challenge ID")
+		return
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute query
+	result, err := c.getChallengeProgressHandler.Handle(r.Context(), &queries.GetChallengeProgressQuery{
+		GroupID:     groupID,
+		ChallengeID: challengeID,
+		UserID:      userID,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// createChallenge handles POST /api/groups/{id}/challenges
+func (c *ChallengeController) createChallenge(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	// Parse request body
+	var req challengeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeInvalidInput(w, "Invalid request body")
+		return
+	}
+
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute command
+	result, err := c.createChallengeHandler.Handle(r.Context(), &commands.CreateChallengeCommand{
+		GroupID:     groupID,
+		UserID:      userID,
+		Name:        req.Name,
+		Description: req.Description,
+		Target:      req.Target,
+		StartsAt:    req.StartsAt,
+		EndsAt:      req.EndsAt,
+		BonusPoints: req.BonusPoints,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(result)
+}
+
+// updateChallenge handles PUT /api/groups/{id}/challenges/{challengeId}
+func (c *ChallengeController) updateChallenge(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameters
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+	challengeID, err := uuid.Parse(vars["challengeId"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid challenge ID")
+		return
+	}
+
+	// Parse request body
+	var req challengeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeInvalidInput(w, "Invalid request body")
+		return
+	}
+
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute command
+	result, err := c.updateChallengeHandler.Handle(r.Context(), &commands.UpdateChallengeCommand{
+		GroupID:     groupID,
+		ChallengeID: challengeID,
+		UserID:      userID,
+		Name:        req.Name,
+		Description: req.Description,
+		Target:      req.Target,
+		StartsAt:    req.StartsAt,
+		EndsAt:      req.EndsAt,
+		BonusPoints: req.BonusPoints,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// deleteChallenge handles DELETE /api/groups/{id}/challenges/{challengeId}
+func (c *ChallengeController) deleteChallenge(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameters
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+	challengeID, err := uuid.Parse(vars["challengeId"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid challenge ID")
+		return
+	}
+
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute command
+	result, err := c.deleteChallengeHandler.Handle(r.Context(), &commands.DeleteChallengeCommand{
+		GroupID:     groupID,
+		ChallengeID: challengeID,
+		UserID:      userID,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
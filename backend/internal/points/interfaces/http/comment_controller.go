@@ -0,0 +1,283 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/application/queries"
+	"pet-of-the-day/internal/shared/auth"
+)
+
+// CommentController handles HTTP requests for the comments and reactions on behavior logs shared with groups
+type CommentController struct {
+	getBehaviorLogCommentsHandler *queries.GetBehaviorLogCommentsHandler
+	addCommentHandler             *commands.AddCommentHandler
+	editCommentHandler            *commands.EditCommentHandler
+	deleteCommentHandler          *commands.DeleteCommentHandler
+	reactToBehaviorLogHandler     *commands.ReactToBehaviorLogHandler
+}
+
+// NewCommentController creates a new comment controller
+func NewCommentController(
+	getBehaviorLogCommentsHandler *queries.GetBehaviorLogCommentsHandler,
+	addCommentHandler *commands.AddCommentHandler,
+	editCommentHandler *commands.EditCommentHandler,
+	deleteCommentHandler *commands.DeleteCommentHandler,
+	reactToBehaviorLogHandler *commands.ReactToBehaviorLogHandler,
+) *CommentController {
+	return &CommentController{
+		getBehaviorLogCommentsHandler: getBehaviorLogCommentsHandler,
+		addCommentHandler:             addCommentHandler,
+		editCommentHandler:            editCommentHandler,
+		deleteCommentHandler:          deleteCommentHandler,
+		reactToBehaviorLogHandler:     reactToBehaviorLogHandler,
+	}
+}
+
+// commentRequest is the body of the comment creation and edit routes
+type commentRequest struct {
+	Body     string     `json:"body"`
+	ParentID *uuid.UUID `json:"parent_id,omitempty"` // Ignored on edit
+}
+
+// reactionRequest is the body of POST /api/groups/{id}/behavior-logs/{logId}/reactions
+type reactionRequest struct {
+	Emoji string `json:"emoji"`
+}
+
+// RegisterRoutes registers the comment and reaction routes
+func (c *CommentController) RegisterRoutes(router *mux.Router, authMiddleware func(http.Handler) http.Handler) {
+	api := router.PathPrefix("/api").Subrouter()
+	api.Use(authMiddleware)
+
+	api.HandleFunc("/groups/{id}/behavior-logs/{logId}/comments", c.getComments).Methods("GET")
+	api.HandleFunc("/groups/{id}/behavior-logs/{logId}/comments", c.addComment).Methods("POST")
+	api.HandleFunc("/groups/{id}/comments/{commentId}", c.editComment).Methods("PUT")
+	api.HandleFunc("/groups/{id}/comments/{commentId}", c.deleteComment).Methods("DELETE")
+	api.HandleFunc("/groups/{id}/behavior-logs/{logId}/reactions", c.addReaction).Methods("POST")
+	api.HandleFunc("/groups/{id}/behavior-logs/{logId}/reactions", c.removeReaction).Methods("DELETE")
+}
+
+// getComments handles GET /api/groups/{id}/behavior-logs/{logId}/comments
+func (c *CommentController) getComments(w http.ResponseWriter, r *http.Request) {
+	groupID, behaviorLogID, ok := parseGroupLogPath(w, r)
+	if !ok {
+		return
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute query
+	result, err := c.getBehaviorLogCommentsHandler.Handle(r.Context(), &queries.GetBehaviorLogCommentsQuery{
+		BehaviorLogID: behaviorLogID,
+		GroupID:       groupID,
+		UserID:        userID,
+		Limit:         parseIntParam(r.URL.Query().Get("limit"), 50),
+		Offset:        parseIntParam(r.URL.Query().Get("offset"), 0),
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// addComment handles POST /api/groups/{id}/behavior-logs/{logId}/comments
+func (c *CommentController) addComment(w http.ResponseWriter, r *http.Request) {
+	groupID, behaviorLogID, ok := parseGroupLogPath(w, r)
+	if !ok {
+		return
+	}
+
+	// Parse request body
+	var req commentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeInvalidInput(w, "Invalid request body")
+		return
+	}
+
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute command
+	comment, err := c.addCommentHandler.Handle(r.Context(), &commands.AddCommentCommand{
+		BehaviorLogID: behaviorLogID,
+		GroupID:       groupID,
+		UserID:        userID,
+		ParentID:      req.ParentID,
+		Body:          req.Body,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(comment)
+}
+
+// editComment handles PUT /api/groups/{id}/comments/{commentId}
+func (c *CommentController) editComment(w http.ResponseWriter, r *http.Request) {
+	groupID, commentID, ok := parseGroupCommentPath(w, r)
+	if !ok {
+		return
+	}
+
+	// Parse request body
+	var req commentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeInvalidInput(w, "Invalid request body")
+		return
+	}
+
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute command
+	comment, err := c.editCommentHandler.Handle(r.Context(), &commands.EditCommentCommand{
+		CommentID: commentID,
+		GroupID:   groupID,
+		UserID:    userID,
+		Body:      req.Body,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(comment)
+}
+
+// deleteComment handles DELETE /api/groups/{id}/comments/{commentId}
+func (c *CommentController) deleteComment(w http.ResponseWriter, r *http.Request) {
+	groupID, commentID, ok := parseGroupCommentPath(w, r)
+	if !ok {
+		return
+	}
+
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute command
+	comment, err := c.deleteCommentHandler.Handle(r.Context(), &commands.DeleteCommentCommand{
+		CommentID: commentID,
+		GroupID:   groupID,
+		UserID:    userID,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(comment)
+}
+
+// addReaction handles POST /api/groups/{id}/behavior-logs/{logId}/reactions
+func (c *CommentController) addReaction(w http.ResponseWriter, r *http.Request) {
+	var req reactionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeInvalidInput(w, "Invalid request body")
+		return
+	}
+
+	c.react(w, r, req.Emoji, false)
+}
+
+// removeReaction handles DELETE /api/groups/{id}/behavior-logs/{logId}/reactions?emoji=...
+func (c *CommentController) removeReaction(w http.ResponseWriter, r *http.Request) {
+	c.react(w, r, r.URL.Query().Get("emoji"), true)
+}
+
+// react adds or removes a reaction on the behavior log and group of the path
+func (c *CommentController) react(w http.ResponseWriter, r *http.Request, emoji string, remove bool) {
+	groupID, behaviorLogID, ok := parseGroupLogPath(w, r)
+	if !ok {
+		return
+	}
+
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute command
+	result, err := c.reactToBehaviorLogHandler.Handle(r.Context(), &commands.ReactToBehaviorLogCommand{
+		BehaviorLogID: behaviorLogID,
+		GroupID:       groupID,
+		UserID:        userID,
+		Emoji:         emoji,
+		Remove:        remove,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// parseGroupLogPath parses the group and behavior log IDs of the path, writing the error if one is invalid
+func parseGroupLogPath(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+	behaviorLogID, err := uuid.Parse(vars["logId"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid behavior log ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+	return groupID, behaviorLogID, true
+}
+
+// parseGroupCommentPath parses the group and comment IDs of the path, writing the error if one is invalid
+func parseGroupCommentPath(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+	commentID, err := uuid.Parse(vars["commentId"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid comment ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+	return groupID, commentID, true
+}
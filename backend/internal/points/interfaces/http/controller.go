@@ -2,9 +2,11 @@ package http
 
 import (
 	"encoding/json"
-	"fmt"
+	stderrors "errors"
+	"mime/multipart"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,23 +14,30 @@ import (
 
 	"pet-of-the-day/internal/points/application/commands"
 	"pet-of-the-day/internal/points/application/queries"
+	"pet-of-the-day/internal/points/domain"
 	"pet-of-the-day/internal/shared/auth"
-	"pet-of-the-day/internal/shared/errors"
 )
 
 // BehaviorController handles HTTP requests for behavior-related operations
 type BehaviorController struct {
 	// Query handlers
-	getBehaviorsHandler      *queries.GetBehaviorsHandler
-	getBehaviorLogsHandler   *queries.GetBehaviorLogsHandler
-	getGroupRankingsHandler  *queries.GetGroupRankingsHandler
-	getPetOfTheDayHandler    *queries.GetPetOfTheDayHandler
-	getDailyScoreHandler     *queries.GetDailyScoreHandler
+	getBehaviorsHandler     *queries.GetBehaviorsHandler
+	getBehaviorLogsHandler  *queries.GetBehaviorLogsHandler
+	getGroupRankingsHandler *queries.GetGroupRankingsHandler
+	getPetOfTheDayHandler   *queries.GetPetOfTheDayHandler
+	getDailyScoreHandler    *queries.GetPetDailyScoreHandler
+	getTrendingPetsHandler  *queries.GetTrendingPetsHandler
+	getPetStreaksHandler    *queries.GetPetStreaksHandler
 
 	// Command handlers
-	createBehaviorLogHandler *commands.CreateBehaviorLogHandler
-	updateBehaviorLogHandler *commands.UpdateBehaviorLogHandler
-	deleteBehaviorLogHandler *commands.DeleteBehaviorLogHandler
+	createBehaviorLogHandler      *commands.CreateBehaviorLogHandler
+	createBehaviorLogBatchHandler *commands.CreateBehaviorLogBatchHandler
+	deleteBehaviorLogHandler      *commands.DeleteBehaviorLogHandler
+
+	// Group behavior catalog handlers
+	createGroupBehaviorHandler *commands.CreateGroupBehaviorHandler
+	updateGroupBehaviorHandler *commands.UpdateGroupBehaviorHandler
+	deleteGroupBehaviorHandler *commands.DeleteGroupBehaviorHandler
 }
 
 // NewBehaviorController creates a new behavior controller
@@ -37,44 +46,61 @@ func NewBehaviorController(
 	getBehaviorLogsHandler *queries.GetBehaviorLogsHandler,
 	getGroupRankingsHandler *queries.GetGroupRankingsHandler,
 	getPetOfTheDayHandler *queries.GetPetOfTheDayHandler,
-	getDailyScoreHandler *queries.GetDailyScoreHandler,
+	getDailyScoreHandler *queries.GetPetDailyScoreHandler,
+	getTrendingPetsHandler *queries.GetTrendingPetsHandler,
+	getPetStreaksHandler *queries.GetPetStreaksHandler,
 	createBehaviorLogHandler *commands.CreateBehaviorLogHandler,
-	updateBehaviorLogHandler *commands.UpdateBehaviorLogHandler,
+	createBehaviorLogBatchHandler *commands.CreateBehaviorLogBatchHandler,
 	deleteBehaviorLogHandler *commands.DeleteBehaviorLogHandler,
+	createGroupBehaviorHandler *commands.CreateGroupBehaviorHandler,
+	updateGroupBehaviorHandler *commands.UpdateGroupBehaviorHandler,
+	deleteGroupBehaviorHandler *commands.DeleteGroupBehaviorHandler,
 ) *BehaviorController {
 	return &BehaviorController{
-		getBehaviorsHandler:      getBehaviorsHandler,
-		getBehaviorLogsHandler:   getBehaviorLogsHandler,
-		getGroupRankingsHandler:  getGroupRankingsHandler,
-		getPetOfTheDayHandler:    getPetOfTheDayHandler,
-		getDailyScoreHandler:     getDailyScoreHandler,
-		createBehaviorLogHandler: createBehaviorLogHandler,
-		updateBehaviorLogHandler: updateBehaviorLogHandler,
-		deleteBehaviorLogHandler: deleteBehaviorLogHandler,
+		getBehaviorsHandler:           getBehaviorsHandler,
+		getBehaviorLogsHandler:        getBehaviorLogsHandler,
+		getGroupRankingsHandler:       getGroupRankingsHandler,
+		getPetOfTheDayHandler:         getPetOfTheDayHandler,
+		getDailyScoreHandler:          getDailyScoreHandler,
+		getTrendingPetsHandler:        getTrendingPetsHandler,
+		getPetStreaksHandler:          getPetStreaksHandler,
+		createBehaviorLogHandler:      createBehaviorLogHandler,
+		createBehaviorLogBatchHandler: createBehaviorLogBatchHandler,
+		deleteBehaviorLogHandler:      deleteBehaviorLogHandler,
+		createGroupBehaviorHandler:    createGroupBehaviorHandler,
+		updateGroupBehaviorHandler:    updateGroupBehaviorHandler,
+		deleteGroupBehaviorHandler:    deleteGroupBehaviorHandler,
 	}
 }
 
 // RegisterRoutes registers all behavior-related HTTP routes
-func (c *BehaviorController) RegisterRoutes(router *mux.Router) {
+func (c *BehaviorController) RegisterRoutes(router *mux.Router, authMiddleware func(http.Handler) http.Handler) {
 	// Public routes (authenticated users)
 	api := router.PathPrefix("/api").Subrouter()
-	api.Use(auth.RequireAuthentication)
+	api.Use(authMiddleware)
 
 	// Behavior catalog routes
 	api.HandleFunc("/behaviors", c.getBehaviors).Methods("GET")
 
+	// Group behavior catalog routes (group admin only)
+	api.HandleFunc("/groups/{id}/behaviors", c.createGroupBehavior).Methods("POST")
+	api.HandleFunc("/groups/{id}/behaviors/{behaviorId}", c.updateGroupBehavior).Methods("PUT")
+	api.HandleFunc("/groups/{id}/behaviors/{behaviorId}", c.deleteGroupBehavior).Methods("DELETE")
+
 	// Behavior log routes
 	api.HandleFunc("/behavior-logs", c.createBehaviorLog).Methods("POST")
+	api.HandleFunc("/behavior-logs/batch", c.createBehaviorLogBatch).Methods("POST")
 	api.HandleFunc("/behavior-logs", c.getBehaviorLogs).Methods("GET")
-	api.HandleFunc("/behavior-logs/{id}", c.updateBehaviorLog).Methods("PUT")
 	api.HandleFunc("/behavior-logs/{id}", c.deleteBehaviorLog).Methods("DELETE")
 
 	// Group ranking routes
 	api.HandleFunc("/groups/{id}/rankings", c.getGroupRankings).Methods("GET")
+	api.HandleFunc("/groups/{id}/trending", c.getTrendingPets).Methods("GET")
 	api.HandleFunc("/groups/{id}/pet-of-the-day", c.getPetOfTheDay).Methods("GET")
 
 	// Pet scoring routes
 	api.HandleFunc("/pets/{id}/daily-score", c.getDailyScore).Methods("GET")
+	api.HandleFunc("/pets/{id}/streaks", c.getPetStreaks).Methods("GET")
 }
 
 // getBehaviors handles GET /api/behaviors
@@ -89,10 +115,28 @@ func (c *BehaviorController) getBehaviors(w http.ResponseWriter, r *http.Request
 		Category: parseCategoryParam(categoryParam),
 	}
 
+	// With a group ID the group's merged catalog is returned
+	if groupIDParam := r.URL.Query().Get("group_id"); groupIDParam != "" {
+		groupID, err := uuid.Parse(groupIDParam)
+		if err != nil {
+			writeInvalidInput(w, "Invalid group ID")
+			return
+		}
+		query.GroupID = &groupID
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+	query.UserID = userID
+
 	// Execute query
 	result, err := c.getBehaviorsHandler.Handle(r.Context(), query)
 	if err != nil {
-		errors.HandleHTTPError(w, err)
+		writeError(w, err)
 		return
 	}
 
@@ -101,7 +145,8 @@ func (c *BehaviorController) getBehaviors(w http.ResponseWriter, r *http.Request
 	json.NewEncoder(w).Encode(result)
 }
 
-// createBehaviorLog handles POST /api/behavior-logs
+// createBehaviorLog handles POST /api/behavior-logs. Photos are sent as a multipart form with
+// the JSON body in a "data" field and the files in "attachments" fields.
 func (c *BehaviorController) createBehaviorLog(w http.ResponseWriter, r *http.Request) {
 	// Parse request body
 	var req struct {
@@ -112,32 +157,52 @@ func (c *BehaviorController) createBehaviorLog(w http.ResponseWriter, r *http.Re
 		GroupIDs   []uuid.UUID `json:"group_ids"`
 	}
 
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		errors.HandleHTTPError(w, errors.NewValidationError("Invalid request body", err))
+	var attachments []*multipart.FileHeader
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		r.Body = http.MaxBytesReader(w, r.Body, maxAttachmentsRequestSize)
+		if err := r.ParseMultipartForm(maxAttachmentsMemory); err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if stderrors.As(err, &maxBytesErr) {
+				writeInvalidInput(w, "Request body too large")
+				return
+			}
+			writeInvalidInput(w, "Invalid multipart form")
+			return
+		}
+		defer r.MultipartForm.RemoveAll()
+
+		if err := json.Unmarshal([]byte(r.FormValue("data")), &req); err != nil {
+			writeInvalidInput(w, "Invalid request body")
+			return
+		}
+		attachments = r.MultipartForm.File["attachments"]
+	} else if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeInvalidInput(w, "Invalid request body")
 		return
 	}
 
 	// Get user ID from context
 	userID, err := auth.GetUserIDFromContext(r.Context())
 	if err != nil {
-		errors.HandleHTTPError(w, errors.NewUnauthorizedError("User not authenticated"))
+		writeUnauthorized(w, "User not authenticated")
 		return
 	}
 
 	// Create command
 	cmd := &commands.CreateBehaviorLogCommand{
-		PetID:      req.PetID,
-		BehaviorID: req.BehaviorID,
-		UserID:     userID,
-		LoggedAt:   req.LoggedAt,
-		Notes:      req.Notes,
-		GroupIDs:   req.GroupIDs,
+		PetID:       req.PetID,
+		BehaviorID:  req.BehaviorID,
+		UserID:      userID,
+		LoggedAt:    req.LoggedAt,
+		Notes:       req.Notes,
+		GroupIDs:    req.GroupIDs,
+		Attachments: attachments,
 	}
 
 	// Execute command
 	result, err := c.createBehaviorLogHandler.Handle(r.Context(), cmd)
 	if err != nil {
-		errors.HandleHTTPError(w, err)
+		writeError(w, err)
 		return
 	}
 
@@ -147,6 +212,46 @@ func (c *BehaviorController) createBehaviorLog(w http.ResponseWriter, r *http.Re
 	json.NewEncoder(w).Encode(result)
 }
 
+// createBehaviorLogBatch handles POST /api/behavior-logs/batch. Each item is logged or rejected
+// on its own: the response is 201 when every item was logged, 207 otherwise.
+func (c *BehaviorController) createBehaviorLogBatch(w http.ResponseWriter, r *http.Request) {
+	// Parse request body
+	var req struct {
+		Items []commands.CreateBehaviorLogBatchItem `json:"items"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeInvalidInput(w, "Invalid request body")
+		return
+	}
+
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute command
+	result, err := c.createBehaviorLogBatchHandler.Handle(r.Context(), &commands.CreateBehaviorLogBatchCommand{
+		UserID: userID,
+		Items:  req.Items,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	status := http.StatusCreated
+	if result.Failed > 0 {
+		status = http.StatusMultiStatus
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(result)
+}
+
 // getBehaviorLogs handles GET /api/behavior-logs
 func (c *BehaviorController) getBehaviorLogs(w http.ResponseWriter, r *http.Request) {
 	// Parse query parameters
@@ -154,8 +259,8 @@ func (c *BehaviorController) getBehaviorLogs(w http.ResponseWriter, r *http.Requ
 		PetID:      parseUUIDParam(r.URL.Query().Get("pet_id")),
 		BehaviorID: parseUUIDParam(r.URL.Query().Get("behavior_id")),
 		GroupID:    parseUUIDParam(r.URL.Query().Get("group_id")),
-		DateFrom:   parseTimeParam(r.URL.Query().Get("date_from")),
-		DateTo:     parseTimeParam(r.URL.Query().Get("date_to")),
+		DateFrom:   parseStringParam(r.URL.Query().Get("date_from")),
+		DateTo:     parseStringParam(r.URL.Query().Get("date_to")),
 		Limit:      parseIntParam(r.URL.Query().Get("limit"), 50),
 		Offset:     parseIntParam(r.URL.Query().Get("offset"), 0),
 	}
@@ -163,7 +268,7 @@ func (c *BehaviorController) getBehaviorLogs(w http.ResponseWriter, r *http.Requ
 	// Get user ID from context for authorization
 	userID, err := auth.GetUserIDFromContext(r.Context())
 	if err != nil {
-		errors.HandleHTTPError(w, errors.NewUnauthorizedError("User not authenticated"))
+		writeUnauthorized(w, "User not authenticated")
 		return
 	}
 	query.UserID = userID
@@ -171,7 +276,7 @@ func (c *BehaviorController) getBehaviorLogs(w http.ResponseWriter, r *http.Requ
 	// Execute query
 	result, err := c.getBehaviorLogsHandler.Handle(r.Context(), query)
 	if err != nil {
-		errors.HandleHTTPError(w, err)
+		writeError(w, err)
 		return
 	}
 
@@ -180,46 +285,115 @@ func (c *BehaviorController) getBehaviorLogs(w http.ResponseWriter, r *http.Requ
 	json.NewEncoder(w).Encode(result)
 }
 
-// updateBehaviorLog handles PUT /api/behavior-logs/{id}
-func (c *BehaviorController) updateBehaviorLog(w http.ResponseWriter, r *http.Request) {
+// deleteBehaviorLog handles DELETE /api/behavior-logs/{id}
+func (c *BehaviorController) deleteBehaviorLog(w http.ResponseWriter, r *http.Request) {
 	// Parse path parameter
 	vars := mux.Vars(r)
 	id, err := uuid.Parse(vars["id"])
 	if err != nil {
-		errors.HandleHTTPError(w, errors.NewValidationError("Invalid behavior log ID", err))
+		writeInvalidInput(w, "Invalid behavior log ID")
 		return
 	}
 
-	// Parse request body
-	var req struct {
-		Notes    string      `json:"notes"`
-		GroupIDs []uuid.UUID `json:"group_ids"`
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
 	}
 
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		errors.HandleHTTPError(w, errors.NewValidationError("Invalid request body", err))
+	// Create command
+	cmd := &commands.DeleteBehaviorLogCommand{
+		BehaviorLogID: id,
+		UserID:        userID,
+	}
+
+	// Execute command
+	if _, err := c.deleteBehaviorLogHandler.Handle(r.Context(), cmd); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return success response
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// createGroupBehavior handles POST /api/groups/{id}/behaviors
+func (c *BehaviorController) createGroupBehavior(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	// Parse request body
+	var cmd commands.CreateGroupBehaviorCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		writeInvalidInput(w, "Invalid request body")
 		return
 	}
 
 	// Get user ID from context
 	userID, err := auth.GetUserIDFromContext(r.Context())
 	if err != nil {
-		errors.HandleHTTPError(w, errors.NewUnauthorizedError("User not authenticated"))
+		writeUnauthorized(w, "User not authenticated")
 		return
 	}
+	cmd.GroupID = groupID
+	cmd.UserID = userID
 
-	// Create command
-	cmd := &commands.UpdateBehaviorLogCommand{
-		ID:       id,
-		UserID:   userID,
-		Notes:    req.Notes,
-		GroupIDs: req.GroupIDs,
+	// Execute command
+	result, err := c.createGroupBehaviorHandler.Handle(r.Context(), &cmd)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(result)
+}
+
+// updateGroupBehavior handles PUT /api/groups/{id}/behaviors/{behaviorId}
+func (c *BehaviorController) updateGroupBehavior(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameters
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	behaviorID, err := uuid.Parse(vars["behaviorId"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid behavior ID")
+		return
+	}
+
+	// Parse request body
+	var cmd commands.UpdateGroupBehaviorCommand
+	if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+		writeInvalidInput(w, "Invalid request body")
+		return
+	}
+
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
 	}
+	cmd.GroupID = groupID
+	cmd.BehaviorID = behaviorID
+	cmd.UserID = userID
 
 	// Execute command
-	result, err := c.updateBehaviorLogHandler.Handle(r.Context(), cmd)
+	result, err := c.updateGroupBehaviorHandler.Handle(r.Context(), &cmd)
 	if err != nil {
-		errors.HandleHTTPError(w, err)
+		writeError(w, err)
 		return
 	}
 
@@ -228,33 +402,39 @@ func (c *BehaviorController) updateBehaviorLog(w http.ResponseWriter, r *http.Re
 	json.NewEncoder(w).Encode(result)
 }
 
-// deleteBehaviorLog handles DELETE /api/behavior-logs/{id}
-func (c *BehaviorController) deleteBehaviorLog(w http.ResponseWriter, r *http.Request) {
-	// Parse path parameter
+// deleteGroupBehavior handles DELETE /api/groups/{id}/behaviors/{behaviorId}
+func (c *BehaviorController) deleteGroupBehavior(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameters
 	vars := mux.Vars(r)
-	id, err := uuid.Parse(vars["id"])
+	groupID, err := uuid.Parse(vars["id"])
 	if err != nil {
-		errors.HandleHTTPError(w, errors.NewValidationError("Invalid behavior log ID", err))
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	behaviorID, err := uuid.Parse(vars["behaviorId"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid behavior ID")
 		return
 	}
 
 	// Get user ID from context
 	userID, err := auth.GetUserIDFromContext(r.Context())
 	if err != nil {
-		errors.HandleHTTPError(w, errors.NewUnauthorizedError("User not authenticated"))
+		writeUnauthorized(w, "User not authenticated")
 		return
 	}
 
 	// Create command
-	cmd := &commands.DeleteBehaviorLogCommand{
-		ID:     id,
-		UserID: userID,
+	cmd := &commands.DeleteGroupBehaviorCommand{
+		GroupID:    groupID,
+		BehaviorID: behaviorID,
+		UserID:     userID,
 	}
 
 	// Execute command
-	err = c.deleteBehaviorLogHandler.Handle(r.Context(), cmd)
-	if err != nil {
-		errors.HandleHTTPError(w, err)
+	if _, err := c.deleteGroupBehaviorHandler.Handle(r.Context(), cmd); err != nil {
+		writeError(w, err)
 		return
 	}
 
@@ -268,41 +448,87 @@ func (c *BehaviorController) getGroupRankings(w http.ResponseWriter, r *http.Req
 	vars := mux.Vars(r)
 	groupID, err := uuid.Parse(vars["id"])
 	if err != nil {
-		errors.HandleHTTPError(w, errors.NewValidationError("Invalid group ID", err))
+		writeInvalidInput(w, "Invalid group ID")
 		return
 	}
 
-	// Parse query parameters
-	dateParam := r.URL.Query().Get("date")
-	var queryDate time.Time
-	if dateParam != "" {
-		queryDate, err = time.Parse("2006-01-02", dateParam)
-		if err != nil {
-			errors.HandleHTTPError(w, errors.NewValidationError("Invalid date format (expected YYYY-MM-DD)", err))
+	query := &queries.GetGroupRankingsQuery{
+		GroupID: groupID,
+	}
+
+	// Parse query parameters (period: day, week, month, custom or all_time). Without a period
+	// the query ranks a day, or the custom range of date_from and date_to.
+	if value := r.URL.Query().Get("period"); value != "" {
+		if query.Period, err = domain.ParseRankingPeriod(value); err != nil {
+			writeInvalidInput(w, "Invalid period (expected day, week, month, custom or all_time)")
 			return
 		}
-	} else {
-		queryDate = time.Now().UTC()
 	}
 
+	if query.Date, err = parseDateParam(r.URL.Query().Get("date")); err != nil {
+		writeInvalidInput(w, "Invalid date format (expected YYYY-MM-DD)")
+		return
+	}
+	if query.DateFrom, err = parseDateParam(r.URL.Query().Get("date_from")); err != nil {
+		writeInvalidInput(w, "Invalid date_from format (expected YYYY-MM-DD)")
+		return
+	}
+	if query.DateTo, err = parseDateParam(r.URL.Query().Get("date_to")); err != nil {
+		writeInvalidInput(w, "Invalid date_to format (expected YYYY-MM-DD)")
+		return
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+	query.UserID = userID
+
+	// Execute query
+	result, err := c.getGroupRankingsHandler.Handle(r.Context(), query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// getTrendingPets handles GET /api/groups/{id}/trending
+func (c *BehaviorController) getTrendingPets(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	// Parse query parameters (recent window in days, default one week, bounded by the ranking service)
+	days := parseIntParam(r.URL.Query().Get("days"), 7)
+
 	// Get user ID from context for authorization
 	userID, err := auth.GetUserIDFromContext(r.Context())
 	if err != nil {
-		errors.HandleHTTPError(w, errors.NewUnauthorizedError("User not authenticated"))
+		writeUnauthorized(w, "User not authenticated")
 		return
 	}
 
 	// Create query
-	query := &queries.GetGroupRankingsQuery{
+	query := &queries.GetTrendingPetsQuery{
 		GroupID: groupID,
-		Date:    queryDate,
+		Days:    days,
 		UserID:  userID,
 	}
 
 	// Execute query
-	result, err := c.getGroupRankingsHandler.Handle(r.Context(), query)
+	result, err := c.getTrendingPetsHandler.Handle(r.Context(), query)
 	if err != nil {
-		errors.HandleHTTPError(w, err)
+		writeError(w, err)
 		return
 	}
 
@@ -317,28 +543,21 @@ func (c *BehaviorController) getPetOfTheDay(w http.ResponseWriter, r *http.Reque
 	vars := mux.Vars(r)
 	groupID, err := uuid.Parse(vars["id"])
 	if err != nil {
-		errors.HandleHTTPError(w, errors.NewValidationError("Invalid group ID", err))
+		writeInvalidInput(w, "Invalid group ID")
 		return
 	}
 
-	// Parse query parameters
-	dateParam := r.URL.Query().Get("date")
-	var queryDate time.Time
-	if dateParam != "" {
-		queryDate, err = time.Parse("2006-01-02", dateParam)
-		if err != nil {
-			errors.HandleHTTPError(w, errors.NewValidationError("Invalid date format (expected YYYY-MM-DD)", err))
-			return
-		}
-	} else {
-		// Default to yesterday (Pet of the Day is awarded for previous day)
-		queryDate = time.Now().UTC().AddDate(0, 0, -1)
+	// The date defaults to the last closed day of the group
+	queryDate, err := parseDateParam(r.URL.Query().Get("date"))
+	if err != nil {
+		writeInvalidInput(w, "Invalid date format (expected YYYY-MM-DD)")
+		return
 	}
 
 	// Get user ID from context for authorization
 	userID, err := auth.GetUserIDFromContext(r.Context())
 	if err != nil {
-		errors.HandleHTTPError(w, errors.NewUnauthorizedError("User not authenticated"))
+		writeUnauthorized(w, "User not authenticated")
 		return
 	}
 
@@ -352,7 +571,7 @@ func (c *BehaviorController) getPetOfTheDay(w http.ResponseWriter, r *http.Reque
 	// Execute query
 	result, err := c.getPetOfTheDayHandler.Handle(r.Context(), query)
 	if err != nil {
-		errors.HandleHTTPError(w, err)
+		writeError(w, err)
 		return
 	}
 
@@ -367,54 +586,67 @@ func (c *BehaviorController) getDailyScore(w http.ResponseWriter, r *http.Reques
 	vars := mux.Vars(r)
 	petID, err := uuid.Parse(vars["id"])
 	if err != nil {
-		errors.HandleHTTPError(w, errors.NewValidationError("Invalid pet ID", err))
+		writeInvalidInput(w, "Invalid pet ID")
 		return
 	}
 
-	// Parse query parameters
-	groupIDParam := r.URL.Query().Get("group_id")
-	if groupIDParam == "" {
-		errors.HandleHTTPError(w, errors.NewValidationError("group_id parameter is required", nil))
+	// The date defaults to the current day of the user
+	date, err := parseDateParam(r.URL.Query().Get("date"))
+	if err != nil {
+		writeInvalidInput(w, "Invalid date format (expected YYYY-MM-DD)")
 		return
 	}
 
-	groupID, err := uuid.Parse(groupIDParam)
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
 	if err != nil {
-		errors.HandleHTTPError(w, errors.NewValidationError("Invalid group ID", err))
+		writeUnauthorized(w, "User not authenticated")
 		return
 	}
 
-	dateParam := r.URL.Query().Get("date")
-	var queryDate time.Time
-	if dateParam != "" {
-		queryDate, err = time.Parse("2006-01-02", dateParam)
-		if err != nil {
-			errors.HandleHTTPError(w, errors.NewValidationError("Invalid date format (expected YYYY-MM-DD)", err))
-			return
-		}
-	} else {
-		queryDate = time.Now().UTC()
+	// Create query
+	query := &queries.GetPetDailyScoreQuery{
+		PetID:  petID,
+		Date:   date,
+		UserID: userID,
 	}
 
-	// Get user ID from context for authorization
-	userID, err := auth.GetUserIDFromContext(r.Context())
+	// Execute query
+	result, err := c.getDailyScoreHandler.Handle(r.Context(), query)
 	if err != nil {
-		errors.HandleHTTPError(w, errors.NewUnauthorizedError("User not authenticated"))
+		writeError(w, err)
 		return
 	}
 
-	// Create query
-	query := &queries.GetDailyScoreQuery{
-		PetID:   petID,
-		GroupID: groupID,
-		Date:    queryDate,
-		UserID:  userID,
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// getPetStreaks handles GET /api/pets/{id}/streaks
+func (c *BehaviorController) getPetStreaks(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	petID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid pet ID")
+		return
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
 	}
 
 	// Execute query
-	result, err := c.getDailyScoreHandler.Handle(r.Context(), query)
+	result, err := c.getPetStreaksHandler.Handle(r.Context(), &queries.GetPetStreaksQuery{
+		PetID:  petID,
+		UserID: userID,
+	})
 	if err != nil {
-		errors.HandleHTTPError(w, err)
+		writeError(w, err)
 		return
 	}
 
@@ -435,17 +667,23 @@ func parseUUIDParam(param string) *uuid.UUID {
 	return nil
 }
 
-func parseTimeParam(param string) *time.Time {
+func parseStringParam(param string) *string {
 	if param == "" {
 		return nil
 	}
-	if t, err := time.Parse("2006-01-02", param); err == nil {
-		return &t
+	return &param
+}
+
+// parseDateParam parses an optional YYYY-MM-DD parameter
+func parseDateParam(param string) (*time.Time, error) {
+	if param == "" {
+		return nil, nil
 	}
-	if t, err := time.Parse(time.RFC3339, param); err == nil {
-		return &t
+	date, err := time.Parse("2006-01-02", param)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return &date, nil
 }
 
 func parseIntParam(param string, defaultValue int) int {
@@ -458,16 +696,18 @@ func parseIntParam(param string, defaultValue int) int {
 	return defaultValue
 }
 
-func parseSpeciesParam(param string) *string {
+func parseSpeciesParam(param string) *domain.Species {
 	if param == "" {
 		return nil
 	}
-	return &param
+	species := domain.Species(param)
+	return &species
 }
 
-func parseCategoryParam(param string) *string {
+func parseCategoryParam(param string) *domain.BehaviorCategory {
 	if param == "" {
 		return nil
 	}
-	return &param
+	category := domain.BehaviorCategory(param)
+	return &category
 }
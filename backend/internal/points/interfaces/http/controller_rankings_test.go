@@ -0,0 +1,107 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"pet-of-the-day/internal/points/application/queries"
+	"pet-of-the-day/internal/points/application/services"
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/points/infrastructure/mock"
+	"pet-of-the-day/internal/shared/auth"
+	"pet-of-the-day/internal/shared/events"
+)
+
+func TestBehaviorController_GetGroupRankings(t *testing.T) {
+	ctx := context.Background()
+
+	dailyScoreRepo := mock.NewMockDailyScoreRepository()
+	authRepo := mock.NewMockAuthorizationRepository()
+	userSettingsRepo := mock.NewMockUserSettingsRepository()
+	scoringRulesRepo := mock.NewMockScoringRulesRepository()
+	rankingService := services.NewRankingService(
+		dailyScoreRepo, mock.NewMockRankingReadModelRepository(dailyScoreRepo, authRepo), mock.NewMockBehaviorLogRepository(), mock.NewMockPetOfTheDayRepository(), authRepo, userSettingsRepo,
+		mock.NewMockDailyResetStateRepository(), scoringRulesRepo, events.NewInMemoryBus(),
+	)
+	controller := NewBehaviorController(
+		nil, nil,
+		queries.NewGetGroupRankingsHandler(dailyScoreRepo, authRepo, userSettingsRepo, scoringRulesRepo, rankingService),
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+	)
+
+	userID, groupID, rex := uuid.New(), uuid.New(), uuid.New()
+	authRepo.AddUserGroup(userID, groupID, &domain.GroupInfo{ID: groupID, Name: "Park friends", OwnerID: userID})
+	authRepo.AddUserPet(userID, rex, &domain.PetInfo{ID: rex, Name: "Rex", Species: domain.SpeciesDog, OwnerID: userID})
+	for _, day := range []int{2, 5} {
+		date := time.Date(2026, time.March, day, 0, 0, 0, 0, time.UTC)
+		dailyScore, _ := dailyScoreRepo.GetOrCreate(ctx, rex, groupID, date)
+		dailyScore.AddBehaviorLog(&domain.BehaviorLog{PetID: rex, PointsAwarded: 3, LoggedAt: date})
+	}
+
+	getRankings := func(requester uuid.UUID, params string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/api/groups/"+groupID.String()+"/rankings?"+params, nil)
+		req = mux.SetURLVars(req, map[string]string{"id": groupID.String()})
+		req = req.WithContext(context.WithValue(req.Context(), auth.UserIDKey, requester))
+		w := httptest.NewRecorder()
+		controller.getGroupRankings(w, req)
+		return w
+	}
+
+	t.Run("Date range without period is a custom ranking", func(t *testing.T) {
+		w := getRankings(userID, "date_from=2026-03-01&date_to=2026-03-07")
+		if w.Code != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+
+		var result queries.GetGroupRankingsResult
+		if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if result.Period != domain.RankingPeriodCustom || result.DateFrom != "2026-03-01" || result.DateTo != "2026-03-07" {
+			t.Errorf("Expected a custom ranking of March 1 to 7, got %s %s..%s", result.Period, result.DateFrom, result.DateTo)
+		}
+		if len(result.Rankings) != 1 || result.Rankings[0].TotalPoints != 6 {
+			t.Errorf("Expected Rex with 6 points, got %+v", result.Rankings)
+		}
+	})
+
+	t.Run("Date without period is a single day", func(t *testing.T) {
+		w := getRankings(userID, "date=2026-03-05")
+		if w.Code != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+
+		var result queries.GetGroupRankingsResult
+		if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if result.Period != domain.RankingPeriodDay || result.DateFrom != "2026-03-05" || result.DateTo != "2026-03-05" {
+			t.Errorf("Expected the ranking of March 5, got %s %s..%s", result.Period, result.DateFrom, result.DateTo)
+		}
+	})
+
+	t.Run("Invalid period", func(t *testing.T) {
+		if w := getRankings(userID, "period=weekly"); w.Code != http.StatusBadRequest {
+			t.Errorf("Expected status 400, got %d", w.Code)
+		}
+	})
+
+	t.Run("Inverted range", func(t *testing.T) {
+		if w := getRankings(userID, "date_from=2026-03-07&date_to=2026-03-01"); w.Code != http.StatusBadRequest {
+			t.Errorf("Expected status 400, got %d", w.Code)
+		}
+	})
+
+	t.Run("Users outside the group", func(t *testing.T) {
+		if w := getRankings(uuid.New(), "date=2026-03-05"); w.Code != http.StatusForbidden {
+			t.Errorf("Expected status 403, got %d", w.Code)
+		}
+	})
+}
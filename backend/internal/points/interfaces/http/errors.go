@@ -0,0 +1,41 @@
+package http
+
+import (
+	stderrors "errors"
+	"net/http"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/errors"
+)
+
+// writeError writes the response for an error returned by a command or query handler
+func writeError(w http.ResponseWriter, err error) {
+	var notFoundErr *commands.NotFoundError
+	var authorizationErr *commands.AuthorizationError
+	var validationErr *domain.ValidationError
+	var intervalErr *commands.IntervalError
+
+	switch {
+	case stderrors.As(err, &notFoundErr):
+		errors.WriteErrorResponse(w, errors.ErrCodeNotFound, notFoundErr.Error(), http.StatusNotFound)
+	case stderrors.As(err, &authorizationErr):
+		errors.WriteErrorResponse(w, errors.ErrCodeForbidden, authorizationErr.Error(), http.StatusForbidden)
+	case stderrors.As(err, &validationErr):
+		errors.WriteErrorResponse(w, errors.ErrCodeValidationFailed, validationErr.Error(), http.StatusBadRequest)
+	case stderrors.As(err, &intervalErr):
+		errors.WriteErrorResponse(w, errors.ErrCodeRateLimited, intervalErr.Error(), http.StatusTooManyRequests)
+	default:
+		errors.WriteErrorResponse(w, errors.ErrCodeInternalServer, "Internal server error", http.StatusInternalServerError)
+	}
+}
+
+// writeInvalidInput writes a bad request response for a malformed parameter or body
+func writeInvalidInput(w http.ResponseWriter, message string) {
+	errors.WriteErrorResponse(w, errors.ErrCodeInvalidInput, message, http.StatusBadRequest)
+}
+
+// writeUnauthorized writes the response for a request without an authenticated user
+func writeUnauthorized(w http.ResponseWriter, message string) {
+	errors.WriteErrorResponse(w, errors.ErrCodeUnauthorized, message, http.StatusUnauthorized)
+}
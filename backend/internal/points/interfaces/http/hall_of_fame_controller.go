@@ -0,0 +1,182 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"pet-of-the-day/internal/points/application/queries"
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/auth"
+)
+
+// HallOfFameController handles HTTP requests for the Pet of the Day history
+type HallOfFameController struct {
+	getPetOfTheDayHistoryHandler *queries.GetPetOfTheDayHistoryHandler
+	getHallOfFameHandler         *queries.GetHallOfFameHandler
+	getPetTrophiesHandler        *queries.GetPetTrophiesHandler
+	getLatestWinnersHandler      *queries.GetLatestWinnersHandler
+}
+
+// NewHallOfFameController creates a new hall of fame controller
+func NewHallOfFameController(
+	getPetOfTheDayHistoryHandler *queries.GetPetOfTheDayHistoryHandler,
+	getHallOfFameHandler *queries.GetHallOfFameHandler,
+	getPetTrophiesHandler *queries.GetPetTrophiesHandler,
+	getLatestWinnersHandler *queries.GetLatestWinnersHandler,
+) *HallOfFameController {
+	return &HallOfFameController{
+		getPetOfTheDayHistoryHandler: getPetOfTheDayHistoryHandler,
+		getHallOfFameHandler:         getHallOfFameHandler,
+		getPetTrophiesHandler:        getPetTrophiesHandler,
+		getLatestWinnersHandler:      getLatestWinnersHandler,
+	}
+}
+
+// RegisterRoutes registers the hall of fame routes
+func (c *HallOfFameController) RegisterRoutes(router *mux.Router, authMiddleware func(http.Handler) http.Handler) {
+	api := router.PathPrefix("/api").Subrouter()
+	api.Use(authMiddleware)
+
+	api.HandleFunc("/groups/{id}/pet-of-the-day/history", c.getHistory).Methods("GET")
+	api.HandleFunc("/groups/{id}/hall-of-fame", c.getHallOfFame).Methods("GET")
+	api.HandleFunc("/pets/{id}/trophies", c.getPetTrophies).Methods("GET")
+	api.HandleFunc("/pet-of-the-day/latest", c.getLatestWinners).Methods("GET")
+}
+
+// getHistory handles GET /api/groups/{id}/pet-of-the-day/history
+func (c *HallOfFameController) getHistory(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Parse query parameters
+	query := &queries.GetPetOfTheDayHistoryQuery{
+		GroupID: groupID,
+		UserID:  userID,
+		Limit:   parseIntParam(r.URL.Query().Get("limit"), 31),
+		Offset:  parseIntParam(r.URL.Query().Get("offset"), 0),
+	}
+	if query.From, err = parseDateParam(r.URL.Query().Get("from")); err != nil {
+		writeInvalidInput(w, "Invalid from format (expected YYYY-MM-DD)")
+		return
+	}
+	if query.To, err = parseDateParam(r.URL.Query().Get("to")); err != nil {
+		writeInvalidInput(w, "Invalid to format (expected YYYY-MM-DD)")
+		return
+	}
+
+	// Execute query
+	result, err := c.getPetOfTheDayHistoryHandler.Handle(r.Context(), query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// getHallOfFame handles GET /api/groups/{id}/hall-of-fame
+func (c *HallOfFameController) getHallOfFame(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute query
+	result, err := c.getHallOfFameHandler.Handle(r.Context(), &queries.GetHallOfFameQuery{
+		GroupID: groupID,
+		UserID:  userID,
+		Period:  domain.HistoryPeriod(r.URL.Query().Get("period")),
+		Limit:   parseIntParam(r.URL.Query().Get("limit"), 10),
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// getPetTrophies handles GET /api/pets/{id}/trophies
+func (c *HallOfFameController) getPetTrophies(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	petID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid pet ID")
+		return
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute query
+	result, err := c.getPetTrophiesHandler.Handle(r.Context(), &queries.GetPetTrophiesQuery{
+		PetID:  petID,
+		UserID: userID,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// getLatestWinners handles GET /api/pet-of-the-day/latest
+func (c *HallOfFameController) getLatestWinners(w http.ResponseWriter, r *http.Request) {
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute query
+	result, err := c.getLatestWinnersHandler.Handle(r.Context(), &queries.GetLatestWinnersQuery{
+		UserID: userID,
+		Limit:  parseIntParam(r.URL.Query().Get("limit"), 20),
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
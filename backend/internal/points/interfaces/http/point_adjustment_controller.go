@@ -0,0 +1,201 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/application/queries"
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/auth"
+)
+
+// PointAdjustmentController handles HTTP requests for the point ledger of groups
+type PointAdjustmentController struct {
+	getPointAdjustmentsHandler    *queries.GetPointAdjustmentsHandler
+	adjustPointsHandler           *commands.AdjustPointsHandler
+	reversePointAdjustmentHandler *commands.ReversePointAdjustmentHandler
+}
+
+// NewPointAdjustmentController creates a new point adjustment controller
+func NewPointAdjustmentController(
+	getPointAdjustmentsHandler *queries.GetPointAdjustmentsHandler,
+	adjustPointsHandler *commands.AdjustPointsHandler,
+	reversePointAdjustmentHandler *commands.ReversePointAdjustmentHandler,
+) *PointAdjustmentController {
+	return &PointAdjustmentController{
+		getPointAdjustmentsHandler:    getPointAdjustmentsHandler,
+		adjustPointsHandler:           adjustPointsHandler,
+		reversePointAdjustmentHandler: reversePointAdjustmentHandler,
+	}
+}
+
+// adjustPointsRequest is the body of POST /api/groups/{id}/point-adjustments, date as YYYY-MM-DD
+type adjustPointsRequest struct {
+	PetID  uuid.UUID                  `json:"pet_id"`
+	Points int                        `json:"points"`
+	Kind   domain.PointAdjustmentKind `json:"kind"`
+	Reason string                     `json:"reason"`
+	Date   string                     `json:"date,omitempty"`
+}
+
+// reversePointAdjustmentRequest is the body of POST /api/groups/{id}/point-adjustments/{adjustmentId}/reverse
+type reversePointAdjustmentRequest struct {
+	Reason string `json:"reason"`
+}
+
+// RegisterRoutes registers the point adjustment routes
+func (c *PointAdjustmentController) RegisterRoutes(router *mux.Router, authMiddleware func(http.Handler) http.Handler) {
+	api := router.PathPrefix("/api").Subrouter()
+	api.Use(authMiddleware)
+
+	api.HandleFunc("/groups/{id}/point-adjustments", c.getPointAdjustments).Methods("GET")
+	api.HandleFunc("/groups/{id}/point-adjustments", c.adjustPoints).Methods("POST")
+	api.HandleFunc("/groups/{id}/point-adjustments/{adjustmentId}/reverse", c.reversePointAdjustment).Methods("POST")
+}
+
+// getPointAdjustments handles GET /api/groups/{id}/point-adjustments
+func (c *PointAdjustmentController) getPointAdjustments(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	query := &queries.GetPointAdjustmentsQuery{
+		GroupID: groupID,
+		UserID:  userID,
+		PetID:   parseUUIDParam(r.URL.Query().Get("pet_id")),
+		Limit:   parseIntParam(r.URL.Query().Get("limit"), 50),
+		Offset:  parseIntParam(r.URL.Query().Get("offset"), 0),
+	}
+	if query.DateFrom, err = parseDateParam(r.URL.Query().Get("date_from")); err != nil {
+		writeInvalidInput(w, "Invalid date_from format, expected YYYY-MM-DD")
+		return
+	}
+	if query.DateTo, err = parseDateParam(r.URL.Query().Get("date_to")); err != nil {
+		writeInvalidInput(w, "Invalid date_to format, expected YYYY-MM-DD")
+		return
+	}
+
+	// Execute query
+	result, err := c.getPointAdjustmentsHandler.Handle(r.Context(), query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// adjustPoints handles POST /api/groups/{id}/point-adjustments
+func (c *PointAdjustmentController) adjustPoints(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	// Parse request body
+	var req adjustPointsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeInvalidInput(w, "Invalid request body")
+		return
+	}
+
+	date, err := parseDateParam(req.Date)
+	if err != nil {
+		writeInvalidInput(w, "Invalid date format, expected YYYY-MM-DD")
+		return
+	}
+
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute command
+	result, err := c.adjustPointsHandler.Handle(r.Context(), &commands.AdjustPointsCommand{
+		GroupID: groupID,
+		PetID:   req.PetID,
+		UserID:  userID,
+		Points:  req.Points,
+		Kind:    req.Kind,
+		Reason:  req.Reason,
+		Date:    date,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(result)
+}
+
+// reversePointAdjustment handles POST /api/groups/{id}/point-adjustments/{adjustmentId}/reverse
+func (c *PointAdjustmentController) reversePointAdjustment(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameters
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+	adjustmentID, err := uuid.Parse(vars["adjustmentId"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid adjustment ID")
+		return
+	}
+
+	// Parse request body
+	var req reversePointAdjustmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeInvalidInput(w, "Invalid request body")
+		return
+	}
+
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute command
+	result, err := c.reversePointAdjustmentHandler.Handle(r.Context(), &commands.ReversePointAdjustmentCommand{
+		GroupID:      groupID,
+		AdjustmentID: adjustmentID,
+		UserID:       userID,
+		Reason:       req.Reason,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(result)
+}
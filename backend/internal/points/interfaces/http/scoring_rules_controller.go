@@ -0,0 +1,125 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/application/queries"
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/auth"
+)
+
+// ScoringRulesController handles HTTP requests for the ranking rules of groups
+type ScoringRulesController struct {
+	getScoringRulesHandler    *queries.GetScoringRulesHandler
+	updateScoringRulesHandler *commands.UpdateScoringRulesHandler
+}
+
+// NewScoringRulesController creates a new scoring rules controller
+func NewScoringRulesController(
+	getScoringRulesHandler *queries.GetScoringRulesHandler,
+	updateScoringRulesHandler *commands.UpdateScoringRulesHandler,
+) *ScoringRulesController {
+	return &ScoringRulesController{
+		getScoringRulesHandler:    getScoringRulesHandler,
+		updateScoringRulesHandler: updateScoringRulesHandler,
+	}
+}
+
+// updateScoringRulesRequest is the body of PUT /api/groups/{id}/scoring-rules
+type updateScoringRulesRequest struct {
+	Mode             domain.ScoringMode  `json:"mode"`
+	CategoryCap      int                 `json:"category_cap"`
+	DiminishingAfter int                 `json:"diminishing_after"`
+	TieBreakers      []domain.TieBreaker `json:"tie_breakers"`
+	SharedTitles     bool                `json:"shared_titles"`
+}
+
+// RegisterRoutes registers the scoring rules routes
+func (c *ScoringRulesController) RegisterRoutes(router *mux.Router, authMiddleware func(http.Handler) http.Handler) {
+	api := router.PathPrefix("/api").Subrouter()
+	api.Use(authMiddleware)
+
+	api.HandleFunc("/groups/{id}/scoring-rules", c.getScoringRules).Methods("GET")
+	api.HandleFunc("/groups/{id}/scoring-rules", c.updateScoringRules).Methods("PUT")
+}
+
+// getScoringRules handles GET /api/groups/{id}/scoring-rules
+func (c *ScoringRulesController) getScoringRules(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute query
+	rules, err := c.getScoringRulesHandler.Handle(r.Context(), &queries.GetScoringRulesQuery{
+		GroupID: groupID,
+		UserID:  userID,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(rules)
+}
+
+// updateScoringRules handles PUT /api/groups/{id}/scoring-rules
+func (c *ScoringRulesController) updateScoringRules(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	// Parse request body
+	var req updateScoringRulesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeInvalidInput(w, "Invalid request body")
+		return
+	}
+
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute command
+	result, err := c.updateScoringRulesHandler.Handle(r.Context(), &commands.UpdateScoringRulesCommand{
+		GroupID:          groupID,
+		UserID:           userID,
+		Mode:             req.Mode,
+		CategoryCap:      req.CategoryCap,
+		DiminishingAfter: req.DiminishingAfter,
+		TieBreakers:      req.TieBreakers,
+		SharedTitles:     req.SharedTitles,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
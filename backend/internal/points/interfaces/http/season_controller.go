@@ -0,0 +1,192 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/application/queries"
+	"pet-of-the-day/internal/shared/auth"
+)
+
+// SeasonController handles HTTP requests for group seasons and league standings
+type SeasonController struct {
+	getGroupSeasonsHandler    *queries.GetGroupSeasonsHandler
+	getSeasonStandingsHandler *queries.GetSeasonStandingsHandler
+	createSeasonHandler       *commands.CreateSeasonHandler
+}
+
+// NewSeasonController creates a new season controller
+func NewSeasonController(
+	getGroupSeasonsHandler *queries.GetGroupSeasonsHandler,
+	getSeasonStandingsHandler *queries.GetSeasonStandingsHandler,
+	createSeasonHandler *commands.CreateSeasonHandler,
+) *SeasonController {
+	return &SeasonController{
+		getGroupSeasonsHandler:    getGroupSeasonsHandler,
+		getSeasonStandingsHandler: getSeasonStandingsHandler,
+		createSeasonHandler:       createSeasonHandler,
+	}
+}
+
+// createSeasonRequest is the body of POST /api/groups/{id}/seasons, dates as YYYY-MM-DD
+type createSeasonRequest struct {
+	Name            string `json:"name"`
+	StartDate       string `json:"start_date"`
+	EndDate         string `json:"end_date"`
+	PromotionSlots  *int   `json:"promotion_slots,omitempty"`
+	RelegationSlots *int   `json:"relegation_slots,omitempty"`
+}
+
+// RegisterRoutes registers the season routes
+func (c *SeasonController) RegisterRoutes(router *mux.Router, authMiddleware func(http.Handler) http.Handler) {
+	api := router.PathPrefix("/api").Subrouter()
+	api.Use(authMiddleware)
+
+	api.HandleFunc("/groups/{id}/seasons", c.getGroupSeasons).Methods("GET")
+	api.HandleFunc("/groups/{id}/seasons", c.createSeason).Methods("POST")
+	api.HandleFunc("/groups/{id}/seasons/current", c.getCurrentSeason).Methods("GET")
+	api.HandleFunc("/groups/{id}/seasons/{seasonId}", c.getSeason).Methods("GET")
+}
+
+// getGroupSeasons handles GET /api/groups/{id}/seasons
+func (c *SeasonController) getGroupSeasons(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute query
+	result, err := c.getGroupSeasonsHandler.Handle(r.Context(), &queries.GetGroupSeasonsQuery{
+		GroupID: groupID,
+		UserID:  userID,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// createSeason handles POST /api/groups/{id}/seasons
+func (c *SeasonController) createSeason(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	// Parse request body
+	var req createSeasonRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeInvalidInput(w, "Invalid request body")
+		return
+	}
+
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		writeInvalidInput(w, "Invalid start_date format, expected YYYY-MM-DD")
+		return
+	}
+	endDate, err := time.Parse("2006-01-02", req.EndDate)
+	if err != nil {
+		writeInvalidInput(w, "Invalid end_date format, expected YYYY-MM-DD")
+		return
+	}
+
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute command
+	result, err := c.createSeasonHandler.Handle(r.Context(), &commands.CreateSeasonCommand{
+		GroupID:         groupID,
+		UserID:          userID,
+		Name:            req.Name,
+		StartDate:       startDate,
+		EndDate:         endDate,
+		PromotionSlots:  req.PromotionSlots,
+		RelegationSlots: req.RelegationSlots,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(result)
+}
+
+// getCurrentSeason handles GET /api/groups/{id}/seasons/current
+func (c *SeasonController) getCurrentSeason(w http.ResponseWriter, r *http.Request) {
+	c.getSeasonStandings(w, r, false)
+}
+
+// getSeason handles GET /api/groups/{id}/seasons/{seasonId}
+func (c *SeasonController) getSeason(w http.ResponseWriter, r *http.Request) {
+	c.getSeasonStandings(w, r, true)
+}
+
+// getSeasonStandings returns the standings of the season in the path, or of the current season
+func (c *SeasonController) getSeasonStandings(w http.ResponseWriter, r *http.Request, withSeasonID bool) {
+	// Parse path parameters
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	query := &queries.GetSeasonStandingsQuery{GroupID: groupID}
+	if withSeasonID {
+		seasonID, err := uuid.Parse(vars["seasonId"])
+		if err != nil {
+			writeInvalidInput(w, "Invalid season ID")
+			return
+		}
+		query.SeasonID = &seasonID
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+	query.UserID = userID
+
+	// Execute query
+	result, err := c.getSeasonStandingsHandler.Handle(r.Context(), query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,167 @@
+package http
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/application/queries"
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/auth"
+)
+
+// ShareCardController handles HTTP requests for Pet of the Day share cards
+type ShareCardController struct {
+	getPetOfTheDayCardHandler      *queries.GetPetOfTheDayCardHandler
+	getShareCardSettingsHandler    *queries.GetShareCardSettingsHandler
+	updateShareCardSettingsHandler *commands.UpdateShareCardSettingsHandler
+}
+
+// NewShareCardController creates a new share card controller
+func NewShareCardController(
+	getPetOfTheDayCardHandler *queries.GetPetOfTheDayCardHandler,
+	getShareCardSettingsHandler *queries.GetShareCardSettingsHandler,
+	updateShareCardSettingsHandler *commands.UpdateShareCardSettingsHandler,
+) *ShareCardController {
+	return &ShareCardController{
+		getPetOfTheDayCardHandler:      getPetOfTheDayCardHandler,
+		getShareCardSettingsHandler:    getShareCardSettingsHandler,
+		updateShareCardSettingsHandler: updateShareCardSettingsHandler,
+	}
+}
+
+// updateShareCardSettingsRequest is the body of PUT /api/groups/{id}/share-card
+type updateShareCardSettingsRequest struct {
+	Template domain.CardTemplate `json:"template"`
+	Font     domain.CardFont     `json:"font"`
+}
+
+// RegisterRoutes registers the share card routes
+func (c *ShareCardController) RegisterRoutes(router *mux.Router, authMiddleware func(http.Handler) http.Handler) {
+	api := router.PathPrefix("/api").Subrouter()
+	api.Use(authMiddleware)
+
+	api.HandleFunc("/groups/{id}/pet-of-the-day/{date}/card.png", c.getCard).Methods("GET")
+	api.HandleFunc("/groups/{id}/share-card", c.getSettings).Methods("GET")
+	api.HandleFunc("/groups/{id}/share-card", c.updateSettings).Methods("PUT")
+}
+
+// getCard handles GET /api/groups/{id}/pet-of-the-day/{date}/card.png?pet_id=
+func (c *ShareCardController) getCard(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameters
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+	date, err := time.Parse("2006-01-02", vars["date"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid date format (expected YYYY-MM-DD)")
+		return
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute query
+	result, err := c.getPetOfTheDayCardHandler.Handle(r.Context(), &queries.GetPetOfTheDayCardQuery{
+		GroupID: groupID,
+		Date:    date,
+		UserID:  userID,
+		PetID:   parseUUIDParam(r.URL.Query().Get("pet_id")),
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	defer result.Content.Close()
+
+	// Stream the card, it is private to the members of the group
+	w.Header().Set("Content-Type", "image/png")
+	w.Header().Set("Cache-Control", "private, max-age=3600")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	io.Copy(w, result.Content)
+}
+
+// getSettings handles GET /api/groups/{id}/share-card
+func (c *ShareCardController) getSettings(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute query
+	settings, err := c.getShareCardSettingsHandler.Handle(r.Context(), &queries.GetShareCardSettingsQuery{
+		GroupID: groupID,
+		UserID:  userID,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(settings)
+}
+
+// updateSettings handles PUT /api/groups/{id}/share-card
+func (c *ShareCardController) updateSettings(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	// Parse request body
+	var req updateShareCardSettingsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeInvalidInput(w, "Invalid request body")
+		return
+	}
+
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute command
+	result, err := c.updateShareCardSettingsHandler.Handle(r.Context(), &commands.UpdateShareCardSettingsCommand{
+		GroupID:  groupID,
+		UserID:   userID,
+		Template: req.Template,
+		Font:     req.Font,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
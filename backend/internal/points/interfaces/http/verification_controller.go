@@ -0,0 +1,247 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/application/queries"
+	"pet-of-the-day/internal/points/domain"
+	"pet-of-the-day/internal/shared/auth"
+)
+
+// VerificationController handles HTTP requests for the peer verification of behavior logs in groups
+type VerificationController struct {
+	getVerificationPolicyHandler    *queries.GetVerificationPolicyHandler
+	getReviewQueueHandler           *queries.GetReviewQueueHandler
+	updateVerificationPolicyHandler *commands.UpdateVerificationPolicyHandler
+	reviewBehaviorLogHandler        *commands.ReviewBehaviorLogHandler
+}
+
+// NewVerificationController creates a new verification controller
+func NewVerificationController(
+	getVerificationPolicyHandler *queries.GetVerificationPolicyHandler,
+	getReviewQueueHandler *queries.GetReviewQueueHandler,
+	updateVerificationPolicyHandler *commands.UpdateVerificationPolicyHandler,
+	reviewBehaviorLogHandler *commands.ReviewBehaviorLogHandler,
+) *VerificationController {
+	return &VerificationController{
+		getVerificationPolicyHandler:    getVerificationPolicyHandler,
+		getReviewQueueHandler:           getReviewQueueHandler,
+		updateVerificationPolicyHandler: updateVerificationPolicyHandler,
+		reviewBehaviorLogHandler:        reviewBehaviorLogHandler,
+	}
+}
+
+// updateVerificationPolicyRequest is the body of PUT /api/groups/{id}/verification
+type updateVerificationPolicyRequest struct {
+	Mode            domain.VerificationMode `json:"mode"`
+	Threshold       int                     `json:"threshold"`
+	AutoHoldFlagged bool                    `json:"auto_hold_flagged"`
+}
+
+// disputeBehaviorLogRequest is the body of POST /api/groups/{id}/behavior-logs/{logId}/dispute
+type disputeBehaviorLogRequest struct {
+	Reason string `json:"reason"`
+}
+
+// resolveDisputeRequest is the body of POST /api/groups/{id}/behavior-logs/{logId}/resolve
+type resolveDisputeRequest struct {
+	Uphold bool `json:"uphold"`
+}
+
+// RegisterRoutes registers the verification routes
+func (c *VerificationController) RegisterRoutes(router *mux.Router, authMiddleware func(http.Handler) http.Handler) {
+	api := router.PathPrefix("/api").Subrouter()
+	api.Use(authMiddleware)
+
+	api.HandleFunc("/groups/{id}/verification", c.getVerificationPolicy).Methods("GET")
+	api.HandleFunc("/groups/{id}/verification", c.updateVerificationPolicy).Methods("PUT")
+	api.HandleFunc("/groups/{id}/behavior-logs/review", c.getReviewQueue).Methods("GET")
+	api.HandleFunc("/groups/{id}/behavior-logs/{logId}/confirm", c.confirmBehaviorLog).Methods("POST")
+	api.HandleFunc("/groups/{id}/behavior-logs/{logId}/dispute", c.disputeBehaviorLog).Methods("POST")
+	api.HandleFunc("/groups/{id}/behavior-logs/{logId}/resolve", c.resolveDispute).Methods("POST")
+}
+
+// getVerificationPolicy handles GET /api/groups/{id}/verification
+func (c *VerificationController) getVerificationPolicy(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute query
+	policy, err := c.getVerificationPolicyHandler.Handle(r.Context(), &queries.GetVerificationPolicyQuery{
+		GroupID: groupID,
+		UserID:  userID,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(policy)
+}
+
+// updateVerificationPolicy handles PUT /api/groups/{id}/verification
+func (c *VerificationController) updateVerificationPolicy(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	// Parse request body
+	var req updateVerificationPolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeInvalidInput(w, "Invalid request body")
+		return
+	}
+
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute command
+	result, err := c.updateVerificationPolicyHandler.Handle(r.Context(), &commands.UpdateVerificationPolicyCommand{
+		GroupID:         groupID,
+		UserID:          userID,
+		Mode:            req.Mode,
+		Threshold:       req.Threshold,
+		AutoHoldFlagged: req.AutoHoldFlagged,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// getReviewQueue handles GET /api/groups/{id}/behavior-logs/review?status=pending|disputed|rejected|verified
+func (c *VerificationController) getReviewQueue(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute query
+	result, err := c.getReviewQueueHandler.Handle(r.Context(), &queries.GetReviewQueueQuery{
+		GroupID: groupID,
+		UserID:  userID,
+		Status:  domain.ShareStatus(r.URL.Query().Get("status")),
+		Limit:   parseIntParam(r.URL.Query().Get("limit"), 50),
+		Offset:  parseIntParam(r.URL.Query().Get("offset"), 0),
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// confirmBehaviorLog handles POST /api/groups/{id}/behavior-logs/{logId}/confirm
+func (c *VerificationController) confirmBehaviorLog(w http.ResponseWriter, r *http.Request) {
+	c.reviewBehaviorLog(w, r, commands.ReviewActionConfirm, "")
+}
+
+// disputeBehaviorLog handles POST /api/groups/{id}/behavior-logs/{logId}/dispute
+func (c *VerificationController) disputeBehaviorLog(w http.ResponseWriter, r *http.Request) {
+	var req disputeBehaviorLogRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeInvalidInput(w, "Invalid request body")
+		return
+	}
+
+	c.reviewBehaviorLog(w, r, commands.ReviewActionDispute, req.Reason)
+}
+
+// resolveDispute handles POST /api/groups/{id}/behavior-logs/{logId}/resolve
+func (c *VerificationController) resolveDispute(w http.ResponseWriter, r *http.Request) {
+	var req resolveDisputeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeInvalidInput(w, "Invalid request body")
+		return
+	}
+
+	action := commands.ReviewActionReject
+	if req.Uphold {
+		action = commands.ReviewActionUphold
+	}
+	c.reviewBehaviorLog(w, r, action, "")
+}
+
+// reviewBehaviorLog runs a review action on the behavior log and group of the path
+func (c *VerificationController) reviewBehaviorLog(w http.ResponseWriter, r *http.Request, action commands.ReviewAction, reason string) {
+	// Parse path parameters
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+	behaviorLogID, err := uuid.Parse(vars["logId"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid behavior log ID")
+		return
+	}
+
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute command
+	result, err := c.reviewBehaviorLogHandler.Handle(r.Context(), &commands.ReviewBehaviorLogCommand{
+		BehaviorLogID: behaviorLogID,
+		GroupID:       groupID,
+		UserID:        userID,
+		Action:        action,
+		Reason:        reason,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,206 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"pet-of-the-day/internal/points/application/commands"
+	"pet-of-the-day/internal/points/application/queries"
+	"pet-of-the-day/internal/shared/auth"
+)
+
+// VotingController handles HTTP requests for Pet of the Day voting
+type VotingController struct {
+	getVotingPolicyHandler     *queries.GetVotingPolicyHandler
+	getPetOfTheDayVotesHandler *queries.GetPetOfTheDayVotesHandler
+	updateVotingPolicyHandler  *commands.UpdateVotingPolicyHandler
+	castPetOfTheDayVoteHandler *commands.CastPetOfTheDayVoteHandler
+}
+
+// NewVotingController creates a new voting controller
+func NewVotingController(
+	getVotingPolicyHandler *queries.GetVotingPolicyHandler,
+	getPetOfTheDayVotesHandler *queries.GetPetOfTheDayVotesHandler,
+	updateVotingPolicyHandler *commands.UpdateVotingPolicyHandler,
+	castPetOfTheDayVoteHandler *commands.CastPetOfTheDayVoteHandler,
+) *VotingController {
+	return &VotingController{
+		getVotingPolicyHandler:     getVotingPolicyHandler,
+		getPetOfTheDayVotesHandler: getPetOfTheDayVotesHandler,
+		updateVotingPolicyHandler:  updateVotingPolicyHandler,
+		castPetOfTheDayVoteHandler: castPetOfTheDayVoteHandler,
+	}
+}
+
+// updateVotingPolicyRequest is the body of PUT /api/groups/{id}/voting
+type updateVotingPolicyRequest struct {
+	Enabled        bool `json:"enabled"`
+	WindowHours    int  `json:"window_hours"`
+	WeightByPoints bool `json:"weight_by_points"`
+}
+
+// castVoteRequest is the body of POST /api/groups/{id}/votes
+type castVoteRequest struct {
+	PetID uuid.UUID `json:"pet_id"`
+}
+
+// RegisterRoutes registers the voting routes
+func (c *VotingController) RegisterRoutes(router *mux.Router, authMiddleware func(http.Handler) http.Handler) {
+	api := router.PathPrefix("/api").Subrouter()
+	api.Use(authMiddleware)
+
+	api.HandleFunc("/groups/{id}/voting", c.getVotingPolicy).Methods("GET")
+	api.HandleFunc("/groups/{id}/voting", c.updateVotingPolicy).Methods("PUT")
+	api.HandleFunc("/groups/{id}/votes", c.getVotes).Methods("GET")
+	api.HandleFunc("/groups/{id}/votes", c.castVote).Methods("POST")
+}
+
+// getVotingPolicy handles GET /api/groups/{id}/voting
+func (c *VotingController) getVotingPolicy(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute query
+	policy, err := c.getVotingPolicyHandler.Handle(r.Context(), &queries.GetVotingPolicyQuery{
+		GroupID: groupID,
+		UserID:  userID,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(policy)
+}
+
+// updateVotingPolicy handles PUT /api/groups/{id}/voting
+func (c *VotingController) updateVotingPolicy(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	// Parse request body
+	var req updateVotingPolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeInvalidInput(w, "Invalid request body")
+		return
+	}
+
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute command
+	result, err := c.updateVotingPolicyHandler.Handle(r.Context(), &commands.UpdateVotingPolicyCommand{
+		GroupID:        groupID,
+		UserID:         userID,
+		Enabled:        req.Enabled,
+		WindowHours:    req.WindowHours,
+		WeightByPoints: req.WeightByPoints,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// getVotes handles GET /api/groups/{id}/votes
+func (c *VotingController) getVotes(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	// Get user ID from context for authorization
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute query
+	result, err := c.getPetOfTheDayVotesHandler.Handle(r.Context(), &queries.GetPetOfTheDayVotesQuery{
+		GroupID: groupID,
+		UserID:  userID,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// castVote handles POST /api/groups/{id}/votes
+func (c *VotingController) castVote(w http.ResponseWriter, r *http.Request) {
+	// Parse path parameter
+	vars := mux.Vars(r)
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		writeInvalidInput(w, "Invalid group ID")
+		return
+	}
+
+	// Parse request body
+	var req castVoteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeInvalidInput(w, "Invalid request body")
+		return
+	}
+
+	// Get user ID from context
+	userID, err := auth.GetUserIDFromContext(r.Context())
+	if err != nil {
+		writeUnauthorized(w, "User not authenticated")
+		return
+	}
+
+	// Execute command
+	result, err := c.castPetOfTheDayVoteHandler.Handle(r.Context(), &commands.CastPetOfTheDayVoteCommand{
+		GroupID: groupID,
+		PetID:   req.PetID,
+		UserID:  userID,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// Return response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(result)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/gorilla/websocket"
 
 	"pet-of-the-day/internal/points/application/queries"
+	"pet-of-the-day/internal/points/domain"
 	"pet-of-the-day/internal/shared/auth"
 	"pet-of-the-day/internal/shared/events"
 )
@@ -42,17 +43,25 @@ type Connection struct {
 	UserID   uuid.UUID
 	GroupID  uuid.UUID
 	LastPing time.Time
-	Send     chan []byte
-	Done     chan struct{}
+	// Ranking period the client follows (guarded by the handler's mutex)
+	Subscription RankingSubscription
+	Send         chan []byte
+	Done         chan struct{}
 }
 
 // Message types for WebSocket communication
 const (
-	MessageTypeRankingsUpdate = "rankings_update"
+	MessageTypeRankingsUpdate    = "rankings_update"
 	MessageTypePetOfTheDayUpdate = "pet_of_the_day_update"
-	MessageTypeError = "error"
-	MessageTypePing = "ping"
-	MessageTypePong = "pong"
+	MessageTypeBehaviorsUpdated  = "behaviors_updated"
+	MessageTypeBadgeUnlocked     = "badge_unlocked"
+	MessageTypeCommentPosted     = "comment_posted"
+	MessageTypeLateCorrection    = "late_correction"
+	MessageTypeVoteTallies       = "vote_tallies"
+	MessageTypeSubscribe         = "subscribe"
+	MessageTypeError             = "error"
+	MessageTypePing              = "ping"
+	MessageTypePong              = "pong"
 )
 
 // WebSocket message structure
@@ -63,6 +72,26 @@ type WebSocketMessage struct {
 	Timestamp time.Time   `json:"timestamp"`
 }
 
+// RankingSubscription is the ranking period a connection receives updates for
+type RankingSubscription struct {
+	Period   domain.RankingPeriod `json:"period"`
+	DateFrom *time.Time           `json:"date_from,omitempty"`
+	DateTo   *time.Time           `json:"date_to,omitempty"`
+}
+
+// subscribeRequest is the payload of a subscribe message; dates use YYYY-MM-DD
+type subscribeRequest struct {
+	Period   string `json:"period"`
+	DateFrom string `json:"date_from"`
+	DateTo   string `json:"date_to"`
+}
+
+// clientMessage is a message received from a client
+type clientMessage struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
 // NewRankingsHandler creates a new WebSocket rankings handler
 func NewRankingsHandler(
 	getGroupRankingsHandler *queries.GetGroupRankingsHandler,
@@ -94,7 +123,8 @@ func NewRankingsHandler(
 	return handler
 }
 
-// HandleConnection handles a new WebSocket connection for group rankings
+// HandleConnection handles a new WebSocket connection for group rankings.
+// The period, date_from and date_to query parameters select the rankings to follow (today by default).
 func (h *RankingsHandler) HandleConnection(w http.ResponseWriter, r *http.Request, groupID uuid.UUID) {
 	// Get user ID from context
 	userID, err := auth.GetUserIDFromContext(r.Context())
@@ -103,6 +133,16 @@ func (h *RankingsHandler) HandleConnection(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	subscription, err := parseRankingSubscription(subscribeRequest{
+		Period:   r.URL.Query().Get("period"),
+		DateFrom: r.URL.Query().Get("date_from"),
+		DateTo:   r.URL.Query().Get("date_to"),
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	// Upgrade HTTP connection to WebSocket
 	conn, err := h.upgrader.Upgrade(w, r, nil)
 	if err != nil {
@@ -120,6 +160,8 @@ func (h *RankingsHandler) HandleConnection(w http.ResponseWriter, r *http.Reques
 		LastPing: time.Now(),
 		Send:     make(chan []byte, 256),
 		Done:     make(chan struct{}),
+
+		Subscription: subscription,
 	}
 
 	// Register connection
@@ -192,22 +234,12 @@ func (h *RankingsHandler) sendInitialData(conn *Connection) {
 	ctx := context.Background()
 
 	// Send current rankings
-	rankingsQuery := &queries.GetGroupRankingsQuery{
-		GroupID: conn.GroupID,
-		Date:    time.Now().UTC(),
-		UserID:  conn.UserID,
-	}
-
-	rankings, err := h.getGroupRankingsHandler.Handle(ctx, rankingsQuery)
-	if err == nil {
-		h.sendMessage(conn, MessageTypeRankingsUpdate, rankings)
-	}
+	h.sendRankings(ctx, conn)
 
 	// Send current Pet of the Day
 	potdQuery := &queries.GetPetOfTheDayQuery{
 		GroupID: conn.GroupID,
-		Date:    time.Now().UTC().AddDate(0, 0, -1), // Yesterday's winner
-		UserID:  conn.UserID,
+		UserID:  conn.UserID, // Winner of the group's last closed day
 	}
 
 	petOfTheDay, err := h.getPetOfTheDayHandler.Handle(ctx, potdQuery)
@@ -235,7 +267,7 @@ func (h *RankingsHandler) readPump(conn *Connection) {
 		case <-conn.Done:
 			return
 		default:
-			var msg WebSocketMessage
+			var msg clientMessage
 			err := conn.Conn.ReadJSON(&msg)
 			if err != nil {
 				if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
@@ -244,14 +276,70 @@ func (h *RankingsHandler) readPump(conn *Connection) {
 				return
 			}
 
-			// Handle ping messages
-			if msg.Type == MessageTypePing {
+			switch msg.Type {
+			case MessageTypePing:
 				h.sendMessage(conn, MessageTypePong, nil)
+			case MessageTypeSubscribe:
+				h.handleSubscribe(conn, msg.Data)
 			}
 		}
 	}
 }
 
+// handleSubscribe switches a connection to another ranking period and sends its rankings
+func (h *RankingsHandler) handleSubscribe(conn *Connection, data json.RawMessage) {
+	var request subscribeRequest
+	if err := json.Unmarshal(data, &request); err != nil {
+		h.sendError(conn, "Invalid subscribe message")
+		return
+	}
+
+	subscription, err := parseRankingSubscription(request)
+	if err != nil {
+		h.sendError(conn, err.Error())
+		return
+	}
+
+	h.mu.Lock()
+	conn.Subscription = subscription
+	h.mu.Unlock()
+
+	h.sendRankings(context.Background(), conn)
+}
+
+// sendRankings sends the rankings of the connection's subscribed period
+func (h *RankingsHandler) sendRankings(ctx context.Context, conn *Connection) {
+	h.mu.RLock()
+	subscription := conn.Subscription
+	h.mu.RUnlock()
+
+	rankings, err := h.getGroupRankingsHandler.Handle(ctx, subscription.query(conn.GroupID, conn.UserID))
+	if err != nil {
+		h.sendError(conn, "Failed to load rankings")
+		return
+	}
+
+	h.sendMessage(conn, MessageTypeRankingsUpdate, rankings)
+}
+
+// sendError sends an error message to a specific connection
+func (h *RankingsHandler) sendError(conn *Connection, message string) {
+	messageBytes, err := json.Marshal(WebSocketMessage{
+		Type:      MessageTypeError,
+		Error:     message,
+		Timestamp: time.Now(),
+	})
+	if err != nil {
+		return
+	}
+
+	select {
+	case conn.Send <- messageBytes:
+	case <-conn.Done:
+	default:
+	}
+}
+
 // writePump handles outgoing WebSocket messages to the client
 func (h *RankingsHandler) writePump(conn *Connection) {
 	ticker := time.NewTicker(54 * time.Second)
@@ -338,95 +426,220 @@ func (h *RankingsHandler) broadcastToGroup(groupID uuid.UUID, msgType string, da
 // subscribeToEvents sets up event listeners for behavior-related events
 func (h *RankingsHandler) subscribeToEvents() {
 	// Listen for behavior log events
-	h.eventBus.Subscribe("behavior_log_created", h.handleBehaviorLogEvent)
-	h.eventBus.Subscribe("behavior_log_updated", h.handleBehaviorLogEvent)
-	h.eventBus.Subscribe("behavior_log_deleted", h.handleBehaviorLogEvent)
+	h.eventBus.Subscribe(domain.BehaviorLogCreatedEventType, events.HandlerFunc(h.handleBehaviorLogEvent))
+	h.eventBus.Subscribe(domain.BehaviorLogDeletedEventType, events.HandlerFunc(h.handleBehaviorLogEvent))
+	h.eventBus.Subscribe(domain.BehaviorLogReviewedEventType, events.HandlerFunc(h.handleBehaviorLogEvent))
 
 	// Listen for Pet of the Day events
-	h.eventBus.Subscribe("pet_of_the_day_selected", h.handlePetOfTheDayEvent)
+	h.eventBus.Subscribe(domain.PetOfTheDaySelectedEventType, events.HandlerFunc(h.handlePetOfTheDayEvent))
+
+	// Listen for behavior catalog changes
+	for _, eventType := range domain.BehaviorCatalogEventTypes {
+		h.eventBus.Subscribe(eventType, events.HandlerFunc(h.handleBehaviorCatalogEvent))
+	}
+
+	// Listen for badge unlocks
+	h.eventBus.Subscribe(domain.BadgeAwardedEventType, events.HandlerFunc(h.handleBadgeAwardedEvent))
+
+	// Refresh rankings once per group for a batch of behavior logs
+	h.eventBus.Subscribe(domain.BehaviorLogBatchCreatedEventType, events.HandlerFunc(h.handleBehaviorLogBatchCreatedEvent))
+
+	// Listen for comments, which are only visible in the group they were posted in
+	h.eventBus.Subscribe(domain.CommentPostedEventType, events.HandlerFunc(h.handleCommentPostedEvent))
+
+	// Tell groups when a closed day was corrected
+	h.eventBus.Subscribe(domain.LateCorrectionEventType, events.HandlerFunc(h.handleLateCorrectionEvent))
+
+	// Broadcast live vote tallies of groups that vote for Pet of the Day
+	h.eventBus.Subscribe(domain.PetOfTheDayVoteCastEventType, events.HandlerFunc(h.handleVoteCastEvent))
 }
 
-// handleBehaviorLogEvent handles behavior log events and broadcasts updated rankings
-func (h *RankingsHandler) handleBehaviorLogEvent(eventData interface{}) {
-	// Parse event data to extract group IDs
-	data, ok := eventData.(map[string]interface{})
+// handleBehaviorLogBatchCreatedEvent broadcasts the rankings of each group of a batch of behavior logs
+func (h *RankingsHandler) handleBehaviorLogBatchCreatedEvent(ctx context.Context, event events.Event) error {
+	batchEvent, ok := event.(*domain.BehaviorLogBatchCreatedEvent)
 	if !ok {
-		return
+		return nil
 	}
 
-	groupIDsInterface, exists := data["group_ids"]
-	if !exists {
-		return
+	for _, groupID := range batchEvent.GroupIDs {
+		go h.broadcastUpdatedRankings(groupID)
 	}
+	return nil
+}
 
-	groupIDs, ok := groupIDsInterface.([]uuid.UUID)
+// handleCommentPostedEvent sends a new comment to the connections of its group
+func (h *RankingsHandler) handleCommentPostedEvent(ctx context.Context, event events.Event) error {
+	commentEvent, ok := event.(*domain.CommentPostedEvent)
 	if !ok {
-		return
+		return nil
 	}
 
-	// Broadcast updated rankings to affected groups
-	for _, groupID := range groupIDs {
-		go h.broadcastUpdatedRankings(groupID)
+	h.broadcastToGroup(commentEvent.GroupID, MessageTypeCommentPosted, map[string]interface{}{
+		"comment_id":      commentEvent.CommentID,
+		"behavior_log_id": commentEvent.BehaviorLogID,
+		"parent_id":       commentEvent.ParentID,
+		"user_id":         commentEvent.UserID,
+		"body":            commentEvent.Body,
+		"posted_at":       commentEvent.OccurredAt(),
+	})
+	return nil
+}
+
+// handleLateCorrectionEvent notifies the connections of a group that a closed day changed
+func (h *RankingsHandler) handleLateCorrectionEvent(ctx context.Context, event events.Event) error {
+	correctionEvent, ok := event.(*domain.LateCorrectionEvent)
+	if !ok {
+		return nil
 	}
+
+	h.broadcastToGroup(correctionEvent.GroupID, MessageTypeLateCorrection, map[string]interface{}{
+		"correction_id":       correctionEvent.CorrectionID,
+		"date":                correctionEvent.Date.Format("2006-01-02"),
+		"cause":               correctionEvent.Cause,
+		"behavior_log_id":     correctionEvent.BehaviorLogID,
+		"pet_id":              correctionEvent.PetID,
+		"previous_winner_ids": correctionEvent.PreviousWinnerIDs,
+		"winner_ids":          correctionEvent.WinnerIDs,
+		"winners_changed":     correctionEvent.WinnersChanged,
+	})
+	return nil
 }
 
-// handlePetOfTheDayEvent handles Pet of the Day selection events
-func (h *RankingsHandler) handlePetOfTheDayEvent(eventData interface{}) {
-	data, ok := eventData.(map[string]interface{})
+// handleVoteCastEvent sends the updated vote tallies of a day to the connections of its group
+func (h *RankingsHandler) handleVoteCastEvent(ctx context.Context, event events.Event) error {
+	voteEvent, ok := event.(*domain.PetOfTheDayVoteCastEvent)
 	if !ok {
-		return
+		return nil
 	}
 
-	groupIDInterface, exists := data["group_id"]
-	if !exists {
-		return
+	h.broadcastToGroup(voteEvent.GroupID, MessageTypeVoteTallies, map[string]interface{}{
+		"date":    voteEvent.Date.Format("2006-01-02"),
+		"pet_id":  voteEvent.PetID,
+		"tallies": voteEvent.Tallies,
+	})
+	return nil
+}
+
+// handleBadgeAwardedEvent notifies the connections of the user who unlocked a badge
+func (h *RankingsHandler) handleBadgeAwardedEvent(ctx context.Context, event events.Event) error {
+	badgeEvent, ok := event.(*domain.BadgeAwardedEvent)
+	if !ok {
+		return nil
 	}
 
-	groupID, ok := groupIDInterface.(uuid.UUID)
+	h.sendToUser(badgeEvent.UserID, MessageTypeBadgeUnlocked, map[string]interface{}{
+		"badge_id":   badgeEvent.BadgeID,
+		"badge":      badgeEvent.Rule,
+		"pet_id":     badgeEvent.PetID,
+		"awarded_at": badgeEvent.OccurredAt(),
+	})
+	return nil
+}
+
+// sendToUser sends a message to every open connection of a user
+func (h *RankingsHandler) sendToUser(userID uuid.UUID, msgType string, data interface{}) {
+	h.mu.RLock()
+	conns := make([]*Connection, 0)
+	for _, conn := range h.connections {
+		if conn.UserID == userID {
+			conns = append(conns, conn)
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, conn := range conns {
+		h.sendMessage(conn, msgType, data)
+	}
+}
+
+// handleBehaviorCatalogEvent tells every client to refresh its behavior list
+func (h *RankingsHandler) handleBehaviorCatalogEvent(ctx context.Context, event events.Event) error {
+	h.broadcastToAll(MessageTypeBehaviorsUpdated, map[string]interface{}{
+		"event":       event.EventType(),
+		"behavior_id": event.AggregateID(),
+	})
+	return nil
+}
+
+// broadcastToAll sends a message to every open connection
+func (h *RankingsHandler) broadcastToAll(msgType string, data interface{}) {
+	h.mu.RLock()
+	conns := make([]*Connection, 0, len(h.connections))
+	for _, conn := range h.connections {
+		conns = append(conns, conn)
+	}
+	h.mu.RUnlock()
+
+	for _, conn := range conns {
+		h.sendMessage(conn, msgType, data)
+	}
+}
+
+// handleBehaviorLogEvent handles behavior log events and broadcasts updated rankings
+func (h *RankingsHandler) handleBehaviorLogEvent(ctx context.Context, event events.Event) error {
+	var groupIDs []uuid.UUID
+	switch logEvent := event.(type) {
+	case *domain.BehaviorLogCreatedEvent:
+		groupIDs = logEvent.GroupIDs
+	case *domain.BehaviorLogDeletedEvent:
+		groupIDs = logEvent.GroupIDs
+	case *domain.BehaviorLogReviewedEvent:
+		groupIDs = []uuid.UUID{logEvent.GroupID}
+	default:
+		return nil
+	}
+
+	// Broadcast updated rankings to affected groups
+	for _, groupID := range groupIDs {
+		go h.broadcastUpdatedRankings(groupID)
+	}
+	return nil
+}
+
+// handlePetOfTheDayEvent handles Pet of the Day selection events
+func (h *RankingsHandler) handlePetOfTheDayEvent(ctx context.Context, event events.Event) error {
+	selectedEvent, ok := event.(*domain.PetOfTheDaySelectedEvent)
 	if !ok {
-		return
+		return nil
 	}
 
 	// Broadcast Pet of the Day update
-	go h.broadcastPetOfTheDayUpdate(groupID)
+	go h.broadcastPetOfTheDayUpdate(selectedEvent.GroupID)
+	return nil
 }
 
-// broadcastUpdatedRankings fetches and broadcasts current rankings for a group
+// broadcastUpdatedRankings fetches and broadcasts current rankings to every subscriber of a group.
+// Rankings are fetched once per subscribed period.
 func (h *RankingsHandler) broadcastUpdatedRankings(groupID uuid.UUID) {
 	ctx := context.Background()
 
-	// Get a user ID from one of the connections (for authorization)
+	// Group the connections by subscription
 	h.mu.RLock()
-	connectionIDs, exists := h.groups[groupID]
-	if !exists || len(connectionIDs) == 0 {
-		h.mu.RUnlock()
-		return
-	}
-	
-	firstConnID := connectionIDs[0]
-	conn, exists := h.connections[firstConnID]
-	if !exists {
-		h.mu.RUnlock()
-		return
+	subscribers := make(map[string][]*Connection)
+	subscriptions := make(map[string]RankingSubscription)
+	for _, connID := range h.groups[groupID] {
+		if conn, exists := h.connections[connID]; exists {
+			key := conn.Subscription.key()
+			subscribers[key] = append(subscribers[key], conn)
+			subscriptions[key] = conn.Subscription
+		}
 	}
-	userID := conn.UserID
 	h.mu.RUnlock()
 
-	// Fetch updated rankings
-	query := &queries.GetGroupRankingsQuery{
-		GroupID: groupID,
-		Date:    time.Now().UTC(),
-		UserID:  userID,
-	}
+	for key, conns := range subscribers {
+		subscription := subscriptions[key]
 
-	rankings, err := h.getGroupRankingsHandler.Handle(ctx, query)
-	if err != nil {
-		log.Printf("Error fetching rankings for broadcast: %v", err)
-		return
-	}
+		// Any subscriber can be used for authorization
+		rankings, err := h.getGroupRankingsHandler.Handle(ctx, subscription.query(groupID, conns[0].UserID))
+		if err != nil {
+			log.Printf("Error fetching %s rankings for broadcast: %v", subscription.Period, err)
+			continue
+		}
 
-	// Broadcast to group
-	h.broadcastToGroup(groupID, MessageTypeRankingsUpdate, rankings)
+		for _, conn := range conns {
+			h.sendMessage(conn, MessageTypeRankingsUpdate, rankings)
+		}
+	}
 }
 
 // broadcastPetOfTheDayUpdate fetches and broadcasts Pet of the Day update for a group
@@ -440,7 +653,7 @@ func (h *RankingsHandler) broadcastPetOfTheDayUpdate(groupID uuid.UUID) {
 		h.mu.RUnlock()
 		return
 	}
-	
+
 	firstConnID := connectionIDs[0]
 	conn, exists := h.connections[firstConnID]
 	if !exists {
@@ -453,8 +666,7 @@ func (h *RankingsHandler) broadcastPetOfTheDayUpdate(groupID uuid.UUID) {
 	// Fetch Pet of the Day
 	query := &queries.GetPetOfTheDayQuery{
 		GroupID: groupID,
-		Date:    time.Now().UTC().AddDate(0, 0, -1), // Yesterday's winner
-		UserID:  userID,
+		UserID:  userID, // Winner of the group's last closed day
 	}
 
 	petOfTheDay, err := h.getPetOfTheDayHandler.Handle(ctx, query)
@@ -510,9 +722,9 @@ func (h *RankingsHandler) GetConnectionStats() map[string]interface{} {
 	defer h.mu.RUnlock()
 
 	stats := map[string]interface{}{
-		"total_connections": len(h.connections),
+		"total_connections":       len(h.connections),
 		"groups_with_connections": len(h.groups),
-		"connections_by_group": make(map[string]int),
+		"connections_by_group":    make(map[string]int),
 	}
 
 	for groupID, connIDs := range h.groups {
@@ -522,6 +734,54 @@ func (h *RankingsHandler) GetConnectionStats() map[string]interface{} {
 	return stats
 }
 
+// parseRankingSubscription validates a requested ranking period
+func parseRankingSubscription(request subscribeRequest) (RankingSubscription, error) {
+	period, err := domain.ParseRankingPeriod(request.Period)
+	if err != nil {
+		return RankingSubscription{}, err
+	}
+
+	subscription := RankingSubscription{Period: period}
+	if period != domain.RankingPeriodCustom {
+		return subscription, nil
+	}
+
+	from, err := time.Parse("2006-01-02", request.DateFrom)
+	if err != nil {
+		return RankingSubscription{}, fmt.Errorf("invalid date_from (expected YYYY-MM-DD)")
+	}
+	to, err := time.Parse("2006-01-02", request.DateTo)
+	if err != nil {
+		return RankingSubscription{}, fmt.Errorf("invalid date_to (expected YYYY-MM-DD)")
+	}
+	if _, err := domain.NewRankingWindow(period, from, &from, &to); err != nil {
+		return RankingSubscription{}, err
+	}
+
+	subscription.DateFrom = &from
+	subscription.DateTo = &to
+	return subscription, nil
+}
+
+// key identifies subscriptions that receive the same rankings
+func (s RankingSubscription) key() string {
+	if s.DateFrom == nil || s.DateTo == nil {
+		return string(s.Period)
+	}
+	return fmt.Sprintf("%s_%s_%s", s.Period, s.DateFrom.Format("2006-01-02"), s.DateTo.Format("2006-01-02"))
+}
+
+// query builds the rankings query for the subscription
+func (s RankingSubscription) query(groupID, userID uuid.UUID) *queries.GetGroupRankingsQuery {
+	return &queries.GetGroupRankingsQuery{
+		GroupID:  groupID,
+		Period:   s.Period,
+		DateFrom: s.DateFrom,
+		DateTo:   s.DateTo,
+		UserID:   userID,
+	}
+}
+
 // generateConnectionID creates a unique connection identifier
 func generateConnectionID() string {
 	return fmt.Sprintf("conn_%d_%s", time.Now().UnixNano(), uuid.New().String()[:8])
@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// AdminAllowlist grants platform administration rights to a fixed set of users,
+// typically configured through the ADMIN_USER_IDS environment variable
+type AdminAllowlist struct {
+	userIDs map[uuid.UUID]struct{}
+}
+
+// NewAdminAllowlist creates an allowlist from user IDs
+func NewAdminAllowlist(userIDs ...uuid.UUID) *AdminAllowlist {
+	allowlist := &AdminAllowlist{
+		userIDs: make(map[uuid.UUID]struct{}, len(userIDs)),
+	}
+	for _, userID := range userIDs {
+		allowlist.userIDs[userID] = struct{}{}
+	}
+	return allowlist
+}
+
+// ParseAdminAllowlist creates an allowlist from a comma separated list of user IDs
+func ParseAdminAllowlist(value string) (*AdminAllowlist, error) {
+	var userIDs []uuid.UUID
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		userID, err := uuid.Parse(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid admin user ID %q: %w", part, err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	return NewAdminAllowlist(userIDs...), nil
+}
+
+// IsAdmin reports whether the user is a platform administrator
+func (a *AdminAllowlist) IsAdmin(ctx context.Context, userID uuid.UUID) (bool, error) {
+	_, ok := a.userIDs[userID]
+	return ok, nil
+}
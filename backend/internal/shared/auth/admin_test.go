@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestParseAdminAllowlist(t *testing.T) {
+	adminID := uuid.New()
+	otherAdminID := uuid.New()
+
+	allowlist, err := ParseAdminAllowlist(adminID.String() + ", " + otherAdminID.String() + ",")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	for _, userID := range []uuid.UUID{adminID, otherAdminID} {
+		isAdmin, _ := allowlist.IsAdmin(context.Background(), userID)
+		if !isAdmin {
+			t.Errorf("Expected %s to be an admin", userID)
+		}
+	}
+
+	isAdmin, _ := allowlist.IsAdmin(context.Background(), uuid.New())
+	if isAdmin {
+		t.Error("Expected unknown user not to be an admin")
+	}
+}
+
+func TestParseAdminAllowlist_InvalidID(t *testing.T) {
+	if _, err := ParseAdminAllowlist("not-a-uuid"); err == nil {
+		t.Error("Expected error for invalid user ID")
+	}
+}
+
+func TestParseAdminAllowlist_Empty(t *testing.T) {
+	allowlist, err := ParseAdminAllowlist("")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	isAdmin, _ := allowlist.IsAdmin(context.Background(), uuid.New())
+	if isAdmin {
+		t.Error("Expected nobody to be an admin")
+	}
+}
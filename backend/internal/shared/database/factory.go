@@ -14,6 +14,9 @@ import (
 	petDomain "pet-of-the-day/internal/pet/domain"
 	petInfra "pet-of-the-day/internal/pet/infrastructure"
 	petInfraEnt "pet-of-the-day/internal/pet/infrastructure/ent"
+	pointsDomain "pet-of-the-day/internal/points/domain"
+	pointsInfraEnt "pet-of-the-day/internal/points/infrastructure/ent"
+	pointsInfraMock "pet-of-the-day/internal/points/infrastructure/mock"
 	sharingDomain "pet-of-the-day/internal/sharing/domain"
 	sharingInfra "pet-of-the-day/internal/sharing/infrastructure"
 	userDomain "pet-of-the-day/internal/user/domain"
@@ -96,6 +99,13 @@ func (f *RepositoryFactory) CreateShareRepository() sharingDomain.ShareRepositor
 	return sharingInfra.NewMockShareRepository()
 }
 
+func (f *RepositoryFactory) CreateUserSettingsRepository() pointsDomain.UserSettingsRepository {
+	if f.entClient != nil {
+		return pointsInfraEnt.NewUserSettingsRepository(f.entClient)
+	}
+	return pointsInfraMock.NewMockUserSettingsRepository()
+}
+
 func (f *RepositoryFactory) GetEntClient() *ent.Client {
 	return f.entClient
 }
@@ -5,6 +5,7 @@ import (
 	// TODO: Re-enable when notebook system compilation issues are fixed
 	// notebookDomain "pet-of-the-day/internal/notebook/domain"
 	petDomain "pet-of-the-day/internal/pet/domain"
+	pointsDomain "pet-of-the-day/internal/points/domain"
 	sharingDomain "pet-of-the-day/internal/sharing/domain"
 	userDomain "pet-of-the-day/internal/user/domain"
 )
@@ -19,6 +20,7 @@ type Factory interface {
 	// CreateNotebookRepository() notebookDomain.NotebookRepository
 	// CreateNotebookEntryRepository() notebookDomain.NotebookEntryRepository
 	CreateShareRepository() sharingDomain.ShareRepository
+	CreateUserSettingsRepository() pointsDomain.UserSettingsRepository
 
 	// Direct client access for bounded contexts that need it
 	GetEntClient() *ent.Client
@@ -13,6 +13,7 @@ const (
 	ErrCodeUnauthorized       ErrorCode = "UNAUTHORIZED"
 	ErrCodeInvalidCredentials ErrorCode = "INVALID_CREDENTIALS"
 	ErrCodeTokenExpired       ErrorCode = "TOKEN_EXPIRED"
+	ErrCodeForbidden          ErrorCode = "FORBIDDEN"
 
 	// Validation errors
 	ErrCodeValidationFailed ErrorCode = "VALIDATION_FAILED"
@@ -25,6 +26,7 @@ const (
 	ErrCodeUserNotFound       ErrorCode = "USER_NOT_FOUND"
 	ErrCodePetNotFound        ErrorCode = "PET_NOT_FOUND"
 	ErrCodePetAlreadyExists   ErrorCode = "PET_ALREADY_EXISTS"
+	ErrCodeNotFound           ErrorCode = "NOT_FOUND"
 
 	// Rate limiting errors
 	ErrCodeRateLimited        ErrorCode = "RATE_LIMITED"
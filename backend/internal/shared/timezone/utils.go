@@ -73,6 +73,33 @@ func GetDailyBoundaryForDate(date time.Time, config UserTimeConfig) (DailyBounda
 	}, nil
 }
 
+// GetDailyBoundaryForDay calculates the daily boundary of a calendar day, whatever the location
+// the day was loaded in, e.g. a date column read back as midnight UTC
+func GetDailyBoundaryForDay(day time.Time, config UserTimeConfig) (DailyBoundary, error) {
+	location, err := GetUserLocation(config.Timezone)
+	if err != nil {
+		return DailyBoundary{}, err
+	}
+
+	return GetDailyBoundaryForDate(time.Date(day.Year(), day.Month(), day.Day(), 12, 0, 0, 0, location), config)
+}
+
+// GetUserDate returns the day a moment belongs to, normalized to midnight in the user's timezone.
+// Moments after the daily reset time belong to the next day.
+func GetUserDate(t time.Time, config UserTimeConfig) (time.Time, error) {
+	boundary, err := GetDailyBoundaryForDate(t, config)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	date := boundary.End
+	if t.After(boundary.End) {
+		date = date.AddDate(0, 0, 1)
+	}
+
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location()), nil
+}
+
 // GetCurrentDailyBoundary calculates the current daily boundary for a user
 func GetCurrentDailyBoundary(config UserTimeConfig) (DailyBoundary, error) {
 	location, err := GetUserLocation(config.Timezone)
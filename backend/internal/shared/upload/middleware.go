@@ -114,16 +114,18 @@ func (s *FileUploadService) isAllowedType(mimeType string) bool {
 	return false
 }
 
+// mimeTypeExtensions lists the file extensions accepted for each detected MIME type, the
+// extension of stored files first
+var mimeTypeExtensions = map[string][]string{
+	"image/jpeg": {".jpg", ".jpeg"},
+	"image/png":  {".png"},
+	"image/gif":  {".gif"},
+	"image/webp": {".webp"},
+}
+
 // isValidExtensionForMimeType validates that file extension matches MIME type
 func (s *FileUploadService) isValidExtensionForMimeType(ext, mimeType string) bool {
-	validExtensions := map[string][]string{
-		"image/jpeg": {".jpg", ".jpeg"},
-		"image/png":  {".png"},
-		"image/gif":  {".gif"},
-		"image/webp": {".webp"},
-	}
-
-	if exts, exists := validExtensions[mimeType]; exists {
+	if exts, exists := mimeTypeExtensions[mimeType]; exists {
 		for _, validExt := range exts {
 			if ext == validExt {
 				return true
@@ -0,0 +1,255 @@
+package upload
+
+import (
+	"fmt"
+	"image"
+	"image/jpeg"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	// Register the decoders of the image types stored images are decoded from
+	_ "image/gif"
+	_ "image/png"
+
+	"github.com/google/uuid"
+)
+
+// ErrThumbnailUnsupported is returned for images the standard library cannot decode, e.g. WebP
+var ErrThumbnailUnsupported = fmt.Errorf("thumbnails are not supported for this file type")
+
+// ErrImageTooLarge is returned for images with more pixels than stored images are decoded for
+var ErrImageTooLarge = fmt.Errorf("image dimensions are too large")
+
+// maxDecodedImagePixels bounds the memory used to decode an image, whatever its file size
+const maxDecodedImagePixels = 50_000_000
+
+// SaveFile validates an uploaded file and writes it under a directory of the upload path with a
+// random name. The returned path is relative to the upload path.
+func (s *FileUploadService) SaveFile(fileHeader *multipart.FileHeader, dir string) (*UploadedFile, error) {
+	if err := s.ValidateFile(fileHeader); err != nil {
+		return nil, err
+	}
+
+	src, err := fileHeader.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open uploaded file: %w", err)
+	}
+	defer src.Close()
+
+	// Read the first 512 bytes to detect content type, like ValidateFile
+	buffer := make([]byte, 512)
+	n, err := src.Read(buffer)
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to read uploaded file: %w", err)
+	}
+	mimeType := http.DetectContentType(buffer[:n])
+
+	// The stored name takes its extension from the content, never from the client
+	path := filepath.Join(dir, uuid.New().String()+extensionForMimeType(mimeType))
+	dst, err := s.create(path)
+	if err != nil {
+		return nil, err
+	}
+	defer dst.Close()
+
+	size, err := io.Copy(dst, io.MultiReader(strings.NewReader(string(buffer[:n])), src))
+	if err != nil {
+		s.DeleteFile(path)
+		return nil, fmt.Errorf("failed to write uploaded file: %w", err)
+	}
+
+	return &UploadedFile{
+		Filename:     filepath.Base(path),
+		OriginalName: filepath.Base(fileHeader.Filename),
+		Size:         size,
+		MimeType:     mimeType,
+		Path:         path,
+	}, nil
+}
+
+// CreateThumbnail writes a JPEG copy of a stored image that fits in a square of the given size,
+// next to the image
+func (s *FileUploadService) CreateThumbnail(file *UploadedFile, maxDimension int) (*UploadedFile, error) {
+	img, err := s.DecodeImage(file.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	path := strings.TrimSuffix(file.Path, filepath.Ext(file.Path)) + "_thumb.jpg"
+	dst, err := s.create(path)
+	if err != nil {
+		return nil, err
+	}
+	defer dst.Close()
+
+	counter := &countingWriter{w: dst}
+	if err := jpeg.Encode(counter, scaleDown(img, maxDimension), &jpeg.Options{Quality: 80}); err != nil {
+		s.DeleteFile(path)
+		return nil, fmt.Errorf("failed to write thumbnail: %w", err)
+	}
+
+	return &UploadedFile{
+		Filename:     filepath.Base(path),
+		OriginalName: file.OriginalName,
+		Size:         counter.n,
+		MimeType:     "image/jpeg",
+		Path:         path,
+	}, nil
+}
+
+// DecodeImage decodes a stored image by its path relative to the upload path. Images the
+// standard library cannot decode return ErrThumbnailUnsupported, and images with more pixels
+// than can safely be held in memory return ErrImageTooLarge.
+func (s *FileUploadService) DecodeImage(path string) (image.Image, error) {
+	src, err := s.OpenFile(path)
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+
+	// Check the dimensions first: a small compressed file can decode into a huge image
+	config, _, err := image.DecodeConfig(src)
+	if err != nil {
+		if err == image.ErrFormat {
+			return nil, ErrThumbnailUnsupported
+		}
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	if int64(config.Width)*int64(config.Height) > maxDecodedImagePixels {
+		return nil, ErrImageTooLarge
+	}
+
+	if _, err := src.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed to read stored file: %w", err)
+	}
+	img, _, err := image.Decode(src)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	return img, nil
+}
+
+// OpenFile opens a stored file by its path relative to the upload path
+func (s *FileUploadService) OpenFile(path string) (*os.File, error) {
+	fullPath, err := s.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(fullPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open stored file: %w", err)
+	}
+	return file, nil
+}
+
+// DeleteFile removes a stored file. Files that are already gone are ignored.
+func (s *FileUploadService) DeleteFile(path string) error {
+	fullPath, err := s.resolve(path)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(fullPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete stored file: %w", err)
+	}
+	return nil
+}
+
+// create creates a stored file and its directory
+func (s *FileUploadService) create(path string) (*os.File, error) {
+	fullPath, err := s.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create upload directory: %w", err)
+	}
+
+	file, err := os.Create(fullPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stored file: %w", err)
+	}
+	return file, nil
+}
+
+// resolve returns the location of a stored file, refusing paths that leave the upload path
+func (s *FileUploadService) resolve(path string) (string, error) {
+	root := filepath.Clean(s.config.UploadPath)
+	fullPath := filepath.Join(root, path)
+	if fullPath != root && !strings.HasPrefix(fullPath, root+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid file path: %s", path)
+	}
+	return fullPath, nil
+}
+
+// extensionForMimeType returns the extension of stored files of a MIME type, none for unknown types
+func extensionForMimeType(mimeType string) string {
+	if exts, exists := mimeTypeExtensions[mimeType]; exists {
+		return exts[0]
+	}
+	return ""
+}
+
+// scaleDown shrinks an image to fit in a square of the given size, averaging the source pixels
+// covered by each thumbnail pixel. Smaller images are returned unchanged.
+func scaleDown(img image.Image, maxDimension int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= maxDimension && height <= maxDimension {
+		return img
+	}
+
+	thumbWidth, thumbHeight := maxDimension, height*maxDimension/width
+	if height > width {
+		thumbWidth, thumbHeight = width*maxDimension/height, maxDimension
+	}
+	if thumbWidth < 1 {
+		thumbWidth = 1
+	}
+	if thumbHeight < 1 {
+		thumbHeight = 1
+	}
+
+	thumb := image.NewRGBA(image.Rect(0, 0, thumbWidth, thumbHeight))
+	for y := 0; y < thumbHeight; y++ {
+		y0, y1 := bounds.Min.Y+y*height/thumbHeight, bounds.Min.Y+(y+1)*height/thumbHeight
+		for x := 0; x < thumbWidth; x++ {
+			x0, x1 := bounds.Min.X+x*width/thumbWidth, bounds.Min.X+(x+1)*width/thumbWidth
+
+			var r, g, b, a, count uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := img.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa)
+					count++
+				}
+			}
+
+			offset := thumb.PixOffset(x, y)
+			thumb.Pix[offset] = uint8(r / count >> 8)
+			thumb.Pix[offset+1] = uint8(g / count >> 8)
+			thumb.Pix[offset+2] = uint8(b / count >> 8)
+			thumb.Pix[offset+3] = uint8(a / count >> 8)
+		}
+	}
+
+	return thumb
+}
+
+// countingWriter counts the bytes written through it
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -62,6 +62,16 @@ CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_score_events_created_at ON score_eve
 CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_pets_user_created ON pets(user_id, created_at DESC);
 CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_notebook_entries_notebook_created ON notebook_entries(notebook_id, created_at DESC);
 CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_notebook_entries_type_date ON notebook_entries(type, date DESC);
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_behavior_logs_pet_logged_at ON behavior_logs(pet_id, logged_at);
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_daily_scores_pet_date ON daily_scores(pet_id, date);
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_daily_scores_group_date_points ON daily_scores(group_id, date, total_points DESC);
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_behavior_logs_logged_at ON behavior_logs(logged_at);
+CREATE UNIQUE INDEX CONCURRENTLY IF NOT EXISTS idx_daily_scores_pet_group_date ON daily_scores(pet_id, group_id, date);
+CREATE UNIQUE INDEX CONCURRENTLY IF NOT EXISTS idx_archived_daily_scores_pet_group_date ON archived_daily_scores(pet_id, group_id, date);
+CREATE UNIQUE INDEX CONCURRENTLY IF NOT EXISTS idx_group_reset_states_group_id ON group_reset_states(group_id);
+CREATE UNIQUE INDEX CONCURRENTLY IF NOT EXISTS idx_badges_pet_code ON badges(pet_id, code) WHERE scope = 'pet';
+CREATE UNIQUE INDEX CONCURRENTLY IF NOT EXISTS idx_badges_user_code ON badges(user_id, code) WHERE scope = 'user';
+CREATE UNIQUE INDEX CONCURRENTLY IF NOT EXISTS idx_pet_of_the_day_votes_group_date_voter ON pet_of_the_day_votes(group_id, date, voter_id);
 
 -- Full-text search indexes (for search functionality)
 CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_pets_name_trgm ON pets USING gin(name gin_trgm_ops) WHERE EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'pg_trgm');